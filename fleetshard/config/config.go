@@ -15,6 +15,11 @@ import (
 const (
 	// EnvDev is the expected value of the environment variable "ENVIRONMENT" for dev deployments of fleetshard-sync
 	EnvDev = "dev"
+
+	// ManagedDBProviderAWS provisions Central databases as AWS RDS Aurora clusters
+	ManagedDBProviderAWS = "aws"
+	// ManagedDBProviderInCluster provisions Central databases as PostgreSQL instances within the data plane cluster
+	ManagedDBProviderInCluster = "in-cluster"
 )
 
 // Config contains this application's runtime configuration.
@@ -32,6 +37,9 @@ type Config struct {
 	MetricsAddress          string        `env:"FLEETSHARD_METRICS_ADDRESS" envDefault:":8080"`
 	LogVerbosity            string        `env:"GLOG_V" envDefault:"1"`
 	DefaultBaseCRDURL       string        `env:"DEFAULT_BASE_CRD_URL" envDefault:"https://raw.githubusercontent.com/stackrox/stackrox/%s/operator/bundle/manifests/"`
+	// CentralExposure defines which resources expose Central outside the cluster. It is one of auto, route, gateway,
+	// ingress or none. With auto, the first one available on the cluster is used.
+	CentralExposure string `env:"CENTRAL_EXPOSURE" envDefault:"auto"`
 	// TenantImagePullSecret can be used to inject a Kubernetes image pull secret into tenant namespaces.
 	// If it is empty, nothing is injected (for example, it is not required when running on OpenShift).
 	// It is required when central images need to fetched from a private Quay registry.
@@ -42,6 +50,7 @@ type Config struct {
 	TenantDefaultArgoCdAppSourcePath           string `env:"TENANT_DEFAULT_ARGOCD_APP_SOURCE_PATH" envDefault:"tenant-resources"`
 	ArgoCdNamespace                            string `env:"ARGOCD_NAMESPACE" envDefault:"openshift-gitops"`
 	ManagedDB                                  ManagedDB
+	Reconcile                                  Reconcile
 	Telemetry                                  Telemetry
 	AuditLogging                               AuditLogging
 	SecretEncryption                           SecretEncryption
@@ -60,6 +69,39 @@ type ManagedDB struct {
 	MinCapacityACU        float32        `env:"MANAGED_DB_MIN_CAPACITY_ACU" envDefault:"0.5"`       // Aurora Capacity Unit (ACU). 1 ACU = 1 vCPU + 2GB RAM
 	MaxCapacityACU        float32        `env:"MANAGED_DB_MAX_CAPACITY_ACU" envDefault:"16"`
 	SharedTags            []ManagedDBTag `envPrefix:"MANAGED_DB_TAGS"`
+	Provider              string         `env:"MANAGED_DB_PROVIDER" envDefault:"aws"` // one of: aws, in-cluster
+	Account               string         `env:"MANAGED_DB_ACCOUNT"`                   // cloud account of the databases, e.g. the AWS account ID
+	Region                string         `env:"MANAGED_DB_REGION"`                    // if empty, the region of the provider's default configuration is used
+	InCluster             ManagedDBInCluster
+	OrphanDetection       ManagedDBOrphanDetection
+}
+
+// ManagedDBOrphanDetection for configuring the detection of managed DB resources that belong to no tenant
+type ManagedDBOrphanDetection struct {
+	Enabled     bool          `env:"MANAGED_DB_ORPHAN_DETECTION_ENABLED" envDefault:"false"`
+	Interval    time.Duration `env:"MANAGED_DB_ORPHAN_DETECTION_INTERVAL" envDefault:"1h"`
+	GracePeriod time.Duration `env:"MANAGED_DB_ORPHAN_DETECTION_GRACE_PERIOD" envDefault:"168h"` // how long a resource has to be orphaned before it is deleted
+	DryRun      bool          `env:"MANAGED_DB_ORPHAN_DETECTION_DRY_RUN" envDefault:"true"`      // only report orphaned resources, never delete them
+	// RestoreRetention is how long deleted Centrals can be restored by fleet-manager (central_retention_period_days).
+	// Resources younger than this are never deleted, as a restore needs the DB or its final snapshot.
+	RestoreRetention time.Duration `env:"MANAGED_DB_ORPHAN_DETECTION_RESTORE_RETENTION" envDefault:"168h"`
+}
+
+// ManagedDBInCluster for configuring databases provisioned within the data plane cluster
+type ManagedDBInCluster struct {
+	Namespace    string `env:"MANAGED_DB_IN_CLUSTER_NAMESPACE" envDefault:"rhacs-db"`
+	Image        string `env:"MANAGED_DB_IN_CLUSTER_IMAGE" envDefault:"docker.io/library/postgres:15"`
+	StorageSize  string `env:"MANAGED_DB_IN_CLUSTER_STORAGE_SIZE" envDefault:"20Gi"`
+	StorageClass string `env:"MANAGED_DB_IN_CLUSTER_STORAGE_CLASS"` // if empty, the default storage class of the cluster will be used
+	MaxDatabases int64  `env:"MANAGED_DB_IN_CLUSTER_MAX_DATABASES" envDefault:"100"`
+	SSLMode      string `env:"MANAGED_DB_IN_CLUSTER_SSL_MODE" envDefault:"require"` // one of: disable, require
+}
+
+// Reconcile for configuring the scheduling of Central reconciliations
+type Reconcile struct {
+	Workers        int           `env:"RECONCILE_WORKERS" envDefault:"10"`          // maximum number of parallel Central reconciliations
+	BackoffInitial time.Duration `env:"RECONCILE_BACKOFF_INITIAL" envDefault:"10s"` // delay before retrying a tenant after its first failed reconciliation
+	BackoffMax     time.Duration `env:"RECONCILE_BACKOFF_MAX" envDefault:"10m"`
 }
 
 // ManagedDBTag configures shared managed DB tags
@@ -106,9 +148,11 @@ func GetConfig() (*Config, error) {
 	if c.AuthType == "" {
 		configErrors.AddError(errors.New("AUTH_TYPE unset in the environment"))
 	}
+	validateReconcileConfig(c, &configErrors)
 	validateManagedDBConfig(c, &configErrors)
 	validateSecretEncryptionConfig(c, &configErrors)
 	validateTenantImagePullSecrets(c, &configErrors)
+	validateCentralExposure(c, &configErrors)
 
 	cfgErr := configErrors.ToError()
 	if cfgErr != nil {
@@ -121,8 +165,44 @@ func validateManagedDBConfig(c Config, configErrors *errorhelpers.ErrorList) {
 	if !c.ManagedDB.Enabled {
 		return
 	}
-	if c.ManagedDB.SecurityGroup == "" {
-		configErrors.AddError(errors.New("MANAGED_DB_ENABLED == true and MANAGED_DB_SECURITY_GROUP unset in the environment"))
+	switch c.ManagedDB.Provider {
+	case ManagedDBProviderAWS:
+		if c.ManagedDB.SecurityGroup == "" {
+			configErrors.AddError(errors.New("MANAGED_DB_ENABLED == true and MANAGED_DB_SECURITY_GROUP unset in the environment"))
+		}
+	case ManagedDBProviderInCluster:
+		if c.ManagedDB.InCluster.Namespace == "" {
+			configErrors.AddError(errors.New("MANAGED_DB_PROVIDER == in-cluster and MANAGED_DB_IN_CLUSTER_NAMESPACE unset in the environment"))
+		}
+		if mode := c.ManagedDB.InCluster.SSLMode; mode != "disable" && mode != "require" {
+			configErrors.AddError(fmt.Errorf("MANAGED_DB_IN_CLUSTER_SSL_MODE %q is not supported, use disable or require", mode))
+		}
+	default:
+		configErrors.AddError(fmt.Errorf("MANAGED_DB_PROVIDER %q is not supported", c.ManagedDB.Provider))
+	}
+	// without shared tags the resources of other data plane clusters in the same account can not be told apart
+	if c.ManagedDB.OrphanDetection.Enabled && !c.ManagedDB.OrphanDetection.DryRun && len(c.ManagedDB.SharedTags) == 0 {
+		configErrors.AddError(errors.New("MANAGED_DB_ORPHAN_DETECTION_DRY_RUN == false requires MANAGED_DB_TAGS to be set"))
+	}
+	if c.ManagedDB.OrphanDetection.GracePeriod < c.ManagedDB.OrphanDetection.RestoreRetention {
+		configErrors.AddError(errors.New("MANAGED_DB_ORPHAN_DETECTION_GRACE_PERIOD must not be shorter than MANAGED_DB_ORPHAN_DETECTION_RESTORE_RETENTION"))
+	}
+}
+
+func validateReconcileConfig(c Config, configErrors *errorhelpers.ErrorList) {
+	if c.Reconcile.Workers <= 0 {
+		configErrors.AddError(errors.New("RECONCILE_WORKERS must be greater than zero"))
+	}
+	if c.Reconcile.BackoffMax < c.Reconcile.BackoffInitial {
+		configErrors.AddError(errors.New("RECONCILE_BACKOFF_MAX must not be lower than RECONCILE_BACKOFF_INITIAL"))
+	}
+}
+
+func validateCentralExposure(c Config, configErrors *errorhelpers.ErrorList) {
+	switch c.CentralExposure {
+	case "auto", "route", "gateway", "ingress", "none":
+	default:
+		configErrors.AddError(fmt.Errorf("CENTRAL_EXPOSURE %q is not supported", c.CentralExposure))
 	}
 }
 
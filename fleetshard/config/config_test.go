@@ -42,6 +42,47 @@ func TestSingleton_Failure_WhenManagedDBEnabledAndManagedDbSecurityGroupNotSet(t
 	assert.Nil(t, cfg)
 }
 
+func TestSingleton_Success_WhenManagedDBInCluster(t *testing.T) {
+	t.Setenv("CLUSTER_ID", "some-value")
+	t.Setenv("MANAGED_DB_ENABLED", "true")
+	t.Setenv("MANAGED_DB_PROVIDER", "in-cluster")
+	cfg, err := GetConfig()
+	require.NoError(t, err)
+	assert.Equal(t, ManagedDBProviderInCluster, cfg.ManagedDB.Provider)
+	assert.Equal(t, "rhacs-db", cfg.ManagedDB.InCluster.Namespace)
+	assert.Equal(t, "require", cfg.ManagedDB.InCluster.SSLMode)
+}
+
+func TestSingleton_Failure_WhenManagedDBInClusterSSLModeUnknown(t *testing.T) {
+	t.Setenv("CLUSTER_ID", "some-value")
+	t.Setenv("MANAGED_DB_ENABLED", "true")
+	t.Setenv("MANAGED_DB_PROVIDER", "in-cluster")
+	t.Setenv("MANAGED_DB_IN_CLUSTER_SSL_MODE", "verify-full")
+	cfg, err := GetConfig()
+	assert.ErrorContains(t, err, `MANAGED_DB_IN_CLUSTER_SSL_MODE "verify-full" is not supported`)
+	assert.Nil(t, cfg)
+}
+
+func TestSingleton_Failure_WhenManagedDBProviderUnknown(t *testing.T) {
+	t.Setenv("CLUSTER_ID", "some-value")
+	t.Setenv("MANAGED_DB_ENABLED", "true")
+	t.Setenv("MANAGED_DB_PROVIDER", "gcp")
+	cfg, err := GetConfig()
+	assert.ErrorContains(t, err, `MANAGED_DB_PROVIDER "gcp" is not supported`)
+	assert.Nil(t, cfg)
+}
+
+func TestSingleton_Failure_WhenOrphanDeletionWithoutSharedTags(t *testing.T) {
+	t.Setenv("CLUSTER_ID", "some-value")
+	t.Setenv("MANAGED_DB_ENABLED", "true")
+	t.Setenv("MANAGED_DB_SECURITY_GROUP", "some-group")
+	t.Setenv("MANAGED_DB_ORPHAN_DETECTION_ENABLED", "true")
+	t.Setenv("MANAGED_DB_ORPHAN_DETECTION_DRY_RUN", "false")
+	cfg, err := GetConfig()
+	assert.ErrorContains(t, err, "MANAGED_DB_ORPHAN_DETECTION_DRY_RUN == false requires MANAGED_DB_TAGS to be set")
+	assert.Nil(t, cfg)
+}
+
 func TestSingleton_ManagedDBTags(t *testing.T) {
 	t.Setenv("CLUSTER_ID", "some-value")
 	t.Setenv("MANAGED_DB_TAGS_0_KEY", "DataplaneClusterName")
@@ -53,3 +94,11 @@ func TestSingleton_ManagedDBTags(t *testing.T) {
 	assert.Equal(t, cfg.ManagedDB.SharedTags[0].Key, "DataplaneClusterName")
 	assert.Equal(t, cfg.ManagedDB.SharedTags[0].Value, "acs-dev-dp-01")
 }
+
+func TestSingleton_Failure_WhenReconcileWorkersNotPositive(t *testing.T) {
+	t.Setenv("CLUSTER_ID", "some-value")
+	t.Setenv("RECONCILE_WORKERS", "0")
+	cfg, err := GetConfig()
+	assert.ErrorContains(t, err, "RECONCILE_WORKERS must be greater than zero")
+	assert.Nil(t, cfg)
+}
@@ -82,7 +82,8 @@ func main() {
 	}
 
 	glog.Info("Creating metrics server...")
-	metricServer := fleetshardmetrics.NewMetricsServer(config.MetricsAddress)
+	metricServer := fleetshardmetrics.NewMetricsServer(config.MetricsAddress,
+		fleetshardmetrics.WithHandler("/orphaned-db-resources", runtime.OrphanedDBResourcesHandler()))
 	go func() {
 		if err := metricServer.ListenAndServe(); err != nil {
 			glog.Errorf("serving metrics server: %v", err)
@@ -5,6 +5,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,6 +19,7 @@ import (
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/postgres"
 	"k8s.io/apimachinery/pkg/util/rand"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // RDSClient is a wrapper around the rdsiface.RDSAPI to generate a mock
@@ -36,6 +39,15 @@ type RDSClient interface {
 	DeleteDBInstance(ctx context.Context, params *rds.DeleteDBInstanceInput, optFns ...func(*rds.Options)) (*rds.DeleteDBInstanceOutput, error)
 	DeleteDBCluster(ctx context.Context, params *rds.DeleteDBClusterInput, optFns ...func(*rds.Options)) (*rds.DeleteDBClusterOutput, error)
 	DeleteDBClusterSnapshot(ctx context.Context, params *rds.DeleteDBClusterSnapshotInput, optFns ...func(*rds.Options)) (*rds.DeleteDBClusterSnapshotOutput, error)
+
+	CreateDBClusterSnapshot(ctx context.Context, params *rds.CreateDBClusterSnapshotInput, optFns ...func(*rds.Options)) (*rds.CreateDBClusterSnapshotOutput, error)
+	ModifyDBCluster(ctx context.Context, params *rds.ModifyDBClusterInput, optFns ...func(*rds.Options)) (*rds.ModifyDBClusterOutput, error)
+	AddTagsToResource(ctx context.Context, params *rds.AddTagsToResourceInput, optFns ...func(*rds.Options)) (*rds.AddTagsToResourceOutput, error)
+
+	DescribeBlueGreenDeployments(ctx context.Context, params *rds.DescribeBlueGreenDeploymentsInput, optFns ...func(*rds.Options)) (*rds.DescribeBlueGreenDeploymentsOutput, error)
+	CreateBlueGreenDeployment(ctx context.Context, params *rds.CreateBlueGreenDeploymentInput, optFns ...func(*rds.Options)) (*rds.CreateBlueGreenDeploymentOutput, error)
+	SwitchoverBlueGreenDeployment(ctx context.Context, params *rds.SwitchoverBlueGreenDeploymentInput, optFns ...func(*rds.Options)) (*rds.SwitchoverBlueGreenDeploymentOutput, error)
+	DeleteBlueGreenDeployment(ctx context.Context, params *rds.DeleteBlueGreenDeploymentInput, optFns ...func(*rds.Options)) (*rds.DeleteBlueGreenDeploymentOutput, error)
 }
 
 const (
@@ -49,6 +61,21 @@ const (
 	dbClusterSuffix   = "-db-cluster"
 	awsRetrySeconds   = 30
 
+	preUpgradeSnapshotInfix = "-pre-upgrade-"
+	blueGreenSuffix         = "-upgrade"
+	// blueGreenOldSuffix is appended by AWS to the identifiers of the previous (blue) cluster and its instances
+	// after a switchover
+	blueGreenOldSuffix = "-old1"
+	// preUpgradeSnapshotRetention is how long the snapshots taken before engine upgrades are kept after the upgrade
+	preUpgradeSnapshotRetention = 7 * 24 * time.Hour
+
+	// Blue/green deployment states, see https://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_BlueGreenDeployment.html
+	blueGreenProvisioningStatus         = "PROVISIONING"
+	blueGreenAvailableStatus            = "AVAILABLE"
+	blueGreenSwitchoverInProgressStatus = "SWITCHOVER_IN_PROGRESS"
+	blueGreenSwitchoverCompletedStatus  = "SWITCHOVER_COMPLETED"
+	blueGreenDeletingStatus             = "DELETING"
+
 	// DB cluster / instance configuration parameters
 	dbEngine                = "aurora-postgresql"
 	dbInstanceClass         = "db.serverless"
@@ -160,6 +187,306 @@ func (r *RDS) GetAccountQuotas(ctx context.Context) (cloudprovider.AccountQuotas
 	return accountQuotas, nil
 }
 
+// EnsureDBEngineVersion drives the upgrade of the Aurora cluster of a Central to the given engine version. A manual
+// cluster snapshot is taken first. The upgrade is then applied either in place or through a blue/green deployment.
+// The function never blocks on long-running AWS operations and is expected to be called repeatedly until the
+// returned status is completed.
+func (r *RDS) EnsureDBEngineVersion(ctx context.Context, databaseID string, spec cloudprovider.DBEngineUpgradeSpec) (cloudprovider.DBEngineUpgradeStatus, error) {
+	if err := spec.Validate(); err != nil {
+		return cloudprovider.DBEngineUpgradeStatus{}, err
+	}
+
+	clusterID := getClusterID(databaseID)
+	dbCluster, err := r.describeDBCluster(clusterID)
+	if err != nil {
+		return cloudprovider.DBEngineUpgradeStatus{}, fmt.Errorf("getting DB cluster %s: %w", clusterID, err)
+	}
+
+	currentVersion := aws.ToString(dbCluster.EngineVersion)
+	if currentVersion == spec.EngineVersion {
+		if err := r.ensureEngineUpgradeCleanedUp(ctx, databaseID); err != nil {
+			return cloudprovider.DBEngineUpgradeStatus{}, err
+		}
+		return cloudprovider.DBEngineUpgradeStatus{
+			Phase:   cloudprovider.DBEngineUpgradePhaseCompleted,
+			Message: fmt.Sprintf("DB cluster runs engine version %s", currentVersion),
+		}, nil
+	}
+
+	if err := validateEngineUpgrade(currentVersion, spec.EngineVersion); err != nil {
+		return cloudprovider.DBEngineUpgradeStatus{}, err
+	}
+
+	if clusterStatus := aws.ToString(dbCluster.Status); clusterStatus != dbAvailableStatus {
+		return cloudprovider.DBEngineUpgradeStatus{
+			Phase:   cloudprovider.DBEngineUpgradePhaseUpgrading,
+			Message: fmt.Sprintf("DB cluster is %s", clusterStatus),
+		}, nil
+	}
+
+	snapshotID := getPreUpgradeSnapshotID(clusterID, spec.EngineVersion)
+	snapshotAvailable, err := r.ensurePreUpgradeSnapshotCreated(ctx, dbCluster, snapshotID)
+	if err != nil {
+		return cloudprovider.DBEngineUpgradeStatus{}, err
+	}
+	if !snapshotAvailable {
+		return cloudprovider.DBEngineUpgradeStatus{
+			Phase:   cloudprovider.DBEngineUpgradePhaseSnapshotting,
+			Message: fmt.Sprintf("waiting for snapshot %s", snapshotID),
+		}, nil
+	}
+
+	if spec.Strategy == cloudprovider.DBEngineUpgradeStrategyBlueGreen {
+		return r.upgradeBlueGreen(ctx, dbCluster, spec)
+	}
+	return r.upgradeInPlace(ctx, dbCluster, spec)
+}
+
+func (r *RDS) ensurePreUpgradeSnapshotCreated(ctx context.Context, dbCluster *types.DBCluster, snapshotID string) (bool, error) {
+	snapshotsOut, err := r.rdsClient.DescribeDBClusterSnapshots(ctx, &rds.DescribeDBClusterSnapshotsInput{
+		DBClusterSnapshotIdentifier: aws.String(snapshotID),
+	})
+	if err != nil {
+		var notFound *types.DBClusterSnapshotNotFoundFault
+		if !errors.As(err, &notFound) {
+			return false, fmt.Errorf("getting DB cluster snapshot %s: %w", snapshotID, err)
+		}
+	}
+
+	if snapshotsOut != nil && len(snapshotsOut.DBClusterSnapshots) > 0 {
+		return aws.ToString(snapshotsOut.DBClusterSnapshots[0].Status) == dbAvailableStatus, nil
+	}
+
+	glog.Infof("Creating snapshot %s of DB cluster %s before engine upgrade", snapshotID, aws.ToString(dbCluster.DBClusterIdentifier))
+	_, err = r.rdsClient.CreateDBClusterSnapshot(ctx, &rds.CreateDBClusterSnapshotInput{
+		DBClusterIdentifier:         dbCluster.DBClusterIdentifier,
+		DBClusterSnapshotIdentifier: aws.String(snapshotID),
+		Tags:                        dbCluster.TagList,
+	})
+	if err != nil {
+		return false, fmt.Errorf("creating DB cluster snapshot %s: %w", snapshotID, err)
+	}
+
+	return false, nil
+}
+
+func (r *RDS) upgradeInPlace(ctx context.Context, dbCluster *types.DBCluster, spec cloudprovider.DBEngineUpgradeSpec) (cloudprovider.DBEngineUpgradeStatus, error) {
+	clusterID := aws.ToString(dbCluster.DBClusterIdentifier)
+	glog.Infof("Initiating in-place upgrade of DB cluster %s from engine version %s to %s", clusterID, aws.ToString(dbCluster.EngineVersion), spec.EngineVersion)
+
+	input := &rds.ModifyDBClusterInput{
+		DBClusterIdentifier:      dbCluster.DBClusterIdentifier,
+		EngineVersion:            aws.String(spec.EngineVersion),
+		AllowMajorVersionUpgrade: aws.Bool(true),
+		ApplyImmediately:         aws.Bool(true),
+	}
+	if spec.ClusterParameterGroup != "" {
+		input.DBClusterParameterGroupName = aws.String(spec.ClusterParameterGroup)
+	}
+
+	if _, err := r.rdsClient.ModifyDBCluster(ctx, input); err != nil {
+		var invalidState *types.InvalidDBClusterStateFault
+		if !errors.As(err, &invalidState) {
+			return cloudprovider.DBEngineUpgradeStatus{}, fmt.Errorf("modifying engine version of DB cluster %s: %w", clusterID, err)
+		}
+		// the cluster did not yet transition to the upgrading state after a previous modification
+		glog.Infof("DB cluster %s can not be modified right now: %v", clusterID, err)
+	}
+
+	return cloudprovider.DBEngineUpgradeStatus{
+		Phase:   cloudprovider.DBEngineUpgradePhaseUpgrading,
+		Message: fmt.Sprintf("upgrading engine version to %s in place", spec.EngineVersion),
+	}, nil
+}
+
+func (r *RDS) upgradeBlueGreen(ctx context.Context, dbCluster *types.DBCluster, spec cloudprovider.DBEngineUpgradeSpec) (cloudprovider.DBEngineUpgradeStatus, error) {
+	clusterID := aws.ToString(dbCluster.DBClusterIdentifier)
+	deployment, err := r.describeBlueGreenDeployment(ctx, clusterID)
+	if err != nil {
+		return cloudprovider.DBEngineUpgradeStatus{}, err
+	}
+
+	if deployment == nil {
+		glog.Infof("Creating blue/green deployment for DB cluster %s with engine version %s", clusterID, spec.EngineVersion)
+		input := &rds.CreateBlueGreenDeploymentInput{
+			BlueGreenDeploymentName: aws.String(getBlueGreenDeploymentName(clusterID)),
+			Source:                  dbCluster.DBClusterArn,
+			TargetEngineVersion:     aws.String(spec.EngineVersion),
+			Tags:                    dbCluster.TagList,
+		}
+		if spec.ClusterParameterGroup != "" {
+			input.TargetDBClusterParameterGroupName = aws.String(spec.ClusterParameterGroup)
+		}
+		if _, err := r.rdsClient.CreateBlueGreenDeployment(ctx, input); err != nil {
+			return cloudprovider.DBEngineUpgradeStatus{}, fmt.Errorf("creating blue/green deployment for DB cluster %s: %w", clusterID, err)
+		}
+		return cloudprovider.DBEngineUpgradeStatus{
+			Phase:   cloudprovider.DBEngineUpgradePhaseUpgrading,
+			Message: fmt.Sprintf("provisioning green DB cluster with engine version %s", spec.EngineVersion),
+		}, nil
+	}
+
+	deploymentID := aws.ToString(deployment.BlueGreenDeploymentIdentifier)
+	switch status := aws.ToString(deployment.Status); status {
+	case blueGreenProvisioningStatus:
+		return cloudprovider.DBEngineUpgradeStatus{
+			Phase:   cloudprovider.DBEngineUpgradePhaseUpgrading,
+			Message: fmt.Sprintf("provisioning green DB cluster with engine version %s", spec.EngineVersion),
+		}, nil
+	case blueGreenAvailableStatus:
+		glog.Infof("Switching over blue/green deployment %s of DB cluster %s", deploymentID, clusterID)
+		_, err := r.rdsClient.SwitchoverBlueGreenDeployment(ctx, &rds.SwitchoverBlueGreenDeploymentInput{
+			BlueGreenDeploymentIdentifier: deployment.BlueGreenDeploymentIdentifier,
+		})
+		if err != nil {
+			return cloudprovider.DBEngineUpgradeStatus{}, fmt.Errorf("switching over blue/green deployment %s: %w", deploymentID, err)
+		}
+		return cloudprovider.DBEngineUpgradeStatus{Phase: cloudprovider.DBEngineUpgradePhaseSwitchingOver}, nil
+	case blueGreenSwitchoverInProgressStatus, blueGreenSwitchoverCompletedStatus, blueGreenDeletingStatus:
+		return cloudprovider.DBEngineUpgradeStatus{
+			Phase:   cloudprovider.DBEngineUpgradePhaseSwitchingOver,
+			Message: fmt.Sprintf("blue/green deployment is %s", status),
+		}, nil
+	default:
+		return cloudprovider.DBEngineUpgradeStatus{}, fmt.Errorf("%w: blue/green deployment %s is in state %s: %s",
+			cloudprovider.ErrDBEngineUpgradeNotSupported, deploymentID, status, aws.ToString(deployment.StatusDetails))
+	}
+}
+
+// ensureEngineUpgradeCleanedUp removes what is left over from a finished engine upgrade: the blue/green deployment,
+// the previous (blue) cluster once the deployment is gone, and the pre-upgrade snapshots after their retention.
+func (r *RDS) ensureEngineUpgradeCleanedUp(ctx context.Context, databaseID string) error {
+	clusterID := getClusterID(databaseID)
+	deploymentDeleted, err := r.ensureBlueGreenDeploymentDeleted(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+	if !deploymentDeleted {
+		return nil
+	}
+
+	// the blue cluster is not needed as a fallback, the pre-upgrade snapshot can be restored instead
+	for _, instanceID := range []string{getInstanceID(databaseID) + blueGreenOldSuffix, getFailoverInstanceID(databaseID) + blueGreenOldSuffix} {
+		if err := r.ensureInstanceDeleted(instanceID); err != nil {
+			return fmt.Errorf("deleting DB instance %s of the previous cluster: %w", instanceID, err)
+		}
+	}
+	if err := r.ensureClusterDeleted(clusterID+blueGreenOldSuffix, true); err != nil {
+		return fmt.Errorf("deleting the previous DB cluster of %s: %w", clusterID, err)
+	}
+
+	return r.ensureExpiredPreUpgradeSnapshotsDeleted(ctx, clusterID)
+}
+
+// ensureBlueGreenDeploymentDeleted removes a blue/green deployment after the switchover. It returns true once the
+// DB cluster has no blue/green deployment anymore.
+func (r *RDS) ensureBlueGreenDeploymentDeleted(ctx context.Context, clusterID string) (bool, error) {
+	deployment, err := r.describeBlueGreenDeployment(ctx, clusterID)
+	if err != nil {
+		return false, err
+	}
+	if deployment == nil {
+		return true, nil
+	}
+	if aws.ToString(deployment.Status) != blueGreenSwitchoverCompletedStatus {
+		return false, nil
+	}
+
+	glog.Infof("Deleting completed blue/green deployment %s of DB cluster %s", aws.ToString(deployment.BlueGreenDeploymentIdentifier), clusterID)
+	_, err = r.rdsClient.DeleteBlueGreenDeployment(ctx, &rds.DeleteBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: deployment.BlueGreenDeploymentIdentifier,
+		DeleteTarget:                  aws.Bool(false),
+	})
+	if err != nil {
+		return false, fmt.Errorf("deleting blue/green deployment of DB cluster %s: %w", clusterID, err)
+	}
+	return false, nil
+}
+
+func (r *RDS) ensureExpiredPreUpgradeSnapshotsDeleted(ctx context.Context, clusterID string) error {
+	snapshotsOut, err := r.rdsClient.DescribeDBClusterSnapshots(ctx, &rds.DescribeDBClusterSnapshotsInput{
+		DBClusterIdentifier: aws.String(clusterID),
+		SnapshotType:        aws.String("manual"),
+	})
+	if err != nil {
+		return fmt.Errorf("listing snapshots of DB cluster %s: %w", clusterID, err)
+	}
+
+	for _, snapshot := range snapshotsOut.DBClusterSnapshots {
+		snapshotID := aws.ToString(snapshot.DBClusterSnapshotIdentifier)
+		if !strings.HasPrefix(snapshotID, clusterID+preUpgradeSnapshotInfix) || snapshot.SnapshotCreateTime == nil {
+			continue
+		}
+		if time.Since(*snapshot.SnapshotCreateTime) < preUpgradeSnapshotRetention {
+			continue
+		}
+		glog.Infof("Deleting pre-upgrade snapshot %s of DB cluster %s", snapshotID, clusterID)
+		_, err := r.rdsClient.DeleteDBClusterSnapshot(ctx, &rds.DeleteDBClusterSnapshotInput{
+			DBClusterSnapshotIdentifier: snapshot.DBClusterSnapshotIdentifier,
+		})
+		if err != nil {
+			var notFound *types.DBClusterSnapshotNotFoundFault
+			if !errors.As(err, &notFound) {
+				return fmt.Errorf("deleting DB cluster snapshot %s: %w", snapshotID, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (r *RDS) describeBlueGreenDeployment(ctx context.Context, clusterID string) (*types.BlueGreenDeployment, error) {
+	result, err := r.rdsClient.DescribeBlueGreenDeployments(ctx, &rds.DescribeBlueGreenDeploymentsInput{
+		Filters: []types.Filter{
+			{
+				Name:   aws.String("blue-green-deployment-name"),
+				Values: []string{getBlueGreenDeploymentName(clusterID)},
+			},
+		},
+	})
+	if err != nil {
+		var notFound *types.BlueGreenDeploymentNotFoundFault
+		if errors.As(err, &notFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving blue/green deployment of DB cluster %s: %w", clusterID, err)
+	}
+
+	if len(result.BlueGreenDeployments) == 0 {
+		return nil, nil
+	}
+	return &result.BlueGreenDeployments[0], nil
+}
+
+// validateEngineUpgrade rejects downgrades of the engine version, which are not supported by Aurora
+func validateEngineUpgrade(currentVersion, desiredVersion string) error {
+	current, err := parseEngineVersion(currentVersion)
+	if err != nil {
+		return err
+	}
+	desired, err := parseEngineVersion(desiredVersion)
+	if err != nil {
+		return err
+	}
+	if slices.Compare(desired, current) < 0 {
+		return fmt.Errorf("%w: downgrading DB engine from version %s to %s", cloudprovider.ErrDBEngineUpgradeNotSupported, currentVersion, desiredVersion)
+	}
+	return nil
+}
+
+// parseEngineVersion returns the numeric parts of an engine version, e.g. [15 4] for 15.4
+func parseEngineVersion(engineVersion string) ([]int, error) {
+	parts := strings.Split(engineVersion, ".")
+	version := make([]int, 0, len(parts))
+	for _, part := range parts {
+		v, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid DB engine version %q: %v", cloudprovider.ErrDBEngineUpgradeNotSupported, engineVersion, err)
+		}
+		version = append(version, v)
+	}
+	return version, nil
+}
+
 func (r *RDS) ensureDBClusterCreated(clusterID, acsInstanceID, masterPassword string, isTestInstance bool) error {
 	clusterExists, _, err := r.clusterStatus(clusterID)
 	if err != nil {
@@ -402,7 +729,7 @@ func (r *RDS) waitForInstanceToBeAvailable(ctx context.Context, instanceID strin
 
 // NewRDSClient initializes a new awsclient.RDS
 func NewRDSClient(config *config.Config) (*RDS, error) {
-	rdsClient, err := newRdsClient()
+	rdsClient, err := newRdsClient(config.ManagedDB.Region)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create RDS client: %w", err)
 	}
@@ -413,6 +740,11 @@ func NewRDSClient(config *config.Config) (*RDS, error) {
 	}, nil
 }
 
+// NewDBClient is the cloudprovider.DBClientFactory of the AWS managed DB provider
+func NewDBClient(config *config.Config, _ ctrlClient.Client) (cloudprovider.DBClient, error) {
+	return NewRDSClient(config)
+}
+
 func getClusterID(databaseID string) string {
 	return dbPrefix + databaseID + dbClusterSuffix
 }
@@ -425,6 +757,14 @@ func getFailoverInstanceID(databaseID string) string {
 	return dbPrefix + databaseID + dbFailoverSuffix
 }
 
+func getPreUpgradeSnapshotID(clusterID, engineVersion string) string {
+	return clusterID + preUpgradeSnapshotInfix + strings.ReplaceAll(engineVersion, ".", "-")
+}
+
+func getBlueGreenDeploymentName(clusterID string) string {
+	return clusterID + blueGreenSuffix
+}
+
 type createCentralDBClusterInput struct {
 	clusterID      string
 	acsInstanceID  string
@@ -450,6 +790,8 @@ func (r *RDS) newCreateCentralDBClusterInput(input *createCentralDBClusterInput)
 		BackupRetentionPeriod: aws.Int32(r.config.BackupRetentionPeriod),
 		StorageEncrypted:      aws.Bool(true),
 		Tags:                  r.getDesiredTags(input.acsInstanceID, input.isTestInstance),
+		// final snapshots need the tags to be attributed to their tenant by the orphaned resource detection
+		CopyTagsToSnapshot: aws.Bool(true),
 	}
 	if r.config.ClusterParameterGroup != "" {
 		awsInput.DBClusterParameterGroupName = aws.String(r.config.ClusterParameterGroup)
@@ -495,6 +837,7 @@ func newRestoreCentralDBClusterInput(snapshotID string, input *rds.CreateDBClust
 		DBSubnetGroupName:                input.DBSubnetGroupName,
 		ServerlessV2ScalingConfiguration: input.ServerlessV2ScalingConfiguration,
 		Tags:                             input.Tags,
+		CopyTagsToSnapshot:               input.CopyTagsToSnapshot,
 		SnapshotIdentifier:               &snapshotID,
 		EnableCloudwatchLogsExports:      input.EnableCloudwatchLogsExports,
 	}
@@ -546,8 +889,12 @@ func newDeleteCentralDBClusterInput(clusterID string, skipFinalSnapshot bool) *r
 	return input
 }
 
-func newRdsClient() (*rds.Client, error) {
-	cfg, err := awsConfig.LoadDefaultConfig(context.TODO())
+func newRdsClient(region string) (*rds.Client, error) {
+	var opts []func(*awsConfig.LoadOptions) error
+	if region != "" {
+		opts = append(opts, awsConfig.WithRegion(region))
+	}
+	cfg, err := awsConfig.LoadDefaultConfig(context.TODO(), opts...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to load AWS SDK config: %w", err)
 	}
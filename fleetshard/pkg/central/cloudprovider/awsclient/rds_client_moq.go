@@ -19,12 +19,24 @@ var _ RDSClient = &RDSClientMock{}
 //
 //		// make and configure a mocked RDSClient
 //		mockedRDSClient := &RDSClientMock{
+//			AddTagsToResourceFunc: func(ctx context.Context, params *rds.AddTagsToResourceInput, optFns ...func(*rds.Options)) (*rds.AddTagsToResourceOutput, error) {
+//				panic("mock out the AddTagsToResource method")
+//			},
+//			CreateBlueGreenDeploymentFunc: func(ctx context.Context, params *rds.CreateBlueGreenDeploymentInput, optFns ...func(*rds.Options)) (*rds.CreateBlueGreenDeploymentOutput, error) {
+//				panic("mock out the CreateBlueGreenDeployment method")
+//			},
 //			CreateDBClusterFunc: func(ctx context.Context, params *rds.CreateDBClusterInput, optFns ...func(*rds.Options)) (*rds.CreateDBClusterOutput, error) {
 //				panic("mock out the CreateDBCluster method")
 //			},
+//			CreateDBClusterSnapshotFunc: func(ctx context.Context, params *rds.CreateDBClusterSnapshotInput, optFns ...func(*rds.Options)) (*rds.CreateDBClusterSnapshotOutput, error) {
+//				panic("mock out the CreateDBClusterSnapshot method")
+//			},
 //			CreateDBInstanceFunc: func(ctx context.Context, params *rds.CreateDBInstanceInput, optFns ...func(*rds.Options)) (*rds.CreateDBInstanceOutput, error) {
 //				panic("mock out the CreateDBInstance method")
 //			},
+//			DeleteBlueGreenDeploymentFunc: func(ctx context.Context, params *rds.DeleteBlueGreenDeploymentInput, optFns ...func(*rds.Options)) (*rds.DeleteBlueGreenDeploymentOutput, error) {
+//				panic("mock out the DeleteBlueGreenDeployment method")
+//			},
 //			DeleteDBClusterFunc: func(ctx context.Context, params *rds.DeleteDBClusterInput, optFns ...func(*rds.Options)) (*rds.DeleteDBClusterOutput, error) {
 //				panic("mock out the DeleteDBCluster method")
 //			},
@@ -37,6 +49,9 @@ var _ RDSClient = &RDSClientMock{}
 //			DescribeAccountAttributesFunc: func(ctx context.Context, params *rds.DescribeAccountAttributesInput, optFns ...func(*rds.Options)) (*rds.DescribeAccountAttributesOutput, error) {
 //				panic("mock out the DescribeAccountAttributes method")
 //			},
+//			DescribeBlueGreenDeploymentsFunc: func(ctx context.Context, params *rds.DescribeBlueGreenDeploymentsInput, optFns ...func(*rds.Options)) (*rds.DescribeBlueGreenDeploymentsOutput, error) {
+//				panic("mock out the DescribeBlueGreenDeployments method")
+//			},
 //			DescribeDBClusterSnapshotsFunc: func(ctx context.Context, params *rds.DescribeDBClusterSnapshotsInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClusterSnapshotsOutput, error) {
 //				panic("mock out the DescribeDBClusterSnapshots method")
 //			},
@@ -46,9 +61,15 @@ var _ RDSClient = &RDSClientMock{}
 //			DescribeDBInstancesFunc: func(ctx context.Context, params *rds.DescribeDBInstancesInput, optFns ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error) {
 //				panic("mock out the DescribeDBInstances method")
 //			},
+//			ModifyDBClusterFunc: func(ctx context.Context, params *rds.ModifyDBClusterInput, optFns ...func(*rds.Options)) (*rds.ModifyDBClusterOutput, error) {
+//				panic("mock out the ModifyDBCluster method")
+//			},
 //			RestoreDBClusterFromSnapshotFunc: func(ctx context.Context, params *rds.RestoreDBClusterFromSnapshotInput, optFns ...func(*rds.Options)) (*rds.RestoreDBClusterFromSnapshotOutput, error) {
 //				panic("mock out the RestoreDBClusterFromSnapshot method")
 //			},
+//			SwitchoverBlueGreenDeploymentFunc: func(ctx context.Context, params *rds.SwitchoverBlueGreenDeploymentInput, optFns ...func(*rds.Options)) (*rds.SwitchoverBlueGreenDeploymentOutput, error) {
+//				panic("mock out the SwitchoverBlueGreenDeployment method")
+//			},
 //		}
 //
 //		// use mockedRDSClient in code that requires RDSClient
@@ -56,12 +77,24 @@ var _ RDSClient = &RDSClientMock{}
 //
 //	}
 type RDSClientMock struct {
+	// AddTagsToResourceFunc mocks the AddTagsToResource method.
+	AddTagsToResourceFunc func(ctx context.Context, params *rds.AddTagsToResourceInput, optFns ...func(*rds.Options)) (*rds.AddTagsToResourceOutput, error)
+
+	// CreateBlueGreenDeploymentFunc mocks the CreateBlueGreenDeployment method.
+	CreateBlueGreenDeploymentFunc func(ctx context.Context, params *rds.CreateBlueGreenDeploymentInput, optFns ...func(*rds.Options)) (*rds.CreateBlueGreenDeploymentOutput, error)
+
 	// CreateDBClusterFunc mocks the CreateDBCluster method.
 	CreateDBClusterFunc func(ctx context.Context, params *rds.CreateDBClusterInput, optFns ...func(*rds.Options)) (*rds.CreateDBClusterOutput, error)
 
+	// CreateDBClusterSnapshotFunc mocks the CreateDBClusterSnapshot method.
+	CreateDBClusterSnapshotFunc func(ctx context.Context, params *rds.CreateDBClusterSnapshotInput, optFns ...func(*rds.Options)) (*rds.CreateDBClusterSnapshotOutput, error)
+
 	// CreateDBInstanceFunc mocks the CreateDBInstance method.
 	CreateDBInstanceFunc func(ctx context.Context, params *rds.CreateDBInstanceInput, optFns ...func(*rds.Options)) (*rds.CreateDBInstanceOutput, error)
 
+	// DeleteBlueGreenDeploymentFunc mocks the DeleteBlueGreenDeployment method.
+	DeleteBlueGreenDeploymentFunc func(ctx context.Context, params *rds.DeleteBlueGreenDeploymentInput, optFns ...func(*rds.Options)) (*rds.DeleteBlueGreenDeploymentOutput, error)
+
 	// DeleteDBClusterFunc mocks the DeleteDBCluster method.
 	DeleteDBClusterFunc func(ctx context.Context, params *rds.DeleteDBClusterInput, optFns ...func(*rds.Options)) (*rds.DeleteDBClusterOutput, error)
 
@@ -74,6 +107,9 @@ type RDSClientMock struct {
 	// DescribeAccountAttributesFunc mocks the DescribeAccountAttributes method.
 	DescribeAccountAttributesFunc func(ctx context.Context, params *rds.DescribeAccountAttributesInput, optFns ...func(*rds.Options)) (*rds.DescribeAccountAttributesOutput, error)
 
+	// DescribeBlueGreenDeploymentsFunc mocks the DescribeBlueGreenDeployments method.
+	DescribeBlueGreenDeploymentsFunc func(ctx context.Context, params *rds.DescribeBlueGreenDeploymentsInput, optFns ...func(*rds.Options)) (*rds.DescribeBlueGreenDeploymentsOutput, error)
+
 	// DescribeDBClusterSnapshotsFunc mocks the DescribeDBClusterSnapshots method.
 	DescribeDBClusterSnapshotsFunc func(ctx context.Context, params *rds.DescribeDBClusterSnapshotsInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClusterSnapshotsOutput, error)
 
@@ -83,11 +119,35 @@ type RDSClientMock struct {
 	// DescribeDBInstancesFunc mocks the DescribeDBInstances method.
 	DescribeDBInstancesFunc func(ctx context.Context, params *rds.DescribeDBInstancesInput, optFns ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error)
 
+	// ModifyDBClusterFunc mocks the ModifyDBCluster method.
+	ModifyDBClusterFunc func(ctx context.Context, params *rds.ModifyDBClusterInput, optFns ...func(*rds.Options)) (*rds.ModifyDBClusterOutput, error)
+
 	// RestoreDBClusterFromSnapshotFunc mocks the RestoreDBClusterFromSnapshot method.
 	RestoreDBClusterFromSnapshotFunc func(ctx context.Context, params *rds.RestoreDBClusterFromSnapshotInput, optFns ...func(*rds.Options)) (*rds.RestoreDBClusterFromSnapshotOutput, error)
 
+	// SwitchoverBlueGreenDeploymentFunc mocks the SwitchoverBlueGreenDeployment method.
+	SwitchoverBlueGreenDeploymentFunc func(ctx context.Context, params *rds.SwitchoverBlueGreenDeploymentInput, optFns ...func(*rds.Options)) (*rds.SwitchoverBlueGreenDeploymentOutput, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
+		// AddTagsToResource holds details about calls to the AddTagsToResource method.
+		AddTagsToResource []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Params is the params argument value.
+			Params *rds.AddTagsToResourceInput
+			// OptFns is the optFns argument value.
+			OptFns []func(*rds.Options)
+		}
+		// CreateBlueGreenDeployment holds details about calls to the CreateBlueGreenDeployment method.
+		CreateBlueGreenDeployment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Params is the params argument value.
+			Params *rds.CreateBlueGreenDeploymentInput
+			// OptFns is the optFns argument value.
+			OptFns []func(*rds.Options)
+		}
 		// CreateDBCluster holds details about calls to the CreateDBCluster method.
 		CreateDBCluster []struct {
 			// Ctx is the ctx argument value.
@@ -97,6 +157,15 @@ type RDSClientMock struct {
 			// OptFns is the optFns argument value.
 			OptFns []func(*rds.Options)
 		}
+		// CreateDBClusterSnapshot holds details about calls to the CreateDBClusterSnapshot method.
+		CreateDBClusterSnapshot []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Params is the params argument value.
+			Params *rds.CreateDBClusterSnapshotInput
+			// OptFns is the optFns argument value.
+			OptFns []func(*rds.Options)
+		}
 		// CreateDBInstance holds details about calls to the CreateDBInstance method.
 		CreateDBInstance []struct {
 			// Ctx is the ctx argument value.
@@ -106,6 +175,15 @@ type RDSClientMock struct {
 			// OptFns is the optFns argument value.
 			OptFns []func(*rds.Options)
 		}
+		// DeleteBlueGreenDeployment holds details about calls to the DeleteBlueGreenDeployment method.
+		DeleteBlueGreenDeployment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Params is the params argument value.
+			Params *rds.DeleteBlueGreenDeploymentInput
+			// OptFns is the optFns argument value.
+			OptFns []func(*rds.Options)
+		}
 		// DeleteDBCluster holds details about calls to the DeleteDBCluster method.
 		DeleteDBCluster []struct {
 			// Ctx is the ctx argument value.
@@ -142,6 +220,15 @@ type RDSClientMock struct {
 			// OptFns is the optFns argument value.
 			OptFns []func(*rds.Options)
 		}
+		// DescribeBlueGreenDeployments holds details about calls to the DescribeBlueGreenDeployments method.
+		DescribeBlueGreenDeployments []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Params is the params argument value.
+			Params *rds.DescribeBlueGreenDeploymentsInput
+			// OptFns is the optFns argument value.
+			OptFns []func(*rds.Options)
+		}
 		// DescribeDBClusterSnapshots holds details about calls to the DescribeDBClusterSnapshots method.
 		DescribeDBClusterSnapshots []struct {
 			// Ctx is the ctx argument value.
@@ -169,6 +256,15 @@ type RDSClientMock struct {
 			// OptFns is the optFns argument value.
 			OptFns []func(*rds.Options)
 		}
+		// ModifyDBCluster holds details about calls to the ModifyDBCluster method.
+		ModifyDBCluster []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Params is the params argument value.
+			Params *rds.ModifyDBClusterInput
+			// OptFns is the optFns argument value.
+			OptFns []func(*rds.Options)
+		}
 		// RestoreDBClusterFromSnapshot holds details about calls to the RestoreDBClusterFromSnapshot method.
 		RestoreDBClusterFromSnapshot []struct {
 			// Ctx is the ctx argument value.
@@ -178,17 +274,113 @@ type RDSClientMock struct {
 			// OptFns is the optFns argument value.
 			OptFns []func(*rds.Options)
 		}
+		// SwitchoverBlueGreenDeployment holds details about calls to the SwitchoverBlueGreenDeployment method.
+		SwitchoverBlueGreenDeployment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Params is the params argument value.
+			Params *rds.SwitchoverBlueGreenDeploymentInput
+			// OptFns is the optFns argument value.
+			OptFns []func(*rds.Options)
+		}
+	}
+	lockAddTagsToResource             sync.RWMutex
+	lockCreateBlueGreenDeployment     sync.RWMutex
+	lockCreateDBCluster               sync.RWMutex
+	lockCreateDBClusterSnapshot       sync.RWMutex
+	lockCreateDBInstance              sync.RWMutex
+	lockDeleteBlueGreenDeployment     sync.RWMutex
+	lockDeleteDBCluster               sync.RWMutex
+	lockDeleteDBClusterSnapshot       sync.RWMutex
+	lockDeleteDBInstance              sync.RWMutex
+	lockDescribeAccountAttributes     sync.RWMutex
+	lockDescribeBlueGreenDeployments  sync.RWMutex
+	lockDescribeDBClusterSnapshots    sync.RWMutex
+	lockDescribeDBClusters            sync.RWMutex
+	lockDescribeDBInstances           sync.RWMutex
+	lockModifyDBCluster               sync.RWMutex
+	lockRestoreDBClusterFromSnapshot  sync.RWMutex
+	lockSwitchoverBlueGreenDeployment sync.RWMutex
+}
+
+// AddTagsToResource calls AddTagsToResourceFunc.
+func (mock *RDSClientMock) AddTagsToResource(ctx context.Context, params *rds.AddTagsToResourceInput, optFns ...func(*rds.Options)) (*rds.AddTagsToResourceOutput, error) {
+	if mock.AddTagsToResourceFunc == nil {
+		panic("RDSClientMock.AddTagsToResourceFunc: method is nil but RDSClient.AddTagsToResource was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Params *rds.AddTagsToResourceInput
+		OptFns []func(*rds.Options)
+	}{
+		Ctx:    ctx,
+		Params: params,
+		OptFns: optFns,
+	}
+	mock.lockAddTagsToResource.Lock()
+	mock.calls.AddTagsToResource = append(mock.calls.AddTagsToResource, callInfo)
+	mock.lockAddTagsToResource.Unlock()
+	return mock.AddTagsToResourceFunc(ctx, params, optFns...)
+}
+
+// AddTagsToResourceCalls gets all the calls that were made to AddTagsToResource.
+// Check the length with:
+//
+//	len(mockedRDSClient.AddTagsToResourceCalls())
+func (mock *RDSClientMock) AddTagsToResourceCalls() []struct {
+	Ctx    context.Context
+	Params *rds.AddTagsToResourceInput
+	OptFns []func(*rds.Options)
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Params *rds.AddTagsToResourceInput
+		OptFns []func(*rds.Options)
+	}
+	mock.lockAddTagsToResource.RLock()
+	calls = mock.calls.AddTagsToResource
+	mock.lockAddTagsToResource.RUnlock()
+	return calls
+}
+
+// CreateBlueGreenDeployment calls CreateBlueGreenDeploymentFunc.
+func (mock *RDSClientMock) CreateBlueGreenDeployment(ctx context.Context, params *rds.CreateBlueGreenDeploymentInput, optFns ...func(*rds.Options)) (*rds.CreateBlueGreenDeploymentOutput, error) {
+	if mock.CreateBlueGreenDeploymentFunc == nil {
+		panic("RDSClientMock.CreateBlueGreenDeploymentFunc: method is nil but RDSClient.CreateBlueGreenDeployment was just called")
 	}
-	lockCreateDBCluster              sync.RWMutex
-	lockCreateDBInstance             sync.RWMutex
-	lockDeleteDBCluster              sync.RWMutex
-	lockDeleteDBClusterSnapshot      sync.RWMutex
-	lockDeleteDBInstance             sync.RWMutex
-	lockDescribeAccountAttributes    sync.RWMutex
-	lockDescribeDBClusterSnapshots   sync.RWMutex
-	lockDescribeDBClusters           sync.RWMutex
-	lockDescribeDBInstances          sync.RWMutex
-	lockRestoreDBClusterFromSnapshot sync.RWMutex
+	callInfo := struct {
+		Ctx    context.Context
+		Params *rds.CreateBlueGreenDeploymentInput
+		OptFns []func(*rds.Options)
+	}{
+		Ctx:    ctx,
+		Params: params,
+		OptFns: optFns,
+	}
+	mock.lockCreateBlueGreenDeployment.Lock()
+	mock.calls.CreateBlueGreenDeployment = append(mock.calls.CreateBlueGreenDeployment, callInfo)
+	mock.lockCreateBlueGreenDeployment.Unlock()
+	return mock.CreateBlueGreenDeploymentFunc(ctx, params, optFns...)
+}
+
+// CreateBlueGreenDeploymentCalls gets all the calls that were made to CreateBlueGreenDeployment.
+// Check the length with:
+//
+//	len(mockedRDSClient.CreateBlueGreenDeploymentCalls())
+func (mock *RDSClientMock) CreateBlueGreenDeploymentCalls() []struct {
+	Ctx    context.Context
+	Params *rds.CreateBlueGreenDeploymentInput
+	OptFns []func(*rds.Options)
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Params *rds.CreateBlueGreenDeploymentInput
+		OptFns []func(*rds.Options)
+	}
+	mock.lockCreateBlueGreenDeployment.RLock()
+	calls = mock.calls.CreateBlueGreenDeployment
+	mock.lockCreateBlueGreenDeployment.RUnlock()
+	return calls
 }
 
 // CreateDBCluster calls CreateDBClusterFunc.
@@ -231,6 +423,46 @@ func (mock *RDSClientMock) CreateDBClusterCalls() []struct {
 	return calls
 }
 
+// CreateDBClusterSnapshot calls CreateDBClusterSnapshotFunc.
+func (mock *RDSClientMock) CreateDBClusterSnapshot(ctx context.Context, params *rds.CreateDBClusterSnapshotInput, optFns ...func(*rds.Options)) (*rds.CreateDBClusterSnapshotOutput, error) {
+	if mock.CreateDBClusterSnapshotFunc == nil {
+		panic("RDSClientMock.CreateDBClusterSnapshotFunc: method is nil but RDSClient.CreateDBClusterSnapshot was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Params *rds.CreateDBClusterSnapshotInput
+		OptFns []func(*rds.Options)
+	}{
+		Ctx:    ctx,
+		Params: params,
+		OptFns: optFns,
+	}
+	mock.lockCreateDBClusterSnapshot.Lock()
+	mock.calls.CreateDBClusterSnapshot = append(mock.calls.CreateDBClusterSnapshot, callInfo)
+	mock.lockCreateDBClusterSnapshot.Unlock()
+	return mock.CreateDBClusterSnapshotFunc(ctx, params, optFns...)
+}
+
+// CreateDBClusterSnapshotCalls gets all the calls that were made to CreateDBClusterSnapshot.
+// Check the length with:
+//
+//	len(mockedRDSClient.CreateDBClusterSnapshotCalls())
+func (mock *RDSClientMock) CreateDBClusterSnapshotCalls() []struct {
+	Ctx    context.Context
+	Params *rds.CreateDBClusterSnapshotInput
+	OptFns []func(*rds.Options)
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Params *rds.CreateDBClusterSnapshotInput
+		OptFns []func(*rds.Options)
+	}
+	mock.lockCreateDBClusterSnapshot.RLock()
+	calls = mock.calls.CreateDBClusterSnapshot
+	mock.lockCreateDBClusterSnapshot.RUnlock()
+	return calls
+}
+
 // CreateDBInstance calls CreateDBInstanceFunc.
 func (mock *RDSClientMock) CreateDBInstance(ctx context.Context, params *rds.CreateDBInstanceInput, optFns ...func(*rds.Options)) (*rds.CreateDBInstanceOutput, error) {
 	if mock.CreateDBInstanceFunc == nil {
@@ -271,6 +503,46 @@ func (mock *RDSClientMock) CreateDBInstanceCalls() []struct {
 	return calls
 }
 
+// DeleteBlueGreenDeployment calls DeleteBlueGreenDeploymentFunc.
+func (mock *RDSClientMock) DeleteBlueGreenDeployment(ctx context.Context, params *rds.DeleteBlueGreenDeploymentInput, optFns ...func(*rds.Options)) (*rds.DeleteBlueGreenDeploymentOutput, error) {
+	if mock.DeleteBlueGreenDeploymentFunc == nil {
+		panic("RDSClientMock.DeleteBlueGreenDeploymentFunc: method is nil but RDSClient.DeleteBlueGreenDeployment was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Params *rds.DeleteBlueGreenDeploymentInput
+		OptFns []func(*rds.Options)
+	}{
+		Ctx:    ctx,
+		Params: params,
+		OptFns: optFns,
+	}
+	mock.lockDeleteBlueGreenDeployment.Lock()
+	mock.calls.DeleteBlueGreenDeployment = append(mock.calls.DeleteBlueGreenDeployment, callInfo)
+	mock.lockDeleteBlueGreenDeployment.Unlock()
+	return mock.DeleteBlueGreenDeploymentFunc(ctx, params, optFns...)
+}
+
+// DeleteBlueGreenDeploymentCalls gets all the calls that were made to DeleteBlueGreenDeployment.
+// Check the length with:
+//
+//	len(mockedRDSClient.DeleteBlueGreenDeploymentCalls())
+func (mock *RDSClientMock) DeleteBlueGreenDeploymentCalls() []struct {
+	Ctx    context.Context
+	Params *rds.DeleteBlueGreenDeploymentInput
+	OptFns []func(*rds.Options)
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Params *rds.DeleteBlueGreenDeploymentInput
+		OptFns []func(*rds.Options)
+	}
+	mock.lockDeleteBlueGreenDeployment.RLock()
+	calls = mock.calls.DeleteBlueGreenDeployment
+	mock.lockDeleteBlueGreenDeployment.RUnlock()
+	return calls
+}
+
 // DeleteDBCluster calls DeleteDBClusterFunc.
 func (mock *RDSClientMock) DeleteDBCluster(ctx context.Context, params *rds.DeleteDBClusterInput, optFns ...func(*rds.Options)) (*rds.DeleteDBClusterOutput, error) {
 	if mock.DeleteDBClusterFunc == nil {
@@ -431,6 +703,46 @@ func (mock *RDSClientMock) DescribeAccountAttributesCalls() []struct {
 	return calls
 }
 
+// DescribeBlueGreenDeployments calls DescribeBlueGreenDeploymentsFunc.
+func (mock *RDSClientMock) DescribeBlueGreenDeployments(ctx context.Context, params *rds.DescribeBlueGreenDeploymentsInput, optFns ...func(*rds.Options)) (*rds.DescribeBlueGreenDeploymentsOutput, error) {
+	if mock.DescribeBlueGreenDeploymentsFunc == nil {
+		panic("RDSClientMock.DescribeBlueGreenDeploymentsFunc: method is nil but RDSClient.DescribeBlueGreenDeployments was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Params *rds.DescribeBlueGreenDeploymentsInput
+		OptFns []func(*rds.Options)
+	}{
+		Ctx:    ctx,
+		Params: params,
+		OptFns: optFns,
+	}
+	mock.lockDescribeBlueGreenDeployments.Lock()
+	mock.calls.DescribeBlueGreenDeployments = append(mock.calls.DescribeBlueGreenDeployments, callInfo)
+	mock.lockDescribeBlueGreenDeployments.Unlock()
+	return mock.DescribeBlueGreenDeploymentsFunc(ctx, params, optFns...)
+}
+
+// DescribeBlueGreenDeploymentsCalls gets all the calls that were made to DescribeBlueGreenDeployments.
+// Check the length with:
+//
+//	len(mockedRDSClient.DescribeBlueGreenDeploymentsCalls())
+func (mock *RDSClientMock) DescribeBlueGreenDeploymentsCalls() []struct {
+	Ctx    context.Context
+	Params *rds.DescribeBlueGreenDeploymentsInput
+	OptFns []func(*rds.Options)
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Params *rds.DescribeBlueGreenDeploymentsInput
+		OptFns []func(*rds.Options)
+	}
+	mock.lockDescribeBlueGreenDeployments.RLock()
+	calls = mock.calls.DescribeBlueGreenDeployments
+	mock.lockDescribeBlueGreenDeployments.RUnlock()
+	return calls
+}
+
 // DescribeDBClusterSnapshots calls DescribeDBClusterSnapshotsFunc.
 func (mock *RDSClientMock) DescribeDBClusterSnapshots(ctx context.Context, params *rds.DescribeDBClusterSnapshotsInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClusterSnapshotsOutput, error) {
 	if mock.DescribeDBClusterSnapshotsFunc == nil {
@@ -551,6 +863,46 @@ func (mock *RDSClientMock) DescribeDBInstancesCalls() []struct {
 	return calls
 }
 
+// ModifyDBCluster calls ModifyDBClusterFunc.
+func (mock *RDSClientMock) ModifyDBCluster(ctx context.Context, params *rds.ModifyDBClusterInput, optFns ...func(*rds.Options)) (*rds.ModifyDBClusterOutput, error) {
+	if mock.ModifyDBClusterFunc == nil {
+		panic("RDSClientMock.ModifyDBClusterFunc: method is nil but RDSClient.ModifyDBCluster was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Params *rds.ModifyDBClusterInput
+		OptFns []func(*rds.Options)
+	}{
+		Ctx:    ctx,
+		Params: params,
+		OptFns: optFns,
+	}
+	mock.lockModifyDBCluster.Lock()
+	mock.calls.ModifyDBCluster = append(mock.calls.ModifyDBCluster, callInfo)
+	mock.lockModifyDBCluster.Unlock()
+	return mock.ModifyDBClusterFunc(ctx, params, optFns...)
+}
+
+// ModifyDBClusterCalls gets all the calls that were made to ModifyDBCluster.
+// Check the length with:
+//
+//	len(mockedRDSClient.ModifyDBClusterCalls())
+func (mock *RDSClientMock) ModifyDBClusterCalls() []struct {
+	Ctx    context.Context
+	Params *rds.ModifyDBClusterInput
+	OptFns []func(*rds.Options)
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Params *rds.ModifyDBClusterInput
+		OptFns []func(*rds.Options)
+	}
+	mock.lockModifyDBCluster.RLock()
+	calls = mock.calls.ModifyDBCluster
+	mock.lockModifyDBCluster.RUnlock()
+	return calls
+}
+
 // RestoreDBClusterFromSnapshot calls RestoreDBClusterFromSnapshotFunc.
 func (mock *RDSClientMock) RestoreDBClusterFromSnapshot(ctx context.Context, params *rds.RestoreDBClusterFromSnapshotInput, optFns ...func(*rds.Options)) (*rds.RestoreDBClusterFromSnapshotOutput, error) {
 	if mock.RestoreDBClusterFromSnapshotFunc == nil {
@@ -590,3 +942,43 @@ func (mock *RDSClientMock) RestoreDBClusterFromSnapshotCalls() []struct {
 	mock.lockRestoreDBClusterFromSnapshot.RUnlock()
 	return calls
 }
+
+// SwitchoverBlueGreenDeployment calls SwitchoverBlueGreenDeploymentFunc.
+func (mock *RDSClientMock) SwitchoverBlueGreenDeployment(ctx context.Context, params *rds.SwitchoverBlueGreenDeploymentInput, optFns ...func(*rds.Options)) (*rds.SwitchoverBlueGreenDeploymentOutput, error) {
+	if mock.SwitchoverBlueGreenDeploymentFunc == nil {
+		panic("RDSClientMock.SwitchoverBlueGreenDeploymentFunc: method is nil but RDSClient.SwitchoverBlueGreenDeployment was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Params *rds.SwitchoverBlueGreenDeploymentInput
+		OptFns []func(*rds.Options)
+	}{
+		Ctx:    ctx,
+		Params: params,
+		OptFns: optFns,
+	}
+	mock.lockSwitchoverBlueGreenDeployment.Lock()
+	mock.calls.SwitchoverBlueGreenDeployment = append(mock.calls.SwitchoverBlueGreenDeployment, callInfo)
+	mock.lockSwitchoverBlueGreenDeployment.Unlock()
+	return mock.SwitchoverBlueGreenDeploymentFunc(ctx, params, optFns...)
+}
+
+// SwitchoverBlueGreenDeploymentCalls gets all the calls that were made to SwitchoverBlueGreenDeployment.
+// Check the length with:
+//
+//	len(mockedRDSClient.SwitchoverBlueGreenDeploymentCalls())
+func (mock *RDSClientMock) SwitchoverBlueGreenDeploymentCalls() []struct {
+	Ctx    context.Context
+	Params *rds.SwitchoverBlueGreenDeploymentInput
+	OptFns []func(*rds.Options)
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Params *rds.SwitchoverBlueGreenDeploymentInput
+		OptFns []func(*rds.Options)
+	}
+	mock.lockSwitchoverBlueGreenDeployment.RLock()
+	calls = mock.calls.SwitchoverBlueGreenDeployment
+	mock.lockSwitchoverBlueGreenDeployment.RUnlock()
+	return calls
+}
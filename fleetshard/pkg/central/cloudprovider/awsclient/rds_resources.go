@@ -0,0 +1,216 @@
+package awsclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
+)
+
+const manualSnapshotType = "manual"
+
+var _ cloudprovider.DBResourceLister = &RDS{}
+
+// ListDBResources returns the RDS clusters, instances and manual cluster snapshots that carry the shared tags of
+// this data plane cluster and an ACS instance ID tag
+func (r *RDS) ListDBResources(ctx context.Context) ([]cloudprovider.DBResource, error) {
+	var resources []cloudprovider.DBResource
+
+	clustersInput := &rds.DescribeDBClustersInput{}
+	for {
+		out, err := r.rdsClient.DescribeDBClusters(ctx, clustersInput)
+		if err != nil {
+			return nil, fmt.Errorf("listing DB clusters: %w", err)
+		}
+		for _, cluster := range out.DBClusters {
+			if resource, ok := r.toDBResource(cloudprovider.DBResourceCluster, cluster.DBClusterIdentifier, cluster.TagList, cluster.ClusterCreateTime); ok {
+				resources = append(resources, resource)
+			}
+		}
+		if out.Marker == nil {
+			break
+		}
+		clustersInput.Marker = out.Marker
+	}
+
+	instancesInput := &rds.DescribeDBInstancesInput{}
+	for {
+		out, err := r.rdsClient.DescribeDBInstances(ctx, instancesInput)
+		if err != nil {
+			return nil, fmt.Errorf("listing DB instances: %w", err)
+		}
+		for _, instance := range out.DBInstances {
+			if resource, ok := r.toDBResource(cloudprovider.DBResourceInstance, instance.DBInstanceIdentifier, instance.TagList, instance.InstanceCreateTime); ok {
+				resources = append(resources, resource)
+			}
+		}
+		if out.Marker == nil {
+			break
+		}
+		instancesInput.Marker = out.Marker
+	}
+
+	snapshotsInput := &rds.DescribeDBClusterSnapshotsInput{SnapshotType: aws.String(manualSnapshotType)}
+	for {
+		out, err := r.rdsClient.DescribeDBClusterSnapshots(ctx, snapshotsInput)
+		if err != nil {
+			return nil, fmt.Errorf("listing DB cluster snapshots: %w", err)
+		}
+		for _, snapshot := range out.DBClusterSnapshots {
+			if resource, ok := r.toDBResource(cloudprovider.DBResourceSnapshot, snapshot.DBClusterSnapshotIdentifier, snapshot.TagList, snapshot.SnapshotCreateTime); ok {
+				resources = append(resources, resource)
+			}
+		}
+		if out.Marker == nil {
+			break
+		}
+		snapshotsInput.Marker = out.Marker
+	}
+
+	return resources, nil
+}
+
+// DeleteDBResource initiates the deletion of an RDS resource. The instances of a cluster are deleted first, the
+// cluster itself is deleted by a later call once all its instances are gone. Clusters are deleted with a final
+// snapshot, which is in turn subject to orphan detection.
+func (r *RDS) DeleteDBResource(ctx context.Context, resource cloudprovider.DBResource) error {
+	switch resource.Type {
+	case cloudprovider.DBResourceInstance:
+		return r.ensureInstanceDeleted(resource.ID)
+	case cloudprovider.DBResourceCluster:
+		dbCluster, err := r.describeDBCluster(resource.ID)
+		if err != nil {
+			var notFound *types.DBClusterNotFoundFault
+			if errors.As(err, &notFound) {
+				return nil
+			}
+			return err
+		}
+		if len(dbCluster.DBClusterMembers) > 0 {
+			for _, member := range dbCluster.DBClusterMembers {
+				if err := r.ensureInstanceDeleted(aws.ToString(member.DBInstanceIdentifier)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		return r.ensureClusterDeleted(resource.ID, false)
+	case cloudprovider.DBResourceSnapshot:
+		glog.Infof("Deleting DB cluster snapshot %s", resource.ID)
+		_, err := r.rdsClient.DeleteDBClusterSnapshot(ctx, &rds.DeleteDBClusterSnapshotInput{
+			DBClusterSnapshotIdentifier: aws.String(resource.ID),
+		})
+		if err != nil {
+			var notFound *types.DBClusterSnapshotNotFoundFault
+			if errors.As(err, &notFound) {
+				return nil
+			}
+			return fmt.Errorf("deleting DB cluster snapshot %s: %w", resource.ID, err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown DB resource type %q", resource.Type)
+	}
+}
+
+// AdoptDBResources tags the cluster, instances and manual snapshots of a database with the shared tags of this data
+// plane cluster. Resources which already carry them are left unchanged.
+func (r *RDS) AdoptDBResources(ctx context.Context, databaseID string) error {
+	clusterID := getClusterID(databaseID)
+	dbCluster, err := r.describeDBCluster(clusterID)
+	if err != nil {
+		return fmt.Errorf("adopting DB cluster %s: %w", clusterID, err)
+	}
+	if err := r.ensureSharedTags(ctx, dbCluster.DBClusterArn, dbCluster.TagList); err != nil {
+		return err
+	}
+
+	for _, member := range dbCluster.DBClusterMembers {
+		instance, err := r.describeDBInstance(aws.ToString(member.DBInstanceIdentifier))
+		if err != nil {
+			return fmt.Errorf("adopting DB instance %s: %w", aws.ToString(member.DBInstanceIdentifier), err)
+		}
+		if err := r.ensureSharedTags(ctx, instance.DBInstanceArn, instance.TagList); err != nil {
+			return err
+		}
+	}
+
+	snapshotsInput := &rds.DescribeDBClusterSnapshotsInput{
+		DBClusterIdentifier: aws.String(clusterID),
+		SnapshotType:        aws.String(manualSnapshotType),
+	}
+	for {
+		out, err := r.rdsClient.DescribeDBClusterSnapshots(ctx, snapshotsInput)
+		if err != nil {
+			return fmt.Errorf("listing DB cluster snapshots of %s: %w", clusterID, err)
+		}
+		for _, snapshot := range out.DBClusterSnapshots {
+			if err := r.ensureSharedTags(ctx, snapshot.DBClusterSnapshotArn, snapshot.TagList); err != nil {
+				return err
+			}
+		}
+		if out.Marker == nil {
+			break
+		}
+		snapshotsInput.Marker = out.Marker
+	}
+	return nil
+}
+
+func (r *RDS) ensureSharedTags(ctx context.Context, arn *string, tags []types.Tag) error {
+	tagValues := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		tagValues[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	var missingTags []types.Tag
+	for _, sharedTag := range r.config.SharedTags {
+		if value, ok := tagValues[sharedTag.Key]; !ok || value != sharedTag.Value {
+			missingTags = append(missingTags, types.Tag{Key: aws.String(sharedTag.Key), Value: aws.String(sharedTag.Value)})
+		}
+	}
+	if len(missingTags) == 0 {
+		return nil
+	}
+
+	glog.Infof("Tagging DB resource %s with the shared tags of this data plane cluster", aws.ToString(arn))
+	if _, err := r.rdsClient.AddTagsToResource(ctx, &rds.AddTagsToResourceInput{ResourceName: arn, Tags: missingTags}); err != nil {
+		return fmt.Errorf("tagging DB resource %s: %w", aws.ToString(arn), err)
+	}
+	return nil
+}
+
+// toDBResource converts an RDS resource to a cloudprovider.DBResource, if it was provisioned by fleetshard-sync
+// for this data plane cluster
+func (r *RDS) toDBResource(resourceType cloudprovider.DBResourceType, id *string, tags []types.Tag, createdAt *time.Time) (cloudprovider.DBResource, bool) {
+	if !strings.HasPrefix(aws.ToString(id), dbPrefix) {
+		return cloudprovider.DBResource{}, false
+	}
+
+	tagValues := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		tagValues[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	for _, sharedTag := range r.config.SharedTags {
+		if value, ok := tagValues[sharedTag.Key]; !ok || value != sharedTag.Value {
+			return cloudprovider.DBResource{}, false
+		}
+	}
+	acsInstanceID, ok := tagValues[acsInstanceIDKey]
+	if !ok || acsInstanceID == "" {
+		return cloudprovider.DBResource{}, false
+	}
+
+	return cloudprovider.DBResource{
+		Type:          resourceType,
+		ID:            aws.ToString(id),
+		ACSInstanceID: acsInstanceID,
+		CreatedAt:     aws.ToTime(createdAt),
+	}, true
+}
@@ -0,0 +1,167 @@
+package awsclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/config"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testResourceTags(clusterName, acsInstanceID string) []types.Tag {
+	return []types.Tag{
+		{Key: aws.String("DataplaneClusterName"), Value: aws.String(clusterName)},
+		{Key: aws.String(acsInstanceIDKey), Value: aws.String(acsInstanceID)},
+	}
+}
+
+func TestListDBResources(t *testing.T) {
+	mockRDSClient := &RDSClientMock{}
+	mockRDSClient.DescribeDBClustersFunc = func(ctx context.Context, params *rds.DescribeDBClustersInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClustersOutput, error) {
+		if params.Marker == nil {
+			return &rds.DescribeDBClustersOutput{
+				DBClusters: []types.DBCluster{
+					{DBClusterIdentifier: aws.String("rhacs-a-db-cluster"), TagList: testResourceTags("acs-dev-dp-01", "a")},
+					// belongs to a different data plane cluster in the same account
+					{DBClusterIdentifier: aws.String("rhacs-b-db-cluster"), TagList: testResourceTags("acs-dev-dp-02", "b")},
+				},
+				Marker: aws.String("next"),
+			}, nil
+		}
+		return &rds.DescribeDBClustersOutput{
+			DBClusters: []types.DBCluster{
+				// not provisioned by fleetshard-sync
+				{DBClusterIdentifier: aws.String("other-db-cluster"), TagList: testResourceTags("acs-dev-dp-01", "c")},
+				{DBClusterIdentifier: aws.String("rhacs-d-db-cluster"), TagList: testResourceTags("acs-dev-dp-01", "d")},
+			},
+		}, nil
+	}
+	mockRDSClient.DescribeDBInstancesFunc = func(ctx context.Context, params *rds.DescribeDBInstancesInput, optFns ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error) {
+		return &rds.DescribeDBInstancesOutput{
+			DBInstances: []types.DBInstance{
+				{DBInstanceIdentifier: aws.String("rhacs-a-db-instance"), TagList: testResourceTags("acs-dev-dp-01", "a")},
+			},
+		}, nil
+	}
+	mockRDSClient.DescribeDBClusterSnapshotsFunc = func(ctx context.Context, params *rds.DescribeDBClusterSnapshotsInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClusterSnapshotsOutput, error) {
+		assert.Equal(t, manualSnapshotType, *params.SnapshotType)
+		return &rds.DescribeDBClusterSnapshotsOutput{
+			DBClusterSnapshots: []types.DBClusterSnapshot{
+				{DBClusterSnapshotIdentifier: aws.String("rhacs-e-db-cluster-final"), TagList: testResourceTags("acs-dev-dp-01", "e")},
+				// final snapshots of clusters created without CopyTagsToSnapshot can not be attributed
+				{DBClusterSnapshotIdentifier: aws.String("rhacs-f-db-cluster-final")},
+			},
+		}, nil
+	}
+
+	rdsDBClient := &RDS{
+		rdsClient: mockRDSClient,
+		config: &config.ManagedDB{
+			SharedTags: []config.ManagedDBTag{{Key: "DataplaneClusterName", Value: "acs-dev-dp-01"}},
+		},
+	}
+
+	resources, err := rdsDBClient.ListDBResources(context.Background())
+	require.NoError(t, err)
+	require.Len(t, resources, 4)
+	assert.Equal(t, cloudprovider.DBResource{Type: cloudprovider.DBResourceCluster, ID: "rhacs-a-db-cluster", ACSInstanceID: "a"}, resources[0])
+	assert.Equal(t, "rhacs-d-db-cluster", resources[1].ID)
+	assert.Equal(t, cloudprovider.DBResourceInstance, resources[2].Type)
+	assert.Equal(t, cloudprovider.DBResourceSnapshot, resources[3].Type)
+	assert.Equal(t, "e", resources[3].ACSInstanceID)
+}
+
+func TestDeleteDBResourceClusterDeletesInstancesFirst(t *testing.T) {
+	mockRDSClient := &RDSClientMock{}
+	mockRDSClient.DescribeDBClustersFunc = func(ctx context.Context, params *rds.DescribeDBClustersInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClustersOutput, error) {
+		return &rds.DescribeDBClustersOutput{
+			DBClusters: []types.DBCluster{{
+				DBClusterIdentifier: params.DBClusterIdentifier,
+				Status:              aws.String(dbAvailableStatus),
+				DBClusterMembers:    []types.DBClusterMember{{DBInstanceIdentifier: aws.String("rhacs-a-db-instance")}},
+			}},
+		}, nil
+	}
+	mockRDSClient.DescribeDBInstancesFunc = func(ctx context.Context, params *rds.DescribeDBInstancesInput, optFns ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error) {
+		return &rds.DescribeDBInstancesOutput{
+			DBInstances: []types.DBInstance{{DBInstanceIdentifier: params.DBInstanceIdentifier, DBInstanceStatus: aws.String(dbAvailableStatus)}},
+		}, nil
+	}
+	mockRDSClient.DeleteDBInstanceFunc = func(ctx context.Context, params *rds.DeleteDBInstanceInput, optFns ...func(*rds.Options)) (*rds.DeleteDBInstanceOutput, error) {
+		return &rds.DeleteDBInstanceOutput{}, nil
+	}
+	rdsDBClient := &RDS{rdsClient: mockRDSClient, config: &config.ManagedDB{}}
+
+	err := rdsDBClient.DeleteDBResource(context.Background(), cloudprovider.DBResource{Type: cloudprovider.DBResourceCluster, ID: "rhacs-a-db-cluster"})
+
+	require.NoError(t, err)
+	require.Len(t, mockRDSClient.DeleteDBInstanceCalls(), 1)
+	assert.Equal(t, "rhacs-a-db-instance", *mockRDSClient.DeleteDBInstanceCalls()[0].Params.DBInstanceIdentifier)
+	assert.Empty(t, mockRDSClient.DeleteDBClusterCalls())
+}
+
+func TestAdoptDBResources(t *testing.T) {
+	mockRDSClient := &RDSClientMock{}
+	mockRDSClient.DescribeDBClustersFunc = func(ctx context.Context, params *rds.DescribeDBClustersInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClustersOutput, error) {
+		assert.Equal(t, "rhacs-a-db-cluster", *params.DBClusterIdentifier)
+		return &rds.DescribeDBClustersOutput{
+			DBClusters: []types.DBCluster{{
+				DBClusterIdentifier: aws.String("rhacs-a-db-cluster"),
+				DBClusterArn:        aws.String("arn:cluster"),
+				TagList:             testResourceTags("acs-dev-dp-01", "a"),
+				DBClusterMembers: []types.DBClusterMember{
+					{DBInstanceIdentifier: aws.String("rhacs-a-db-instance")},
+					{DBInstanceIdentifier: aws.String("rhacs-a-db-failover")},
+				},
+			}},
+		}, nil
+	}
+	mockRDSClient.DescribeDBInstancesFunc = func(ctx context.Context, params *rds.DescribeDBInstancesInput, optFns ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error) {
+		tags := testResourceTags("acs-dev-dp-01", "a")
+		if *params.DBInstanceIdentifier == "rhacs-a-db-failover" {
+			// already adopted
+			tags = testResourceTags("acs-dev-dp-02", "a")
+		}
+		return &rds.DescribeDBInstancesOutput{
+			DBInstances: []types.DBInstance{{
+				DBInstanceIdentifier: params.DBInstanceIdentifier,
+				DBInstanceArn:        aws.String("arn:" + *params.DBInstanceIdentifier),
+				TagList:              tags,
+			}},
+		}, nil
+	}
+	mockRDSClient.DescribeDBClusterSnapshotsFunc = func(ctx context.Context, params *rds.DescribeDBClusterSnapshotsInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClusterSnapshotsOutput, error) {
+		assert.Equal(t, "rhacs-a-db-cluster", *params.DBClusterIdentifier)
+		assert.Equal(t, manualSnapshotType, *params.SnapshotType)
+		return &rds.DescribeDBClusterSnapshotsOutput{
+			DBClusterSnapshots: []types.DBClusterSnapshot{{
+				DBClusterSnapshotIdentifier: aws.String("rhacs-a-db-cluster-pre-upgrade-16"),
+				DBClusterSnapshotArn:        aws.String("arn:snapshot"),
+				TagList:                     testResourceTags("acs-dev-dp-01", "a"),
+			}},
+		}, nil
+	}
+	mockRDSClient.AddTagsToResourceFunc = func(ctx context.Context, params *rds.AddTagsToResourceInput, optFns ...func(*rds.Options)) (*rds.AddTagsToResourceOutput, error) {
+		return &rds.AddTagsToResourceOutput{}, nil
+	}
+
+	rdsDBClient := &RDS{
+		rdsClient: mockRDSClient,
+		config: &config.ManagedDB{
+			SharedTags: []config.ManagedDBTag{{Key: "DataplaneClusterName", Value: "acs-dev-dp-02"}},
+		},
+	}
+
+	require.NoError(t, rdsDBClient.AdoptDBResources(context.Background(), "a"))
+	calls := mockRDSClient.AddTagsToResourceCalls()
+	require.Len(t, calls, 3)
+	assert.Equal(t, "arn:cluster", *calls[0].Params.ResourceName)
+	assert.Equal(t, "arn:rhacs-a-db-instance", *calls[1].Params.ResourceName)
+	assert.Equal(t, "arn:snapshot", *calls[2].Params.ResourceName)
+	assert.Equal(t, []types.Tag{{Key: aws.String("DataplaneClusterName"), Value: aws.String("acs-dev-dp-02")}}, calls[0].Params.Tags)
+}
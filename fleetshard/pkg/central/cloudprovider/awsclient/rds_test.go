@@ -310,6 +310,222 @@ func TestRDSTags(t *testing.T) {
 	require.Equal(t, *tags[2].Value, "veryrandomid")
 }
 
+func newEngineUpgradeTestRDS(clusterStatus, engineVersion string) (*RDS, *RDSClientMock) {
+	mockRDSClient := &RDSClientMock{}
+	mockRDSClient.DescribeDBClustersFunc = func(ctx context.Context, params *rds.DescribeDBClustersInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClustersOutput, error) {
+		return &rds.DescribeDBClustersOutput{
+			DBClusters: []types.DBCluster{{
+				DBClusterIdentifier: params.DBClusterIdentifier,
+				DBClusterArn:        aws.String("arn:aws:rds:us-east-1:123456789012:cluster:" + aws.ToString(params.DBClusterIdentifier)),
+				EngineVersion:       aws.String(engineVersion),
+				Status:              aws.String(clusterStatus),
+			}},
+		}, nil
+	}
+	mockRDSClient.DescribeBlueGreenDeploymentsFunc = func(ctx context.Context, params *rds.DescribeBlueGreenDeploymentsInput, optFns ...func(*rds.Options)) (*rds.DescribeBlueGreenDeploymentsOutput, error) {
+		return &rds.DescribeBlueGreenDeploymentsOutput{}, nil
+	}
+	return &RDS{rdsClient: mockRDSClient, config: &config.ManagedDB{}}, mockRDSClient
+}
+
+func availableSnapshot(ctx context.Context, params *rds.DescribeDBClusterSnapshotsInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClusterSnapshotsOutput, error) {
+	return &rds.DescribeDBClusterSnapshotsOutput{
+		DBClusterSnapshots: []types.DBClusterSnapshot{{
+			DBClusterSnapshotIdentifier: params.DBClusterSnapshotIdentifier,
+			Status:                      aws.String(dbAvailableStatus),
+		}},
+	}, nil
+}
+
+func TestEnsureDBEngineVersionCompleted(t *testing.T) {
+	rdsDBClient, mockRDSClient := newEngineUpgradeTestRDS(dbAvailableStatus, "15.4")
+	clusterID := getClusterID("veryrandomid")
+	mockRDSClient.DescribeDBInstancesFunc = func(ctx context.Context, params *rds.DescribeDBInstancesInput, optFns ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error) {
+		return nil, &types.DBInstanceNotFoundFault{Message: aws.String("instance not found")}
+	}
+	mockRDSClient.DescribeDBClusterSnapshotsFunc = func(ctx context.Context, params *rds.DescribeDBClusterSnapshotsInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClusterSnapshotsOutput, error) {
+		return &rds.DescribeDBClusterSnapshotsOutput{
+			DBClusterSnapshots: []types.DBClusterSnapshot{
+				{
+					DBClusterSnapshotIdentifier: aws.String(getPreUpgradeSnapshotID(clusterID, "15.4")),
+					SnapshotCreateTime:          aws.Time(time.Now().Add(-preUpgradeSnapshotRetention - time.Hour)),
+				},
+				{
+					DBClusterSnapshotIdentifier: aws.String(getPreUpgradeSnapshotID(clusterID, "16.1")),
+					SnapshotCreateTime:          aws.Time(time.Now().Add(-time.Hour)),
+				},
+				{
+					DBClusterSnapshotIdentifier: aws.String(clusterID + "-manual"),
+					SnapshotCreateTime:          aws.Time(time.Now().Add(-preUpgradeSnapshotRetention - time.Hour)),
+				},
+			},
+		}, nil
+	}
+	mockRDSClient.DeleteDBClusterFunc = func(ctx context.Context, params *rds.DeleteDBClusterInput, optFns ...func(*rds.Options)) (*rds.DeleteDBClusterOutput, error) {
+		return &rds.DeleteDBClusterOutput{}, nil
+	}
+	mockRDSClient.DeleteDBClusterSnapshotFunc = func(ctx context.Context, params *rds.DeleteDBClusterSnapshotInput, optFns ...func(*rds.Options)) (*rds.DeleteDBClusterSnapshotOutput, error) {
+		return &rds.DeleteDBClusterSnapshotOutput{}, nil
+	}
+
+	status, err := rdsDBClient.EnsureDBEngineVersion(context.Background(), "veryrandomid", cloudprovider.DBEngineUpgradeSpec{
+		EngineVersion: "15.4",
+		Strategy:      cloudprovider.DBEngineUpgradeStrategyInPlace,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, status.Completed())
+	require.Len(t, mockRDSClient.DeleteDBClusterCalls(), 1, "the previous blue cluster is deleted")
+	assert.Equal(t, clusterID+blueGreenOldSuffix, *mockRDSClient.DeleteDBClusterCalls()[0].Params.DBClusterIdentifier)
+	require.Len(t, mockRDSClient.DeleteDBClusterSnapshotCalls(), 1, "only expired pre-upgrade snapshots are deleted")
+	assert.Equal(t, getPreUpgradeSnapshotID(clusterID, "15.4"), *mockRDSClient.DeleteDBClusterSnapshotCalls()[0].Params.DBClusterSnapshotIdentifier)
+}
+
+func TestEnsureDBEngineVersionKeepsPreviousClusterDuringSwitchover(t *testing.T) {
+	rdsDBClient, mockRDSClient := newEngineUpgradeTestRDS(dbAvailableStatus, "15.4")
+	mockRDSClient.DescribeBlueGreenDeploymentsFunc = func(ctx context.Context, params *rds.DescribeBlueGreenDeploymentsInput, optFns ...func(*rds.Options)) (*rds.DescribeBlueGreenDeploymentsOutput, error) {
+		return &rds.DescribeBlueGreenDeploymentsOutput{
+			BlueGreenDeployments: []types.BlueGreenDeployment{{
+				BlueGreenDeploymentIdentifier: aws.String("bgd-12345"),
+				Status:                        aws.String(blueGreenDeletingStatus),
+			}},
+		}, nil
+	}
+
+	status, err := rdsDBClient.EnsureDBEngineVersion(context.Background(), "veryrandomid", cloudprovider.DBEngineUpgradeSpec{
+		EngineVersion: "15.4",
+		Strategy:      cloudprovider.DBEngineUpgradeStrategyBlueGreen,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, status.Completed())
+	assert.Empty(t, mockRDSClient.DeleteDBClusterCalls())
+}
+
+func TestEnsureDBEngineVersionRejectsDowngrade(t *testing.T) {
+	rdsDBClient, _ := newEngineUpgradeTestRDS(dbAvailableStatus, "15.4")
+
+	_, err := rdsDBClient.EnsureDBEngineVersion(context.Background(), "veryrandomid", cloudprovider.DBEngineUpgradeSpec{
+		EngineVersion: "14.9",
+		Strategy:      cloudprovider.DBEngineUpgradeStrategyInPlace,
+	})
+
+	require.ErrorIs(t, err, cloudprovider.ErrDBEngineUpgradeNotSupported)
+}
+
+func TestValidateEngineUpgrade(t *testing.T) {
+	tests := map[string]struct {
+		current, desired string
+		wantErr          bool
+	}{
+		"major upgrade":   {current: "14.9", desired: "15.4"},
+		"minor upgrade":   {current: "15.4", desired: "15.10"},
+		"major downgrade": {current: "15.4", desired: "14.9", wantErr: true},
+		"minor downgrade": {current: "15.10", desired: "15.4", wantErr: true},
+		"invalid version": {current: "15.4", desired: "latest", wantErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateEngineUpgrade(tc.current, tc.desired)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, cloudprovider.ErrDBEngineUpgradeNotSupported)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEnsureDBEngineVersionRejectsUnknownStrategy(t *testing.T) {
+	rdsDBClient, mockRDSClient := newEngineUpgradeTestRDS(dbAvailableStatus, "14.9")
+
+	_, err := rdsDBClient.EnsureDBEngineVersion(context.Background(), "veryrandomid", cloudprovider.DBEngineUpgradeSpec{
+		EngineVersion: "15.4",
+		Strategy:      "blue_green",
+	})
+
+	require.ErrorIs(t, err, cloudprovider.ErrDBEngineUpgradeNotSupported)
+	assert.Empty(t, mockRDSClient.DescribeDBClustersCalls())
+}
+
+func TestEnsureDBEngineVersionCreatesSnapshotFirst(t *testing.T) {
+	rdsDBClient, mockRDSClient := newEngineUpgradeTestRDS(dbAvailableStatus, "14.9")
+	mockRDSClient.DescribeDBClusterSnapshotsFunc = func(ctx context.Context, params *rds.DescribeDBClusterSnapshotsInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClusterSnapshotsOutput, error) {
+		return nil, &types.DBClusterSnapshotNotFoundFault{Message: aws.String("snapshot not found")}
+	}
+	var snapshotInput *rds.CreateDBClusterSnapshotInput
+	mockRDSClient.CreateDBClusterSnapshotFunc = func(ctx context.Context, params *rds.CreateDBClusterSnapshotInput, optFns ...func(*rds.Options)) (*rds.CreateDBClusterSnapshotOutput, error) {
+		snapshotInput = params
+		return &rds.CreateDBClusterSnapshotOutput{}, nil
+	}
+
+	status, err := rdsDBClient.EnsureDBEngineVersion(context.Background(), "veryrandomid", cloudprovider.DBEngineUpgradeSpec{
+		EngineVersion: "15.4",
+		Strategy:      cloudprovider.DBEngineUpgradeStrategyInPlace,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, cloudprovider.DBEngineUpgradePhaseSnapshotting, status.Phase)
+	require.NotNil(t, snapshotInput)
+	assert.Equal(t, getPreUpgradeSnapshotID(getClusterID("veryrandomid"), "15.4"), *snapshotInput.DBClusterSnapshotIdentifier)
+	assert.Empty(t, mockRDSClient.ModifyDBClusterCalls())
+}
+
+func TestEnsureDBEngineVersionInPlace(t *testing.T) {
+	rdsDBClient, mockRDSClient := newEngineUpgradeTestRDS(dbAvailableStatus, "14.9")
+	mockRDSClient.DescribeDBClusterSnapshotsFunc = availableSnapshot
+	mockRDSClient.ModifyDBClusterFunc = func(ctx context.Context, params *rds.ModifyDBClusterInput, optFns ...func(*rds.Options)) (*rds.ModifyDBClusterOutput, error) {
+		return &rds.ModifyDBClusterOutput{}, nil
+	}
+
+	status, err := rdsDBClient.EnsureDBEngineVersion(context.Background(), "veryrandomid", cloudprovider.DBEngineUpgradeSpec{
+		EngineVersion:         "15.4",
+		Strategy:              cloudprovider.DBEngineUpgradeStrategyInPlace,
+		ClusterParameterGroup: "postgres15",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, cloudprovider.DBEngineUpgradePhaseUpgrading, status.Phase)
+	require.Len(t, mockRDSClient.ModifyDBClusterCalls(), 1)
+	input := mockRDSClient.ModifyDBClusterCalls()[0].Params
+	assert.Equal(t, "15.4", *input.EngineVersion)
+	assert.Equal(t, "postgres15", *input.DBClusterParameterGroupName)
+	assert.True(t, *input.AllowMajorVersionUpgrade)
+}
+
+func TestEnsureDBEngineVersionBlueGreen(t *testing.T) {
+	rdsDBClient, mockRDSClient := newEngineUpgradeTestRDS(dbAvailableStatus, "14.9")
+	mockRDSClient.DescribeDBClusterSnapshotsFunc = availableSnapshot
+	mockRDSClient.CreateBlueGreenDeploymentFunc = func(ctx context.Context, params *rds.CreateBlueGreenDeploymentInput, optFns ...func(*rds.Options)) (*rds.CreateBlueGreenDeploymentOutput, error) {
+		return &rds.CreateBlueGreenDeploymentOutput{}, nil
+	}
+	mockRDSClient.SwitchoverBlueGreenDeploymentFunc = func(ctx context.Context, params *rds.SwitchoverBlueGreenDeploymentInput, optFns ...func(*rds.Options)) (*rds.SwitchoverBlueGreenDeploymentOutput, error) {
+		return &rds.SwitchoverBlueGreenDeploymentOutput{}, nil
+	}
+	spec := cloudprovider.DBEngineUpgradeSpec{EngineVersion: "15.4", Strategy: cloudprovider.DBEngineUpgradeStrategyBlueGreen}
+
+	status, err := rdsDBClient.EnsureDBEngineVersion(context.Background(), "veryrandomid", spec)
+	require.NoError(t, err)
+	assert.Equal(t, cloudprovider.DBEngineUpgradePhaseUpgrading, status.Phase)
+	require.Len(t, mockRDSClient.CreateBlueGreenDeploymentCalls(), 1)
+	assert.Equal(t, "15.4", *mockRDSClient.CreateBlueGreenDeploymentCalls()[0].Params.TargetEngineVersion)
+
+	mockRDSClient.DescribeBlueGreenDeploymentsFunc = func(ctx context.Context, params *rds.DescribeBlueGreenDeploymentsInput, optFns ...func(*rds.Options)) (*rds.DescribeBlueGreenDeploymentsOutput, error) {
+		return &rds.DescribeBlueGreenDeploymentsOutput{
+			BlueGreenDeployments: []types.BlueGreenDeployment{{
+				BlueGreenDeploymentIdentifier: aws.String("bgd-12345"),
+				Status:                        aws.String(blueGreenAvailableStatus),
+			}},
+		}, nil
+	}
+
+	status, err = rdsDBClient.EnsureDBEngineVersion(context.Background(), "veryrandomid", spec)
+	require.NoError(t, err)
+	assert.Equal(t, cloudprovider.DBEngineUpgradePhaseSwitchingOver, status.Phase)
+	require.Len(t, mockRDSClient.SwitchoverBlueGreenDeploymentCalls(), 1)
+	assert.Empty(t, mockRDSClient.ModifyDBClusterCalls())
+}
+
 func randomNonFinalSnapshotsID(clusterID string) *string {
 	return aws.String(fmt.Sprintf("%s-%s", clusterID, rand.String(20)))
 }
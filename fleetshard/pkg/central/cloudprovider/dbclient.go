@@ -4,6 +4,7 @@ package cloudprovider
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/postgres"
 )
@@ -24,6 +25,66 @@ type DBClient interface {
 	// GetAccountQuotas returns database-related service quotas for the cloud provider region on which
 	// the instance of fleetshard-sync runs
 	GetAccountQuotas(ctx context.Context) (AccountQuotas, error)
+	// EnsureDBEngineVersion is a non-blocking function that drives the upgrade of a database to the engine version
+	// given in the spec. A snapshot of the database is taken before the upgrade is started. Every call advances the
+	// upgrade by at most one step and returns its current progress.
+	EnsureDBEngineVersion(ctx context.Context, databaseID string, spec DBEngineUpgradeSpec) (DBEngineUpgradeStatus, error)
+}
+
+// DBEngineUpgradeStrategy defines how a database engine upgrade is applied
+type DBEngineUpgradeStrategy string
+
+// Supported database engine upgrade strategies
+const (
+	// DBEngineUpgradeStrategyInPlace modifies the engine version of the existing database, which causes a downtime
+	DBEngineUpgradeStrategyInPlace DBEngineUpgradeStrategy = "in-place"
+	// DBEngineUpgradeStrategyBlueGreen creates an upgraded copy of the database and switches over to it
+	DBEngineUpgradeStrategyBlueGreen DBEngineUpgradeStrategy = "blue-green"
+)
+
+// DBEngineUpgradeSpec describes the desired engine of a database
+type DBEngineUpgradeSpec struct {
+	EngineVersion string
+	Strategy      DBEngineUpgradeStrategy
+	// ClusterParameterGroup is the parameter group used after the upgrade. Required for major version upgrades
+	// if the database uses a custom parameter group, otherwise the default group of the target version is used.
+	ClusterParameterGroup string
+}
+
+// Validate rejects specs which can not be applied by any provider
+func (s DBEngineUpgradeSpec) Validate() error {
+	if s.EngineVersion == "" {
+		return fmt.Errorf("%w: no engine version given", ErrDBEngineUpgradeNotSupported)
+	}
+	switch s.Strategy {
+	case DBEngineUpgradeStrategyInPlace, DBEngineUpgradeStrategyBlueGreen:
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown upgrade strategy %q, supported are %q and %q", ErrDBEngineUpgradeNotSupported,
+			s.Strategy, DBEngineUpgradeStrategyInPlace, DBEngineUpgradeStrategyBlueGreen)
+	}
+}
+
+// DBEngineUpgradePhase describes the current step of a database engine upgrade
+type DBEngineUpgradePhase string
+
+// Database engine upgrade phases
+const (
+	DBEngineUpgradePhaseSnapshotting  DBEngineUpgradePhase = "Snapshotting"
+	DBEngineUpgradePhaseUpgrading     DBEngineUpgradePhase = "Upgrading"
+	DBEngineUpgradePhaseSwitchingOver DBEngineUpgradePhase = "SwitchingOver"
+	DBEngineUpgradePhaseCompleted     DBEngineUpgradePhase = "Completed"
+)
+
+// DBEngineUpgradeStatus holds the progress of a database engine upgrade
+type DBEngineUpgradeStatus struct {
+	Phase   DBEngineUpgradePhase
+	Message string
+}
+
+// Completed returns true if the database runs the desired engine version
+func (s DBEngineUpgradeStatus) Completed() bool {
+	return s.Phase == DBEngineUpgradePhaseCompleted
 }
 
 // AccountQuotas maps a service to its quota values
@@ -45,6 +106,10 @@ var ErrDBBackupInProgress = errors.New("DB Backup in Progress")
 // ErrDBNotFound is returned if an action failed because a expected DB is not found
 var ErrDBNotFound = errors.New("DB not found")
 
+// ErrDBEngineUpgradeNotSupported is returned for engine upgrades which will not succeed without a change of the
+// requested spec, e.g. downgrades, and for upgrades which need manual intervention
+var ErrDBEngineUpgradeNotSupported = errors.New("DB engine upgrade not supported")
+
 // AccountQuotaValue holds quota data for services, as a pair of currently Used out of Max
 type AccountQuotaValue struct {
 	Used int64
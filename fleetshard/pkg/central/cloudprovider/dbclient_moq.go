@@ -22,6 +22,9 @@ var _ DBClient = &DBClientMock{}
 //			EnsureDBDeprovisionedFunc: func(databaseID string, skipFinalSnapshot bool) error {
 //				panic("mock out the EnsureDBDeprovisioned method")
 //			},
+//			EnsureDBEngineVersionFunc: func(ctx context.Context, databaseID string, spec DBEngineUpgradeSpec) (DBEngineUpgradeStatus, error) {
+//				panic("mock out the EnsureDBEngineVersion method")
+//			},
 //			EnsureDBProvisionedFunc: func(ctx context.Context, databaseID string, acsInstanceID string, passwordSecretName string, isTestInstance bool) error {
 //				panic("mock out the EnsureDBProvisioned method")
 //			},
@@ -41,6 +44,9 @@ type DBClientMock struct {
 	// EnsureDBDeprovisionedFunc mocks the EnsureDBDeprovisioned method.
 	EnsureDBDeprovisionedFunc func(databaseID string, skipFinalSnapshot bool) error
 
+	// EnsureDBEngineVersionFunc mocks the EnsureDBEngineVersion method.
+	EnsureDBEngineVersionFunc func(ctx context.Context, databaseID string, spec DBEngineUpgradeSpec) (DBEngineUpgradeStatus, error)
+
 	// EnsureDBProvisionedFunc mocks the EnsureDBProvisioned method.
 	EnsureDBProvisionedFunc func(ctx context.Context, databaseID string, acsInstanceID string, passwordSecretName string, isTestInstance bool) error
 
@@ -59,6 +65,15 @@ type DBClientMock struct {
 			// SkipFinalSnapshot is the skipFinalSnapshot argument value.
 			SkipFinalSnapshot bool
 		}
+		// EnsureDBEngineVersion holds details about calls to the EnsureDBEngineVersion method.
+		EnsureDBEngineVersion []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DatabaseID is the databaseID argument value.
+			DatabaseID string
+			// Spec is the spec argument value.
+			Spec DBEngineUpgradeSpec
+		}
 		// EnsureDBProvisioned holds details about calls to the EnsureDBProvisioned method.
 		EnsureDBProvisioned []struct {
 			// Ctx is the ctx argument value.
@@ -84,6 +99,7 @@ type DBClientMock struct {
 		}
 	}
 	lockEnsureDBDeprovisioned sync.RWMutex
+	lockEnsureDBEngineVersion sync.RWMutex
 	lockEnsureDBProvisioned   sync.RWMutex
 	lockGetAccountQuotas      sync.RWMutex
 	lockGetDBConnection       sync.RWMutex
@@ -125,6 +141,46 @@ func (mock *DBClientMock) EnsureDBDeprovisionedCalls() []struct {
 	return calls
 }
 
+// EnsureDBEngineVersion calls EnsureDBEngineVersionFunc.
+func (mock *DBClientMock) EnsureDBEngineVersion(ctx context.Context, databaseID string, spec DBEngineUpgradeSpec) (DBEngineUpgradeStatus, error) {
+	if mock.EnsureDBEngineVersionFunc == nil {
+		panic("DBClientMock.EnsureDBEngineVersionFunc: method is nil but DBClient.EnsureDBEngineVersion was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		DatabaseID string
+		Spec       DBEngineUpgradeSpec
+	}{
+		Ctx:        ctx,
+		DatabaseID: databaseID,
+		Spec:       spec,
+	}
+	mock.lockEnsureDBEngineVersion.Lock()
+	mock.calls.EnsureDBEngineVersion = append(mock.calls.EnsureDBEngineVersion, callInfo)
+	mock.lockEnsureDBEngineVersion.Unlock()
+	return mock.EnsureDBEngineVersionFunc(ctx, databaseID, spec)
+}
+
+// EnsureDBEngineVersionCalls gets all the calls that were made to EnsureDBEngineVersion.
+// Check the length with:
+//
+//	len(mockedDBClient.EnsureDBEngineVersionCalls())
+func (mock *DBClientMock) EnsureDBEngineVersionCalls() []struct {
+	Ctx        context.Context
+	DatabaseID string
+	Spec       DBEngineUpgradeSpec
+} {
+	var calls []struct {
+		Ctx        context.Context
+		DatabaseID string
+		Spec       DBEngineUpgradeSpec
+	}
+	mock.lockEnsureDBEngineVersion.RLock()
+	calls = mock.calls.EnsureDBEngineVersion
+	mock.lockEnsureDBEngineVersion.RUnlock()
+	return calls
+}
+
 // EnsureDBProvisioned calls EnsureDBProvisionedFunc.
 func (mock *DBClientMock) EnsureDBProvisioned(ctx context.Context, databaseID string, acsInstanceID string, passwordSecretName string, isTestInstance bool) error {
 	if mock.EnsureDBProvisionedFunc == nil {
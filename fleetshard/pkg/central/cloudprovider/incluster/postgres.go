@@ -0,0 +1,408 @@
+// Package incluster provides implementations of the interfaces in cloudprovider that provision resources within the
+// data plane cluster, for clusters that have no managed database service available (e.g. on-prem or GCP dev clusters)
+package incluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/config"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/postgres"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/k8s"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	dbPrefix       = "central-db-"
+	dbUser         = "postgres"
+	dbName         = "postgres"
+	dbPostgresPort = 5432
+
+	dbPasswordKey = "password" // pragma: allowlist secret
+	dbTLSCertKey  = "tls.crt"
+	dbTLSKeyKey   = "tls.key"
+	dbDataVolume  = "data"
+	dbDataPath    = "/var/lib/postgresql/data"
+	dbTLSVolume   = "tls"
+	dbTLSPath     = "/etc/postgresql/tls"
+	// dbGroupID is the group of the postgres user in the official image, which must be able to read the TLS key
+	dbGroupID      = 999
+	dbRetryPeriod  = 10 * time.Second
+	dbAppLabelKey  = "app.kubernetes.io/name"
+	dbAppLabelVal  = "central-db"
+	dbIDLabelKey   = "rhacs.redhat.com/database-id"
+	tenantLabelKey = "rhacs.redhat.com/tenant"
+	testLabelKey   = "rhacs.redhat.com/test-instance"
+)
+
+// Postgres is a cloudprovider.DBClient that runs every Central database as a single replica PostgreSQL StatefulSet
+// in a dedicated namespace of the data plane cluster. It does not provide backups or high availability.
+type Postgres struct {
+	client ctrlClient.Client
+	config *config.ManagedDBInCluster
+}
+
+// NewDBClient is the cloudprovider.DBClientFactory of the in-cluster managed DB provider
+func NewDBClient(config *config.Config, k8sClient ctrlClient.Client) (cloudprovider.DBClient, error) {
+	return NewPostgres(k8sClient, &config.ManagedDB.InCluster)
+}
+
+// NewPostgres initializes a new incluster.Postgres
+func NewPostgres(k8sClient ctrlClient.Client, config *config.ManagedDBInCluster) (*Postgres, error) {
+	if _, err := resource.ParseQuantity(config.StorageSize); err != nil {
+		return nil, fmt.Errorf("parsing in-cluster DB storage size %q: %w", config.StorageSize, err)
+	}
+	return &Postgres{
+		client: k8sClient,
+		config: config,
+	}, nil
+}
+
+var _ cloudprovider.DBClient = &Postgres{}
+
+// EnsureDBProvisioned is a blocking function that makes sure that the PostgreSQL StatefulSet of a Central exists
+// and is ready. The master password is only used when the database is created for the first time.
+func (p *Postgres) EnsureDBProvisioned(ctx context.Context, databaseID, acsInstanceID, masterPassword string, isTestInstance bool) error {
+	name := getResourceName(databaseID)
+	labels := getLabels(databaseID, acsInstanceID, isTestInstance)
+
+	if err := p.ensureNamespaceExists(ctx); err != nil {
+		return err
+	}
+	if err := p.ensureSecretExists(ctx, name, labels, masterPassword); err != nil {
+		return fmt.Errorf("ensuring DB secret %s exists: %w", name, err)
+	}
+	if err := p.ensureServiceExists(ctx, name, labels); err != nil {
+		return fmt.Errorf("ensuring DB service %s exists: %w", name, err)
+	}
+	if err := p.ensureStatefulSetExists(ctx, name, labels); err != nil {
+		return fmt.Errorf("ensuring DB StatefulSet %s exists: %w", name, err)
+	}
+
+	return p.waitForDBToBeReady(ctx, name)
+}
+
+// EnsureDBDeprovisioned initiates the deletion of all resources of the Central database, including its volume.
+// The in-cluster provider does not take snapshots, so skipFinalSnapshot has no effect.
+func (p *Postgres) EnsureDBDeprovisioned(databaseID string, skipFinalSnapshot bool) error {
+	ctx := context.TODO()
+	name := getResourceName(databaseID)
+	if !skipFinalSnapshot {
+		glog.Infof("No final snapshot is taken for in-cluster DB %s/%s", p.config.Namespace, name)
+	}
+
+	objectMeta := metav1.ObjectMeta{Namespace: p.config.Namespace, Name: name}
+	objects := []ctrlClient.Object{
+		&appsv1.StatefulSet{ObjectMeta: objectMeta},
+		&corev1.Service{ObjectMeta: objectMeta},
+		&corev1.Secret{ObjectMeta: objectMeta},
+	}
+	for _, obj := range objects {
+		if err := p.client.Delete(ctx, obj); err != nil && !apiErrors.IsNotFound(err) {
+			return fmt.Errorf("deleting %T %s/%s: %w", obj, p.config.Namespace, name, err)
+		}
+	}
+
+	err := p.client.DeleteAllOf(ctx, &corev1.PersistentVolumeClaim{},
+		ctrlClient.InNamespace(p.config.Namespace), ctrlClient.MatchingLabels{dbIDLabelKey: databaseID})
+	if err != nil {
+		return fmt.Errorf("deleting volumes of DB %s/%s: %w", p.config.Namespace, name, err)
+	}
+
+	return nil
+}
+
+// GetDBConnection returns a postgres.DBConnection pointing to the service of the Central database
+func (p *Postgres) GetDBConnection(databaseID string) (postgres.DBConnection, error) {
+	name := getResourceName(databaseID)
+	service := &corev1.Service{}
+	err := p.client.Get(context.TODO(), ctrlClient.ObjectKey{Namespace: p.config.Namespace, Name: name}, service)
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			err = errors.Join(cloudprovider.ErrDBNotFound, err)
+		}
+		return postgres.DBConnection{}, fmt.Errorf("getting DB service %s/%s: %w", p.config.Namespace, name, err)
+	}
+
+	host := getServiceHost(service.Name, service.Namespace)
+	connection, err := postgres.NewDBConnection(host, dbPostgresPort, dbUser, dbName)
+	if err != nil {
+		return postgres.DBConnection{}, fmt.Errorf("incorrect DB connection parameters: %w", err)
+	}
+
+	return connection.WithSSLMode(p.config.SSLMode), nil
+}
+
+// GetAccountQuotas returns the number of provisioned in-cluster databases out of the configured maximum
+func (p *Postgres) GetAccountQuotas(ctx context.Context) (cloudprovider.AccountQuotas, error) {
+	statefulSets := &appsv1.StatefulSetList{}
+	err := p.client.List(ctx, statefulSets, ctrlClient.InNamespace(p.config.Namespace), ctrlClient.MatchingLabels{dbAppLabelKey: dbAppLabelVal})
+	if err != nil {
+		return nil, fmt.Errorf("listing in-cluster DBs: %w", err)
+	}
+
+	used := int64(len(statefulSets.Items))
+	return cloudprovider.AccountQuotas{
+		cloudprovider.DBClusters:  {Used: used, Max: p.config.MaxDatabases},
+		cloudprovider.DBInstances: {Used: used, Max: p.config.MaxDatabases},
+	}, nil
+}
+
+// EnsureDBEngineVersion reports whether the database runs the requested engine version. Upgrades of the PostgreSQL
+// data directory are not supported by the in-cluster provider, a different version results in an error.
+func (p *Postgres) EnsureDBEngineVersion(ctx context.Context, databaseID string, spec cloudprovider.DBEngineUpgradeSpec) (cloudprovider.DBEngineUpgradeStatus, error) {
+	name := getResourceName(databaseID)
+	statefulSet := &appsv1.StatefulSet{}
+	if err := p.client.Get(ctx, ctrlClient.ObjectKey{Namespace: p.config.Namespace, Name: name}, statefulSet); err != nil {
+		return cloudprovider.DBEngineUpgradeStatus{}, fmt.Errorf("getting DB StatefulSet %s/%s: %w", p.config.Namespace, name, err)
+	}
+
+	containers := statefulSet.Spec.Template.Spec.Containers
+	if len(containers) == 0 {
+		return cloudprovider.DBEngineUpgradeStatus{}, fmt.Errorf("DB StatefulSet %s/%s has no containers", p.config.Namespace, name)
+	}
+	version := getImageTag(containers[0].Image)
+	if version != spec.EngineVersion {
+		return cloudprovider.DBEngineUpgradeStatus{}, fmt.Errorf("%w: upgrading in-cluster DB %s from engine version %s to %s",
+			cloudprovider.ErrDBEngineUpgradeNotSupported, name, version, spec.EngineVersion)
+	}
+	return cloudprovider.DBEngineUpgradeStatus{
+		Phase:   cloudprovider.DBEngineUpgradePhaseCompleted,
+		Message: fmt.Sprintf("DB runs engine version %s", version),
+	}, nil
+}
+
+func (p *Postgres) ensureNamespaceExists(ctx context.Context) error {
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   p.config.Namespace,
+			Labels: map[string]string{k8s.ManagedByLabelKey: k8s.ManagedByFleetshardValue},
+		},
+	}
+	if err := p.client.Create(ctx, namespace); err != nil && !apiErrors.IsAlreadyExists(err) {
+		return fmt.Errorf("creating DB namespace %s: %w", p.config.Namespace, err)
+	}
+	return nil
+}
+
+func (p *Postgres) ensureSecretExists(ctx context.Context, name string, labels map[string]string, masterPassword string) error {
+	secret := &corev1.Secret{}
+	err := p.client.Get(ctx, ctrlClient.ObjectKey{Namespace: p.config.Namespace, Name: name}, secret)
+	if err == nil {
+		if len(secret.Data[dbTLSCertKey]) > 0 && len(secret.Data[dbTLSKeyKey]) > 0 {
+			return nil
+		}
+		// DBs created before TLS was served get a certificate added
+		if err := p.addServerCertificate(secret); err != nil {
+			return err
+		}
+		return p.client.Update(ctx, secret)
+	}
+	if !apiErrors.IsNotFound(err) {
+		return fmt.Errorf("getting DB secret: %w", err)
+	}
+	if masterPassword == "" {
+		// the managed DB reconciler tries to restore a deleted DB without password, but there are no backups to restore from
+		return errors.New("in-cluster DB does not exist and can not be restored")
+	}
+
+	secret = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: p.config.Namespace, Name: name, Labels: labels},
+		Data:       map[string][]byte{dbPasswordKey: []byte(masterPassword)},
+	}
+	if err := p.addServerCertificate(secret); err != nil {
+		return err
+	}
+	return p.client.Create(ctx, secret)
+}
+
+func (p *Postgres) addServerCertificate(secret *corev1.Secret) error {
+	certPEM, keyPEM, err := generateServerCertificate(getServiceHost(secret.Name, p.config.Namespace), time.Now())
+	if err != nil {
+		return err
+	}
+	secret.Data[dbTLSCertKey] = certPEM
+	secret.Data[dbTLSKeyKey] = keyPEM
+	return nil
+}
+
+func (p *Postgres) ensureServiceExists(ctx context.Context, name string, labels map[string]string) error {
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: p.config.Namespace, Name: name, Labels: labels},
+		Spec: corev1.ServiceSpec{
+			Selector: getSelector(labels),
+			Ports: []corev1.ServicePort{{
+				Name:       "postgres",
+				Port:       dbPostgresPort,
+				TargetPort: intstr.FromInt32(dbPostgresPort),
+			}},
+		},
+	}
+	if err := p.client.Create(ctx, service); err != nil && !apiErrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+func (p *Postgres) ensureStatefulSetExists(ctx context.Context, name string, labels map[string]string) error {
+	desired := p.newStatefulSet(name, labels)
+	statefulSet := &appsv1.StatefulSet{}
+	err := p.client.Get(ctx, ctrlClient.ObjectKey{Namespace: p.config.Namespace, Name: name}, statefulSet)
+	if apiErrors.IsNotFound(err) {
+		return p.client.Create(ctx, desired)
+	}
+	if err != nil {
+		return err
+	}
+
+	// DBs created before TLS was served are restarted with the TLS configuration
+	if !slices.Equal(statefulSet.Spec.Template.Spec.Containers[0].Args, desired.Spec.Template.Spec.Containers[0].Args) {
+		glog.Infof("Updating in-cluster DB %s/%s to serve TLS", p.config.Namespace, name)
+		statefulSet.Spec.Template.Spec = desired.Spec.Template.Spec
+		return p.client.Update(ctx, statefulSet)
+	}
+	return nil
+}
+
+func (p *Postgres) newStatefulSet(name string, labels map[string]string) *appsv1.StatefulSet {
+	selector := getSelector(labels)
+	claim := corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: dbDataVolume, Labels: labels},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(p.config.StorageSize)},
+			},
+		},
+	}
+	if p.config.StorageClass != "" {
+		claim.Spec.StorageClassName = ptr.To(p.config.StorageClass)
+	}
+
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: p.config.Namespace, Name: name, Labels: labels},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:    ptr.To[int32](1),
+			ServiceName: name,
+			Selector:    &metav1.LabelSelector{MatchLabels: selector},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					SecurityContext: &corev1.PodSecurityContext{FSGroup: ptr.To[int64](dbGroupID)},
+					Containers: []corev1.Container{{
+						Name:  "postgres",
+						Image: p.config.Image,
+						Args: []string{
+							"-c", "ssl=on",
+							"-c", "ssl_cert_file=" + dbTLSPath + "/" + dbTLSCertKey,
+							"-c", "ssl_key_file=" + dbTLSPath + "/" + dbTLSKeyKey,
+						},
+						Env: []corev1.EnvVar{
+							{
+								Name: "POSTGRES_PASSWORD",
+								ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: name},
+									Key:                  dbPasswordKey,
+								}},
+							},
+							{Name: "PGDATA", Value: dbDataPath + "/pgdata"},
+						},
+						Ports: []corev1.ContainerPort{{Name: "postgres", ContainerPort: dbPostgresPort}},
+						ReadinessProbe: &corev1.Probe{
+							ProbeHandler: corev1.ProbeHandler{
+								Exec: &corev1.ExecAction{Command: []string{"pg_isready", "-U", dbUser}},
+							},
+							PeriodSeconds: 10,
+						},
+						VolumeMounts: []corev1.VolumeMount{
+							{Name: dbDataVolume, MountPath: dbDataPath},
+							{Name: dbTLSVolume, MountPath: dbTLSPath, ReadOnly: true},
+						},
+					}},
+					Volumes: []corev1.Volume{{
+						Name: dbTLSVolume,
+						VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+							SecretName: name,
+							Items: []corev1.KeyToPath{
+								{Key: dbTLSCertKey, Path: dbTLSCertKey},
+								{Key: dbTLSKeyKey, Path: dbTLSKeyKey},
+							},
+							// postgres accepts a key owned by root only if it is not readable by others
+							DefaultMode: ptr.To[int32](0o640),
+						}},
+					}},
+				},
+			},
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{claim},
+		},
+	}
+}
+
+func (p *Postgres) waitForDBToBeReady(ctx context.Context, name string) error {
+	ticker := time.NewTicker(dbRetryPeriod)
+	defer ticker.Stop()
+	for {
+		statefulSet := &appsv1.StatefulSet{}
+		if err := p.client.Get(ctx, ctrlClient.ObjectKey{Namespace: p.config.Namespace, Name: name}, statefulSet); err != nil {
+			return fmt.Errorf("getting DB StatefulSet %s: %w", name, err)
+		}
+		if statefulSet.Status.ReadyReplicas > 0 {
+			return nil
+		}
+
+		glog.Infof("Waiting for in-cluster DB %s/%s to be ready", p.config.Namespace, name)
+		select {
+		case <-ticker.C:
+			continue
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for in-cluster DB to be ready: %w", ctx.Err())
+		}
+	}
+}
+
+func getServiceHost(name, namespace string) string {
+	return fmt.Sprintf("%s.%s.svc", name, namespace)
+}
+
+func getResourceName(databaseID string) string {
+	return dbPrefix + databaseID
+}
+
+func getLabels(databaseID, acsInstanceID string, isTestInstance bool) map[string]string {
+	return map[string]string{
+		k8s.ManagedByLabelKey: k8s.ManagedByFleetshardValue,
+		dbAppLabelKey:         dbAppLabelVal,
+		dbIDLabelKey:          databaseID,
+		tenantLabelKey:        acsInstanceID,
+		testLabelKey:          strconv.FormatBool(isTestInstance),
+	}
+}
+
+func getSelector(labels map[string]string) map[string]string {
+	return map[string]string{
+		dbAppLabelKey: labels[dbAppLabelKey],
+		dbIDLabelKey:  labels[dbIDLabelKey],
+	}
+}
+
+func getImageTag(image string) string {
+	_, tag, found := strings.Cut(image[strings.LastIndex(image, "/")+1:], ":")
+	if !found {
+		return "latest"
+	}
+	return tag
+}
@@ -0,0 +1,149 @@
+package incluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/fleetshard/config"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const (
+	testNamespace  = "rhacs-db"
+	testDatabaseID = "cb45idheg5ip6dq1jo4g"
+)
+
+func newTestPostgres(t *testing.T) (*Postgres, ctrlClient.Client) {
+	// StatefulSets become ready immediately, there is no controller running in the fake client
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(testutils.NewScheme(t)).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, client ctrlClient.WithWatch, obj ctrlClient.Object, opts ...ctrlClient.CreateOption) error {
+				if statefulSet, ok := obj.(*appsv1.StatefulSet); ok {
+					statefulSet.Status.ReadyReplicas = 1
+				}
+				return client.Create(ctx, obj, opts...)
+			},
+		}).
+		Build()
+
+	p, err := NewPostgres(k8sClient, &config.ManagedDBInCluster{
+		Namespace:    testNamespace,
+		Image:        "docker.io/library/postgres:15",
+		StorageSize:  "20Gi",
+		StorageClass: "standard",
+		MaxDatabases: 10,
+		SSLMode:      "require",
+	})
+	require.NoError(t, err)
+	return p, k8sClient
+}
+
+func TestNewPostgresInvalidStorageSize(t *testing.T) {
+	_, err := NewPostgres(nil, &config.ManagedDBInCluster{StorageSize: "lots"})
+	require.Error(t, err)
+}
+
+func TestEnsureDBProvisioned(t *testing.T) {
+	p, k8sClient := newTestPostgres(t)
+	ctx := context.Background()
+
+	require.NoError(t, p.EnsureDBProvisioned(ctx, testDatabaseID, testDatabaseID, "master-password", false))
+	// provisioning is idempotent
+	require.NoError(t, p.EnsureDBProvisioned(ctx, testDatabaseID, testDatabaseID, "", false))
+
+	key := ctrlClient.ObjectKey{Namespace: testNamespace, Name: getResourceName(testDatabaseID)}
+	secret := &corev1.Secret{}
+	require.NoError(t, k8sClient.Get(ctx, key, secret))
+	assert.Equal(t, "master-password", string(secret.Data[dbPasswordKey]))
+	assert.NotEmpty(t, secret.Data[dbTLSCertKey])
+	assert.NotEmpty(t, secret.Data[dbTLSKeyKey])
+
+	statefulSet := &appsv1.StatefulSet{}
+	require.NoError(t, k8sClient.Get(ctx, key, statefulSet))
+	assert.Equal(t, "docker.io/library/postgres:15", statefulSet.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, "standard", *statefulSet.Spec.VolumeClaimTemplates[0].Spec.StorageClassName)
+	assert.Equal(t, testDatabaseID, statefulSet.Labels[tenantLabelKey])
+	assert.Contains(t, statefulSet.Spec.Template.Spec.Containers[0].Args, "ssl=on")
+
+	connection, err := p.GetDBConnection(testDatabaseID)
+	require.NoError(t, err)
+	assert.Equal(t, "host=central-db-cb45idheg5ip6dq1jo4g.rhacs-db.svc port=5432 user=postgres dbname=postgres statement_timeout=1200000 client_encoding=UTF8 sslmode=require",
+		connection.WithSSLRootCert("/ca.crt").AsConnectionString(), "the server certificate is self-signed and not verified")
+
+	quotas, err := p.GetAccountQuotas(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, cloudprovider.AccountQuotaValue{Used: 1, Max: 10}, quotas[cloudprovider.DBClusters])
+}
+
+func TestEnsureDBProvisionedCannotRestore(t *testing.T) {
+	p, _ := newTestPostgres(t)
+
+	err := p.EnsureDBProvisioned(context.Background(), testDatabaseID, testDatabaseID, "", false)
+	require.ErrorContains(t, err, "can not be restored")
+}
+
+func TestGetDBConnectionNotFound(t *testing.T) {
+	p, _ := newTestPostgres(t)
+
+	_, err := p.GetDBConnection(testDatabaseID)
+	require.ErrorIs(t, err, cloudprovider.ErrDBNotFound)
+}
+
+func TestEnsureDBDeprovisioned(t *testing.T) {
+	p, k8sClient := newTestPostgres(t)
+	ctx := context.Background()
+	require.NoError(t, p.EnsureDBProvisioned(ctx, testDatabaseID, testDatabaseID, "master-password", false))
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      dbDataVolume + "-" + getResourceName(testDatabaseID) + "-0",
+			Labels:    getLabels(testDatabaseID, testDatabaseID, false),
+		},
+	}
+	require.NoError(t, k8sClient.Create(ctx, pvc))
+
+	require.NoError(t, p.EnsureDBDeprovisioned(testDatabaseID, false))
+	// deprovisioning is idempotent
+	require.NoError(t, p.EnsureDBDeprovisioned(testDatabaseID, false))
+
+	_, err := p.GetDBConnection(testDatabaseID)
+	require.ErrorIs(t, err, cloudprovider.ErrDBNotFound)
+	pvcs := &corev1.PersistentVolumeClaimList{}
+	require.NoError(t, k8sClient.List(ctx, pvcs, ctrlClient.InNamespace(testNamespace)))
+	assert.Empty(t, pvcs.Items)
+}
+
+func TestEnsureDBEngineVersion(t *testing.T) {
+	p, _ := newTestPostgres(t)
+	ctx := context.Background()
+	require.NoError(t, p.EnsureDBProvisioned(ctx, testDatabaseID, testDatabaseID, "master-password", false))
+
+	status, err := p.EnsureDBEngineVersion(ctx, testDatabaseID, cloudprovider.DBEngineUpgradeSpec{EngineVersion: "15"})
+	require.NoError(t, err)
+	assert.True(t, status.Completed())
+
+	_, err = p.EnsureDBEngineVersion(ctx, testDatabaseID, cloudprovider.DBEngineUpgradeSpec{EngineVersion: "16"})
+	require.ErrorIs(t, err, cloudprovider.ErrDBEngineUpgradeNotSupported)
+}
+
+func TestEnsureDBEngineVersionNoContainers(t *testing.T) {
+	p, k8sClient := newTestPostgres(t)
+	ctx := context.Background()
+	require.NoError(t, k8sClient.Create(ctx, &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: getResourceName(testDatabaseID)},
+	}))
+
+	_, err := p.EnsureDBEngineVersion(ctx, testDatabaseID, cloudprovider.DBEngineUpgradeSpec{EngineVersion: "15"})
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, cloudprovider.ErrDBEngineUpgradeNotSupported)
+}
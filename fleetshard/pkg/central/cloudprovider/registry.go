@@ -0,0 +1,72 @@
+package cloudprovider
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/stackrox/acs-fleet-manager/fleetshard/config"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DBClientFactory creates the DBClient of a managed DB provider
+type DBClientFactory func(config *config.Config, k8sClient ctrlClient.Client) (DBClient, error)
+
+// DBClientKey identifies the databases a DBClient manages. Clients of the same provider for different cloud accounts
+// or regions manage different databases and are never shared.
+type DBClientKey struct {
+	Provider string
+	Account  string
+	Region   string
+}
+
+func (k DBClientKey) String() string {
+	return fmt.Sprintf("%s/%s/%s", k.Provider, k.Account, k.Region)
+}
+
+// DBClientRegistry creates the DBClients of the managed DB providers (see config.ManagedDB.Provider) and keeps one
+// client per provider, account and region
+type DBClientRegistry struct {
+	factories map[string]DBClientFactory
+
+	mu      sync.Mutex
+	clients map[DBClientKey]DBClient
+}
+
+// NewDBClientRegistry creates a registry for the given factories, which are keyed by provider name
+func NewDBClientRegistry(factories map[string]DBClientFactory) *DBClientRegistry {
+	return &DBClientRegistry{
+		factories: factories,
+		clients:   map[DBClientKey]DBClient{},
+	}
+}
+
+// NewDBClient returns the DBClient of the managed DB provider, account and region configured for this data plane
+// cluster. The client is created on first use.
+func (r *DBClientRegistry) NewDBClient(config *config.Config, k8sClient ctrlClient.Client) (DBClient, error) {
+	key := GetDBClientKey(config)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if client, ok := r.clients[key]; ok {
+		return client, nil
+	}
+
+	factory, ok := r.factories[key.Provider]
+	if !ok {
+		return nil, fmt.Errorf("unsupported managed DB provider %q", key.Provider)
+	}
+	client, err := factory(config, k8sClient)
+	if err != nil {
+		return nil, fmt.Errorf("creating managed DB client %s: %w", key, err)
+	}
+	r.clients[key] = client
+	return client, nil
+}
+
+// GetDBClientKey returns the key of the DBClient configured for this data plane cluster
+func GetDBClientKey(config *config.Config) DBClientKey {
+	return DBClientKey{
+		Provider: config.ManagedDB.Provider,
+		Account:  config.ManagedDB.Account,
+		Region:   config.ManagedDB.Region,
+	}
+}
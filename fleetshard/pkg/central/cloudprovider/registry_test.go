@@ -0,0 +1,40 @@
+package cloudprovider
+
+import (
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/fleetshard/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestDBClientRegistryKeepsClientPerAccountAndRegion(t *testing.T) {
+	created := 0
+	registry := NewDBClientRegistry(map[string]DBClientFactory{
+		"aws": func(_ *config.Config, _ ctrlClient.Client) (DBClient, error) {
+			created++
+			return &DBClientMock{}, nil
+		},
+	})
+	newConfig := func(account, region string) *config.Config {
+		return &config.Config{ManagedDB: config.ManagedDB{Provider: "aws", Account: account, Region: region}}
+	}
+
+	usEast, err := registry.NewDBClient(newConfig("123", "us-east-1"), nil)
+	require.NoError(t, err)
+	again, err := registry.NewDBClient(newConfig("123", "us-east-1"), nil)
+	require.NoError(t, err)
+	assert.Same(t, usEast, again)
+
+	euWest, err := registry.NewDBClient(newConfig("123", "eu-west-1"), nil)
+	require.NoError(t, err)
+	otherAccount, err := registry.NewDBClient(newConfig("456", "us-east-1"), nil)
+	require.NoError(t, err)
+	assert.NotSame(t, usEast, euWest)
+	assert.NotSame(t, usEast, otherAccount)
+	assert.Equal(t, 3, created)
+
+	_, err = registry.NewDBClient(&config.Config{ManagedDB: config.ManagedDB{Provider: "gcp"}}, nil)
+	assert.ErrorContains(t, err, `unsupported managed DB provider "gcp"`)
+}
@@ -0,0 +1,38 @@
+package cloudprovider
+
+import (
+	"context"
+	"time"
+)
+
+// DBResourceLister is implemented by DBClients that can enumerate the cloud resources they provisioned for the data
+// plane cluster, which allows detecting resources that no longer belong to any tenant
+//
+//go:generate moq -out resources_moq.go . DBResourceLister
+type DBResourceLister interface {
+	// ListDBResources returns all database resources provisioned for tenants of this data plane cluster
+	ListDBResources(ctx context.Context) ([]DBResource, error)
+	// DeleteDBResource initiates the deletion of the given resource. Deleting a cluster also deletes its instances.
+	DeleteDBResource(ctx context.Context, resource DBResource) error
+	// AdoptDBResources attributes the resources of a database provisioned by another data plane cluster to this one,
+	// e.g. after the tenant was migrated. Otherwise the other cluster would consider them orphaned.
+	AdoptDBResources(ctx context.Context, databaseID string) error
+}
+
+// DBResourceType is the kind of a cloud database resource
+type DBResourceType string
+
+// Database resource types
+const (
+	DBResourceCluster  DBResourceType = "cluster"
+	DBResourceInstance DBResourceType = "instance"
+	DBResourceSnapshot DBResourceType = "snapshot"
+)
+
+// DBResource identifies a cloud database resource and the tenant it was provisioned for
+type DBResource struct {
+	Type          DBResourceType `json:"type"`
+	ID            string         `json:"id"`
+	ACSInstanceID string         `json:"acsInstanceId"`
+	CreatedAt     time.Time      `json:"createdAt"`
+}
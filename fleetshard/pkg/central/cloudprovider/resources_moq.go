@@ -0,0 +1,175 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package cloudprovider
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that DBResourceListerMock does implement DBResourceLister.
+// If this is not the case, regenerate this file with moq.
+var _ DBResourceLister = &DBResourceListerMock{}
+
+// DBResourceListerMock is a mock implementation of DBResourceLister.
+//
+//	func TestSomethingThatUsesDBResourceLister(t *testing.T) {
+//
+//		// make and configure a mocked DBResourceLister
+//		mockedDBResourceLister := &DBResourceListerMock{
+//			AdoptDBResourcesFunc: func(ctx context.Context, databaseID string) error {
+//				panic("mock out the AdoptDBResources method")
+//			},
+//			DeleteDBResourceFunc: func(ctx context.Context, resource DBResource) error {
+//				panic("mock out the DeleteDBResource method")
+//			},
+//			ListDBResourcesFunc: func(ctx context.Context) ([]DBResource, error) {
+//				panic("mock out the ListDBResources method")
+//			},
+//		}
+//
+//		// use mockedDBResourceLister in code that requires DBResourceLister
+//		// and then make assertions.
+//
+//	}
+type DBResourceListerMock struct {
+	// AdoptDBResourcesFunc mocks the AdoptDBResources method.
+	AdoptDBResourcesFunc func(ctx context.Context, databaseID string) error
+
+	// DeleteDBResourceFunc mocks the DeleteDBResource method.
+	DeleteDBResourceFunc func(ctx context.Context, resource DBResource) error
+
+	// ListDBResourcesFunc mocks the ListDBResources method.
+	ListDBResourcesFunc func(ctx context.Context) ([]DBResource, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AdoptDBResources holds details about calls to the AdoptDBResources method.
+		AdoptDBResources []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DatabaseID is the databaseID argument value.
+			DatabaseID string
+		}
+		// DeleteDBResource holds details about calls to the DeleteDBResource method.
+		DeleteDBResource []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Resource is the resource argument value.
+			Resource DBResource
+		}
+		// ListDBResources holds details about calls to the ListDBResources method.
+		ListDBResources []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+	}
+	lockAdoptDBResources sync.RWMutex
+	lockDeleteDBResource sync.RWMutex
+	lockListDBResources  sync.RWMutex
+}
+
+// AdoptDBResources calls AdoptDBResourcesFunc.
+func (mock *DBResourceListerMock) AdoptDBResources(ctx context.Context, databaseID string) error {
+	if mock.AdoptDBResourcesFunc == nil {
+		panic("DBResourceListerMock.AdoptDBResourcesFunc: method is nil but DBResourceLister.AdoptDBResources was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		DatabaseID string
+	}{
+		Ctx:        ctx,
+		DatabaseID: databaseID,
+	}
+	mock.lockAdoptDBResources.Lock()
+	mock.calls.AdoptDBResources = append(mock.calls.AdoptDBResources, callInfo)
+	mock.lockAdoptDBResources.Unlock()
+	return mock.AdoptDBResourcesFunc(ctx, databaseID)
+}
+
+// AdoptDBResourcesCalls gets all the calls that were made to AdoptDBResources.
+// Check the length with:
+//
+//	len(mockedDBResourceLister.AdoptDBResourcesCalls())
+func (mock *DBResourceListerMock) AdoptDBResourcesCalls() []struct {
+	Ctx        context.Context
+	DatabaseID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		DatabaseID string
+	}
+	mock.lockAdoptDBResources.RLock()
+	calls = mock.calls.AdoptDBResources
+	mock.lockAdoptDBResources.RUnlock()
+	return calls
+}
+
+// DeleteDBResource calls DeleteDBResourceFunc.
+func (mock *DBResourceListerMock) DeleteDBResource(ctx context.Context, resource DBResource) error {
+	if mock.DeleteDBResourceFunc == nil {
+		panic("DBResourceListerMock.DeleteDBResourceFunc: method is nil but DBResourceLister.DeleteDBResource was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Resource DBResource
+	}{
+		Ctx:      ctx,
+		Resource: resource,
+	}
+	mock.lockDeleteDBResource.Lock()
+	mock.calls.DeleteDBResource = append(mock.calls.DeleteDBResource, callInfo)
+	mock.lockDeleteDBResource.Unlock()
+	return mock.DeleteDBResourceFunc(ctx, resource)
+}
+
+// DeleteDBResourceCalls gets all the calls that were made to DeleteDBResource.
+// Check the length with:
+//
+//	len(mockedDBResourceLister.DeleteDBResourceCalls())
+func (mock *DBResourceListerMock) DeleteDBResourceCalls() []struct {
+	Ctx      context.Context
+	Resource DBResource
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Resource DBResource
+	}
+	mock.lockDeleteDBResource.RLock()
+	calls = mock.calls.DeleteDBResource
+	mock.lockDeleteDBResource.RUnlock()
+	return calls
+}
+
+// ListDBResources calls ListDBResourcesFunc.
+func (mock *DBResourceListerMock) ListDBResources(ctx context.Context) ([]DBResource, error) {
+	if mock.ListDBResourcesFunc == nil {
+		panic("DBResourceListerMock.ListDBResourcesFunc: method is nil but DBResourceLister.ListDBResources was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListDBResources.Lock()
+	mock.calls.ListDBResources = append(mock.calls.ListDBResources, callInfo)
+	mock.lockListDBResources.Unlock()
+	return mock.ListDBResourcesFunc(ctx)
+}
+
+// ListDBResourcesCalls gets all the calls that were made to ListDBResources.
+// Check the length with:
+//
+//	len(mockedDBResourceLister.ListDBResourcesCalls())
+func (mock *DBResourceListerMock) ListDBResourcesCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListDBResources.RLock()
+	calls = mock.calls.ListDBResources
+	mock.lockListDBResources.RUnlock()
+	return calls
+}
@@ -14,6 +14,7 @@ type DBConnection struct {
 	user        string
 	password    string
 	sslrootcert string
+	sslmode     string
 }
 
 var (
@@ -63,11 +64,22 @@ func (c DBConnection) WithSSLRootCert(sslrootcert string) DBConnection {
 	return c
 }
 
+// WithSSLMode overrides the default sslmode (verify-full) of the DBConnection struct
+func (c DBConnection) WithSSLMode(sslmode string) DBConnection {
+	c.sslmode = sslmode
+	return c
+}
+
 // AsConnectionString returns a string that can be used to connect to a PostgreSQL server. The password is omitted.
 func (c DBConnection) AsConnectionString() string {
+	mode := sslMode
+	if c.sslmode != "" {
+		mode = c.sslmode
+	}
 	connectionString := fmt.Sprintf("host=%s port=%d user=%s dbname=%s statement_timeout=%d client_encoding=%s sslmode=%s",
-		c.host, c.port, c.user, c.database, statementTimeout, clientEncoding, sslMode)
-	if c.sslrootcert != "" {
+		c.host, c.port, c.user, c.database, statementTimeout, clientEncoding, mode)
+	// with a root certificate, libpq and pgx verify the server certificate even if the mode is only require
+	if c.sslrootcert != "" && (mode == "verify-ca" || mode == "verify-full") {
 		connectionString = fmt.Sprintf("%s sslrootcert=%s", connectionString, c.sslrootcert)
 	}
 
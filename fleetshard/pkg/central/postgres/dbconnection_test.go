@@ -28,6 +28,10 @@ func TestPostgresConnectionString(t *testing.T) {
 	dbConnectionWithChangedUserAndDB := dbConnection.GetConnectionForUserAndDB("new_user", "central_active")
 	require.Equal(t, "host=localhost port=14543 user=new_user dbname=central_active statement_timeout=1200000 client_encoding=UTF8 sslmode=verify-full",
 		dbConnectionWithChangedUserAndDB.AsConnectionString())
+
+	dbConnectionWithSSLMode := dbConnection.WithSSLMode("disable")
+	require.Equal(t, "host=localhost port=14543 user=test-user dbname=postgresdb statement_timeout=1200000 client_encoding=UTF8 sslmode=disable",
+		dbConnectionWithSSLMode.AsConnectionString())
 }
 
 func TestNewDBConnection(t *testing.T) {
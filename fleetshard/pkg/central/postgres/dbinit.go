@@ -55,6 +55,29 @@ func InitializeDatabase(ctx context.Context, con DBConnection, userName, userPas
 	return nil
 }
 
+// CentralDBCheckFunc is a type for functions that check whether the user of a DBConnection can connect to its database
+type CentralDBCheckFunc func(ctx context.Context, con DBConnection) error
+
+// CheckConnection connects to the database of the given connection, e.g. to verify the credentials of the Central
+// user of a database that was not initialized by this fleetshard-sync
+func CheckConnection(ctx context.Context, con DBConnection) error {
+	db, err := sql.Open("postgres", con.asConnectionStringWithPassword())
+	if err != nil {
+		return fmt.Errorf("opening DB: %w", err)
+	}
+
+	defer func() {
+		if closeErr := db.Close(); closeErr != nil {
+			glog.Errorf("Error closing DB: %v", closeErr)
+		}
+	}()
+
+	if err := db.PingContext(ctx); err != nil {
+		return fmt.Errorf("connecting to DB %s as user %s: %w", con.database, con.user, err)
+	}
+	return nil
+}
+
 func initializeCentralDBUser(ctx context.Context, db *sql.DB, userName, userPassword string) error {
 	err := createNonPrivilegedUser(ctx, db, userName, userPassword)
 	if err == nil {
@@ -36,6 +36,8 @@ type ArgoReconcilerOptions struct {
 	Environment                                string
 	WantsAuthProvider                          bool
 	Telemetry                                  config.Telemetry
+	// CentralExposure tells the tenant resources which resources expose Central. See k8s.Exposure*.
+	CentralExposure string
 }
 
 func newArgoReconciler(
@@ -79,6 +81,7 @@ func (r *argoReconciler) makeDesiredArgoCDApplication(remoteCentral private.Mana
 	values["centralAdminPasswordEnabled"] = !r.argoOpts.WantsAuthProvider
 	values["centralUIHost"] = remoteCentral.Spec.UiHost
 	values["centralDataHost"] = remoteCentral.Spec.DataHost
+	values["centralExposure"] = r.argoOpts.CentralExposure
 
 	if remoteCentral.Metadata.ExpiredAt != nil {
 		values["expiredAt"] = remoteCentral.Metadata.ExpiredAt.Format(time.RFC3339)
@@ -127,6 +130,11 @@ func (r *argoReconciler) makeDesiredArgoCDApplication(remoteCentral private.Mana
 			"ownerUserId":          remoteCentral.Spec.Auth.OwnerUserId,
 			"ownerAlternateUserId": remoteCentral.Spec.Auth.OwnerAlternateUserId,
 		}
+		if len(remoteCentral.Spec.AuthProviders) > 0 {
+			dc["additionalAuthProviders"] = customerAuthProviderValues(remoteCentral)
+		} else {
+			delete(dc, "additionalAuthProviders")
+		}
 	}
 
 	valuesBytes, err := json.Marshal(values)
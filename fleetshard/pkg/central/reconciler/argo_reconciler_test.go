@@ -5,6 +5,7 @@ import (
 
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/private"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSourceGetRepoURL(t *testing.T) {
@@ -195,3 +196,31 @@ func TestGetHelmValueByPath(t *testing.T) {
 		assert.Equal(t, "default", getHelmValueByPath(values, "", "default"))
 	})
 }
+
+func TestCustomerAuthProviderValues(t *testing.T) {
+	mc := private.ManagedCentral{
+		Spec: private.ManagedCentralAllOfSpec{
+			AuthProviders: []private.AuthProvider{{
+				Id:          "2abc",
+				Name:        "Corporate SSO",
+				MinimumRole: "None",
+				Groups:      []private.AuthProviderGroups{{Key: "groups", Value: "admins", Role: "Admin"}},
+				Oidc: private.AuthProviderOidc{
+					Issuer:       "https://sso.example.com",
+					ClientId:     "acs",
+					ClientSecret: "secret", // pragma: allowlist secret
+				},
+			}},
+		},
+	}
+
+	values := customerAuthProviderValues(mc)
+
+	require.Len(t, values, 1)
+	value := values[0].(map[string]interface{})
+	assert.Equal(t, "Corporate SSO", value["name"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"key": "groups", "value": "admins", "role": "Admin"}}, value["groups"])
+	oidc := value["oidc"].(map[string]interface{})
+	assert.Equal(t, "customer-auth-provider-2abc-client-credentials", oidc["clientCredentialsSecret"])
+	assert.NotContains(t, oidc, "clientSecret", "secrets are referenced, not inlined into the application")
+}
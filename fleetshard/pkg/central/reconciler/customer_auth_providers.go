@@ -0,0 +1,103 @@
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/private"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// customerAuthProviderLabelKey marks the client credentials secrets of the auth providers customers added to
+	// their Central, so that the secrets of removed providers can be found
+	customerAuthProviderLabelKey = "rhacs.redhat.com/customer-auth-provider"
+)
+
+func customerAuthProviderSecretName(authProvider private.AuthProvider) string {
+	return fmt.Sprintf("customer-auth-provider-%s-client-credentials", strings.ToLower(authProvider.Id))
+}
+
+// reconcileCustomerAuthProviderSecrets ensures a client credentials secret for each auth provider of the customer
+// and deletes the secrets of removed providers
+func (r *CentralReconciler) reconcileCustomerAuthProviderSecrets(ctx context.Context, remoteCentral private.ManagedCentral) error {
+	namespace := remoteCentral.Metadata.Namespace
+	desired := make(map[string]bool, len(remoteCentral.Spec.AuthProviders))
+	for _, authProvider := range remoteCentral.Spec.AuthProviders {
+		authProvider := authProvider
+		secretName := customerAuthProviderSecretName(authProvider)
+		desired[secretName] = true
+		err := ensureSecretExists(ctx, r.client, namespace, secretName, func(secret *corev1.Secret) error {
+			if secret.Labels == nil {
+				secret.Labels = make(map[string]string)
+			}
+			secret.Labels[customerAuthProviderLabelKey] = "true"
+			secret.Data = map[string][]byte{
+				"clientID":     []byte(authProvider.Oidc.ClientId),
+				"clientSecret": []byte(authProvider.Oidc.ClientSecret), // pragma: allowlist secret
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("ensuring client credentials of auth provider %q: %w", authProvider.Name, err)
+		}
+	}
+
+	secrets := &corev1.SecretList{}
+	if err := r.client.List(ctx, secrets, ctrlClient.InNamespace(namespace),
+		ctrlClient.MatchingLabels{customerAuthProviderLabelKey: "true"}); err != nil {
+		return fmt.Errorf("listing customer auth provider secrets in namespace %s: %w", namespace, err)
+	}
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if desired[secret.Name] {
+			continue
+		}
+		if err := r.client.Delete(ctx, secret); err != nil && !apiErrors.IsNotFound(err) {
+			return fmt.Errorf("deleting secret %s/%s of removed auth provider: %w", namespace, secret.Name, err)
+		}
+	}
+	return nil
+}
+
+// customerAuthProviderValues returns the declarative configuration of the auth providers of the customer. The client
+// credentials are referenced by secret name.
+//
+// The values are rendered by the tenant resources chart of the Argo CD source repository, which is maintained outside
+// of this repository. Chart revisions which do not render declarativeConfig.additionalAuthProviders ignore the values,
+// so fleet-manager rejects new auth providers until a chart revision supporting them is confirmed with
+// --auth-provider-tenant-chart-supported.
+func customerAuthProviderValues(remoteCentral private.ManagedCentral) []interface{} {
+	values := make([]interface{}, 0, len(remoteCentral.Spec.AuthProviders))
+	for _, authProvider := range remoteCentral.Spec.AuthProviders {
+		groups := make([]interface{}, 0, len(authProvider.Groups))
+		for _, group := range authProvider.Groups {
+			groups = append(groups, map[string]interface{}{"key": group.Key, "value": group.Value, "role": group.Role})
+		}
+		requiredAttributes := make([]interface{}, 0, len(authProvider.RequiredAttributes))
+		for _, attribute := range authProvider.RequiredAttributes {
+			requiredAttributes = append(requiredAttributes, map[string]interface{}{"key": attribute.Key, "value": attribute.Value})
+		}
+		claimMappings := make([]interface{}, 0, len(authProvider.ClaimMappings))
+		for _, mapping := range authProvider.ClaimMappings {
+			claimMappings = append(claimMappings, map[string]interface{}{"path": mapping.Path, "name": mapping.Name})
+		}
+		values = append(values, map[string]interface{}{
+			"name":               authProvider.Name,
+			"minimumRole":        authProvider.MinimumRole,
+			"groups":             groups,
+			"requiredAttributes": requiredAttributes,
+			"claimMappings":      claimMappings,
+			"oidc": map[string]interface{}{
+				"issuer":                    authProvider.Oidc.Issuer,
+				"mode":                      authProvider.Oidc.Mode,
+				"disableOfflineAccessScope": authProvider.Oidc.DisableOfflineAccessScope,
+				"clientCredentialsSecret":   customerAuthProviderSecretName(authProvider),
+			},
+		})
+	}
+	return values
+}
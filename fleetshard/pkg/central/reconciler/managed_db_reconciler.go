@@ -3,6 +3,7 @@ package reconciler
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
@@ -15,10 +16,35 @@ import (
 	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	dbEngineVersionValuesPath         = "centralDb.engineVersion"
+	dbUpgradeStrategyValuesPath       = "centralDb.upgradeStrategy"
+	dbClusterParameterGroupValuesPath = "centralDb.clusterParameterGroup"
+
+	dbUpgradeConditionType = "DatabaseUpgrade"
+	dbUpgradeFailedReason  = "Failed"
+
+	// failed engine upgrade steps are retried with an exponential backoff between these delays
+	dbUpgradeRetryInitialDelay = 30 * time.Second
+	dbUpgradeRetryMaxDelay     = 30 * time.Minute
+)
+
 type managedDbReconciler struct {
 	client                      ctrlClient.Client
 	managedDBProvisioningClient cloudprovider.DBClient
 	managedDBInitFunc           postgres.CentralDBInitFunc
+	managedDBCheckFunc          postgres.CentralDBCheckFunc
+
+	// engineUpgradeInProgress is set while an engine upgrade of the tenant DB has not finished yet
+	engineUpgradeInProgress bool
+	// engineUpgradeFailures counts the consecutive failed upgrade steps, which are retried after engineUpgradeRetryAt
+	engineUpgradeFailures int
+	engineUpgradeRetryAt  time.Time
+	// engineUpgradeRejected is the spec of an upgrade which can not succeed. It is not retried until the spec changes.
+	engineUpgradeRejected *cloudprovider.DBEngineUpgradeSpec
+	// engineUpgradeCondition is the last reported upgrade condition, which is reported again while no step is taken
+	engineUpgradeCondition *private.DataPlaneCentralStatusConditions
+	now                    func() time.Time
 }
 
 func newManagedDbReconciler(client ctrlClient.Client, managedDBProvisioningClient cloudprovider.DBClient, managedDBInitFunc postgres.CentralDBInitFunc) *managedDbReconciler {
@@ -26,6 +52,8 @@ func newManagedDbReconciler(client ctrlClient.Client, managedDBProvisioningClien
 		client:                      client,
 		managedDBProvisioningClient: managedDBProvisioningClient,
 		managedDBInitFunc:           managedDBInitFunc,
+		managedDBCheckFunc:          postgres.CheckConnection,
+		now:                         time.Now,
 	}
 }
 
@@ -50,6 +78,108 @@ func (r *managedDbReconciler) ensureDeleted(ctx context.Context, remoteCentral p
 	return true, nil
 }
 
+// reconcileEngineVersion advances the engine upgrade of the managed DB if the tenant values request an engine version.
+// It returns the condition describing the upgrade progress, or nil if there is nothing to report. Upgrade errors are
+// reported through the condition instead of failing the reconciliation, because Central keeps running on the old DB.
+// Failed steps are retried with a backoff, and upgrades which can not succeed are not retried until their spec changes.
+func (r *managedDbReconciler) reconcileEngineVersion(ctx context.Context, remoteCentral private.ManagedCentral) *private.DataPlaneCentralStatusConditions {
+	engineVersion := getTenantResourcesValue(remoteCentral, dbEngineVersionValuesPath, "")
+	if engineVersion == "" {
+		r.resetEngineUpgrade()
+		return nil
+	}
+
+	spec := cloudprovider.DBEngineUpgradeSpec{
+		EngineVersion:         engineVersion,
+		Strategy:              cloudprovider.DBEngineUpgradeStrategy(getTenantResourcesValue(remoteCentral, dbUpgradeStrategyValuesPath, string(cloudprovider.DBEngineUpgradeStrategyInPlace))),
+		ClusterParameterGroup: getTenantResourcesValue(remoteCentral, dbClusterParameterGroupValuesPath, ""),
+	}
+	if r.engineUpgradeRejected != nil {
+		if *r.engineUpgradeRejected == spec {
+			return r.engineUpgradeCondition
+		}
+		r.resetEngineUpgrade()
+	}
+	if err := spec.Validate(); err != nil {
+		return r.engineUpgradeFailed(remoteCentral, spec, err)
+	}
+	if r.engineUpgradeFailures > 0 && r.now().Before(r.engineUpgradeRetryAt) {
+		return r.engineUpgradeCondition
+	}
+
+	databaseID, err := r.getDatabaseID(ctx, remoteCentral.Metadata.Namespace, remoteCentral.Id)
+	if err != nil {
+		return r.engineUpgradeFailed(remoteCentral, spec, fmt.Errorf("getting DB ID: %w", err))
+	}
+
+	status, err := r.managedDBProvisioningClient.EnsureDBEngineVersion(ctx, databaseID, spec)
+	if err != nil {
+		return r.engineUpgradeFailed(remoteCentral, spec, err)
+	}
+	r.engineUpgradeFailures = 0
+
+	if status.Completed() {
+		if !r.engineUpgradeInProgress {
+			return nil
+		}
+		glog.Infof("Central DB engine upgrade of %s/%s to version %s completed", remoteCentral.Metadata.Namespace, remoteCentral.Metadata.Name, engineVersion)
+		r.engineUpgradeInProgress = false
+		return &private.DataPlaneCentralStatusConditions{
+			Type:    dbUpgradeConditionType,
+			Status:  "True",
+			Reason:  string(status.Phase),
+			Message: status.Message,
+		}
+	}
+
+	glog.Infof("Central DB engine upgrade of %s/%s to version %s: %s %s", remoteCentral.Metadata.Namespace, remoteCentral.Metadata.Name, engineVersion, status.Phase, status.Message)
+	r.engineUpgradeInProgress = true
+	r.engineUpgradeCondition = &private.DataPlaneCentralStatusConditions{
+		Type:    dbUpgradeConditionType,
+		Status:  "False",
+		Reason:  string(status.Phase),
+		Message: status.Message,
+	}
+	return r.engineUpgradeCondition
+}
+
+// engineUpgradeFailed reports the failed upgrade step. Upgrades which can not succeed are stopped, other failures
+// are retried with an exponential backoff.
+func (r *managedDbReconciler) engineUpgradeFailed(remoteCentral private.ManagedCentral, spec cloudprovider.DBEngineUpgradeSpec, err error) *private.DataPlaneCentralStatusConditions {
+	r.engineUpgradeCondition = &private.DataPlaneCentralStatusConditions{
+		Type:    dbUpgradeConditionType,
+		Status:  "False",
+		Reason:  dbUpgradeFailedReason,
+		Message: err.Error(),
+	}
+	if errors.Is(err, cloudprovider.ErrDBEngineUpgradeNotSupported) {
+		glog.Errorf("Central DB engine upgrade of %s/%s rejected, not retrying until the upgrade values change: %v", remoteCentral.Metadata.Namespace, remoteCentral.Metadata.Name, err)
+		r.engineUpgradeRejected = &spec
+		r.engineUpgradeInProgress = false
+		return r.engineUpgradeCondition
+	}
+
+	r.engineUpgradeFailures++
+	delay := dbUpgradeRetryInitialDelay
+	for i := 1; i < r.engineUpgradeFailures && delay < dbUpgradeRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > dbUpgradeRetryMaxDelay {
+		delay = dbUpgradeRetryMaxDelay
+	}
+	r.engineUpgradeRetryAt = r.now().Add(delay)
+	r.engineUpgradeInProgress = true
+	glog.Errorf("Central DB engine upgrade of %s/%s failed, retrying in %s: %v", remoteCentral.Metadata.Namespace, remoteCentral.Metadata.Name, delay, err)
+	return r.engineUpgradeCondition
+}
+
+func (r *managedDbReconciler) resetEngineUpgrade() {
+	r.engineUpgradeInProgress = false
+	r.engineUpgradeFailures = 0
+	r.engineUpgradeRejected = nil
+	r.engineUpgradeCondition = nil
+}
+
 // getDatabaseID returns the cloud database ID for a central tenant.
 // By default the database ID is equal to the centralID. It can be overridden by a ConfigMap.
 func (r *managedDbReconciler) getDatabaseID(ctx context.Context, remoteCentralNamespace, centralID string) (string, error) {
@@ -135,6 +265,41 @@ func (r *managedDbReconciler) centralDBUserExists(ctx context.Context, remoteCen
 	return dbUserType == dbUserTypeCentral, nil
 }
 
+// checkCentralDBUser connects to the managed DB with the credentials of the Central DB user secret
+func (r *managedDbReconciler) checkCentralDBUser(ctx context.Context, remoteCentral private.ManagedCentral) error {
+	databaseID, err := r.getDatabaseID(ctx, remoteCentral.Metadata.Namespace, remoteCentral.Id)
+	if err != nil {
+		return fmt.Errorf("getting DB ID: %w", err)
+	}
+	dbConnection, err := r.managedDBProvisioningClient.GetDBConnection(databaseID)
+	if err != nil {
+		return fmt.Errorf("getting DB connection data: %w", err)
+	}
+	password, err := r.getDBPasswordFromSecret(ctx, remoteCentral.Metadata.Namespace)
+	if err != nil {
+		return err
+	}
+	centralConnection := dbConnection.GetConnectionForUserAndDB(dbCentralUserName, postgres.CentralDBName).
+		WithPassword(password).WithSSLRootCert(postgres.DatabaseCACertificatePathFleetshard)
+	return r.managedDBCheckFunc(ctx, centralConnection)
+}
+
+// ensureDBAdopted attributes the managed DB of a Central to this data plane cluster, if the DB client supports it
+func (r *managedDbReconciler) ensureDBAdopted(ctx context.Context, remoteCentral private.ManagedCentral) error {
+	lister, ok := r.managedDBProvisioningClient.(cloudprovider.DBResourceLister)
+	if !ok {
+		return nil
+	}
+	databaseID, err := r.getDatabaseID(ctx, remoteCentral.Metadata.Namespace, remoteCentral.Id)
+	if err != nil {
+		return fmt.Errorf("getting DB ID: %w", err)
+	}
+	if err := lister.AdoptDBResources(ctx, databaseID); err != nil {
+		return fmt.Errorf("adopting managed DB %s: %w", databaseID, err)
+	}
+	return nil
+}
+
 func (r *managedDbReconciler) ensureManagedCentralDBInitialized(ctx context.Context, remoteCentral private.ManagedCentral) error {
 	remoteCentralNamespace := remoteCentral.Metadata.Namespace
 
@@ -0,0 +1,219 @@
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/private"
+	appsv1 "k8s.io/api/apps/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Phases and roles of Central migrations between dataplane clusters, as sent by fleet-manager
+const (
+	migrationPhasePrepareTarget = "prepare_target"
+	migrationPhaseQuiesceSource = "quiesce_source"
+	migrationPhaseSwitchDNS     = "switch_dns"
+	migrationPhaseVerifyTarget  = "verify_target"
+	migrationPhaseCleanupSource = "cleanup_source"
+	migrationPhaseRollingBack   = "rolling_back"
+
+	migrationRoleSource = "source"
+	migrationRoleTarget = "target"
+)
+
+// migrationScaledDownReplicasAnnotation records the replicas of a Central deployment scaled down for a migration, which
+// are restored once Central runs on this cluster again
+const migrationScaledDownReplicasAnnotation = "rhacs.redhat.com/migration-scaled-down-replicas"
+
+// isCentralScaledDown returns true if Central must not run on this cluster in the current phase of its migration.
+// Only one of the clusters runs Central at any time, as both use the same managed DB.
+func isCentralScaledDown(remoteCentral private.ManagedCentral) bool {
+	migration := remoteCentral.Spec.Migration
+	switch migration.Role {
+	case migrationRoleTarget:
+		switch migration.Phase {
+		case migrationPhasePrepareTarget, migrationPhaseQuiesceSource, migrationPhaseSwitchDNS:
+			return true
+		}
+	case migrationRoleSource:
+		switch migration.Phase {
+		case migrationPhaseQuiesceSource, migrationPhaseSwitchDNS, migrationPhaseVerifyTarget:
+			return true
+		}
+	}
+	return false
+}
+
+// isMigrationCleanup returns true if the tenant has to be removed from this cluster, keeping its managed DB
+func isMigrationCleanup(remoteCentral private.ManagedCentral) bool {
+	migration := remoteCentral.Spec.Migration
+	return migration.Role == migrationRoleSource && migration.Phase == migrationPhaseCleanupSource ||
+		migration.Role == migrationRoleTarget && migration.Phase == migrationPhaseRollingBack
+}
+
+// reconcileCentralDeploymentScale scales the Central deployment to zero while Central must not run on this cluster in
+// the current phase of its migration, and restores its replicas afterwards. The scale is reapplied on every
+// reconciliation, as migrating Centrals are reconciled until the migration completes. While a migration is rolled back,
+// the source keeps its scale, so that Central only starts again once the target removed the tenant.
+func (r *CentralReconciler) reconcileCentralDeploymentScale(ctx context.Context, remoteCentral private.ManagedCentral) error {
+	migration := remoteCentral.Spec.Migration
+	if migration.Role == migrationRoleSource && migration.Phase == migrationPhaseRollingBack {
+		return nil
+	}
+	deployment, err := getCentralDeployment(ctx, r.client, remoteCentral.Metadata.Namespace)
+	if err != nil || deployment == nil {
+		return err
+	}
+	original, scaledDown := deployment.Annotations[migrationScaledDownReplicasAnnotation]
+	patch := ctrlClient.MergeFrom(deployment.DeepCopy())
+	if isCentralScaledDown(remoteCentral) {
+		if scaledDown && deployment.Spec.Replicas != nil && *deployment.Spec.Replicas == 0 {
+			return nil
+		}
+		if !scaledDown {
+			replicas := int32(1)
+			if deployment.Spec.Replicas != nil && *deployment.Spec.Replicas > 0 {
+				replicas = *deployment.Spec.Replicas
+			}
+			metav1.SetMetaDataAnnotation(&deployment.ObjectMeta, migrationScaledDownReplicasAnnotation, strconv.Itoa(int(replicas)))
+		}
+		deployment.Spec.Replicas = ptr.To[int32](0)
+		glog.Infof("Scaling down central deployment %s/%s for migration phase %s", deployment.Namespace, deployment.Name, migration.Phase)
+	} else {
+		if !scaledDown {
+			return nil
+		}
+		replicas, err := strconv.ParseInt(original, 10, 32)
+		if err != nil || replicas < 1 {
+			replicas = 1
+		}
+		deployment.Spec.Replicas = ptr.To(int32(replicas))
+		delete(deployment.Annotations, migrationScaledDownReplicasAnnotation)
+		glog.Infof("Restoring %d replicas of central deployment %s/%s", replicas, deployment.Namespace, deployment.Name)
+	}
+	if err := r.client.Patch(ctx, deployment, patch); err != nil {
+		return errors.Wrapf(err, "scaling central deployment %s/%s", deployment.Namespace, deployment.Name)
+	}
+	return nil
+}
+
+// reconcileMigrationCleanup removes the tenant's resources from this cluster. The managed DB is kept, as the Central
+// on the other cluster of the migration uses it.
+func (r *CentralReconciler) reconcileMigrationCleanup(ctx context.Context, remoteCentral private.ManagedCentral) (*private.DataPlaneCentralStatus, error) {
+	deleted, err := r.tenantCleanup.DeleteK8sResources(ctx, remoteCentral.Metadata.Namespace, remoteCentral.Metadata.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cleaning up migrated central %s/%s", remoteCentral.Metadata.Namespace, remoteCentral.Metadata.Name)
+	}
+	if !deleted {
+		return nil, ErrDeletionInProgress
+	}
+	return migrationPhaseCompletedStatus(remoteCentral, nil), nil
+}
+
+// ensureMigratedCentralDBUserRestored makes sure that the target cluster of a migration reattaches the managed DB of
+// the source cluster. The DB user secret is restored from the backup, without it a new DB would be provisioned. While
+// the target is prepared, the restored credentials are verified against the DB, as a stale backup would leave the
+// Central unable to start once the source is scaled down.
+func (r *CentralReconciler) ensureMigratedCentralDBUserRestored(ctx context.Context, remoteCentral private.ManagedCentral) error {
+	if remoteCentral.Spec.Migration.Role != migrationRoleTarget {
+		return nil
+	}
+	exists, err := r.managedDbReconciler.centralDBUserExists(ctx, remoteCentral.Metadata.Namespace)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("central DB user secret of migrated central %s is not restored", remoteCentral.Id)
+	}
+	if remoteCentral.Spec.Migration.Phase != migrationPhasePrepareTarget {
+		return nil
+	}
+	if err := r.managedDbReconciler.checkCentralDBUser(ctx, remoteCentral); err != nil {
+		return errors.Wrapf(err, "verifying restored central DB user of migrated central %s", remoteCentral.Id)
+	}
+	return nil
+}
+
+// reconcileMigrationPhase reports once this cluster completed its part of the current migration phase
+func (r *CentralReconciler) reconcileMigrationPhase(ctx context.Context, remoteCentral private.ManagedCentral) (*private.DataPlaneCentralStatus, error) {
+	namespace := remoteCentral.Metadata.Namespace
+	migration := remoteCentral.Spec.Migration
+	switch {
+	case migration.Role == migrationRoleTarget && migration.Phase == migrationPhasePrepareTarget:
+		// Central must not start on this cluster while the source still runs it
+		deployment, err := getCentralDeployment(ctx, r.client, namespace)
+		if err != nil {
+			return nil, err
+		}
+		if deployment == nil || !isDeploymentScaledDown(deployment) {
+			glog.Infof("Waiting for the central deployment of migrated central %s/%s to be scaled down", namespace, remoteCentral.Metadata.Name)
+			return installingStatus(), nil
+		}
+		// The managed DB is attributed to this cluster before the source cleans up the tenant, as the orphaned DB
+		// detection of the source would otherwise delete it
+		if r.managedDBEnabled {
+			if err := r.managedDbReconciler.ensureDBAdopted(ctx, remoteCentral); err != nil {
+				return nil, err
+			}
+		}
+		// The routes are reported, so that fleet-manager can point the DNS records to this cluster
+		var routes []private.DataPlaneCentralStatusRoutes
+		if r.useRoutes {
+			var err error
+			if routes, err = r.getRoutesStatuses(ctx, &remoteCentral); err != nil {
+				glog.Infof("Waiting for the routes of migrated central %s/%s: %v", namespace, remoteCentral.Metadata.Name, err)
+				return installingStatus(), nil
+			}
+		}
+		return migrationPhaseCompletedStatus(remoteCentral, routes), nil
+	case migration.Role == migrationRoleSource && migration.Phase == migrationPhaseQuiesceSource:
+		deployment, err := getCentralDeployment(ctx, r.client, namespace)
+		if err != nil {
+			return nil, err
+		}
+		if deployment == nil || isDeploymentScaledDown(deployment) {
+			return migrationPhaseCompletedStatus(remoteCentral, nil), nil
+		}
+	case migration.Role == migrationRoleTarget && migration.Phase == migrationPhaseVerifyTarget:
+		ready, err := isCentralDeploymentReady(ctx, r.client, namespace)
+		if err != nil {
+			return nil, err
+		}
+		if ready {
+			return migrationPhaseCompletedStatus(remoteCentral, nil), nil
+		}
+	}
+	return installingStatus(), nil
+}
+
+func migrationPhaseCompletedStatus(remoteCentral private.ManagedCentral, routes []private.DataPlaneCentralStatusRoutes) *private.DataPlaneCentralStatus {
+	status := installingStatus()
+	status.Routes = routes
+	status.MigrationPhaseCompleted = remoteCentral.Spec.Migration.Phase
+	return status
+}
+
+// getCentralDeployment returns the Central deployment in the namespace, or nil if it does not exist
+func getCentralDeployment(ctx context.Context, client ctrlClient.Client, namespace string) (*appsv1.Deployment, error) {
+	deployment := &appsv1.Deployment{}
+	err := client.Get(ctx, ctrlClient.ObjectKey{Name: centralDeploymentName, Namespace: namespace}, deployment)
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "retrieving central deployment resource from Kubernetes")
+	}
+	return deployment, nil
+}
+
+// isDeploymentScaledDown returns true once the deployment is scaled to zero and all of its pods are gone
+func isDeploymentScaledDown(deployment *appsv1.Deployment) bool {
+	return deployment.Spec.Replicas != nil && *deployment.Spec.Replicas == 0 && deployment.Status.Replicas == 0
+}
@@ -0,0 +1,193 @@
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/postgres"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/testutils"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/private"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func migratedCentral(role, phase string) private.ManagedCentral {
+	central := simpleManagedCentral
+	central.Spec.Migration = private.ManagedCentralAllOfSpecMigration{Role: role, Phase: phase}
+	return central
+}
+
+func TestIsCentralScaledDown(t *testing.T) {
+	tests := []struct {
+		role  string
+		phase string
+		want  bool
+	}{
+		{role: "", phase: "", want: false},
+		{role: migrationRoleTarget, phase: migrationPhasePrepareTarget, want: true},
+		{role: migrationRoleSource, phase: migrationPhasePrepareTarget, want: false},
+		{role: migrationRoleSource, phase: migrationPhaseQuiesceSource, want: true},
+		{role: migrationRoleTarget, phase: migrationPhaseSwitchDNS, want: true},
+		{role: migrationRoleSource, phase: migrationPhaseVerifyTarget, want: true},
+		{role: migrationRoleTarget, phase: migrationPhaseVerifyTarget, want: false},
+		{role: migrationRoleSource, phase: migrationPhaseRollingBack, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.role+"/"+tt.phase, func(t *testing.T) {
+			assert.Equal(t, tt.want, isCentralScaledDown(migratedCentral(tt.role, tt.phase)))
+		})
+	}
+}
+
+func TestIsMigrationCleanup(t *testing.T) {
+	assert.True(t, isMigrationCleanup(migratedCentral(migrationRoleSource, migrationPhaseCleanupSource)))
+	assert.True(t, isMigrationCleanup(migratedCentral(migrationRoleTarget, migrationPhaseRollingBack)))
+	assert.False(t, isMigrationCleanup(migratedCentral(migrationRoleTarget, migrationPhaseCleanupSource)))
+	assert.False(t, isMigrationCleanup(migratedCentral(migrationRoleSource, migrationPhaseRollingBack)))
+}
+
+func TestReconcileMigrationPhaseQuiesceSource(t *testing.T) {
+	central := migratedCentral(migrationRoleSource, migrationPhaseQuiesceSource)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: centralDeploymentName, Namespace: central.Metadata.Namespace},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](1)},
+		Status:     appsv1.DeploymentStatus{Replicas: 1},
+	}
+	fakeClient := testutils.NewFakeClientBuilder(t, deployment).Build()
+	r := &CentralReconciler{client: fakeClient}
+
+	status, err := r.reconcileMigrationPhase(context.Background(), central)
+	require.NoError(t, err)
+	assert.Empty(t, status.MigrationPhaseCompleted)
+
+	require.NoError(t, r.reconcileCentralDeploymentScale(context.Background(), central))
+	status, err = r.reconcileMigrationPhase(context.Background(), central)
+	require.NoError(t, err)
+	assert.Empty(t, status.MigrationPhaseCompleted, "pods of the scaled down deployment are still running")
+
+	require.NoError(t, fakeClient.Get(context.Background(), ctrlClient.ObjectKeyFromObject(deployment), deployment))
+	deployment.Status.Replicas = 0
+	require.NoError(t, fakeClient.Status().Update(context.Background(), deployment))
+	status, err = r.reconcileMigrationPhase(context.Background(), central)
+	require.NoError(t, err)
+	assert.Equal(t, migrationPhaseQuiesceSource, status.MigrationPhaseCompleted)
+}
+
+func TestReconcileCentralDeploymentScale(t *testing.T) {
+	ctx := context.Background()
+	central := migratedCentral(migrationRoleSource, migrationPhaseQuiesceSource)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: centralDeploymentName, Namespace: central.Metadata.Namespace},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](3)},
+	}
+	fakeClient := testutils.NewFakeClientBuilder(t, deployment).Build()
+	r := &CentralReconciler{client: fakeClient}
+	key := ctrlClient.ObjectKeyFromObject(deployment)
+
+	require.NoError(t, r.reconcileCentralDeploymentScale(ctx, central))
+	require.NoError(t, fakeClient.Get(ctx, key, deployment))
+	assert.Equal(t, int32(0), *deployment.Spec.Replicas)
+	assert.Equal(t, "3", deployment.Annotations[migrationScaledDownReplicasAnnotation])
+
+	// The source stays scaled down until the target removed the tenant
+	require.NoError(t, r.reconcileCentralDeploymentScale(ctx, migratedCentral(migrationRoleSource, migrationPhaseRollingBack)))
+	require.NoError(t, fakeClient.Get(ctx, key, deployment))
+	assert.Equal(t, int32(0), *deployment.Spec.Replicas)
+
+	require.NoError(t, r.reconcileCentralDeploymentScale(ctx, migratedCentral("", "")))
+	require.NoError(t, fakeClient.Get(ctx, key, deployment))
+	assert.Equal(t, int32(3), *deployment.Spec.Replicas)
+	assert.NotContains(t, deployment.Annotations, migrationScaledDownReplicasAnnotation)
+}
+
+func TestReconcileCentralDeploymentScaleWithoutDeployment(t *testing.T) {
+	r := &CentralReconciler{client: testutils.NewFakeClientBuilder(t).Build()}
+	central := migratedCentral(migrationRoleTarget, migrationPhasePrepareTarget)
+
+	require.NoError(t, r.reconcileCentralDeploymentScale(context.Background(), central))
+	status, err := r.reconcileMigrationPhase(context.Background(), central)
+	require.NoError(t, err)
+	assert.Empty(t, status.MigrationPhaseCompleted, "the target waits until its central deployment exists and is scaled down")
+}
+
+func TestEnsureMigratedCentralDBUserRestoredVerifiesConnection(t *testing.T) {
+	central := migratedCentral(migrationRoleTarget, migrationPhasePrepareTarget)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        centralDbSecretName,
+			Namespace:   central.Metadata.Namespace,
+			Annotations: map[string]string{dbUserTypeAnnotation: dbUserTypeCentral},
+		},
+		Data: map[string][]byte{"password": []byte("restored")},
+	}
+	fakeClient := testutils.NewFakeClientBuilder(t, secret).Build()
+	dbClient := &cloudprovider.DBClientMock{
+		GetDBConnectionFunc: func(databaseID string) (postgres.DBConnection, error) {
+			return postgres.NewDBConnection("localhost", 5432, "rhacs", "postgres")
+		},
+	}
+	var checkErr error
+	var checked []string
+	dbReconciler := newManagedDbReconciler(fakeClient, dbClient, nil)
+	dbReconciler.managedDBCheckFunc = func(_ context.Context, con postgres.DBConnection) error {
+		checked = append(checked, con.AsConnectionString())
+		return checkErr
+	}
+	r := &CentralReconciler{client: fakeClient, managedDbReconciler: dbReconciler}
+
+	require.NoError(t, r.ensureMigratedCentralDBUserRestored(context.Background(), central))
+	require.Len(t, checked, 1)
+	assert.Contains(t, checked[0], "user="+dbCentralUserName)
+	assert.Contains(t, checked[0], "dbname="+postgres.CentralDBName)
+
+	checkErr = errors.New("password authentication failed")
+	assert.ErrorContains(t, r.ensureMigratedCentralDBUserRestored(context.Background(), central), "password authentication failed")
+
+	// the credentials are only verified while the target is prepared
+	require.NoError(t, r.ensureMigratedCentralDBUserRestored(context.Background(), migratedCentral(migrationRoleTarget, migrationPhaseVerifyTarget)))
+	assert.Len(t, checked, 2)
+}
+
+type adoptingDBClientMock struct {
+	*cloudprovider.DBClientMock
+	*cloudprovider.DBResourceListerMock
+}
+
+func TestReconcileMigrationPhasePrepareTargetAdoptsDB(t *testing.T) {
+	central := migratedCentral(migrationRoleTarget, migrationPhasePrepareTarget)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: centralDeploymentName, Namespace: central.Metadata.Namespace},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](0)},
+	}
+	fakeClient := testutils.NewFakeClientBuilder(t, deployment).Build()
+	lister := &cloudprovider.DBResourceListerMock{
+		AdoptDBResourcesFunc: func(_ context.Context, _ string) error {
+			return nil
+		},
+	}
+	dbClient := adoptingDBClientMock{DBClientMock: &cloudprovider.DBClientMock{}, DBResourceListerMock: lister}
+	r := &CentralReconciler{
+		client:              fakeClient,
+		managedDBEnabled:    true,
+		managedDbReconciler: newManagedDbReconciler(fakeClient, dbClient, nil),
+	}
+
+	status, err := r.reconcileMigrationPhase(context.Background(), central)
+	require.NoError(t, err)
+	assert.Equal(t, migrationPhasePrepareTarget, status.MigrationPhaseCompleted)
+	require.Len(t, lister.AdoptDBResourcesCalls(), 1)
+	assert.Equal(t, central.Id, lister.AdoptDBResourcesCalls()[0].DatabaseID)
+
+	lister.AdoptDBResourcesFunc = func(_ context.Context, _ string) error {
+		return errors.New("access denied")
+	}
+	_, err = r.reconcileMigrationPhase(context.Background(), central)
+	assert.ErrorContains(t, err, "access denied")
+}
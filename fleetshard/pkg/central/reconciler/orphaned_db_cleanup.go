@@ -0,0 +1,165 @@
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/config"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/fleetshardmetrics"
+)
+
+// CentralIDLister returns the IDs of all Centrals known to fleet-manager, on any data plane cluster. Deleted
+// Centrals which can still be restored are included.
+type CentralIDLister func(ctx context.Context) ([]string, error)
+
+// OrphanedDBCleanup detects managed DB resources that were provisioned for tenants which fleet-manager no longer
+// knows, and deletes them after a grace period unless it runs in dry-run mode. Resources younger than the restore
+// retention of deleted tenants are never deleted. It serves the report of its last run over HTTP.
+type OrphanedDBCleanup struct {
+	lister         cloudprovider.DBResourceLister
+	listCentralIDs CentralIDLister
+	opts           config.ManagedDBOrphanDetection
+	now            func() time.Time
+
+	// orphanedSince records when a resource was first detected as orphaned. It is not persisted, so the grace
+	// period starts again after a restart of fleetshard-sync.
+	orphanedSince map[dbResourceKey]time.Time
+	lastRun       time.Time
+
+	reportMutex sync.RWMutex
+	report      OrphanedDBReport
+}
+
+type dbResourceKey struct {
+	resourceType cloudprovider.DBResourceType
+	id           string
+}
+
+// OrphanedDBReport lists the orphaned managed DB resources found by the last detection run
+type OrphanedDBReport struct {
+	DryRun      bool                 `json:"dryRun"`
+	GracePeriod string               `json:"gracePeriod"`
+	LastRun     time.Time            `json:"lastRun"`
+	Resources   []OrphanedDBResource `json:"resources"`
+}
+
+// OrphanedDBResource is a managed DB resource that belongs to no known tenant
+type OrphanedDBResource struct {
+	cloudprovider.DBResource
+	OrphanedSince time.Time `json:"orphanedSince"`
+	// Deleted is true if the deletion of the resource was initiated by the last detection run
+	Deleted bool `json:"deleted"`
+}
+
+// NewOrphanedDBCleanup returns a new OrphanedDBCleanup using given arguments
+func NewOrphanedDBCleanup(lister cloudprovider.DBResourceLister, listCentralIDs CentralIDLister, opts config.ManagedDBOrphanDetection) *OrphanedDBCleanup {
+	return &OrphanedDBCleanup{
+		lister:         lister,
+		listCentralIDs: listCentralIDs,
+		opts:           opts,
+		now:            time.Now,
+		orphanedSince:  make(map[dbResourceKey]time.Time),
+		report: OrphanedDBReport{
+			DryRun:      opts.DryRun,
+			GracePeriod: opts.GracePeriod.String(),
+			Resources:   []OrphanedDBResource{},
+		},
+	}
+}
+
+// DetectOrphanedDBResources cross-checks the managed DB resources against all Centrals known to fleet-manager.
+// Centrals placed on other clusters (e.g. during a migration) and deleted Centrals which can still be restored are
+// known, so their resources are not considered orphaned. Detection runs at most once per configured interval.
+func (c *OrphanedDBCleanup) DetectOrphanedDBResources(ctx context.Context) error {
+	now := c.now()
+	if !c.lastRun.IsZero() && now.Sub(c.lastRun) < c.opts.Interval {
+		return nil
+	}
+	c.lastRun = now
+
+	centralIDs, err := c.listCentralIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("listing Central IDs known to fleet-manager: %w", err)
+	}
+	if len(centralIDs) == 0 {
+		// an empty list is more likely an error of fleet-manager than an environment without any tenant
+		glog.Warning("Fleet-manager knows no Centrals, skipping the detection of orphaned managed DB resources")
+		return nil
+	}
+
+	resources, err := c.lister.ListDBResources(ctx)
+	if err != nil {
+		return fmt.Errorf("listing managed DB resources: %w", err)
+	}
+
+	knownTenants := make(map[string]struct{}, len(centralIDs))
+	for _, id := range centralIDs {
+		knownTenants[id] = struct{}{}
+	}
+
+	orphanedSince := make(map[dbResourceKey]time.Time)
+	orphaned := []OrphanedDBResource{}
+	counts := map[cloudprovider.DBResourceType]int{
+		cloudprovider.DBResourceCluster:  0,
+		cloudprovider.DBResourceInstance: 0,
+		cloudprovider.DBResourceSnapshot: 0,
+	}
+	for _, resource := range resources {
+		if _, ok := knownTenants[resource.ACSInstanceID]; ok {
+			continue
+		}
+
+		key := dbResourceKey{resourceType: resource.Type, id: resource.ID}
+		since, ok := c.orphanedSince[key]
+		if !ok {
+			since = now
+			glog.Infof("Detected orphaned managed DB %s %s of tenant %s", resource.Type, resource.ID, resource.ACSInstanceID)
+		}
+		orphanedSince[key] = since
+		counts[resource.Type]++
+
+		orphan := OrphanedDBResource{DBResource: resource, OrphanedSince: since}
+		if !c.opts.DryRun && now.Sub(since) >= c.opts.GracePeriod && now.Sub(resource.CreatedAt) >= c.opts.RestoreRetention {
+			glog.Infof("Deleting orphaned managed DB %s %s of tenant %s", resource.Type, resource.ID, resource.ACSInstanceID)
+			if err := c.lister.DeleteDBResource(ctx, resource); err != nil {
+				glog.Errorf("Failed to delete orphaned managed DB %s %s: %v", resource.Type, resource.ID, err)
+			} else {
+				orphan.Deleted = true
+				fleetshardmetrics.MetricsInstance().IncOrphanedDBResourcesDeleted(resource.Type)
+			}
+		}
+		orphaned = append(orphaned, orphan)
+	}
+	c.orphanedSince = orphanedSince
+
+	for resourceType, count := range counts {
+		fleetshardmetrics.MetricsInstance().SetOrphanedDBResources(resourceType, count)
+	}
+
+	sort.Slice(orphaned, func(i, j int) bool {
+		return orphaned[i].ID < orphaned[j].ID
+	})
+	c.reportMutex.Lock()
+	defer c.reportMutex.Unlock()
+	c.report.LastRun = now
+	c.report.Resources = orphaned
+	return nil
+}
+
+// ServeHTTP writes the report of the last detection run as JSON
+func (c *OrphanedDBCleanup) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	c.reportMutex.RLock()
+	defer c.reportMutex.RUnlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(c.report); err != nil {
+		glog.Errorf("Failed to write orphaned managed DB resources report: %v", err)
+	}
+}
@@ -0,0 +1,127 @@
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/fleetshard/config"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newOrphanedDBTestLister() *cloudprovider.DBResourceListerMock {
+	return &cloudprovider.DBResourceListerMock{
+		ListDBResourcesFunc: func(_ context.Context) ([]cloudprovider.DBResource, error) {
+			return []cloudprovider.DBResource{
+				{Type: cloudprovider.DBResourceCluster, ID: "rhacs-live-db-cluster", ACSInstanceID: "live"},
+				{Type: cloudprovider.DBResourceCluster, ID: "rhacs-migrated-db-cluster", ACSInstanceID: "migrated"},
+				{Type: cloudprovider.DBResourceSnapshot, ID: "rhacs-deleted-db-cluster-final", ACSInstanceID: "deleted"},
+				{Type: cloudprovider.DBResourceCluster, ID: "rhacs-gone-db-cluster", ACSInstanceID: "gone"},
+				{Type: cloudprovider.DBResourceSnapshot, ID: "rhacs-gone-db-cluster-final", ACSInstanceID: "gone"},
+			}, nil
+		},
+		DeleteDBResourceFunc: func(_ context.Context, _ cloudprovider.DBResource) error {
+			return nil
+		},
+	}
+}
+
+func orphanedDBTestCentralIDs(_ context.Context) ([]string, error) {
+	// "migrated" is placed on another cluster, "deleted" is soft-deleted but can still be restored
+	return []string{"live", "migrated", "deleted"}, nil
+}
+
+func TestOrphanedDBCleanupDryRun(t *testing.T) {
+	lister := newOrphanedDBTestLister()
+	cleanup := NewOrphanedDBCleanup(lister, orphanedDBTestCentralIDs, config.ManagedDBOrphanDetection{DryRun: true, GracePeriod: 0})
+
+	require.NoError(t, cleanup.DetectOrphanedDBResources(context.Background()))
+
+	assert.Empty(t, lister.DeleteDBResourceCalls())
+	require.Len(t, cleanup.report.Resources, 2)
+	assert.Equal(t, "rhacs-gone-db-cluster", cleanup.report.Resources[0].ID)
+	assert.Equal(t, "rhacs-gone-db-cluster-final", cleanup.report.Resources[1].ID)
+	assert.False(t, cleanup.report.Resources[0].Deleted)
+}
+
+func TestOrphanedDBCleanupDeletesAfterGracePeriod(t *testing.T) {
+	lister := newOrphanedDBTestLister()
+	cleanup := NewOrphanedDBCleanup(lister, orphanedDBTestCentralIDs, config.ManagedDBOrphanDetection{GracePeriod: time.Hour, Interval: time.Minute})
+	now := time.Now()
+	cleanup.now = func() time.Time { return now }
+
+	require.NoError(t, cleanup.DetectOrphanedDBResources(context.Background()))
+	assert.Empty(t, lister.DeleteDBResourceCalls(), "resources are within the grace period")
+
+	now = now.Add(30 * time.Second)
+	require.NoError(t, cleanup.DetectOrphanedDBResources(context.Background()))
+	assert.Len(t, lister.ListDBResourcesCalls(), 1, "detection runs at most once per interval")
+
+	now = now.Add(time.Hour)
+	require.NoError(t, cleanup.DetectOrphanedDBResources(context.Background()))
+	require.Len(t, lister.DeleteDBResourceCalls(), 2)
+	for _, call := range lister.DeleteDBResourceCalls() {
+		assert.Equal(t, "gone", call.Resource.ACSInstanceID)
+	}
+	assert.True(t, cleanup.report.Resources[0].Deleted)
+}
+
+func TestOrphanedDBCleanupServesReport(t *testing.T) {
+	cleanup := NewOrphanedDBCleanup(newOrphanedDBTestLister(), orphanedDBTestCentralIDs, config.ManagedDBOrphanDetection{DryRun: true, GracePeriod: time.Hour})
+	require.NoError(t, cleanup.DetectOrphanedDBResources(context.Background()))
+
+	rec := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, "/orphaned-db-resources", nil)
+	require.NoError(t, err)
+	cleanup.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var report OrphanedDBReport
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&report))
+	assert.True(t, report.DryRun)
+	assert.Equal(t, "1h0m0s", report.GracePeriod)
+	assert.Len(t, report.Resources, 2)
+}
+
+func TestOrphanedDBCleanupKeepsResourcesWithinRestoreRetention(t *testing.T) {
+	now := time.Now()
+	createdAt := now.Add(-24 * time.Hour)
+	lister := &cloudprovider.DBResourceListerMock{
+		ListDBResourcesFunc: func(_ context.Context) ([]cloudprovider.DBResource, error) {
+			return []cloudprovider.DBResource{
+				{Type: cloudprovider.DBResourceSnapshot, ID: "rhacs-gone-db-cluster-final", ACSInstanceID: "gone", CreatedAt: createdAt},
+			}, nil
+		},
+		DeleteDBResourceFunc: func(_ context.Context, _ cloudprovider.DBResource) error {
+			return nil
+		},
+	}
+	cleanup := NewOrphanedDBCleanup(lister, orphanedDBTestCentralIDs, config.ManagedDBOrphanDetection{GracePeriod: time.Hour, RestoreRetention: 7 * 24 * time.Hour})
+	cleanup.now = func() time.Time { return now }
+
+	require.NoError(t, cleanup.DetectOrphanedDBResources(context.Background()))
+	now = now.Add(2 * time.Hour)
+	cleanup.lastRun = time.Time{}
+	require.NoError(t, cleanup.DetectOrphanedDBResources(context.Background()))
+	assert.Empty(t, lister.DeleteDBResourceCalls(), "the snapshot may still be needed to restore the tenant")
+
+	now = now.Add(7 * 24 * time.Hour)
+	cleanup.lastRun = time.Time{}
+	require.NoError(t, cleanup.DetectOrphanedDBResources(context.Background()))
+	assert.Len(t, lister.DeleteDBResourceCalls(), 1)
+}
+
+func TestOrphanedDBCleanupSkipsEmptyCentralList(t *testing.T) {
+	lister := newOrphanedDBTestLister()
+	listCentralIDs := func(_ context.Context) ([]string, error) { return nil, nil }
+	cleanup := NewOrphanedDBCleanup(lister, listCentralIDs, config.ManagedDBOrphanDetection{GracePeriod: 0})
+
+	require.NoError(t, cleanup.DetectOrphanedDBResources(context.Background()))
+	assert.Empty(t, lister.ListDBResourcesCalls())
+	assert.Empty(t, lister.DeleteDBResourceCalls())
+}
@@ -15,16 +15,15 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/golang/glog"
-	openshiftRouteV1 "github.com/openshift/api/route/v1"
 	"github.com/pkg/errors"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/config"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/postgres"
-	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/cipher"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/k8s"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/util"
 	centralConstants "github.com/stackrox/acs-fleet-manager/internal/central/constants"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/private"
+	"github.com/stackrox/acs-fleet-manager/pkg/cipher"
 	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
 	centralNotifierUtils "github.com/stackrox/rox/central/notifiers/utils"
 	"github.com/stackrox/rox/pkg/random"
@@ -76,6 +75,12 @@ const (
 	centralEncryptionKeySecretName          = "central-encryption-key-chain"             // pragma: allowlist secret
 	authProviderClientCredentialsSecretName = "default-auth-provider-client-credentials" // pragma: allowlist secret
 	tenantImagePullSecretName               = "stackrox"                                 // pragma: allowlist secret
+
+	authProviderClientIDUpdatedAtAnnotation = "rhacs.redhat.com/client-id-updated-at"
+	// declarativeConfigReloadDelay is the time after which Central applied a changed auth provider client: the kubelet
+	// syncs the mounted client credentials secret within its sync period and Central reloads the declarative
+	// configuration periodically.
+	declarativeConfigReloadDelay = 2 * time.Minute
 )
 
 type needsReconcileFunc func(changed bool, central private.ManagedCentral, storedSecrets []string) bool
@@ -89,13 +94,18 @@ type encryptedSecrets struct {
 
 // CentralReconcilerOptions are the static options for creating a reconciler.
 type CentralReconcilerOptions struct {
-	UseRoutes             bool
+	UseRoutes bool
+	// Exposure defines the resources which expose Central, whose hosts are reported as routes. See k8s.Exposure*.
+	Exposure              string
 	ManagedDBEnabled      bool
 	ClusterName           string
 	Environment           string
 	AuditLogging          config.AuditLogging
 	TenantImagePullSecret string
 	ArgoReconcilerOptions ArgoReconcilerOptions
+	// ManagedDBMinCapacityACU and ManagedDBMaxCapacityACU are reported as part of the tenant resource usage
+	ManagedDBMinCapacityACU float32
+	ManagedDBMaxCapacityACU float32
 }
 
 // CentralReconciler is a reconciler tied to a one Central instance. It installs, updates and deletes Central instances
@@ -111,7 +121,7 @@ type CentralReconciler struct {
 	namespaceReconciler    *namespaceReconciler
 	argoReconciler         *argoReconciler
 	tenantCleanup          *TenantCleanup
-	routeService           *k8s.RouteService
+	hostFinder             k8s.HostFinder
 	secretBackup           *k8s.SecretBackup
 	secretCipher           cipher.Cipher
 	clusterName            string
@@ -119,8 +129,10 @@ type CentralReconciler struct {
 	auditLogging           config.AuditLogging
 	encryptionKeyGenerator cipher.KeyGenerator
 
-	managedDbReconciler *managedDbReconciler
-	managedDBEnabled    bool
+	managedDbReconciler     *managedDbReconciler
+	managedDBEnabled        bool
+	managedDBMinCapacityACU float32
+	managedDBMaxCapacityACU float32
 
 	tenantImagePullSecret []byte
 	clock                 clock
@@ -163,7 +175,9 @@ func (r *CentralReconciler) Reconcile(ctx context.Context, remoteCentral private
 
 	needsReconcile := r.needsReconcileFunc(changed, remoteCentral, remoteCentral.Metadata.SecretsStored)
 
-	if !needsReconcile && isRemoteCentralReady(&remoteCentral) {
+	// Migrated Centrals are reconciled until the migration completes, to report the completed phases
+	migrating := remoteCentral.Spec.Migration.Phase != ""
+	if !needsReconcile && isRemoteCentralReady(&remoteCentral) && !migrating {
 		shouldUpdateCentralHash = true
 		return nil, ErrCentralNotChanged
 	}
@@ -176,6 +190,10 @@ func (r *CentralReconciler) Reconcile(ctx context.Context, remoteCentral private
 		return status, err
 	}
 
+	if isMigrationCleanup(remoteCentral) {
+		return r.reconcileMigrationCleanup(ctx, remoteCentral)
+	}
+
 	ns := r.getDesiredNamespace(remoteCentral)
 	if err := r.namespaceReconciler.reconcile(ctx, ns); err != nil {
 		return nil, errors.Wrapf(err, "unable to ensure that namespace %s exists", remoteCentralNamespace)
@@ -199,11 +217,16 @@ func (r *CentralReconciler) Reconcile(ctx context.Context, remoteCentral private
 	}
 
 	centralDBConnectionString := ""
+	var dbUpgradeCondition *private.DataPlaneCentralStatusConditions
 	if r.managedDBEnabled {
+		if err := r.ensureMigratedCentralDBUserRestored(ctx, remoteCentral); err != nil {
+			return nil, err
+		}
 		centralDBConnectionString, err = r.managedDbReconciler.getCentralDBConnectionString(ctx, remoteCentral)
 		if err != nil {
 			return nil, fmt.Errorf("getting Central DB connection string: %w", err)
 		}
+		dbUpgradeCondition = r.managedDbReconciler.reconcileEngineVersion(ctx, remoteCentral)
 	}
 
 	if err := r.argoReconciler.ensureApplicationExists(ctx, remoteCentral, centralDBConnectionString); err != nil {
@@ -224,17 +247,34 @@ func (r *CentralReconciler) Reconcile(ctx context.Context, remoteCentral private
 		return nil, err
 	}
 
+	if err = r.reconcileCentralDeploymentScale(ctx, remoteCentral); err != nil {
+		return nil, err
+	}
+
+	if migrating {
+		return r.reconcileMigrationPhase(ctx, remoteCentral)
+	}
+
 	if !centralDeploymentReady {
 		if isRemoteCentralProvisioning(remoteCentral) && !needsReconcile { // no changes detected, wait until central become ready
 			return nil, ErrCentralNotChanged
 		}
-		return installingStatus(), nil
+		return withCondition(installingStatus(), dbUpgradeCondition), nil
 	}
 
 	status, err := r.collectReconciliationStatus(ctx, &remoteCentral)
 	if err != nil {
 		return nil, err
 	}
+	status = withCondition(status, dbUpgradeCondition)
+	status.AuthClientId = r.appliedAuthClientID(ctx, remoteCentral)
+
+	usage, err := r.collectUsage(ctx, remoteCentralNamespace)
+	if err != nil {
+		// usage reporting is best effort and must not block the reconciliation
+		glog.Warningf("Failed to collect resource usage of central %s/%s: %v", remoteCentralNamespace, remoteCentralName, err)
+	}
+	status.Usage = usage
 
 	shouldUpdateCentralHash = true
 
@@ -308,7 +348,7 @@ func (r *CentralReconciler) reconcileDeclarativeConfigurationData(ctx context.Co
 	if !r.argoReconciler.isArgoDeclarativeConfigReconciliationEnabled(remoteCentral) {
 		return nil
 	}
-	return ensureSecretExists(
+	err := ensureSecretExists(
 		ctx,
 		r.client,
 		remoteCentral.Metadata.Namespace,
@@ -317,11 +357,46 @@ func (r *CentralReconciler) reconcileDeclarativeConfigurationData(ctx context.Co
 			if secret.Data == nil {
 				secret.Data = make(map[string][]byte)
 			}
-			secret.Data["clientID"] = []byte(remoteCentral.Spec.Auth.ClientId)
+			clientID := []byte(remoteCentral.Spec.Auth.ClientId)
+			if !bytes.Equal(secret.Data["clientID"], clientID) {
+				if secret.Annotations == nil {
+					secret.Annotations = make(map[string]string)
+				}
+				secret.Annotations[authProviderClientIDUpdatedAtAnnotation] = r.clock.Now().UTC().Format(time.RFC3339)
+			}
+			secret.Data["clientID"] = clientID
 			secret.Data["clientSecret"] = []byte(remoteCentral.Spec.Auth.ClientSecret) // pragma: allowlist secret
 			return nil
 		},
 	)
+	if err != nil {
+		return err
+	}
+	return r.reconcileCustomerAuthProviderSecrets(ctx, remoteCentral)
+}
+
+// appliedAuthClientID returns the OIDC client ID which Central applied to its auth provider, or an empty string if
+// the auth provider is not managed by declarative configuration or Central may still use the previous client. The client
+// is read from the client credentials secret referenced by the declarative configuration and is considered applied
+// declarativeConfigReloadDelay after it changed. Fleet-manager deletes the previous client of a rotation once the new
+// one is reported.
+func (r *CentralReconciler) appliedAuthClientID(ctx context.Context, remoteCentral private.ManagedCentral) string {
+	if !r.argoReconciler.isArgoDeclarativeConfigReconciliationEnabled(remoteCentral) {
+		return ""
+	}
+	namespace := remoteCentral.Metadata.Namespace
+	secret := &corev1.Secret{}
+	err := r.client.Get(ctx, ctrlClient.ObjectKey{Namespace: namespace, Name: authProviderClientCredentialsSecretName}, secret)
+	if err != nil {
+		glog.Warningf("Failed to get auth provider client credentials of central %s/%s: %v", namespace, remoteCentral.Metadata.Name, err)
+		return ""
+	}
+	// secrets without the annotation were written before the client was tracked and have been applied long ago
+	if updatedAt, err := time.Parse(time.RFC3339, secret.Annotations[authProviderClientIDUpdatedAtAnnotation]); err == nil &&
+		r.clock.Now().Sub(updatedAt) < declarativeConfigReloadDelay {
+		return ""
+	}
+	return string(secret.Data["clientID"])
 }
 
 func stringMapNeedsUpdating(desired, actual map[string]string) bool {
@@ -546,15 +621,15 @@ func (r *CentralReconciler) getRoutesStatuses(ctx context.Context, central *priv
 	unprocessedHosts[central.Spec.UiHost] = struct{}{}
 	unprocessedHosts[central.Spec.DataHost] = struct{}{}
 
-	ingresses, err := r.routeService.FindAdmittedIngresses(ctx, central.Metadata.Namespace)
+	hosts, err := r.hostFinder.FindAdmittedHosts(ctx, central.Metadata.Namespace)
 	if err != nil {
 		return nil, fmt.Errorf("obtaining ingresses for routes statuses: %w", err)
 	}
 	var routesStatuses []private.DataPlaneCentralStatusRoutes
-	for _, ingress := range ingresses {
-		if _, exists := unprocessedHosts[ingress.Host]; exists {
-			delete(unprocessedHosts, ingress.Host)
-			routesStatuses = append(routesStatuses, getRouteStatus(ingress))
+	for _, host := range hosts {
+		if _, exists := unprocessedHosts[host.Host]; exists {
+			delete(unprocessedHosts, host.Host)
+			routesStatuses = append(routesStatuses, getRouteStatus(host))
 		}
 	}
 	if len(unprocessedHosts) != 0 {
@@ -563,10 +638,10 @@ func (r *CentralReconciler) getRoutesStatuses(ctx context.Context, central *priv
 	return routesStatuses, nil
 }
 
-func getRouteStatus(ingress openshiftRouteV1.RouteIngress) private.DataPlaneCentralStatusRoutes {
+func getRouteStatus(host k8s.AdmittedHost) private.DataPlaneCentralStatusRoutes {
 	return private.DataPlaneCentralStatusRoutes{
-		Domain: ingress.Host,
-		Router: ingress.RouterCanonicalHostname,
+		Domain: host.Host,
+		Router: host.Router,
 	}
 }
 
@@ -824,6 +899,10 @@ func (r *CentralReconciler) needsReconcile(changed bool, remoteCentral private.M
 		return true
 	}
 
+	if r.managedDBEnabled && r.managedDbReconciler.engineUpgradeInProgress {
+		return true
+	}
+
 	return false
 }
 
@@ -845,7 +924,7 @@ func NewCentralReconciler(k8sClient ctrlClient.Client, fleetmanagerClient *fleet
 		namespaceReconciler:    nsReconciler,
 		argoReconciler:         argoReconciler,
 		tenantCleanup:          NewTenantCleanup(k8sClient, tenantCleanupOptions),
-		routeService:           k8s.NewRouteService(k8sClient),
+		hostFinder:             k8s.NewHostFinder(k8sClient, opts.Exposure),
 		secretBackup:           k8s.NewSecretBackup(k8sClient, opts.ManagedDBEnabled),
 		secretCipher:           secretCipher, // pragma: allowlist secret
 		clusterName:            opts.ClusterName,
@@ -853,8 +932,10 @@ func NewCentralReconciler(k8sClient ctrlClient.Client, fleetmanagerClient *fleet
 		auditLogging:           opts.AuditLogging,
 		encryptionKeyGenerator: encryptionKeyGenerator,
 
-		managedDbReconciler: dbReconciler,
-		managedDBEnabled:    opts.ManagedDBEnabled,
+		managedDbReconciler:     dbReconciler,
+		managedDBEnabled:        opts.ManagedDBEnabled,
+		managedDBMinCapacityACU: opts.ManagedDBMinCapacityACU,
+		managedDBMaxCapacityACU: opts.ManagedDBMaxCapacityACU,
 
 		tenantImagePullSecret: []byte(opts.TenantImagePullSecret),
 
@@ -17,13 +17,13 @@ import (
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider/awsclient"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/postgres"
-	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/cipher"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/k8s"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/testutils"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/util"
 	centralConstants "github.com/stackrox/acs-fleet-manager/internal/central/constants"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/private"
 	argocd "github.com/stackrox/acs-fleet-manager/pkg/argocd/apis/application/v1alpha1"
+	"github.com/stackrox/acs-fleet-manager/pkg/cipher"
 	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
 	fmMocks "github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager/mocks"
 	centralNotifierUtils "github.com/stackrox/rox/central/notifiers/utils"
@@ -524,6 +524,95 @@ func TestReconcileDeleteWithManagedDBOverride(t *testing.T) {
 	assert.Len(t, managedDBProvisioningClient.EnsureDBDeprovisionedCalls(), 2)
 }
 
+func TestReconcileManagedDBEngineUpgrade(t *testing.T) {
+	upgradeStatus := cloudprovider.DBEngineUpgradeStatus{Phase: cloudprovider.DBEngineUpgradePhaseUpgrading}
+	managedDBProvisioningClient := &cloudprovider.DBClientMock{}
+	managedDBProvisioningClient.EnsureDBEngineVersionFunc = func(_ context.Context, databaseID string, spec cloudprovider.DBEngineUpgradeSpec) (cloudprovider.DBEngineUpgradeStatus, error) {
+		require.Equal(t, simpleManagedCentral.Id, databaseID)
+		require.Equal(t, "15.4", spec.EngineVersion)
+		require.Equal(t, cloudprovider.DBEngineUpgradeStrategyBlueGreen, spec.Strategy)
+		return upgradeStatus, nil
+	}
+
+	reconcilerOptions := defaultReconcilerOptions
+	reconcilerOptions.ManagedDBEnabled = true
+	_, _, r := getClientTrackerAndReconciler(t, managedDBProvisioningClient, reconcilerOptions)
+	dbReconciler := r.managedDbReconciler
+
+	managedCentral := simpleManagedCentral
+	assert.Nil(t, dbReconciler.reconcileEngineVersion(context.TODO(), managedCentral), "no engine version requested")
+	assert.Empty(t, managedDBProvisioningClient.EnsureDBEngineVersionCalls())
+
+	managedCentral.Spec.TenantResourcesValues = map[string]interface{}{
+		"centralDb": map[string]interface{}{
+			"engineVersion":   "15.4",
+			"upgradeStrategy": "blue-green",
+		},
+	}
+	condition := dbReconciler.reconcileEngineVersion(context.TODO(), managedCentral)
+	require.NotNil(t, condition)
+	assert.Equal(t, "False", condition.Status)
+	assert.Equal(t, string(cloudprovider.DBEngineUpgradePhaseUpgrading), condition.Reason)
+	assert.True(t, dbReconciler.engineUpgradeInProgress)
+
+	upgradeStatus = cloudprovider.DBEngineUpgradeStatus{Phase: cloudprovider.DBEngineUpgradePhaseCompleted}
+	condition = dbReconciler.reconcileEngineVersion(context.TODO(), managedCentral)
+	require.NotNil(t, condition)
+	assert.Equal(t, "True", condition.Status)
+	assert.False(t, dbReconciler.engineUpgradeInProgress)
+
+	assert.Nil(t, dbReconciler.reconcileEngineVersion(context.TODO(), managedCentral), "completed upgrade is reported once")
+}
+
+func TestReconcileManagedDBEngineUpgradeFailures(t *testing.T) {
+	upgradeErr := errors.New("throttled")
+	managedDBProvisioningClient := &cloudprovider.DBClientMock{}
+	managedDBProvisioningClient.EnsureDBEngineVersionFunc = func(_ context.Context, _ string, _ cloudprovider.DBEngineUpgradeSpec) (cloudprovider.DBEngineUpgradeStatus, error) {
+		return cloudprovider.DBEngineUpgradeStatus{}, upgradeErr
+	}
+
+	reconcilerOptions := defaultReconcilerOptions
+	reconcilerOptions.ManagedDBEnabled = true
+	_, _, r := getClientTrackerAndReconciler(t, managedDBProvisioningClient, reconcilerOptions)
+	dbReconciler := r.managedDbReconciler
+	now := time.Now()
+	dbReconciler.now = func() time.Time { return now }
+
+	managedCentral := simpleManagedCentral
+	managedCentral.Spec.TenantResourcesValues = map[string]interface{}{
+		"centralDb": map[string]interface{}{"engineVersion": "15.4", "upgradeStrategy": "sideways"},
+	}
+	condition := dbReconciler.reconcileEngineVersion(context.TODO(), managedCentral)
+	require.NotNil(t, condition)
+	assert.Equal(t, dbUpgradeFailedReason, condition.Reason)
+	assert.False(t, dbReconciler.engineUpgradeInProgress, "unknown strategies are not retried")
+	assert.Empty(t, managedDBProvisioningClient.EnsureDBEngineVersionCalls())
+
+	managedCentral.Spec.TenantResourcesValues = map[string]interface{}{
+		"centralDb": map[string]interface{}{"engineVersion": "15.4", "upgradeStrategy": "in-place"},
+	}
+	condition = dbReconciler.reconcileEngineVersion(context.TODO(), managedCentral)
+	require.NotNil(t, condition)
+	assert.Equal(t, dbUpgradeFailedReason, condition.Reason)
+	assert.True(t, dbReconciler.engineUpgradeInProgress)
+	require.Len(t, managedDBProvisioningClient.EnsureDBEngineVersionCalls(), 1)
+
+	dbReconciler.reconcileEngineVersion(context.TODO(), managedCentral)
+	assert.Len(t, managedDBProvisioningClient.EnsureDBEngineVersionCalls(), 1, "failed steps are retried after a backoff")
+
+	now = now.Add(dbUpgradeRetryInitialDelay)
+	upgradeErr = fmt.Errorf("%w: downgrade", cloudprovider.ErrDBEngineUpgradeNotSupported)
+	dbReconciler.reconcileEngineVersion(context.TODO(), managedCentral)
+	require.Len(t, managedDBProvisioningClient.EnsureDBEngineVersionCalls(), 2)
+	assert.False(t, dbReconciler.engineUpgradeInProgress)
+
+	now = now.Add(dbUpgradeRetryMaxDelay)
+	condition = dbReconciler.reconcileEngineVersion(context.TODO(), managedCentral)
+	require.NotNil(t, condition)
+	assert.Equal(t, dbUpgradeFailedReason, condition.Reason)
+	assert.Len(t, managedDBProvisioningClient.EnsureDBEngineVersionCalls(), 2, "rejected upgrades are not retried")
+}
+
 func TestCentralChanged(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -803,7 +892,7 @@ func TestReconcileUpdatesRoutes(t *testing.T) {
 				t, nil,
 				useRoutesReconcilerOptions,
 			)
-			r.routeService = k8s.NewRouteService(fakeClient)
+			r.hostFinder = k8s.NewRouteService(fakeClient)
 			central := simpleManagedCentral
 
 			// create the initial reencrypt route
@@ -1070,6 +1159,61 @@ func TestReconcileDeclarativeConfigurationData(t *testing.T) {
 	})
 }
 
+func TestReconcileCustomerAuthProviderSecrets(t *testing.T) {
+	opts := defaultReconcilerOptions
+	opts.ArgoReconcilerOptions.WantsAuthProvider = true
+	fakeClient, _, r := getClientTrackerAndReconciler(t, nil, opts)
+
+	kept := private.AuthProvider{Id: "kept", Name: "Kept", Oidc: private.AuthProviderOidc{ClientId: "kept-client", ClientSecret: "kept-secret"}}        // pragma: allowlist secret
+	removed := private.AuthProvider{Id: "removed", Name: "Removed", Oidc: private.AuthProviderOidc{ClientId: "removed-client", ClientSecret: "secret"}} // pragma: allowlist secret
+	managedCentral := simpleManagedCentral
+	managedCentral.Spec.AuthProviders = []private.AuthProvider{kept, removed}
+
+	ctx := context.TODO()
+	require.NoError(t, r.reconcileDeclarativeConfigurationData(ctx, managedCentral))
+	secret := &v1.Secret{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKey{Namespace: centralNamespace, Name: customerAuthProviderSecretName(kept)}, secret))
+	assert.Equal(t, []byte("kept-client"), secret.Data["clientID"])
+	assert.Equal(t, []byte("kept-secret"), secret.Data["clientSecret"])
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKey{Namespace: centralNamespace, Name: customerAuthProviderSecretName(removed)}, secret))
+
+	managedCentral.Spec.AuthProviders = []private.AuthProvider{kept}
+	require.NoError(t, r.reconcileDeclarativeConfigurationData(ctx, managedCentral))
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKey{Namespace: centralNamespace, Name: customerAuthProviderSecretName(kept)}, secret))
+	err := fakeClient.Get(ctx, client.ObjectKey{Namespace: centralNamespace, Name: customerAuthProviderSecretName(removed)}, secret)
+	assert.True(t, k8sErrors.IsNotFound(err), "the secret of a removed auth provider is deleted")
+	err = fakeClient.Get(ctx, client.ObjectKey{Namespace: centralNamespace, Name: authProviderClientCredentialsSecretName}, secret)
+	assert.NoError(t, err, "the secret of the default auth provider is kept")
+}
+
+func TestAppliedAuthClientID(t *testing.T) {
+	ctx := context.TODO()
+	managedCentral := simpleManagedCentral
+	managedCentral.Spec.Auth.ClientId = "initial-client-id"
+
+	opts := defaultReconcilerOptions
+	opts.ArgoReconcilerOptions.WantsAuthProvider = true
+	_, _, r := getClientTrackerAndReconciler(t, nil, opts)
+	now := time.Now()
+	r.clock = fakeClock{NowTime: now}
+	assert.Empty(t, r.appliedAuthClientID(ctx, managedCentral), "the client is not applied before the secret exists")
+
+	require.NoError(t, r.reconcileDeclarativeConfigurationData(ctx, managedCentral))
+	r.clock = fakeClock{NowTime: now.Add(declarativeConfigReloadDelay)}
+	assert.Equal(t, "initial-client-id", r.appliedAuthClientID(ctx, managedCentral))
+
+	managedCentral.Spec.Auth.ClientId = "rotated-client-id"
+	require.NoError(t, r.reconcileDeclarativeConfigurationData(ctx, managedCentral))
+	r.clock = fakeClock{NowTime: now.Add(declarativeConfigReloadDelay + time.Minute)}
+	assert.Empty(t, r.appliedAuthClientID(ctx, managedCentral), "Central may still use the previous client")
+	r.clock = fakeClock{NowTime: now.Add(2 * declarativeConfigReloadDelay)}
+	assert.Equal(t, "rotated-client-id", r.appliedAuthClientID(ctx, managedCentral))
+
+	opts.ArgoReconcilerOptions.WantsAuthProvider = false
+	_, _, r = getClientTrackerAndReconciler(t, nil, opts)
+	assert.Empty(t, r.appliedAuthClientID(ctx, managedCentral), "the client is not applied without declarative configuration")
+}
+
 func TestRestoreCentralSecrets(t *testing.T) {
 	testCases := []struct {
 		name                     string
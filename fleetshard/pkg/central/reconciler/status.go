@@ -43,6 +43,14 @@ func installingStatus() *private.DataPlaneCentralStatus {
 	}
 }
 
+// withCondition appends an optional additional condition to the status
+func withCondition(status *private.DataPlaneCentralStatus, condition *private.DataPlaneCentralStatusConditions) *private.DataPlaneCentralStatus {
+	if condition != nil {
+		status.Conditions = append(status.Conditions, *condition)
+	}
+	return status
+}
+
 // StatusesCount is a container that holds counters grouped by statuses
 type StatusesCount struct {
 	readyCentrals int
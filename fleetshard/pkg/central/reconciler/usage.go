@@ -0,0 +1,100 @@
+package reconciler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/private"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// podMetricsListGVK is the kind of the metrics.k8s.io API served by the metrics server. It is accessed as unstructured
+// data, because the metrics server might not be installed on the data plane cluster.
+var podMetricsListGVK = schema.GroupVersionKind{Group: "metrics.k8s.io", Version: "v1beta1", Kind: "PodMetricsList"}
+
+// collectUsage returns the resources consumed by a tenant in its namespace. The current CPU and memory usage is only
+// reported if the metrics API is available on the cluster.
+func (r *CentralReconciler) collectUsage(ctx context.Context, namespace string) (private.DataPlaneCentralStatusUsage, error) {
+	usage := private.DataPlaneCentralStatusUsage{}
+	if r.managedDBEnabled {
+		usage.DbMinCapacityACU = r.managedDBMinCapacityACU
+		usage.DbMaxCapacityACU = r.managedDBMaxCapacityACU
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.client.List(ctx, pods, ctrlClient.InNamespace(namespace)); err != nil {
+		return usage, fmt.Errorf("listing pods in namespace %s: %w", namespace, err)
+	}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		for _, container := range pod.Spec.Containers {
+			usage.CpuRequestsMillicores += container.Resources.Requests.Cpu().MilliValue()
+			usage.MemoryRequestsBytes += container.Resources.Requests.Memory().Value()
+		}
+	}
+
+	pvcs := &corev1.PersistentVolumeClaimList{}
+	if err := r.client.List(ctx, pvcs, ctrlClient.InNamespace(namespace)); err != nil {
+		return usage, fmt.Errorf("listing persistent volume claims in namespace %s: %w", namespace, err)
+	}
+	for _, pvc := range pvcs.Items {
+		usage.StorageBytes += pvc.Spec.Resources.Requests.Storage().Value()
+	}
+
+	podMetrics := &unstructured.UnstructuredList{}
+	podMetrics.SetGroupVersionKind(podMetricsListGVK)
+	if err := r.client.List(ctx, podMetrics, ctrlClient.InNamespace(namespace)); err != nil {
+		if meta.IsNoMatchError(err) || apiErrors.IsNotFound(err) {
+			glog.V(10).Infof("Metrics API is not available, skipping usage metrics for namespace %s", namespace)
+			return usage, nil
+		}
+		return usage, fmt.Errorf("listing pod metrics in namespace %s: %w", namespace, err)
+	}
+	for _, item := range podMetrics.Items {
+		containers, _, err := unstructured.NestedSlice(item.Object, "containers")
+		if err != nil {
+			return usage, fmt.Errorf("reading pod metrics of %s/%s: %w", namespace, item.GetName(), err)
+		}
+		for _, container := range containers {
+			containerMap, ok := container.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			cpu, memory, err := parseContainerUsage(containerMap)
+			if err != nil {
+				return usage, fmt.Errorf("reading pod metrics of %s/%s: %w", namespace, item.GetName(), err)
+			}
+			usage.CpuUsageMillicores += cpu.MilliValue()
+			usage.MemoryUsageBytes += memory.Value()
+		}
+	}
+
+	return usage, nil
+}
+
+func parseContainerUsage(container map[string]interface{}) (cpu resource.Quantity, memory resource.Quantity, err error) {
+	values, _, err := unstructured.NestedStringMap(container, "usage")
+	if err != nil {
+		return cpu, memory, fmt.Errorf("reading container usage: %w", err)
+	}
+	if value, ok := values[corev1.ResourceCPU.String()]; ok {
+		if cpu, err = resource.ParseQuantity(value); err != nil {
+			return cpu, memory, fmt.Errorf("parsing CPU usage %q: %w", value, err)
+		}
+	}
+	if value, ok := values[corev1.ResourceMemory.String()]; ok {
+		if memory, err = resource.ParseQuantity(value); err != nil {
+			return cpu, memory, fmt.Errorf("parsing memory usage %q: %w", value, err)
+		}
+	}
+	return cpu, memory, nil
+}
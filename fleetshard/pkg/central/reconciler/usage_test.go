@@ -0,0 +1,81 @@
+package reconciler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/private"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func usageTestPod(name string, phase corev1.PodPhase, cpu, memory string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: centralNamespace, Name: name},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name: "main",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse(cpu),
+						corev1.ResourceMemory: resource.MustParse(memory),
+					},
+				},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
+
+func TestCollectUsage(t *testing.T) {
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Namespace: centralNamespace, Name: "central-db"},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("100Gi")},
+			},
+		},
+	}
+	otherNamespacePod := usageTestPod("other", corev1.PodRunning, "8", "8Gi")
+	otherNamespacePod.Namespace = "other"
+
+	reconcilerOptions := defaultReconcilerOptions
+	reconcilerOptions.ManagedDBEnabled = true
+	reconcilerOptions.ManagedDBMinCapacityACU = 0.5
+	reconcilerOptions.ManagedDBMaxCapacityACU = 16
+	_, _, r := getClientTrackerAndReconciler(t, nil, reconcilerOptions,
+		usageTestPod("central", corev1.PodRunning, "1500m", "4Gi"),
+		usageTestPod("scanner", corev1.PodRunning, "500m", "1Gi"),
+		usageTestPod("completed-job", corev1.PodSucceeded, "1", "1Gi"),
+		otherNamespacePod,
+		pvc,
+	)
+
+	usage, err := r.collectUsage(context.Background(), centralNamespace)
+	require.NoError(t, err)
+	assert.Equal(t, private.DataPlaneCentralStatusUsage{
+		CpuRequestsMillicores: 2000,
+		MemoryRequestsBytes:   5 * 1024 * 1024 * 1024,
+		StorageBytes:          100 * 1024 * 1024 * 1024,
+		DbMinCapacityACU:      0.5,
+		DbMaxCapacityACU:      16,
+	}, usage)
+}
+
+func TestParseContainerUsage(t *testing.T) {
+	cpu, memory, err := parseContainerUsage(map[string]interface{}{
+		"name":  "central",
+		"usage": map[string]interface{}{"cpu": "250m", "memory": "512Mi"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(250), cpu.MilliValue())
+	assert.Equal(t, int64(512*1024*1024), memory.Value())
+
+	_, _, err = parseContainerUsage(map[string]interface{}{
+		"usage": map[string]interface{}{"cpu": "a lot"},
+	})
+	require.Error(t, err)
+}
@@ -3,6 +3,7 @@ package fleetshardmetrics
 
 import (
 	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -24,6 +25,8 @@ type Metrics struct {
 	centralReconcilations       prometheus.Counter
 	centralReconcilationErrors  prometheus.Counter
 	activeCentralReconcilations prometheus.Gauge
+	reconcileQueueDepth         prometheus.Gauge
+	reconcileQueueWaitSeconds   *prometheus.HistogramVec
 	totalCentrals               prometheus.Gauge
 	readyCentrals               prometheus.Gauge
 	centralDBClustersUsed       prometheus.Gauge
@@ -32,6 +35,8 @@ type Metrics struct {
 	centralDBInstancesMax       prometheus.Gauge
 	centralDBSnapshotsUsed      prometheus.Gauge
 	centralDBSnapshotsMax       prometheus.Gauge
+	orphanedDBResources         *prometheus.GaugeVec
+	orphanedDBResourcesDeleted  *prometheus.CounterVec
 	pauseReconcileInstances     *prometheus.GaugeVec
 	CertificatesExpiry          *prometheus.GaugeVec
 }
@@ -43,6 +48,8 @@ func (m *Metrics) Register(r prometheus.Registerer) {
 	r.MustRegister(m.centralReconcilations)
 	r.MustRegister(m.centralReconcilationErrors)
 	r.MustRegister(m.activeCentralReconcilations)
+	r.MustRegister(m.reconcileQueueDepth)
+	r.MustRegister(m.reconcileQueueWaitSeconds)
 	r.MustRegister(m.totalCentrals)
 	r.MustRegister(m.readyCentrals)
 	r.MustRegister(m.centralDBClustersUsed)
@@ -51,6 +58,8 @@ func (m *Metrics) Register(r prometheus.Registerer) {
 	r.MustRegister(m.centralDBInstancesMax)
 	r.MustRegister(m.centralDBSnapshotsUsed)
 	r.MustRegister(m.centralDBSnapshotsMax)
+	r.MustRegister(m.orphanedDBResources)
+	r.MustRegister(m.orphanedDBResourcesDeleted)
 	r.MustRegister(m.pauseReconcileInstances)
 	r.MustRegister(m.CertificatesExpiry)
 }
@@ -85,6 +94,16 @@ func (m *Metrics) DecActiveCentralReconcilations() {
 	m.activeCentralReconcilations.Dec()
 }
 
+// SetReconcileQueueDepth sets the metric gauge for the number of central reconcilations waiting for a worker
+func (m *Metrics) SetReconcileQueueDepth(v int) {
+	m.reconcileQueueDepth.Set(float64(v))
+}
+
+// ObserveReconcileQueueWait records the time a central reconcilation of the given priority waited for a worker
+func (m *Metrics) ObserveReconcileQueueWait(priority string, wait time.Duration) {
+	m.reconcileQueueWaitSeconds.With(prometheus.Labels{"priority": priority}).Observe(wait.Seconds())
+}
+
 // SetDatabaseAccountQuotas sets all the metrics related to database quotas
 func (m *Metrics) SetDatabaseAccountQuotas(quotas cloudprovider.AccountQuotas) {
 	if quota, found := quotas[cloudprovider.DBClusters]; found {
@@ -101,6 +120,16 @@ func (m *Metrics) SetDatabaseAccountQuotas(quotas cloudprovider.AccountQuotas) {
 	}
 }
 
+// SetOrphanedDBResources sets the metric gauge for orphaned database resources of the given type
+func (m *Metrics) SetOrphanedDBResources(resourceType cloudprovider.DBResourceType, v int) {
+	m.orphanedDBResources.With(prometheus.Labels{"type": string(resourceType)}).Set(float64(v))
+}
+
+// IncOrphanedDBResourcesDeleted increments the metric counter for deleted orphaned database resources
+func (m *Metrics) IncOrphanedDBResourcesDeleted(resourceType cloudprovider.DBResourceType) {
+	m.orphanedDBResourcesDeleted.With(prometheus.Labels{"type": string(resourceType)}).Inc()
+}
+
 // SetPauseReconcileStatus sets the pause reconcile metric for a particular instance
 func (m *Metrics) SetPauseReconcileStatus(instance string, pauseReconcileEnabled bool) {
 	var pauseReconcileValue float64
@@ -159,6 +188,17 @@ func newMetrics() *Metrics {
 			Name: metricsPrefix + "active_central_reconcilations",
 			Help: "The number of currently running central reconcilations",
 		}),
+		reconcileQueueDepth: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: metricsPrefix + "central_reconcile_queue_depth",
+			Help: "The number of central reconcilations waiting for a free worker",
+		}),
+		reconcileQueueWaitSeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    metricsPrefix + "central_reconcile_queue_wait_seconds",
+			Help:    "The time central reconcilations waited in the queue before being picked up by a worker",
+			Buckets: prometheus.ExponentialBuckets(0.1, 2, 14),
+		},
+			[]string{"priority"},
+		),
 		totalCentrals: prometheus.NewGauge(prometheus.GaugeOpts{
 			Name: metricsPrefix + "total_centrals",
 			Help: "The total number of centrals monitored by fleetshard-sync",
@@ -191,6 +231,18 @@ func newMetrics() *Metrics {
 			Name: metricsPrefix + "central_db_snapshots_max",
 			Help: "The maximum number of Central DB snapshots in the cloud region of fleetshard-sync",
 		}),
+		orphanedDBResources: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: metricsPrefix + "orphaned_central_db_resources",
+			Help: "The number of Central DB resources that belong to no tenant of fleetshard-sync",
+		},
+			[]string{"type"},
+		),
+		orphanedDBResourcesDeleted: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: metricsPrefix + "total_orphaned_central_db_resources_deleted",
+			Help: "The total number of orphaned Central DB resources deleted by fleetshard-sync",
+		},
+			[]string{"type"},
+		),
 		pauseReconcileInstances: prometheus.NewGaugeVec(
 			prometheus.GaugeOpts{
 				Name: metricsPrefix + "pause_reconcile_instances",
@@ -45,6 +45,20 @@ func TestCounterIncrements(t *testing.T) {
 	}
 }
 
+func TestOrphanedDBResources(t *testing.T) {
+	m := newMetrics()
+	m.SetOrphanedDBResources(cloudprovider.DBResourceSnapshot, 3)
+	m.IncOrphanedDBResourcesDeleted(cloudprovider.DBResourceSnapshot)
+	metrics := serveMetrics(t, m)
+
+	orphaned := requireMetric(t, metrics, metricsPrefix+"orphaned_central_db_resources")
+	assert.Equal(t, "snapshot", orphaned.Metric[0].Label[0].GetValue())
+	assert.Equal(t, 3.0, orphaned.Metric[0].Gauge.GetValue())
+
+	deleted := requireMetric(t, metrics, metricsPrefix+"total_orphaned_central_db_resources_deleted")
+	assert.Equal(t, 1.0, deleted.Metric[0].Counter.GetValue())
+}
+
 func TestTotalCentrals(t *testing.T) {
 	m := newMetrics()
 	metricName := metricsPrefix + "total_centrals"
@@ -7,12 +7,22 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
+// ServerOption configures the metrics server
+type ServerOption func(mux *http.ServeMux)
+
+// WithHandler serves an additional endpoint next to the metrics endpoint
+func WithHandler(pattern string, handler http.Handler) ServerOption {
+	return func(mux *http.ServeMux) {
+		mux.Handle(pattern, handler)
+	}
+}
+
 // NewMetricsServer returns the metrics server
-func NewMetricsServer(address string) *http.Server {
-	return newMetricsServer(address, MetricsInstance())
+func NewMetricsServer(address string, opts ...ServerOption) *http.Server {
+	return newMetricsServer(address, MetricsInstance(), opts...)
 }
 
-func newMetricsServer(address string, customMetrics *Metrics) *http.Server {
+func newMetricsServer(address string, customMetrics *Metrics, opts ...ServerOption) *http.Server {
 	registry := prometheus.NewRegistry()
 	// Register default metrics to use a dedicated registry instead of prometheus.DefaultRegistry
 	// this makes it easier to isolate metric state when unit testing this package
@@ -22,6 +32,9 @@ func newMetricsServer(address string, customMetrics *Metrics) *http.Server {
 
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	for _, opt := range opts {
+		opt(mux)
+	}
 
 	return &http.Server{Addr: address, Handler: mux}
 }
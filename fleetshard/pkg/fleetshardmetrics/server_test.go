@@ -19,6 +19,19 @@ func TestMetricsServerCorrectAddress(t *testing.T) {
 	assert.Equal(t, ":8081", server.Addr)
 }
 
+func TestMetricsServerServesAdditionalHandlers(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	server := newMetricsServer(":8081", newMetrics(), WithHandler("/extra", handler))
+
+	rec := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, "/extra", nil)
+	require.NoError(t, err)
+	server.Handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusTeapot, rec.Code)
+}
+
 func TestMetricsServerServesDefaultMetrics(t *testing.T) {
 	metrics := serveMetrics(t, newMetrics())
 	_, hasKey := metrics["go_memstats_alloc_bytes"]
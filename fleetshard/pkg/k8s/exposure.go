@@ -0,0 +1,87 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/golang/glog"
+	networkingv1 "k8s.io/api/networking/v1"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Central exposures define which resources make Central reachable from outside the cluster
+const (
+	// ExposureAuto picks the first exposure available on the cluster, in the order route, gateway, ingress
+	ExposureAuto = "auto"
+	// ExposureRoute exposes Central with OpenShift Routes
+	ExposureRoute = "route"
+	// ExposureGateway exposes Central with Gateway API HTTPRoutes
+	ExposureGateway = "gateway"
+	// ExposureIngress exposes Central with Kubernetes Ingresses
+	ExposureIngress = "ingress"
+	// ExposureNone does not report how Central is exposed. It is meant for development clusters without ingress controller.
+	ExposureNone = "none"
+)
+
+// AdmittedHost is a host name under which a tenant is reachable, together with the canonical host name of the router
+// serving it. The router is where the DNS records of the host point to.
+type AdmittedHost struct {
+	Host   string
+	Router string
+}
+
+// findRouterAddress returns the first host name of a router, or its first IPv4 address if it has none. Fleet-manager
+// points the DNS records of the tenant to host names with CNAME and to IPv4 addresses with A records. IPv6 addresses
+// are not reported, as there are no AAAA records for them.
+func findRouterAddress(hostnames []string, ips []string) string {
+	for _, hostname := range hostnames {
+		if hostname != "" {
+			return hostname
+		}
+	}
+	for _, ip := range ips {
+		if parsed := net.ParseIP(ip); parsed != nil && parsed.To4() != nil {
+			return ip
+		}
+	}
+	return ""
+}
+
+// HostFinder finds the host names of a tenant which are admitted by the router of the cluster
+type HostFinder interface {
+	// FindAdmittedHosts returns the admitted hosts of the namespace or error if they could not be retrieved
+	FindAdmittedHosts(ctx context.Context, namespace string) ([]AdmittedHost, error)
+}
+
+// NewHostFinder returns the host finder for the exposure. Routes are used if the exposure is unknown.
+func NewHostFinder(client ctrlClient.Client, exposure string) HostFinder {
+	switch exposure {
+	case ExposureGateway:
+		return NewGatewayRouteService(client)
+	case ExposureIngress:
+		return NewIngressService(client)
+	default:
+		return NewRouteService(client)
+	}
+}
+
+// DetectExposure returns the exposure available on the cluster. OpenShift Routes are preferred, followed by
+// Gateway API HTTPRoutes and Ingresses. If no ingress class is installed, Central is not exposed.
+func DetectExposure(ctx context.Context, client ctrlClient.Client) (string, error) {
+	if available, err := IsRoutesResourceEnabled(client); err != nil || available {
+		return ExposureRoute, err
+	}
+	if available, err := IsGatewayAPIResourceEnabled(client); err != nil || available {
+		return ExposureGateway, err
+	}
+	ingressClasses := &networkingv1.IngressClassList{}
+	if err := client.List(ctx, ingressClasses); err != nil {
+		return ExposureNone, fmt.Errorf("listing ingress classes: %w", err)
+	}
+	if len(ingressClasses.Items) > 0 {
+		return ExposureIngress, nil
+	}
+	glog.Warning("Neither OpenShift Routes, Gateway API nor an ingress class are available on the cluster")
+	return ExposureNone, nil
+}
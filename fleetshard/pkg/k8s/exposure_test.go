@@ -0,0 +1,140 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const tenantNamespace = "rhacs-tenant"
+
+func TestIngressServiceFindAdmittedHosts(t *testing.T) {
+	ingress := func(name, host string, loadBalancer ...networkingv1.IngressLoadBalancerIngress) *networkingv1.Ingress {
+		return &networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: tenantNamespace},
+			Spec: networkingv1.IngressSpec{
+				Rules: []networkingv1.IngressRule{{Host: host}},
+			},
+			Status: networkingv1.IngressStatus{
+				LoadBalancer: networkingv1.IngressLoadBalancerStatus{Ingress: loadBalancer},
+			},
+		}
+	}
+	fakeClient := testutils.NewFakeClientBuilder(t,
+		ingress("ui", "acs-tenant.acs.test", networkingv1.IngressLoadBalancerIngress{Hostname: "lb.elb.amazonaws.com"}),
+		ingress("data", "acs-data-tenant.acs.test", networkingv1.IngressLoadBalancerIngress{IP: "172.18.0.2"}),
+		ingress("pending", "pending.acs.test"),
+		// IPv6 addresses can not be pointed to by the DNS records
+		ingress("ipv6", "ipv6.acs.test", networkingv1.IngressLoadBalancerIngress{IP: "fd00::1"}),
+		ingress("both", "both.acs.test",
+			networkingv1.IngressLoadBalancerIngress{IP: "172.18.0.3"},
+			networkingv1.IngressLoadBalancerIngress{Hostname: "lb2.elb.amazonaws.com"}),
+	).Build()
+
+	hosts, err := NewIngressService(fakeClient).FindAdmittedHosts(context.Background(), tenantNamespace)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []AdmittedHost{
+		{Host: "acs-tenant.acs.test", Router: "lb.elb.amazonaws.com"},
+		{Host: "acs-data-tenant.acs.test", Router: "172.18.0.2"},
+		{Host: "both.acs.test", Router: "lb2.elb.amazonaws.com"},
+	}, hosts)
+}
+
+func TestGatewayRouteServiceFindAdmittedHosts(t *testing.T) {
+	scheme := runtime.NewScheme()
+	gv := schema.GroupVersion{Group: "gateway.networking.k8s.io", Version: "v1"}
+	scheme.AddKnownTypeWithName(gv.WithKind("HTTPRoute"), &unstructured.Unstructured{})
+	scheme.AddKnownTypeWithName(httpRouteListGVK, &unstructured.UnstructuredList{})
+	scheme.AddKnownTypeWithName(gatewayGVK, &unstructured.Unstructured{})
+
+	httpRoute := func(name, hostname, acceptedStatus string) *unstructured.Unstructured {
+		route := &unstructured.Unstructured{Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"hostnames": []interface{}{hostname},
+			},
+			"status": map[string]interface{}{
+				"parents": []interface{}{map[string]interface{}{
+					"parentRef":  map[string]interface{}{"name": "tenant-gateway", "namespace": "gateways"},
+					"conditions": []interface{}{map[string]interface{}{"type": "Accepted", "status": acceptedStatus}},
+				}},
+			},
+		}}
+		route.SetGroupVersionKind(gv.WithKind("HTTPRoute"))
+		route.SetName(name)
+		route.SetNamespace(tenantNamespace)
+		return route
+	}
+	gateway := &unstructured.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{
+			"addresses": []interface{}{map[string]interface{}{"type": "Hostname", "value": "gateway.elb.amazonaws.com"}},
+		},
+	}}
+	gateway.SetGroupVersionKind(gatewayGVK)
+	gateway.SetName("tenant-gateway")
+	gateway.SetNamespace("gateways")
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		httpRoute("ui", "acs-tenant.acs.test", "True"),
+		httpRoute("data", "acs-data-tenant.acs.test", "False"),
+		gateway,
+	).Build()
+
+	hosts, err := NewGatewayRouteService(fakeClient).FindAdmittedHosts(context.Background(), tenantNamespace)
+	require.NoError(t, err)
+	assert.Equal(t, []AdmittedHost{{Host: "acs-tenant.acs.test", Router: "gateway.elb.amazonaws.com"}}, hosts)
+
+	// gateways without host name are reported with their IPv4 address
+	require.NoError(t, unstructured.SetNestedSlice(gateway.Object, []interface{}{
+		map[string]interface{}{"value": "fd00::1"},
+		map[string]interface{}{"type": "IPAddress", "value": "172.18.0.2"},
+	}, "status", "addresses"))
+	require.NoError(t, fakeClient.Update(context.Background(), gateway))
+	hosts, err = NewGatewayRouteService(fakeClient).FindAdmittedHosts(context.Background(), tenantNamespace)
+	require.NoError(t, err)
+	assert.Equal(t, []AdmittedHost{{Host: "acs-tenant.acs.test", Router: "172.18.0.2"}}, hosts)
+}
+
+func TestIsGatewayAPIResourceEnabled(t *testing.T) {
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{httpRoutesGVR.GroupVersion()})
+	mapper.Add(httpRoutesGVR.GroupVersion().WithKind("HTTPRoute"), meta.RESTScopeNamespace)
+	enabled, err := IsGatewayAPIResourceEnabled(testutils.NewFakeClientBuilder(t).WithRESTMapper(mapper).Build())
+	require.NoError(t, err)
+	assert.True(t, enabled)
+
+	enabled, err = IsGatewayAPIResourceEnabled(fake.NewClientBuilder().Build())
+	require.NoError(t, err)
+	assert.False(t, enabled)
+
+	failingMapper := &failingRESTMapper{RESTMapper: mapper, err: errors.New("discovery failed")}
+	_, err = IsGatewayAPIResourceEnabled(fake.NewClientBuilder().WithRESTMapper(failingMapper).Build())
+	assert.ErrorContains(t, err, "discovery failed")
+}
+
+type failingRESTMapper struct {
+	meta.RESTMapper
+	err error
+}
+
+func (m *failingRESTMapper) ResourceFor(_ schema.GroupVersionResource) (schema.GroupVersionResource, error) {
+	return schema.GroupVersionResource{}, m.err
+}
+
+func TestDetectExposureShouldFallBackToIngress(t *testing.T) {
+	fakeClient := testutils.NewFakeClientBuilder(t, &networkingv1.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "nginx"},
+	}).Build()
+	exposure, err := DetectExposure(context.Background(), fakeClient)
+	require.NoError(t, err)
+	assert.Equal(t, ExposureIngress, exposure)
+}
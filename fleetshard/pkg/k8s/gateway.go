@@ -0,0 +1,144 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// The Gateway API types are read as unstructured objects, so that fleetshard-sync runs on clusters without the
+// Gateway API CRDs installed.
+var (
+	httpRoutesGVR = schema.GroupVersionResource{
+		Group:    "gateway.networking.k8s.io",
+		Version:  "v1",
+		Resource: "httproutes",
+	}
+	httpRouteListGVK = schema.GroupVersionKind{
+		Group:   "gateway.networking.k8s.io",
+		Version: "v1",
+		Kind:    "HTTPRouteList",
+	}
+	gatewayGVK = schema.GroupVersionKind{
+		Group:   "gateway.networking.k8s.io",
+		Version: "v1",
+		Kind:    "Gateway",
+	}
+)
+
+// GatewayRouteService is responsible for performing read operations on the Gateway API HTTPRoute objects in the cluster.
+type GatewayRouteService struct {
+	client ctrlClient.Client
+}
+
+var _ HostFinder = (*GatewayRouteService)(nil)
+
+// NewGatewayRouteService creates a new instance of GatewayRouteService.
+func NewGatewayRouteService(client ctrlClient.Client) *GatewayRouteService {
+	return &GatewayRouteService{
+		client: client,
+	}
+}
+
+// IsGatewayAPIResourceEnabled checks if Gateway API HTTPRoute resources are available on the cluster. Failures to
+// discover the resources are returned, so that the exposure is not detected from an incomplete view of the API.
+func IsGatewayAPIResourceEnabled(client ctrlClient.Client) (bool, error) {
+	_, err := client.RESTMapper().ResourceFor(httpRoutesGVR)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("discovering Gateway API HTTPRoute resources: %w", err)
+	}
+	return true, nil
+}
+
+// FindAdmittedHosts returns the host names of the HTTPRoutes for a given namespace which are accepted by their parent
+// gateway. The host name of the gateway, or its IPv4 address if it has none, is reported as router.
+func (s *GatewayRouteService) FindAdmittedHosts(ctx context.Context, namespace string) ([]AdmittedHost, error) {
+	routes := &unstructured.UnstructuredList{}
+	routes.SetGroupVersionKind(httpRouteListGVK)
+	if err := s.client.List(ctx, routes, ctrlClient.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("find admitted HTTP routes for namespace %s: %w", namespace, err)
+	}
+	var hosts []AdmittedHost
+	for _, route := range routes.Items {
+		gatewayNamespace, gatewayName, accepted := findAcceptingGateway(route)
+		if !accepted {
+			continue
+		}
+		router, err := s.getGatewayAddress(ctx, gatewayNamespace, gatewayName)
+		if err != nil {
+			return nil, err
+		}
+		if router == "" {
+			continue
+		}
+		hostnames, _, _ := unstructured.NestedStringSlice(route.Object, "spec", "hostnames")
+		for _, hostname := range hostnames {
+			hosts = append(hosts, AdmittedHost{Host: hostname, Router: router})
+		}
+	}
+	return hosts, nil
+}
+
+// findAcceptingGateway returns the first parent gateway of the route which accepted it
+func findAcceptingGateway(route unstructured.Unstructured) (namespace string, name string, accepted bool) {
+	parents, _, _ := unstructured.NestedSlice(route.Object, "status", "parents")
+	for _, p := range parents {
+		parent, ok := p.(map[string]interface{})
+		if !ok || !isConditionTrue(parent, "Accepted") {
+			continue
+		}
+		name, _, _ = unstructured.NestedString(parent, "parentRef", "name")
+		namespace, _, _ = unstructured.NestedString(parent, "parentRef", "namespace")
+		if namespace == "" {
+			namespace = route.GetNamespace()
+		}
+		return namespace, name, name != ""
+	}
+	return "", "", false
+}
+
+func isConditionTrue(obj map[string]interface{}, conditionType string) bool {
+	conditions, _, _ := unstructured.NestedSlice(obj, "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if ok && condition["type"] == conditionType {
+			return condition["status"] == "True"
+		}
+	}
+	return false
+}
+
+func (s *GatewayRouteService) getGatewayAddress(ctx context.Context, namespace string, name string) (string, error) {
+	gateway := &unstructured.Unstructured{}
+	gateway.SetGroupVersionKind(gatewayGVK)
+	if err := s.client.Get(ctx, ctrlClient.ObjectKey{Namespace: namespace, Name: name}, gateway); err != nil {
+		if apiErrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("getting gateway %s/%s: %w", namespace, name, err)
+	}
+	addresses, _, _ := unstructured.NestedSlice(gateway.Object, "status", "addresses")
+	var hostnames, ips []string
+	for _, a := range addresses {
+		address, ok := a.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		value, _ := address["value"].(string)
+		// addresses without type are IP addresses
+		if addressType, _ := address["type"].(string); addressType == "Hostname" {
+			hostnames = append(hostnames, value)
+		} else {
+			ips = append(ips, value)
+		}
+	}
+	return findRouterAddress(hostnames, ips), nil
+}
@@ -0,0 +1,54 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// IngressService is responsible for performing read operations on the Kubernetes Ingress objects in the cluster.
+type IngressService struct {
+	client ctrlClient.Client
+}
+
+var _ HostFinder = (*IngressService)(nil)
+
+// NewIngressService creates a new instance of IngressService.
+func NewIngressService(client ctrlClient.Client) *IngressService {
+	return &IngressService{
+		client: client,
+	}
+}
+
+// FindAdmittedHosts returns the hosts of the ingresses for a given namespace which got a load balancer assigned.
+// The load balancer's host name, or its IPv4 address if it has none, is reported as router.
+func (s *IngressService) FindAdmittedHosts(ctx context.Context, namespace string) ([]AdmittedHost, error) {
+	ingresses := &networkingv1.IngressList{}
+	if err := s.client.List(ctx, ingresses, ctrlClient.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("find admitted ingresses for namespace %s: %w", namespace, err)
+	}
+	var hosts []AdmittedHost
+	for _, ingress := range ingresses.Items {
+		router := findLoadBalancerAddress(ingress.Status.LoadBalancer.Ingress)
+		if router == "" {
+			continue
+		}
+		for _, rule := range ingress.Spec.Rules {
+			if rule.Host != "" {
+				hosts = append(hosts, AdmittedHost{Host: rule.Host, Router: router})
+			}
+		}
+	}
+	return hosts, nil
+}
+
+func findLoadBalancerAddress(ingresses []networkingv1.IngressLoadBalancerIngress) string {
+	var hostnames, ips []string
+	for _, ingress := range ingresses {
+		hostnames = append(hostnames, ingress.Hostname)
+		ips = append(ips, ingress.IP)
+	}
+	return findRouterAddress(hostnames, ips)
+}
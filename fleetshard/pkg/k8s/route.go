@@ -15,6 +15,8 @@ type RouteService struct {
 	client ctrlClient.Client
 }
 
+var _ HostFinder = (*RouteService)(nil)
+
 // NewRouteService creates a new instance of RouteService.
 func NewRouteService(client ctrlClient.Client) *RouteService {
 	return &RouteService{
@@ -38,6 +40,19 @@ func (s *RouteService) FindAdmittedIngresses(ctx context.Context, namespace stri
 	return ingresses, nil
 }
 
+// FindAdmittedHosts returns the hosts of the admitted ingresses for a given namespace
+func (s *RouteService) FindAdmittedHosts(ctx context.Context, namespace string) ([]AdmittedHost, error) {
+	ingresses, err := s.FindAdmittedIngresses(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	hosts := make([]AdmittedHost, 0, len(ingresses))
+	for _, ingress := range ingresses {
+		hosts = append(hosts, AdmittedHost{Host: ingress.Host, Router: ingress.RouterCanonicalHostname})
+	}
+	return hosts, nil
+}
+
 // findFirstAdmittedIngress returns first admitted ingress or nil if not found
 func findFirstAdmittedIngress(route openshiftRouteV1.Route) *openshiftRouteV1.RouteIngress {
 	for _, ingress := range route.Status.Ingress {
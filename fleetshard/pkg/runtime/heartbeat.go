@@ -0,0 +1,49 @@
+package runtime
+
+import (
+	"runtime/debug"
+	"strconv"
+	"sync/atomic"
+
+	fmAPI "github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+)
+
+// heartbeat is the state of fleetshard-sync sent along with every request to fleet manager, which records it as
+// heartbeat of the cluster.
+type heartbeat struct {
+	version         string
+	reconcileErrors atomic.Int64
+}
+
+func newHeartbeat() *heartbeat {
+	return &heartbeat{version: fleetshardVersion()}
+}
+
+func (h *heartbeat) headers() map[string]string {
+	return map[string]string{
+		fmAPI.FleetshardVersionHeader:         h.version,
+		fmAPI.FleetshardReconcileErrorsHeader: strconv.FormatInt(h.reconcileErrors.Load(), 10),
+	}
+}
+
+// setReconcileErrors records the number of Central reconciliations that failed in the last reconcile round
+func (h *heartbeat) setReconcileErrors(count int) {
+	h.reconcileErrors.Store(int64(count))
+}
+
+// fleetshardVersion returns the module version of the binary or, for development builds, its VCS revision
+func fleetshardVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+	if info.Main.Version != "" && info.Main.Version != "(devel)" {
+		return info.Main.Version
+	}
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.revision" {
+			return setting.Value
+		}
+	}
+	return "unknown"
+}
@@ -0,0 +1,293 @@
+package runtime
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+
+	centralReconciler "github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/reconciler"
+	centralConstants "github.com/stackrox/acs-fleet-manager/internal/central/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/private"
+)
+
+// reconcilePriority defines the order in which queued Central reconciliations are picked up by the workers.
+// Higher values are reconciled first.
+type reconcilePriority int
+
+const (
+	priorityReady reconcilePriority = iota
+	priorityProvisioning
+	priorityDeletion
+)
+
+func (p reconcilePriority) String() string {
+	switch p {
+	case priorityDeletion:
+		return "deletion"
+	case priorityProvisioning:
+		return "provisioning"
+	default:
+		return "ready"
+	}
+}
+
+func getReconcilePriority(central private.ManagedCentral) reconcilePriority {
+	if central.Metadata.DeletionTimestamp != "" {
+		return priorityDeletion
+	}
+	if central.RequestStatus != centralConstants.CentralRequestStatusReady.String() {
+		return priorityProvisioning
+	}
+	return priorityReady
+}
+
+type reconcileItem struct {
+	central    private.ManagedCentral
+	reconciler *centralReconciler.CentralReconciler
+	priority   reconcilePriority
+	enqueuedAt time.Time
+	index      int
+}
+
+// reconcileItems implements heap.Interface ordered by priority and then by enqueue time.
+type reconcileItems []*reconcileItem
+
+func (q reconcileItems) Len() int { return len(q) }
+
+func (q reconcileItems) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority > q[j].priority
+	}
+	return q[i].enqueuedAt.Before(q[j].enqueuedAt)
+}
+
+func (q reconcileItems) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *reconcileItems) Push(x any) {
+	item := x.(*reconcileItem)
+	item.index = len(*q)
+	*q = append(*q, item)
+}
+
+func (q *reconcileItems) Pop() any {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	item.index = -1
+	*q = old[:n-1]
+	return item
+}
+
+// reconcileQueue is a priority queue of pending Central reconciliations. A Central is contained at most once,
+// either waiting in the queue or in flight. Enqueueing a Central that is already waiting updates it in place.
+type reconcileQueue struct {
+	mu       sync.Mutex
+	items    reconcileItems
+	byID     map[string]*reconcileItem
+	inFlight map[string]struct{}
+	notify   chan struct{}
+	now      func() time.Time
+}
+
+func newReconcileQueue() *reconcileQueue {
+	return &reconcileQueue{
+		byID:     map[string]*reconcileItem{},
+		inFlight: map[string]struct{}{},
+		notify:   make(chan struct{}, 1),
+		now:      time.Now,
+	}
+}
+
+// push adds the central to the queue. It returns false if the central is currently being reconciled.
+func (q *reconcileQueue) push(central private.ManagedCentral, reconciler *centralReconciler.CentralReconciler) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if _, ok := q.inFlight[central.Id]; ok {
+		return false
+	}
+	priority := getReconcilePriority(central)
+	if item, ok := q.byID[central.Id]; ok {
+		item.central = central
+		item.reconciler = reconciler
+		if item.priority != priority {
+			item.priority = priority
+			heap.Fix(&q.items, item.index)
+		}
+		return true
+	}
+
+	item := &reconcileItem{
+		central:    central,
+		reconciler: reconciler,
+		priority:   priority,
+		enqueuedAt: q.now(),
+	}
+	heap.Push(&q.items, item)
+	q.byID[central.Id] = item
+	q.signal()
+	return true
+}
+
+// pop blocks until an item is available or the context is cancelled. The returned item is marked as in flight
+// until done is called for it.
+func (q *reconcileQueue) pop(ctx context.Context) (*reconcileItem, bool) {
+	for {
+		q.mu.Lock()
+		if q.items.Len() > 0 {
+			item := heap.Pop(&q.items).(*reconcileItem)
+			delete(q.byID, item.central.Id)
+			q.inFlight[item.central.Id] = struct{}{}
+			if q.items.Len() > 0 {
+				q.signal()
+			}
+			q.mu.Unlock()
+			return item, true
+		}
+		q.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, false
+		case <-q.notify:
+		}
+	}
+}
+
+// done marks the reconciliation of the given central as finished.
+func (q *reconcileQueue) done(centralID string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.inFlight, centralID)
+}
+
+// remove drops a waiting central from the queue, e.g. because it is no longer assigned to the cluster.
+func (q *reconcileQueue) remove(centralID string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if item, ok := q.byID[centralID]; ok {
+		heap.Remove(&q.items, item.index)
+		delete(q.byID, centralID)
+	}
+}
+
+func (q *reconcileQueue) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.items.Len()
+}
+
+// signal wakes up a waiting worker. Must be called with the lock held.
+func (q *reconcileQueue) signal() {
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// tenantBackoff tracks failed reconciliations per tenant and delays further attempts exponentially.
+type tenantBackoff struct {
+	mu      sync.Mutex
+	initial time.Duration
+	max     time.Duration
+	entries map[string]backoffEntry
+	now     func() time.Time
+}
+
+type backoffEntry struct {
+	failures    int
+	nextAttempt time.Time
+}
+
+func newTenantBackoff(initial, maxDelay time.Duration) *tenantBackoff {
+	return &tenantBackoff{
+		initial: initial,
+		max:     maxDelay,
+		entries: map[string]backoffEntry{},
+		now:     time.Now,
+	}
+}
+
+// ready returns true if the tenant can be reconciled now. Deletions are not delayed, so that tenants whose
+// reconciliation failed can still be removed.
+func (b *tenantBackoff) ready(central private.ManagedCentral) bool {
+	if getReconcilePriority(central) == priorityDeletion {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	entry, ok := b.entries[central.Id]
+	return !ok || !b.now().Before(entry.nextAttempt)
+}
+
+// failure records a failed reconciliation and returns the delay until the next attempt.
+func (b *tenantBackoff) failure(centralID string) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	entry := b.entries[centralID]
+	entry.failures++
+	delay := b.initial
+	for i := 1; i < entry.failures && delay < b.max; i++ {
+		delay *= 2
+	}
+	if delay > b.max {
+		delay = b.max
+	}
+	entry.nextAttempt = b.now().Add(delay)
+	b.entries[centralID] = entry
+	return delay
+}
+
+// reset clears the backoff of a tenant after a successful reconciliation.
+func (b *tenantBackoff) reset(centralID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.entries, centralID)
+}
+
+// reportedStatuses keeps the last status reconciled for each central, so that the statuses of all centrals assigned
+// to the cluster can be counted while the workers only reconcile some of them per poll period.
+type reportedStatuses struct {
+	mu       sync.Mutex
+	statuses map[string]private.DataPlaneCentralStatus
+}
+
+func newReportedStatuses() *reportedStatuses {
+	return &reportedStatuses{statuses: map[string]private.DataPlaneCentralStatus{}}
+}
+
+// set records the status of a reconciled central.
+func (s *reportedStatuses) set(centralID string, status private.DataPlaneCentralStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.statuses[centralID] = status
+}
+
+// remove forgets the status of a central, so that its status from fleet-manager is counted instead.
+func (s *reportedStatuses) remove(centralID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.statuses, centralID)
+}
+
+// count counts the statuses of all given centrals. Centrals which were not reconciled successfully yet are counted
+// by the status fleet-manager reports for them.
+func (s *reportedStatuses) count(centrals []private.ManagedCentral) centralReconciler.StatusesCount {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	statusesCount := centralReconciler.StatusesCount{}
+	for _, central := range centrals {
+		if status, ok := s.statuses[central.Id]; ok {
+			statusesCount.IncrementCurrent(status)
+		} else {
+			statusesCount.IncrementRemote(central.RequestStatus)
+		}
+	}
+	return statusesCount
+}
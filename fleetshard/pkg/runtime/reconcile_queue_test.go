@@ -0,0 +1,145 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	centralReconciler "github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/reconciler"
+	centralConstants "github.com/stackrox/acs-fleet-manager/internal/central/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/private"
+)
+
+func newQueueTestCentral(id string, status centralConstants.CentralStatus, deleted bool) private.ManagedCentral {
+	central := private.ManagedCentral{Id: id, RequestStatus: status.String()}
+	if deleted {
+		central.Metadata.DeletionTimestamp = time.Now().Format(time.RFC3339)
+	}
+	return central
+}
+
+func popIDs(t *testing.T, q *reconcileQueue, n int) []string {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	ids := make([]string, 0, n)
+	for range n {
+		item, ok := q.pop(ctx)
+		require.True(t, ok)
+		ids = append(ids, item.central.Id)
+	}
+	return ids
+}
+
+func TestReconcileQueue_PopsByPriorityThenFIFO(t *testing.T) {
+	q := newReconcileQueue()
+	now := time.Now()
+	q.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	q.push(newQueueTestCentral("ready-1", centralConstants.CentralRequestStatusReady, false), nil)
+	q.push(newQueueTestCentral("provisioning", centralConstants.CentralRequestStatusProvisioning, false), nil)
+	q.push(newQueueTestCentral("ready-2", centralConstants.CentralRequestStatusReady, false), nil)
+	q.push(newQueueTestCentral("deleting", centralConstants.CentralRequestStatusDeprovision, true), nil)
+
+	assert.Equal(t, 4, q.len())
+	assert.Equal(t, []string{"deleting", "provisioning", "ready-1", "ready-2"}, popIDs(t, q, 4))
+}
+
+func TestReconcileQueue_DeduplicatesAndUpdatesPriority(t *testing.T) {
+	q := newReconcileQueue()
+
+	q.push(newQueueTestCentral("a", centralConstants.CentralRequestStatusReady, false), nil)
+	q.push(newQueueTestCentral("b", centralConstants.CentralRequestStatusReady, false), nil)
+	q.push(newQueueTestCentral("b", centralConstants.CentralRequestStatusDeprovision, true), nil)
+
+	assert.Equal(t, 2, q.len())
+	assert.Equal(t, []string{"b", "a"}, popIDs(t, q, 2))
+}
+
+func TestReconcileQueue_SkipsInFlightCentrals(t *testing.T) {
+	q := newReconcileQueue()
+	central := newQueueTestCentral("a", centralConstants.CentralRequestStatusReady, false)
+
+	require.True(t, q.push(central, nil))
+	popIDs(t, q, 1)
+	assert.False(t, q.push(central, nil))
+
+	q.done(central.Id)
+	assert.True(t, q.push(central, nil))
+}
+
+func TestReconcileQueue_Remove(t *testing.T) {
+	q := newReconcileQueue()
+	q.push(newQueueTestCentral("a", centralConstants.CentralRequestStatusReady, false), nil)
+	q.push(newQueueTestCentral("b", centralConstants.CentralRequestStatusReady, false), nil)
+
+	q.remove("a")
+
+	assert.Equal(t, []string{"b"}, popIDs(t, q, 1))
+}
+
+func TestReconcileQueue_PopReturnsOnCancel(t *testing.T) {
+	q := newReconcileQueue()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, ok := q.pop(ctx)
+	assert.False(t, ok)
+}
+
+func TestTenantBackoff(t *testing.T) {
+	now := time.Now()
+	b := newTenantBackoff(10*time.Second, 30*time.Second)
+	b.now = func() time.Time { return now }
+	a := newQueueTestCentral("a", centralConstants.CentralRequestStatusReady, false)
+
+	assert.True(t, b.ready(a))
+	assert.Equal(t, 10*time.Second, b.failure("a"))
+	assert.False(t, b.ready(a))
+	assert.True(t, b.ready(newQueueTestCentral("b", centralConstants.CentralRequestStatusReady, false)))
+	assert.True(t, b.ready(newQueueTestCentral("a", centralConstants.CentralRequestStatusDeprovision, true)),
+		"deletions are not delayed")
+
+	assert.Equal(t, 20*time.Second, b.failure("a"))
+	assert.Equal(t, 30*time.Second, b.failure("a"))
+	assert.Equal(t, 30*time.Second, b.failure("a"))
+
+	now = now.Add(30 * time.Second)
+	assert.True(t, b.ready(a))
+
+	b.failure("a")
+	b.reset("a")
+	assert.True(t, b.ready(a))
+}
+
+func TestReportedStatuses_CountsAllCentrals(t *testing.T) {
+	statuses := newReportedStatuses()
+	centrals := []private.ManagedCentral{
+		newQueueTestCentral("a", centralConstants.CentralRequestStatusProvisioning, false),
+		newQueueTestCentral("b", centralConstants.CentralRequestStatusReady, false),
+		newQueueTestCentral("c", centralConstants.CentralRequestStatusReady, false),
+	}
+	statuses.set("a", private.DataPlaneCentralStatus{
+		Conditions: []private.DataPlaneCentralStatusConditions{{Type: "Ready", Status: "True"}},
+	})
+	ready := centralConstants.CentralRequestStatusReady.String()
+
+	expected := centralReconciler.StatusesCount{}
+	expected.IncrementRemote(ready)
+	expected.IncrementRemote(ready)
+	expected.IncrementRemote(ready)
+	assert.Equal(t, expected, statuses.count(centrals),
+		"centrals which were not reconciled are counted by their status in fleet-manager")
+
+	statuses.remove("a")
+	expected = centralReconciler.StatusesCount{}
+	expected.IncrementRemote(centralConstants.CentralRequestStatusProvisioning.String())
+	expected.IncrementRemote(ready)
+	expected.IncrementRemote(ready)
+	assert.Equal(t, expected, statuses.count(centrals))
+}
@@ -4,7 +4,7 @@ package runtime
 import (
 	"context"
 	"fmt"
-	"sync"
+	"net/http"
 	"time"
 
 	"github.com/golang/glog"
@@ -18,12 +18,13 @@ import (
 	"github.com/stackrox/acs-fleet-manager/fleetshard/config"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider/awsclient"
+	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/cloudprovider/incluster"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/postgres"
 	centralReconciler "github.com/stackrox/acs-fleet-manager/fleetshard/pkg/central/reconciler"
-	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/cipher"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/fleetshardmetrics"
 	"github.com/stackrox/acs-fleet-manager/fleetshard/pkg/k8s"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/private"
+	"github.com/stackrox/acs-fleet-manager/pkg/cipher"
 	fmAPI "github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
 	fleetmanager "github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager/impl"
 	"github.com/stackrox/acs-fleet-manager/pkg/features"
@@ -45,6 +46,11 @@ var backoff = wait.Backoff{
 	Cap:      10 * time.Minute,
 }
 
+var dbClientRegistry = cloudprovider.NewDBClientRegistry(map[string]cloudprovider.DBClientFactory{
+	config.ManagedDBProviderAWS:       awsclient.NewDBClient,
+	config.ManagedDBProviderInCluster: incluster.NewDBClient,
+})
+
 // Runtime represents the runtime to reconcile all centrals associated with the given cluster.
 type Runtime struct {
 	config                        *config.Config
@@ -57,6 +63,12 @@ type Runtime struct {
 	secretCipher                  cipher.Cipher
 	encryptionKeyGenerator        cipher.KeyGenerator
 	runtimeApplicationsReconciler *runtimeApplicationsReconciler
+	queue                         *reconcileQueue
+	backoff                       *tenantBackoff
+	reportedStatuses              *reportedStatuses
+	reconcileResults              chan reconcileResult
+	orphanedDBCleanup             *centralReconciler.OrphanedDBCleanup
+	heartbeat                     *heartbeat
 }
 
 // NewRuntime creates a new runtime
@@ -73,26 +85,42 @@ func NewRuntime(ctx context.Context, config *config.Config, k8sClient ctrlClient
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create fleet manager authentication")
 	}
-	client, err := fleetmanager.NewClient(config.FleetManagerEndpoint, auth, fleetmanager.WithUserAgent(
-		fmt.Sprintf("fleetshard-synchronizer/%s", config.ClusterID)),
+	heartbeat := newHeartbeat()
+	client, err := fleetmanager.NewClient(config.FleetManagerEndpoint, auth,
+		fleetmanager.WithUserAgent(fmt.Sprintf("fleetshard-synchronizer/%s", config.ClusterID)),
+		fleetmanager.WithRequestHeaders(heartbeat.headers),
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create fleet manager client")
 	}
 	var dbProvisionClient cloudprovider.DBClient
 	if config.ManagedDB.Enabled {
-		dbProvisionClient, err = awsclient.NewRDSClient(config)
+		dbProvisionClient, err = dbClientRegistry.NewDBClient(config, k8sClient)
 		if err != nil {
 			return nil, fmt.Errorf("creating managed DB provisioning client: %v", err)
 		}
 	}
 
-	secretCipher, err := cipher.NewCipher(config)
+	var orphanedDBCleanup *centralReconciler.OrphanedDBCleanup
+	if config.ManagedDB.Enabled && config.ManagedDB.OrphanDetection.Enabled {
+		lister, ok := dbProvisionClient.(cloudprovider.DBResourceLister)
+		if ok {
+			listCentralIDs := func(ctx context.Context) ([]string, error) {
+				list, _, err := client.PrivateAPI().GetCentralIds(ctx, config.ClusterID)
+				return list.Items, err
+			}
+			orphanedDBCleanup = centralReconciler.NewOrphanedDBCleanup(lister, listCentralIDs, config.ManagedDB.OrphanDetection)
+		} else {
+			glog.Warningf("Managed DB provider %s does not support orphaned resource detection", config.ManagedDB.Provider)
+		}
+	}
+
+	secretCipher, err := cipher.NewCipher(config.SecretEncryption.Type, config.SecretEncryption.KeyID)
 	if err != nil {
 		return nil, fmt.Errorf("creating secretCipher: %w", err)
 	}
 
-	encryptionKeyGen, err := cipher.NewKeyGenerator(config)
+	encryptionKeyGen, err := cipher.NewKeyGenerator(config.SecretEncryption.Type, config.SecretEncryption.KeyID)
 	if err != nil {
 		return nil, fmt.Errorf("creating encryption KeyGenerator: %w", err)
 	}
@@ -101,21 +129,37 @@ func NewRuntime(ctx context.Context, config *config.Config, k8sClient ctrlClient
 		config:                        config,
 		k8sClient:                     k8sClient,
 		client:                        client,
+		heartbeat:                     heartbeat,
 		clusterID:                     config.ClusterID,
 		dbProvisionClient:             dbProvisionClient,
 		reconcilers:                   make(reconcilerRegistry),
 		secretCipher:                  secretCipher, // pragma: allowlist secret
 		encryptionKeyGenerator:        encryptionKeyGen,
 		runtimeApplicationsReconciler: newRuntimeApplicationsReconciler(k8sClient, config.ArgoCdNamespace),
+		queue:                         newReconcileQueue(),
+		backoff:                       newTenantBackoff(config.Reconcile.BackoffInitial, config.Reconcile.BackoffMax),
+		reportedStatuses:              newReportedStatuses(),
+		reconcileResults:              make(chan reconcileResult, config.Reconcile.Workers),
+		orphanedDBCleanup:             orphanedDBCleanup,
 	}, nil
 }
 
+// OrphanedDBResourcesHandler returns the handler serving the report of orphaned managed DB resources
+func (r *Runtime) OrphanedDBResourcesHandler() http.Handler {
+	if r.orphanedDBCleanup == nil {
+		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, "orphaned managed DB resource detection is disabled", http.StatusNotFound)
+		})
+	}
+	return r.orphanedDBCleanup
+}
+
 // Start starts the fleetshard runtime and schedules
 func (r *Runtime) Start(ctx context.Context) error {
 	glog.Info("fleetshard runtime started")
 	glog.Infof("Auth provider initialisation enabled: %v", r.config.CreateAuthProvider)
 
-	routesAvailable := r.routesAvailable()
+	exposure := r.centralExposure(ctx)
 
 	argoReconcilerOpts := centralReconciler.ArgoReconcilerOptions{
 		TenantDefaultArgoCdAppSourceTargetRevision: r.config.TenantDefaultArgoCdAppSourceTargetRevision,
@@ -127,10 +171,12 @@ func (r *Runtime) Start(ctx context.Context) error {
 		Environment:                                r.config.Environment,
 		WantsAuthProvider:                          r.config.CreateAuthProvider,
 		Telemetry:                                  r.config.Telemetry,
+		CentralExposure:                            exposure,
 	}
 
 	reconcilerOpts := centralReconciler.CentralReconcilerOptions{
-		UseRoutes:             routesAvailable,
+		UseRoutes:             exposure != k8s.ExposureNone,
+		Exposure:              exposure,
 		ManagedDBEnabled:      r.config.ManagedDB.Enabled,
 		ClusterName:           r.config.ClusterName,
 		Environment:           r.config.Environment,
@@ -138,6 +184,10 @@ func (r *Runtime) Start(ctx context.Context) error {
 		TenantImagePullSecret: r.config.TenantImagePullSecret, // pragma: allowlist secret
 		ArgoReconcilerOptions: argoReconcilerOpts,
 	}
+	if r.config.ManagedDB.Provider == config.ManagedDBProviderAWS {
+		reconcilerOpts.ManagedDBMinCapacityACU = r.config.ManagedDB.MinCapacityACU
+		reconcilerOpts.ManagedDBMaxCapacityACU = r.config.ManagedDB.MaxCapacityACU
+	}
 
 	tenantCleanupOpts := centralReconciler.TenantCleanupOptions{
 		ArgoReconcilerOptions: argoReconcilerOpts,
@@ -148,6 +198,11 @@ func (r *Runtime) Start(ctx context.Context) error {
 		tenantCleanupOpts,
 	)
 
+	for range r.config.Reconcile.Workers {
+		go r.runReconcileWorker(ctx)
+	}
+	go r.reportReconcileResults(ctx)
+
 	ticker := concurrency.NewRetryTicker(func(ctx context.Context) (timeToNextTick time.Duration, err error) {
 		list, _, err := r.client.PrivateAPI().GetCentrals(ctx, r.clusterID)
 		if err != nil {
@@ -160,32 +215,23 @@ func (r *Runtime) Start(ctx context.Context) error {
 			glog.Errorf("failed to reconcile runtime applications: %v", err)
 		}
 
-		// Start for each Central its own reconciler which can be triggered by sending a central to the receive channel.
+		// Enqueue each Central for reconciliation. The queue is drained by a bounded pool of workers, which
+		// prefers deletions and provisioning tenants over tenants that are already ready.
 		reconciledCentralCountCache = int32(len(list.Items))
 		logger.InfoChangedInt32(&reconciledCentralCountCache, "Received central count changed: received %d centrals", reconciledCentralCountCache)
-		reconcileResults := make(chan reconcileResult, len(list.Items))
-		var wg sync.WaitGroup
 		for _, central := range list.Items {
 			if _, ok := r.reconcilers[central.Id]; !ok {
 				r.reconcilers[central.Id] = centralReconciler.NewCentralReconciler(r.k8sClient, r.client,
 					r.dbProvisionClient, postgres.InitializeDatabase, r.secretCipher, r.encryptionKeyGenerator, reconcilerOpts)
 			}
 
-			reconciler := r.reconcilers[central.Id]
-			wg.Add(1)
-			go func(reconciler *centralReconciler.CentralReconciler, central private.ManagedCentral) {
-				defer wg.Done()
-				fleetshardmetrics.MetricsInstance().IncActiveCentralReconcilations()
-				defer fleetshardmetrics.MetricsInstance().DecActiveCentralReconcilations()
-
-				// a 15 minutes timeout should cover the duration of a Reconcile call, including the provisioning of an RDS database
-				ctx, cancel := context.WithTimeout(context.Background(), 15*time.Minute)
-				defer cancel()
-
-				status, err := reconciler.Reconcile(ctx, central)
-				fleetshardmetrics.MetricsInstance().IncCentralReconcilations()
-				submitReconcileResult(central, status, err, reconcileResults)
-			}(reconciler, central)
+			if r.backoff.ready(central) {
+				if !r.queue.push(central, r.reconcilers[central.Id]) {
+					glog.V(10).Infof("Skip enqueueing central %s/%s, its reconciliation is still running", central.Metadata.Namespace, central.Metadata.Name)
+				}
+			} else {
+				glog.V(10).Infof("Skip reconciling central %s/%s during backoff", central.Metadata.Namespace, central.Metadata.Name)
+			}
 
 			reconcilePaused, err := r.isReconcilePaused(ctx, central)
 			if err != nil {
@@ -194,12 +240,9 @@ func (r *Runtime) Start(ctx context.Context) error {
 				fleetshardmetrics.MetricsInstance().SetPauseReconcileStatus(central.Id, reconcilePaused)
 			}
 		}
-
-		go func() {
-			wg.Wait()
-			close(reconcileResults)
-			r.handleReconcileResults(reconcileResults)
-		}()
+		fleetshardmetrics.MetricsInstance().SetReconcileQueueDepth(r.queue.len())
+		statusesCount := r.reportedStatuses.count(list.Items)
+		statusesCount.SubmitMetric()
 
 		if reconcilerOpts.ManagedDBEnabled {
 			accountQuotas, err := r.dbProvisionClient.GetAccountQuotas(ctx)
@@ -212,6 +255,12 @@ func (r *Runtime) Start(ctx context.Context) error {
 
 		r.deleteStaleReconcilers(&list)
 
+		if r.orphanedDBCleanup != nil {
+			if err := r.orphanedDBCleanup.DetectOrphanedDBResources(ctx); err != nil {
+				glog.Errorf("Failed to detect orphaned managed DB resources: %v", err)
+			}
+		}
+
 		if features.ClusterMigration.Enabled() {
 			if err := tenantCleanup.DeleteStaleTenantK8sResources(ctx, &list); err != nil {
 				glog.Errorf("Failed to delete stale tenant k8s resources: %s", err.Error())
@@ -254,26 +303,84 @@ func submitReconcileResult(central private.ManagedCentral, status *private.DataP
 	}
 }
 
-func (r *Runtime) handleReconcileResults(results <-chan reconcileResult) {
+// runReconcileWorker reconciles queued centrals one at a time until the context is cancelled.
+func (r *Runtime) runReconcileWorker(ctx context.Context) {
+	for {
+		item, ok := r.queue.pop(ctx)
+		if !ok {
+			return
+		}
+		fleetshardmetrics.MetricsInstance().ObserveReconcileQueueWait(item.priority.String(), time.Since(item.enqueuedAt))
+		fleetshardmetrics.MetricsInstance().SetReconcileQueueDepth(r.queue.len())
+		r.reconcileCentral(item)
+		r.queue.done(item.central.Id)
+	}
+}
+
+func (r *Runtime) reconcileCentral(item *reconcileItem) {
+	fleetshardmetrics.MetricsInstance().IncActiveCentralReconcilations()
+	defer fleetshardmetrics.MetricsInstance().DecActiveCentralReconcilations()
+
+	// a 15 minutes timeout should cover the duration of a Reconcile call, including the provisioning of an RDS database
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Minute)
+	defer cancel()
+
+	central := item.central
+	status, err := item.reconciler.Reconcile(ctx, central)
+	fleetshardmetrics.MetricsInstance().IncCentralReconcilations()
+	if err != nil && !centralReconciler.IsSkippable(err) {
+		delay := r.backoff.failure(central.Id)
+		glog.Infof("Backing off reconciliation of central %s/%s for %s", central.Metadata.Namespace, central.Metadata.Name, delay)
+	} else {
+		r.backoff.reset(central.Id)
+	}
+	submitReconcileResult(central, status, err, r.reconcileResults)
+}
+
+// reportReconcileResults collects the results of the reconcile workers and sends the changed statuses to
+// fleet-manager once per poll period.
+func (r *Runtime) reportReconcileResults(ctx context.Context) {
+	ticker := time.NewTicker(r.config.RuntimePollPeriod)
+	defer ticker.Stop()
+
+	var results []reconcileResult
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case result := <-r.reconcileResults:
+			results = append(results, result)
+		case <-ticker.C:
+			if len(results) == 0 {
+				continue
+			}
+			r.handleReconcileResults(results)
+			results = nil
+		}
+	}
+}
+
+func (r *Runtime) handleReconcileResults(results []reconcileResult) {
 	statuses := map[string]private.DataPlaneCentralStatus{}
-	statusesCount := centralReconciler.StatusesCount{}
-	defer statusesCount.SubmitMetric()
+	reconcileErrors := 0
 
-	for result := range results {
+	for _, result := range results {
 		central := result.central
 		if err := result.err; err != nil {
 			if centralReconciler.IsSkippable(err) {
 				glog.V(10).Infof("Skip sending the status for central %s/%s: %v", central.Metadata.Namespace, central.Metadata.Name, err)
-				statusesCount.IncrementRemote(central.RequestStatus) // get remote status
 			} else {
 				fleetshardmetrics.MetricsInstance().IncCentralReconcilationErrors()
+				r.reportedStatuses.remove(central.Id)
+				reconcileErrors++
 				glog.Errorf("Unexpected error occurred %s/%s: %s", central.Metadata.Namespace, central.Metadata.Name, err.Error())
 			}
 		} else {
-			statusesCount.IncrementCurrent(result.status)
+			r.reportedStatuses.set(central.Id, result.status)
 			statuses[central.Id] = result.status
 		}
 	}
+	r.heartbeat.setReconcileErrors(reconcileErrors)
 	if len(statuses) == 0 {
 		return
 	}
@@ -296,23 +403,29 @@ func (r *Runtime) deleteStaleReconcilers(list *private.ManagedCentralList) {
 	for key := range r.reconcilers {
 		if _, hasKey := centralIds[key]; !hasKey {
 			delete(r.reconcilers, key)
+			r.queue.remove(key)
+			r.backoff.reset(key)
+			r.reportedStatuses.remove(key)
 		}
 	}
 }
 
-func (r *Runtime) routesAvailable() bool {
-	available, err := k8s.IsRoutesResourceEnabled(r.k8sClient)
+// centralExposure returns the configured exposure of Central, or the one available on the cluster
+func (r *Runtime) centralExposure(ctx context.Context) string {
+	if r.config.CentralExposure != k8s.ExposureAuto {
+		glog.Infof("Central exposure: %s", r.config.CentralExposure)
+		return r.config.CentralExposure
+	}
+	exposure, err := k8s.DetectExposure(ctx, r.k8sClient)
 	if err != nil {
-		glog.Errorf("Skip checking OpenShift routes availability due to an error: %v", err)
-		return true // make an optimistic assumption that routes can be created despite the error
+		glog.Errorf("Skip detecting the Central exposure due to an error: %v", err)
+		return k8s.ExposureRoute // make an optimistic assumption that routes can be created despite the error
 	}
-	glog.Infof("OpenShift Routes available: %t", available)
-	if !available {
-		glog.Warning("Most likely the application is running on a plain Kubernetes cluster. " +
-			"Such setup is unsupported and can be used for development only!")
-		return false
+	glog.Infof("Detected Central exposure: %s", exposure)
+	if exposure == k8s.ExposureNone {
+		glog.Warning("Central is not exposed outside the cluster. Such setup can be used for development only!")
 	}
-	return true
+	return exposure
 }
 
 func (r *Runtime) isReconcilePaused(ctx context.Context, remoteCentral private.ManagedCentral) (bool, error) {
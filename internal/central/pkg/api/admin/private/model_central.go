@@ -44,4 +44,14 @@ type Central struct {
 	ClusterId      string               `json:"cluster_id,omitempty"`
 	Namespace      string               `json:"namespace,omitempty"`
 	Traits         []string             `json:"traits,omitempty"`
+	// Phase of the latest migration of the central between data plane clusters
+	MigrationPhase string `json:"migration_phase,omitempty"`
+	// Creation time of the OIDC client of the central
+	ClientCreatedAt *time.Time `json:"client_created_at,omitempty"`
+	// Daily time window in UTC in which the OIDC client of the central is rotated
+	ClientRotationWindow string `json:"client_rotation_window,omitempty"`
+	// Phase of the latest engine upgrade of the central's managed database
+	DbUpgradeStatus string `json:"db_upgrade_status,omitempty"`
+	// Message reported with the phase of the latest engine upgrade of the central's managed database
+	DbUpgradeMessage string `json:"db_upgrade_message,omitempty"`
 }
@@ -0,0 +1,23 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+)
+
+// AccessRule is a deny or allow rule for users of the public API managed via the admin API
+type AccessRule struct {
+	api.Meta
+	// Action is either "deny" or "allow"
+	Action string `json:"action"`
+	// Type is the user attribute the rule matches: "username", "organisation_id", "email_domain" or "pattern"
+	Type  string `json:"type"`
+	Value string `json:"value"`
+	// Reason documents why the rule was added
+	Reason string `json:"reason"`
+	// ExpiresAt is the time after which the rule is ignored. Rules without expiry apply until they are deleted.
+	ExpiresAt *time.Time `json:"expires_at" gorm:"index"`
+	// Owner is the admin who created the rule
+	Owner string `json:"owner"`
+}
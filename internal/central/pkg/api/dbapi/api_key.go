@@ -0,0 +1,27 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+)
+
+// APIKey is a key for the machine access of customers to the public API. Only the hash of the secret part of the key
+// is stored. Revoked keys are soft deleted.
+type APIKey struct {
+	api.Meta
+	Name           string `json:"name"`
+	OrganisationID string `json:"organisation_id" gorm:"index"`
+	// Owner is the user who created the key. Requests with the key act on behalf of the owner.
+	Owner string `json:"owner"`
+	// OwnerUserID, OwnerAlternateUserID and OwnerAccountID are the sub, deprecated_sub and account_id claims of the
+	// owner, so that Centrals created with the key are owned by the owner like Centrals created with their token
+	OwnerUserID          string `json:"owner_user_id"`
+	OwnerAlternateUserID string `json:"owner_alternate_user_id"`
+	OwnerAccountID       string `json:"owner_account_id"`
+	// Scopes are the operations the key can be used for, separated by spaces
+	Scopes     string     `json:"scopes"`
+	SecretHash []byte     `json:"-"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+}
@@ -0,0 +1,114 @@
+package dbapi
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+)
+
+// AuditEvent records an admin mutation of a Central tenant. The events form a hash chain in the order of their
+// sequence: the hash of an event covers its content and the hash of the previous event, so that modified, removed or
+// reordered events can be detected.
+type AuditEvent struct {
+	api.Meta
+	Sequence int64 `json:"sequence" gorm:"uniqueIndex"`
+	// Actor is the username of the admin who made the request
+	Actor string `json:"actor"`
+	// RouteName is the log event type of the admin route, e.g. admin-billing
+	RouteName string `json:"route_name"`
+	Method    string `json:"method"`
+	// TargetID is the ID of the central the request targeted
+	TargetID string `json:"target_id" gorm:"index"`
+	// Before and After are snapshots of the central without its secrets. After is empty if the central was deleted.
+	Before   api.JSON `json:"before"`
+	After    api.JSON `json:"after"`
+	PrevHash string   `json:"prev_hash"`
+	Hash     string   `json:"hash"`
+}
+
+// AuditChainHeadID is the ID of the only chain head row
+const AuditChainHeadID = "audit_events"
+
+// AuditChainHead anchors the end of the audit event chain. It is updated together with every recorded event, so that
+// events removed from the end of the chain are detected, which leaves no broken link behind.
+type AuditChainHead struct {
+	ID        string `gorm:"primaryKey"`
+	Sequence  int64
+	Hash      string
+	UpdatedAt time.Time
+}
+
+// AuditChainVerification is the result of verifying the hash chain of the audit events
+type AuditChainVerification struct {
+	// Valid is false if an event was modified, removed or reordered
+	Valid bool
+	// Checked is the number of events verified
+	Checked int
+	// BrokenEventID is the first event whose hash or link to the previous event does not match
+	BrokenEventID string
+	Reason        string
+}
+
+// auditEventHashContent is the content of an audit event covered by its hash
+type auditEventHashContent struct {
+	Sequence  int64       `json:"sequence"`
+	ID        string      `json:"id"`
+	CreatedAt string      `json:"created_at"`
+	Actor     string      `json:"actor"`
+	RouteName string      `json:"route_name"`
+	Method    string      `json:"method"`
+	TargetID  string      `json:"target_id"`
+	Before    interface{} `json:"before"`
+	After     interface{} `json:"after"`
+	PrevHash  string      `json:"prev_hash"`
+}
+
+// ComputeHash returns the hex encoded SHA-256 hash of the event's content and the hash of the previous event.
+// The snapshots are hashed in their canonical form, as the database does not preserve the formatting of JSON.
+func (e *AuditEvent) ComputeHash() (string, error) {
+	before, err := canonicalJSON(e.Before)
+	if err != nil {
+		return "", fmt.Errorf("canonicalizing snapshot before event %q: %w", e.ID, err)
+	}
+	after, err := canonicalJSON(e.After)
+	if err != nil {
+		return "", fmt.Errorf("canonicalizing snapshot after event %q: %w", e.ID, err)
+	}
+	content, err := json.Marshal(auditEventHashContent{
+		Sequence:  e.Sequence,
+		ID:        e.ID,
+		CreatedAt: e.CreatedAt.UTC().Format(time.RFC3339Nano),
+		Actor:     e.Actor,
+		RouteName: e.RouteName,
+		Method:    e.Method,
+		TargetID:  e.TargetID,
+		Before:    before,
+		After:     after,
+		PrevHash:  e.PrevHash,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshalling audit event %q: %w", e.ID, err)
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// canonicalJSON decodes the JSON, so that marshalling it again sorts the object keys and removes insignificant
+// whitespace
+func canonicalJSON(j api.JSON) (interface{}, error) {
+	if len(j) == 0 || string(j) == "null" {
+		return nil, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(j))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("decoding json: %w", err)
+	}
+	return value, nil
+}
@@ -0,0 +1,60 @@
+package dbapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAuditEvent() *AuditEvent {
+	event := &AuditEvent{
+		Sequence:  2,
+		Actor:     "admin",
+		RouteName: "admin-billing",
+		Method:    "PATCH",
+		TargetID:  "central-1",
+		Before:    api.JSON(`{"name":"central","cloud_account_id":"","traits":["a"]}`),
+		After:     api.JSON(`{"name":"central","cloud_account_id":"1234","traits":["a"]}`),
+		PrevHash:  "prev",
+	}
+	event.ID = "event-1"
+	event.CreatedAt = time.Date(2026, 4, 15, 10, 0, 0, 123456000, time.UTC)
+	return event
+}
+
+func TestAuditEventComputeHash(t *testing.T) {
+	hash, err := newTestAuditEvent().ComputeHash()
+	require.NoError(t, err)
+	assert.Len(t, hash, 64)
+
+	t.Run("should not depend on the formatting of the snapshots and the time zone", func(t *testing.T) {
+		event := newTestAuditEvent()
+		event.Before = api.JSON(`{"traits": ["a"], "cloud_account_id": "", "name": "central"}`)
+		event.CreatedAt = event.CreatedAt.In(time.FixedZone("UTC+2", 2*60*60))
+		got, err := event.ComputeHash()
+		require.NoError(t, err)
+		assert.Equal(t, hash, got)
+	})
+
+	modifications := map[string]func(event *AuditEvent){
+		"actor":    func(event *AuditEvent) { event.Actor = "other" },
+		"sequence": func(event *AuditEvent) { event.Sequence = 3 },
+		"snapshot": func(event *AuditEvent) {
+			event.After = api.JSON(`{"name":"central","cloud_account_id":"5678","traits":["a"]}`)
+		},
+		"prev hash": func(event *AuditEvent) { event.PrevHash = "other" },
+		"time":      func(event *AuditEvent) { event.CreatedAt = event.CreatedAt.Add(time.Microsecond) },
+	}
+	for name, modify := range modifications {
+		t.Run("should change with the "+name, func(t *testing.T) {
+			event := newTestAuditEvent()
+			modify(event)
+			got, err := event.ComputeHash()
+			require.NoError(t, err)
+			assert.NotEqual(t, hash, got)
+		})
+	}
+}
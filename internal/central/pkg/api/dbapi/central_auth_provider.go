@@ -0,0 +1,35 @@
+package dbapi
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/gitops"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+)
+
+// CentralAuthProvider is an OIDC auth provider a customer added to a Central instance in addition to the auth
+// provider of the instance's RHSSO client
+type CentralAuthProvider struct {
+	api.Meta
+	CentralID string `json:"central_id" gorm:"uniqueIndex:idx_central_auth_providers_central_id_name"`
+	Name      string `json:"name" gorm:"uniqueIndex:idx_central_auth_providers_central_id_name"`
+	// Config is the gitops.AuthProvider of the provider without its client secret
+	Config api.JSON `json:"config"`
+	// EncryptedClientSecret is the client secret of the provider encrypted with the auth provider encryption key
+	EncryptedClientSecret []byte `json:"-"`
+	// Owner is the user who added the provider
+	Owner string `json:"owner"`
+}
+
+// AuthProvider returns the configuration of the provider without client secret
+func (p *CentralAuthProvider) AuthProvider() (gitops.AuthProvider, error) {
+	var authProvider gitops.AuthProvider
+	if err := json.Unmarshal(p.Config, &authProvider); err != nil {
+		return gitops.AuthProvider{}, errors.Wrapf(err, "unmarshalling auth provider %s", p.ID)
+	}
+	if authProvider.OIDC == nil {
+		authProvider.OIDC = &gitops.AuthProviderOIDCConfig{}
+	}
+	return authProvider, nil
+}
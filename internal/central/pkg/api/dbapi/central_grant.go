@@ -0,0 +1,45 @@
+package dbapi
+
+import "github.com/stackrox/acs-fleet-manager/pkg/api"
+
+// CentralRole is the role of a user for a Central instance of the public API
+type CentralRole string
+
+// Central roles in ascending order of their privileges. Each role includes the privileges of the roles before it.
+const (
+	// CentralRoleNone grants no access to the instance
+	CentralRoleNone CentralRole = ""
+	// CentralRoleViewer allows viewing the instance
+	CentralRoleViewer CentralRole = "viewer"
+	// CentralRoleEditor allows changing and deleting the instance
+	CentralRoleEditor CentralRole = "editor"
+	// CentralRoleOwner is the role of the user who created the instance and of the organisation admins
+	CentralRoleOwner CentralRole = "owner"
+)
+
+var centralRoleRanks = map[CentralRole]int{
+	CentralRoleNone:   0,
+	CentralRoleViewer: 1,
+	CentralRoleEditor: 2,
+	CentralRoleOwner:  3,
+}
+
+// IsValid returns true if the role is a known role other than CentralRoleNone
+func (r CentralRole) IsValid() bool {
+	_, ok := centralRoleRanks[r]
+	return ok && r != CentralRoleNone
+}
+
+// Includes returns true if the role has at least the privileges of the other role
+func (r CentralRole) Includes(other CentralRole) bool {
+	return centralRoleRanks[r] >= centralRoleRanks[other]
+}
+
+// CentralGrant grants a member of the instance's organisation a role for a Central instance in addition to the role
+// derived from the SSO claims
+type CentralGrant struct {
+	api.Meta
+	CentralID string      `json:"central_id" gorm:"uniqueIndex:idx_central_grants_central_id_username"`
+	Username  string      `json:"username" gorm:"uniqueIndex:idx_central_grants_central_id_username"`
+	Role      CentralRole `json:"role"`
+}
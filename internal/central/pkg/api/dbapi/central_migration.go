@@ -0,0 +1,139 @@
+package dbapi
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+)
+
+// CentralMigrationPhase is the phase of a Central tenant migration between dataplane clusters
+type CentralMigrationPhase string
+
+// Central migration phases. The tenant is moved through them in order. A phase which does not complete in time fails
+// the migration, which an admin can then roll back.
+const (
+	// CentralMigrationPhasePrepareTarget installs the tenant on the target cluster with Central scaled down. Secrets are
+	// restored from the backup, so that the target reattaches the managed DB of the source.
+	CentralMigrationPhasePrepareTarget CentralMigrationPhase = "prepare_target"
+	// CentralMigrationPhaseQuiesceSource scales down Central on the source cluster
+	CentralMigrationPhaseQuiesceSource CentralMigrationPhase = "quiesce_source"
+	// CentralMigrationPhaseSwitchDNS assigns the tenant to the target cluster and points its DNS records to it
+	CentralMigrationPhaseSwitchDNS CentralMigrationPhase = "switch_dns"
+	// CentralMigrationPhaseVerifyTarget scales up Central on the target cluster and waits for it to become ready
+	CentralMigrationPhaseVerifyTarget CentralMigrationPhase = "verify_target"
+	// CentralMigrationPhaseCleanupSource removes the tenant from the source cluster, keeping the managed DB
+	CentralMigrationPhaseCleanupSource CentralMigrationPhase = "cleanup_source"
+	// CentralMigrationPhaseCompleted is set once the tenant runs on the target cluster only
+	CentralMigrationPhaseCompleted CentralMigrationPhase = "completed"
+	// CentralMigrationPhaseFailed is set if a phase did not complete. The tenant is kept as it is until rolled back.
+	CentralMigrationPhaseFailed CentralMigrationPhase = "failed"
+	// CentralMigrationPhaseRollingBack points the DNS records back to the source cluster and removes the tenant from
+	// the target cluster, keeping the managed DB
+	CentralMigrationPhaseRollingBack CentralMigrationPhase = "rolling_back"
+	// CentralMigrationPhaseRolledBack is set once the tenant was removed from the target cluster. The source cluster
+	// scales up Central again.
+	CentralMigrationPhaseRolledBack CentralMigrationPhase = "rolled_back"
+)
+
+// ActiveCentralMigrationPhases are the phases of migrations whose tenant is installed on both clusters
+var ActiveCentralMigrationPhases = []CentralMigrationPhase{
+	CentralMigrationPhasePrepareTarget,
+	CentralMigrationPhaseQuiesceSource,
+	CentralMigrationPhaseSwitchDNS,
+	CentralMigrationPhaseVerifyTarget,
+	CentralMigrationPhaseCleanupSource,
+	CentralMigrationPhaseFailed,
+	CentralMigrationPhaseRollingBack,
+}
+
+// IsActive returns true if the migration has not reached a final phase
+func (p CentralMigrationPhase) IsActive() bool {
+	return p != CentralMigrationPhaseCompleted && p != CentralMigrationPhaseRolledBack
+}
+
+// CentralMigrationRole is the role of a dataplane cluster in a Central migration
+type CentralMigrationRole string
+
+// Central migration roles
+const (
+	CentralMigrationRoleSource CentralMigrationRole = "source"
+	CentralMigrationRoleTarget CentralMigrationRole = "target"
+)
+
+// CentralMigration moves a Central tenant from its source to a target dataplane cluster
+type CentralMigration struct {
+	api.Meta
+	CentralID       string                `json:"central_id" gorm:"index"`
+	SourceClusterID string                `json:"source_cluster_id"`
+	TargetClusterID string                `json:"target_cluster_id"`
+	Phase           CentralMigrationPhase `json:"phase" gorm:"index"`
+	PhaseStartedAt  time.Time             `json:"phase_started_at"`
+	// SourceCompleted and TargetCompleted are set once the cluster completed its part of the current phase
+	SourceCompleted bool `json:"source_completed"`
+	TargetCompleted bool `json:"target_completed"`
+	// FailedPhase is the phase which did not complete
+	FailedPhase  CentralMigrationPhase `json:"failed_phase"`
+	FailedReason string                `json:"failed_reason"`
+	// SourceRoutes are the routes of the tenant on the source cluster, which are restored by a rollback.
+	// TargetRoutes are reported by the target cluster once it is prepared.
+	SourceRoutes api.JSON `json:"source_routes"`
+	TargetRoutes api.JSON `json:"target_routes"`
+	// DNSChangeID is the ID of the pending change of the tenant's DNS records
+	DNSChangeID string       `json:"dns_change_id"`
+	Owner       string       `json:"owner"`
+	CompletedAt sql.NullTime `json:"completed_at"`
+}
+
+// DataPlanePhase returns the phase the dataplane clusters act on. Failed migrations keep the phase which failed, so
+// that the tenant stays as it is until it is rolled back.
+func (m *CentralMigration) DataPlanePhase() CentralMigrationPhase {
+	if m.Phase == CentralMigrationPhaseFailed {
+		return m.FailedPhase
+	}
+	return m.Phase
+}
+
+// RoleOf returns the role of the cluster in the migration
+func (m *CentralMigration) RoleOf(clusterID string) (CentralMigrationRole, bool) {
+	switch clusterID {
+	case m.SourceClusterID:
+		return CentralMigrationRoleSource, true
+	case m.TargetClusterID:
+		return CentralMigrationRoleTarget, true
+	}
+	return "", false
+}
+
+// GetSourceRoutes ...
+func (m *CentralMigration) GetSourceRoutes() ([]DataPlaneCentralRoute, error) {
+	return unmarshalRoutes(m.SourceRoutes)
+}
+
+// GetTargetRoutes ...
+func (m *CentralMigration) GetTargetRoutes() ([]DataPlaneCentralRoute, error) {
+	return unmarshalRoutes(m.TargetRoutes)
+}
+
+// SetTargetRoutes ...
+func (m *CentralMigration) SetTargetRoutes(routes []DataPlaneCentralRoute) error {
+	r, err := json.Marshal(routes)
+	if err != nil {
+		return fmt.Errorf("marshalling routes into JSON: %w", err)
+	}
+	m.TargetRoutes = r
+	return nil
+}
+
+func unmarshalRoutes(data api.JSON) ([]DataPlaneCentralRoute, error) {
+	var routes []DataPlaneCentralRoute
+	if data == nil {
+		return routes, nil
+	}
+	if err := json.Unmarshal(data, &routes); err != nil {
+		return nil, fmt.Errorf("unmarshalling routes from JSON: %w", err)
+	}
+	return routes, nil
+}
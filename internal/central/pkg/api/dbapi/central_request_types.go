@@ -92,6 +92,15 @@ type CentralRequest struct {
 
 	// All we need to integrate Central with an IdP.
 	AuthConfig
+	// ClientCreatedAt is the time the current OIDC client was created. It is not set for clients created before their
+	// creation was recorded, the age of these clients is taken from the Central's creation time.
+	ClientCreatedAt sql.NullTime `json:"client_created_at"`
+	// PreviousClientID is the dynamic OIDC client replaced by a scheduled rotation. It is deleted once the data plane
+	// applied the new client.
+	PreviousClientID string `json:"previous_client_id"`
+	// ClientRotationWindow is the daily time window in UTC in which the OIDC client is rotated, e.g. 02:00-06:00.
+	// The configured default window applies if it is empty.
+	ClientRotationWindow string `json:"client_rotation_window"`
 
 	// ExpiredAt contains the timestamp of when a Central instance's quota allowance was found to be expired.
 	// After a grace period, the Central instance will be marked for deletion, its status will be set to 'deprovision'.
@@ -105,6 +114,15 @@ type CentralRequest struct {
 	// Traits is a set of random strings assigned to an instance. Some traits
 	// can be hardcoded, and change some processing parameters.
 	Traits pq.StringArray `json:"traits" gorm:"type:text[]"`
+
+	// MigrationPhase is the phase of the instance's latest migration between dataplane clusters
+	MigrationPhase CentralMigrationPhase `json:"migration_phase"`
+
+	// DBUpgradeStatus is the phase of the latest engine upgrade of the Central's managed DB reported by the data plane,
+	// e.g. upgrading, completed or Failed. It is empty if no upgrade was reported.
+	DBUpgradeStatus string `json:"db_upgrade_status"`
+	// DBUpgradeMessage is the message reported with DBUpgradeStatus.
+	DBUpgradeMessage string `json:"db_upgrade_message"`
 }
 
 // CentralList ...
@@ -0,0 +1,37 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+)
+
+// CentralUsage is the resource usage of a Central tenant within one hour, as last reported by fleetshard-sync
+// during that hour
+type CentralUsage struct {
+	api.Meta
+	CentralID      string `json:"central_id" gorm:"uniqueIndex:idx_central_usages_central_period"`
+	OrganisationID string `json:"organisation_id" gorm:"index"`
+	ClusterID      string `json:"cluster_id"`
+	InstanceType   string `json:"instance_type"`
+	// PeriodStart is the start of the hour the usage was reported in
+	PeriodStart           time.Time `json:"period_start" gorm:"uniqueIndex:idx_central_usages_central_period;index:idx_central_usages_period_start"`
+	CPURequestsMillicores int64     `json:"cpu_requests_millicores"`
+	CPUUsageMillicores    int64     `json:"cpu_usage_millicores"`
+	MemoryRequestsBytes   int64     `json:"memory_requests_bytes"`
+	MemoryUsageBytes      int64     `json:"memory_usage_bytes"`
+	StorageBytes          int64     `json:"storage_bytes"`
+	DBMinCapacityACU      float32   `json:"db_min_capacity_acu"`
+	DBMaxCapacityACU      float32   `json:"db_max_capacity_acu"`
+}
+
+// DataPlaneCentralUsage is the resource usage of a Central tenant reported by the data plane
+type DataPlaneCentralUsage struct {
+	CPURequestsMillicores int64
+	CPUUsageMillicores    int64
+	MemoryRequestsBytes   int64
+	MemoryUsageBytes      int64
+	StorageBytes          int64
+	DBMinCapacityACU      float32
+	DBMaxCapacityACU      float32
+}
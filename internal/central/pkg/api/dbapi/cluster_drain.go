@@ -0,0 +1,65 @@
+package dbapi
+
+import (
+	"database/sql"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+)
+
+// ClusterDrainStatus is the state of a dataplane cluster drain
+type ClusterDrainStatus string
+
+// Cluster drain states. Running and paused drains are active, all other states are final.
+const (
+	ClusterDrainStatusRunning   ClusterDrainStatus = "running"
+	ClusterDrainStatusPaused    ClusterDrainStatus = "paused"
+	ClusterDrainStatusCancelled ClusterDrainStatus = "cancelled"
+	ClusterDrainStatusCompleted ClusterDrainStatus = "completed"
+	ClusterDrainStatusFailed    ClusterDrainStatus = "failed"
+)
+
+// ActiveClusterDrainStatuses are the states of drains that still keep their cluster unschedulable
+var ActiveClusterDrainStatuses = []ClusterDrainStatus{ClusterDrainStatusRunning, ClusterDrainStatusPaused}
+
+// IsActive returns true if the drain has not reached a final state
+func (s ClusterDrainStatus) IsActive() bool {
+	return s == ClusterDrainStatusRunning || s == ClusterDrainStatusPaused
+}
+
+// ClusterDrainCentralStatus is the state of a single Central tenant being moved off a drained cluster
+type ClusterDrainCentralStatus string
+
+// Cluster drain tenant states
+const (
+	// ClusterDrainCentralStatusMigrating is set once the tenant was assigned to its target cluster
+	ClusterDrainCentralStatusMigrating ClusterDrainCentralStatus = "migrating"
+	// ClusterDrainCentralStatusMigrated is set once the tenant is ready on its target cluster
+	ClusterDrainCentralStatusMigrated ClusterDrainCentralStatus = "migrated"
+	// ClusterDrainCentralStatusFailed is set if the tenant could not be moved. It is not retried by the drain.
+	ClusterDrainCentralStatusFailed ClusterDrainCentralStatus = "failed"
+	// ClusterDrainCentralStatusDeleted is set if the tenant was deleted while being moved
+	ClusterDrainCentralStatusDeleted ClusterDrainCentralStatus = "deleted"
+)
+
+// ClusterDrain moves all Central tenants off a dataplane cluster
+type ClusterDrain struct {
+	api.Meta
+	ClusterID string             `json:"cluster_id" gorm:"index"`
+	Status    ClusterDrainStatus `json:"status" gorm:"index"`
+	// Concurrency is the maximum number of tenants moved at the same time
+	Concurrency int          `json:"concurrency"`
+	Owner       string       `json:"owner"`
+	CompletedAt sql.NullTime `json:"completed_at"`
+	// Centrals are the tenants the drain started moving
+	Centrals []ClusterDrainCentral `json:"centrals" gorm:"foreignKey:DrainID"`
+}
+
+// ClusterDrainCentral tracks a Central tenant moved by a cluster drain
+type ClusterDrainCentral struct {
+	api.Meta
+	DrainID         string                    `json:"drain_id" gorm:"index"`
+	CentralID       string                    `json:"central_id"`
+	TargetClusterID string                    `json:"target_cluster_id"`
+	Status          ClusterDrainCentralStatus `json:"status"`
+	Reason          string                    `json:"reason"`
+}
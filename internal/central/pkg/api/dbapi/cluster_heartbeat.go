@@ -0,0 +1,20 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+)
+
+// ClusterHeartbeat is the last contact of fleetshard-sync on a dataplane cluster with fleet manager. Every request
+// of fleetshard-sync to list the Centrals of its cluster or to report their status is recorded as heartbeat.
+type ClusterHeartbeat struct {
+	api.Meta
+	ClusterID         string `json:"cluster_id" gorm:"uniqueIndex"`
+	FleetshardVersion string `json:"fleetshard_version"`
+	// ReconcileErrors is the number of Central reconciliations that failed in the last reconcile round of fleetshard-sync
+	ReconcileErrors int       `json:"reconcile_errors"`
+	LastSeenAt      time.Time `json:"last_seen_at"`
+	// UnscheduledAsSilent is set while the cluster is unschedulable because fleetshard-sync went silent
+	UnscheduledAsSilent bool `json:"unscheduled_as_silent"`
+}
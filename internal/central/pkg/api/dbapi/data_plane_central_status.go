@@ -14,6 +14,12 @@ type DataPlaneCentralStatus struct {
 	SecretDataSha256Sum    string
 	CentralVersion         string
 	CentralOperatorVersion string
+	// Usage is nil if the data plane did not report resource usage
+	Usage *DataPlaneCentralUsage
+	// MigrationPhaseCompleted is the phase of the Central's migration the cluster completed its part of
+	MigrationPhaseCompleted CentralMigrationPhase
+	// AuthClientID is the OIDC client the cluster applied to the auth provider of the Central
+	AuthClientID string
 }
 
 // DataPlaneCentralStatusCondition ...
@@ -30,10 +36,22 @@ type DataPlaneCentralRoute struct {
 	Router string
 }
 
+// DatabaseUpgradeConditionType is the condition reported while the engine of a Central's managed DB is upgraded.
+const DatabaseUpgradeConditionType = "DatabaseUpgrade"
+
 // GetReadyCondition ...
 func (d *DataPlaneCentralStatus) GetReadyCondition() (DataPlaneCentralStatusCondition, bool) {
+	return d.getCondition("Ready")
+}
+
+// GetDatabaseUpgradeCondition returns the condition of the latest engine upgrade of the Central's managed DB.
+func (d *DataPlaneCentralStatus) GetDatabaseUpgradeCondition() (DataPlaneCentralStatusCondition, bool) {
+	return d.getCondition(DatabaseUpgradeConditionType)
+}
+
+func (d *DataPlaneCentralStatus) getCondition(conditionType string) (DataPlaneCentralStatusCondition, bool) {
 	for _, c := range d.Conditions {
-		if strings.EqualFold(c.Type, "Ready") {
+		if strings.EqualFold(c.Type, conditionType) {
 			return c, true
 		}
 	}
@@ -19,5 +19,10 @@ type DataPlaneCentralStatus struct {
 	// Map of Secrets created for a Central
 	Secrets map[string]string `json:"secrets,omitempty"`
 	// Hash of plain text secret data used for equality check
-	SecretDataSha256Sum string `json:"secretDataSha256Sum,omitempty"`
+	SecretDataSha256Sum string                      `json:"secretDataSha256Sum,omitempty"`
+	Usage               DataPlaneCentralStatusUsage `json:"usage,omitempty"`
+	// The migration phase the data plane cluster completed its part of
+	MigrationPhaseCompleted string `json:"migrationPhaseCompleted,omitempty"`
+	// The OIDC client ID the data plane cluster applied to the auth provider of the Central
+	AuthClientId string `json:"authClientId,omitempty"`
 }
@@ -19,5 +19,8 @@ type ManagedCentralAllOfSpec struct {
 	// Handles GUI/CLI/API connections
 	UiHost string `json:"uiHost,omitempty"`
 	// Handles Sensor connections
-	DataHost string `json:"dataHost,omitempty"`
+	DataHost  string                           `json:"dataHost,omitempty"`
+	Migration ManagedCentralAllOfSpecMigration `json:"migration,omitempty"`
+	// OIDC auth providers the customer added to the Central
+	AuthProviders []AuthProvider `json:"authProviders,omitempty"`
 }
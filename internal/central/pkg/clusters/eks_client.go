@@ -0,0 +1,52 @@
+package clusters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+)
+
+// EKSClient is the subset of the AWS EKS API used by the EKS cluster provider
+//
+//go:generate moq -out eks_client_moq.go . EKSClient
+type EKSClient interface {
+	CreateCluster(ctx context.Context, params *eks.CreateClusterInput, optFns ...func(*eks.Options)) (*eks.CreateClusterOutput, error)
+	DescribeCluster(ctx context.Context, params *eks.DescribeClusterInput, optFns ...func(*eks.Options)) (*eks.DescribeClusterOutput, error)
+	DeleteCluster(ctx context.Context, params *eks.DeleteClusterInput, optFns ...func(*eks.Options)) (*eks.DeleteClusterOutput, error)
+	ListClusters(ctx context.Context, params *eks.ListClustersInput, optFns ...func(*eks.Options)) (*eks.ListClustersOutput, error)
+	CreateNodegroup(ctx context.Context, params *eks.CreateNodegroupInput, optFns ...func(*eks.Options)) (*eks.CreateNodegroupOutput, error)
+	DescribeNodegroup(ctx context.Context, params *eks.DescribeNodegroupInput, optFns ...func(*eks.Options)) (*eks.DescribeNodegroupOutput, error)
+	DeleteNodegroup(ctx context.Context, params *eks.DeleteNodegroupInput, optFns ...func(*eks.Options)) (*eks.DeleteNodegroupOutput, error)
+	ListNodegroups(ctx context.Context, params *eks.ListNodegroupsInput, optFns ...func(*eks.Options)) (*eks.ListNodegroupsOutput, error)
+}
+
+var _ EKSClient = &eks.Client{}
+
+// EKSClientFactory returns an EKSClient for the given AWS region
+type EKSClientFactory func(region string) (EKSClient, error)
+
+// NewEKSClientFactory returns an EKSClientFactory authenticating with the AWS credentials of the fleet manager
+func NewEKSClientFactory(awsConfig *config.AWSConfig) EKSClientFactory {
+	return func(region string) (EKSClient, error) {
+		credentialsCache := aws.NewCredentialsCache(credentials.NewStaticCredentialsProvider(
+			awsConfig.AccessKey,
+			awsConfig.SecretAccessKey,
+			""))
+
+		if _, err := credentialsCache.Retrieve(context.Background()); err != nil {
+			return nil, errors.Wrap(err, "retrieving AWS credentials")
+		}
+
+		cfg := aws.Config{
+			Credentials: credentialsCache,
+			Region:      region,
+			Retryer:     func() aws.Retryer { return retry.AddWithMaxAttempts(retry.NewStandard(), 2) },
+		}
+		return eks.NewFromConfig(cfg), nil
+	}
+}
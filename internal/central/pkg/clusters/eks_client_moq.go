@@ -0,0 +1,480 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package clusters
+
+import (
+	"context"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"sync"
+)
+
+// Ensure, that EKSClientMock does implement EKSClient.
+// If this is not the case, regenerate this file with moq.
+var _ EKSClient = &EKSClientMock{}
+
+// EKSClientMock is a mock implementation of EKSClient.
+//
+//	func TestSomethingThatUsesEKSClient(t *testing.T) {
+//
+//		// make and configure a mocked EKSClient
+//		mockedEKSClient := &EKSClientMock{
+//			CreateClusterFunc: func(ctx context.Context, params *eks.CreateClusterInput, optFns ...func(*eks.Options)) (*eks.CreateClusterOutput, error) {
+//				panic("mock out the CreateCluster method")
+//			},
+//			CreateNodegroupFunc: func(ctx context.Context, params *eks.CreateNodegroupInput, optFns ...func(*eks.Options)) (*eks.CreateNodegroupOutput, error) {
+//				panic("mock out the CreateNodegroup method")
+//			},
+//			DeleteClusterFunc: func(ctx context.Context, params *eks.DeleteClusterInput, optFns ...func(*eks.Options)) (*eks.DeleteClusterOutput, error) {
+//				panic("mock out the DeleteCluster method")
+//			},
+//			DeleteNodegroupFunc: func(ctx context.Context, params *eks.DeleteNodegroupInput, optFns ...func(*eks.Options)) (*eks.DeleteNodegroupOutput, error) {
+//				panic("mock out the DeleteNodegroup method")
+//			},
+//			DescribeClusterFunc: func(ctx context.Context, params *eks.DescribeClusterInput, optFns ...func(*eks.Options)) (*eks.DescribeClusterOutput, error) {
+//				panic("mock out the DescribeCluster method")
+//			},
+//			DescribeNodegroupFunc: func(ctx context.Context, params *eks.DescribeNodegroupInput, optFns ...func(*eks.Options)) (*eks.DescribeNodegroupOutput, error) {
+//				panic("mock out the DescribeNodegroup method")
+//			},
+//			ListClustersFunc: func(ctx context.Context, params *eks.ListClustersInput, optFns ...func(*eks.Options)) (*eks.ListClustersOutput, error) {
+//				panic("mock out the ListClusters method")
+//			},
+//			ListNodegroupsFunc: func(ctx context.Context, params *eks.ListNodegroupsInput, optFns ...func(*eks.Options)) (*eks.ListNodegroupsOutput, error) {
+//				panic("mock out the ListNodegroups method")
+//			},
+//		}
+//
+//		// use mockedEKSClient in code that requires EKSClient
+//		// and then make assertions.
+//
+//	}
+type EKSClientMock struct {
+	// CreateClusterFunc mocks the CreateCluster method.
+	CreateClusterFunc func(ctx context.Context, params *eks.CreateClusterInput, optFns ...func(*eks.Options)) (*eks.CreateClusterOutput, error)
+
+	// CreateNodegroupFunc mocks the CreateNodegroup method.
+	CreateNodegroupFunc func(ctx context.Context, params *eks.CreateNodegroupInput, optFns ...func(*eks.Options)) (*eks.CreateNodegroupOutput, error)
+
+	// DeleteClusterFunc mocks the DeleteCluster method.
+	DeleteClusterFunc func(ctx context.Context, params *eks.DeleteClusterInput, optFns ...func(*eks.Options)) (*eks.DeleteClusterOutput, error)
+
+	// DeleteNodegroupFunc mocks the DeleteNodegroup method.
+	DeleteNodegroupFunc func(ctx context.Context, params *eks.DeleteNodegroupInput, optFns ...func(*eks.Options)) (*eks.DeleteNodegroupOutput, error)
+
+	// DescribeClusterFunc mocks the DescribeCluster method.
+	DescribeClusterFunc func(ctx context.Context, params *eks.DescribeClusterInput, optFns ...func(*eks.Options)) (*eks.DescribeClusterOutput, error)
+
+	// DescribeNodegroupFunc mocks the DescribeNodegroup method.
+	DescribeNodegroupFunc func(ctx context.Context, params *eks.DescribeNodegroupInput, optFns ...func(*eks.Options)) (*eks.DescribeNodegroupOutput, error)
+
+	// ListClustersFunc mocks the ListClusters method.
+	ListClustersFunc func(ctx context.Context, params *eks.ListClustersInput, optFns ...func(*eks.Options)) (*eks.ListClustersOutput, error)
+
+	// ListNodegroupsFunc mocks the ListNodegroups method.
+	ListNodegroupsFunc func(ctx context.Context, params *eks.ListNodegroupsInput, optFns ...func(*eks.Options)) (*eks.ListNodegroupsOutput, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateCluster holds details about calls to the CreateCluster method.
+		CreateCluster []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Params is the params argument value.
+			Params *eks.CreateClusterInput
+			// OptFns is the optFns argument value.
+			OptFns []func(*eks.Options)
+		}
+		// CreateNodegroup holds details about calls to the CreateNodegroup method.
+		CreateNodegroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Params is the params argument value.
+			Params *eks.CreateNodegroupInput
+			// OptFns is the optFns argument value.
+			OptFns []func(*eks.Options)
+		}
+		// DeleteCluster holds details about calls to the DeleteCluster method.
+		DeleteCluster []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Params is the params argument value.
+			Params *eks.DeleteClusterInput
+			// OptFns is the optFns argument value.
+			OptFns []func(*eks.Options)
+		}
+		// DeleteNodegroup holds details about calls to the DeleteNodegroup method.
+		DeleteNodegroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Params is the params argument value.
+			Params *eks.DeleteNodegroupInput
+			// OptFns is the optFns argument value.
+			OptFns []func(*eks.Options)
+		}
+		// DescribeCluster holds details about calls to the DescribeCluster method.
+		DescribeCluster []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Params is the params argument value.
+			Params *eks.DescribeClusterInput
+			// OptFns is the optFns argument value.
+			OptFns []func(*eks.Options)
+		}
+		// DescribeNodegroup holds details about calls to the DescribeNodegroup method.
+		DescribeNodegroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Params is the params argument value.
+			Params *eks.DescribeNodegroupInput
+			// OptFns is the optFns argument value.
+			OptFns []func(*eks.Options)
+		}
+		// ListClusters holds details about calls to the ListClusters method.
+		ListClusters []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Params is the params argument value.
+			Params *eks.ListClustersInput
+			// OptFns is the optFns argument value.
+			OptFns []func(*eks.Options)
+		}
+		// ListNodegroups holds details about calls to the ListNodegroups method.
+		ListNodegroups []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Params is the params argument value.
+			Params *eks.ListNodegroupsInput
+			// OptFns is the optFns argument value.
+			OptFns []func(*eks.Options)
+		}
+	}
+	lockCreateCluster     sync.RWMutex
+	lockCreateNodegroup   sync.RWMutex
+	lockDeleteCluster     sync.RWMutex
+	lockDeleteNodegroup   sync.RWMutex
+	lockDescribeCluster   sync.RWMutex
+	lockDescribeNodegroup sync.RWMutex
+	lockListClusters      sync.RWMutex
+	lockListNodegroups    sync.RWMutex
+}
+
+// CreateCluster calls CreateClusterFunc.
+func (mock *EKSClientMock) CreateCluster(ctx context.Context, params *eks.CreateClusterInput, optFns ...func(*eks.Options)) (*eks.CreateClusterOutput, error) {
+	if mock.CreateClusterFunc == nil {
+		panic("EKSClientMock.CreateClusterFunc: method is nil but EKSClient.CreateCluster was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Params *eks.CreateClusterInput
+		OptFns []func(*eks.Options)
+	}{
+		Ctx:    ctx,
+		Params: params,
+		OptFns: optFns,
+	}
+	mock.lockCreateCluster.Lock()
+	mock.calls.CreateCluster = append(mock.calls.CreateCluster, callInfo)
+	mock.lockCreateCluster.Unlock()
+	return mock.CreateClusterFunc(ctx, params, optFns...)
+}
+
+// CreateClusterCalls gets all the calls that were made to CreateCluster.
+// Check the length with:
+//
+//	len(mockedEKSClient.CreateClusterCalls())
+func (mock *EKSClientMock) CreateClusterCalls() []struct {
+	Ctx    context.Context
+	Params *eks.CreateClusterInput
+	OptFns []func(*eks.Options)
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Params *eks.CreateClusterInput
+		OptFns []func(*eks.Options)
+	}
+	mock.lockCreateCluster.RLock()
+	calls = mock.calls.CreateCluster
+	mock.lockCreateCluster.RUnlock()
+	return calls
+}
+
+// CreateNodegroup calls CreateNodegroupFunc.
+func (mock *EKSClientMock) CreateNodegroup(ctx context.Context, params *eks.CreateNodegroupInput, optFns ...func(*eks.Options)) (*eks.CreateNodegroupOutput, error) {
+	if mock.CreateNodegroupFunc == nil {
+		panic("EKSClientMock.CreateNodegroupFunc: method is nil but EKSClient.CreateNodegroup was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Params *eks.CreateNodegroupInput
+		OptFns []func(*eks.Options)
+	}{
+		Ctx:    ctx,
+		Params: params,
+		OptFns: optFns,
+	}
+	mock.lockCreateNodegroup.Lock()
+	mock.calls.CreateNodegroup = append(mock.calls.CreateNodegroup, callInfo)
+	mock.lockCreateNodegroup.Unlock()
+	return mock.CreateNodegroupFunc(ctx, params, optFns...)
+}
+
+// CreateNodegroupCalls gets all the calls that were made to CreateNodegroup.
+// Check the length with:
+//
+//	len(mockedEKSClient.CreateNodegroupCalls())
+func (mock *EKSClientMock) CreateNodegroupCalls() []struct {
+	Ctx    context.Context
+	Params *eks.CreateNodegroupInput
+	OptFns []func(*eks.Options)
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Params *eks.CreateNodegroupInput
+		OptFns []func(*eks.Options)
+	}
+	mock.lockCreateNodegroup.RLock()
+	calls = mock.calls.CreateNodegroup
+	mock.lockCreateNodegroup.RUnlock()
+	return calls
+}
+
+// DeleteCluster calls DeleteClusterFunc.
+func (mock *EKSClientMock) DeleteCluster(ctx context.Context, params *eks.DeleteClusterInput, optFns ...func(*eks.Options)) (*eks.DeleteClusterOutput, error) {
+	if mock.DeleteClusterFunc == nil {
+		panic("EKSClientMock.DeleteClusterFunc: method is nil but EKSClient.DeleteCluster was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Params *eks.DeleteClusterInput
+		OptFns []func(*eks.Options)
+	}{
+		Ctx:    ctx,
+		Params: params,
+		OptFns: optFns,
+	}
+	mock.lockDeleteCluster.Lock()
+	mock.calls.DeleteCluster = append(mock.calls.DeleteCluster, callInfo)
+	mock.lockDeleteCluster.Unlock()
+	return mock.DeleteClusterFunc(ctx, params, optFns...)
+}
+
+// DeleteClusterCalls gets all the calls that were made to DeleteCluster.
+// Check the length with:
+//
+//	len(mockedEKSClient.DeleteClusterCalls())
+func (mock *EKSClientMock) DeleteClusterCalls() []struct {
+	Ctx    context.Context
+	Params *eks.DeleteClusterInput
+	OptFns []func(*eks.Options)
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Params *eks.DeleteClusterInput
+		OptFns []func(*eks.Options)
+	}
+	mock.lockDeleteCluster.RLock()
+	calls = mock.calls.DeleteCluster
+	mock.lockDeleteCluster.RUnlock()
+	return calls
+}
+
+// DeleteNodegroup calls DeleteNodegroupFunc.
+func (mock *EKSClientMock) DeleteNodegroup(ctx context.Context, params *eks.DeleteNodegroupInput, optFns ...func(*eks.Options)) (*eks.DeleteNodegroupOutput, error) {
+	if mock.DeleteNodegroupFunc == nil {
+		panic("EKSClientMock.DeleteNodegroupFunc: method is nil but EKSClient.DeleteNodegroup was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Params *eks.DeleteNodegroupInput
+		OptFns []func(*eks.Options)
+	}{
+		Ctx:    ctx,
+		Params: params,
+		OptFns: optFns,
+	}
+	mock.lockDeleteNodegroup.Lock()
+	mock.calls.DeleteNodegroup = append(mock.calls.DeleteNodegroup, callInfo)
+	mock.lockDeleteNodegroup.Unlock()
+	return mock.DeleteNodegroupFunc(ctx, params, optFns...)
+}
+
+// DeleteNodegroupCalls gets all the calls that were made to DeleteNodegroup.
+// Check the length with:
+//
+//	len(mockedEKSClient.DeleteNodegroupCalls())
+func (mock *EKSClientMock) DeleteNodegroupCalls() []struct {
+	Ctx    context.Context
+	Params *eks.DeleteNodegroupInput
+	OptFns []func(*eks.Options)
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Params *eks.DeleteNodegroupInput
+		OptFns []func(*eks.Options)
+	}
+	mock.lockDeleteNodegroup.RLock()
+	calls = mock.calls.DeleteNodegroup
+	mock.lockDeleteNodegroup.RUnlock()
+	return calls
+}
+
+// DescribeCluster calls DescribeClusterFunc.
+func (mock *EKSClientMock) DescribeCluster(ctx context.Context, params *eks.DescribeClusterInput, optFns ...func(*eks.Options)) (*eks.DescribeClusterOutput, error) {
+	if mock.DescribeClusterFunc == nil {
+		panic("EKSClientMock.DescribeClusterFunc: method is nil but EKSClient.DescribeCluster was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Params *eks.DescribeClusterInput
+		OptFns []func(*eks.Options)
+	}{
+		Ctx:    ctx,
+		Params: params,
+		OptFns: optFns,
+	}
+	mock.lockDescribeCluster.Lock()
+	mock.calls.DescribeCluster = append(mock.calls.DescribeCluster, callInfo)
+	mock.lockDescribeCluster.Unlock()
+	return mock.DescribeClusterFunc(ctx, params, optFns...)
+}
+
+// DescribeClusterCalls gets all the calls that were made to DescribeCluster.
+// Check the length with:
+//
+//	len(mockedEKSClient.DescribeClusterCalls())
+func (mock *EKSClientMock) DescribeClusterCalls() []struct {
+	Ctx    context.Context
+	Params *eks.DescribeClusterInput
+	OptFns []func(*eks.Options)
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Params *eks.DescribeClusterInput
+		OptFns []func(*eks.Options)
+	}
+	mock.lockDescribeCluster.RLock()
+	calls = mock.calls.DescribeCluster
+	mock.lockDescribeCluster.RUnlock()
+	return calls
+}
+
+// DescribeNodegroup calls DescribeNodegroupFunc.
+func (mock *EKSClientMock) DescribeNodegroup(ctx context.Context, params *eks.DescribeNodegroupInput, optFns ...func(*eks.Options)) (*eks.DescribeNodegroupOutput, error) {
+	if mock.DescribeNodegroupFunc == nil {
+		panic("EKSClientMock.DescribeNodegroupFunc: method is nil but EKSClient.DescribeNodegroup was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Params *eks.DescribeNodegroupInput
+		OptFns []func(*eks.Options)
+	}{
+		Ctx:    ctx,
+		Params: params,
+		OptFns: optFns,
+	}
+	mock.lockDescribeNodegroup.Lock()
+	mock.calls.DescribeNodegroup = append(mock.calls.DescribeNodegroup, callInfo)
+	mock.lockDescribeNodegroup.Unlock()
+	return mock.DescribeNodegroupFunc(ctx, params, optFns...)
+}
+
+// DescribeNodegroupCalls gets all the calls that were made to DescribeNodegroup.
+// Check the length with:
+//
+//	len(mockedEKSClient.DescribeNodegroupCalls())
+func (mock *EKSClientMock) DescribeNodegroupCalls() []struct {
+	Ctx    context.Context
+	Params *eks.DescribeNodegroupInput
+	OptFns []func(*eks.Options)
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Params *eks.DescribeNodegroupInput
+		OptFns []func(*eks.Options)
+	}
+	mock.lockDescribeNodegroup.RLock()
+	calls = mock.calls.DescribeNodegroup
+	mock.lockDescribeNodegroup.RUnlock()
+	return calls
+}
+
+// ListClusters calls ListClustersFunc.
+func (mock *EKSClientMock) ListClusters(ctx context.Context, params *eks.ListClustersInput, optFns ...func(*eks.Options)) (*eks.ListClustersOutput, error) {
+	if mock.ListClustersFunc == nil {
+		panic("EKSClientMock.ListClustersFunc: method is nil but EKSClient.ListClusters was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Params *eks.ListClustersInput
+		OptFns []func(*eks.Options)
+	}{
+		Ctx:    ctx,
+		Params: params,
+		OptFns: optFns,
+	}
+	mock.lockListClusters.Lock()
+	mock.calls.ListClusters = append(mock.calls.ListClusters, callInfo)
+	mock.lockListClusters.Unlock()
+	return mock.ListClustersFunc(ctx, params, optFns...)
+}
+
+// ListClustersCalls gets all the calls that were made to ListClusters.
+// Check the length with:
+//
+//	len(mockedEKSClient.ListClustersCalls())
+func (mock *EKSClientMock) ListClustersCalls() []struct {
+	Ctx    context.Context
+	Params *eks.ListClustersInput
+	OptFns []func(*eks.Options)
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Params *eks.ListClustersInput
+		OptFns []func(*eks.Options)
+	}
+	mock.lockListClusters.RLock()
+	calls = mock.calls.ListClusters
+	mock.lockListClusters.RUnlock()
+	return calls
+}
+
+// ListNodegroups calls ListNodegroupsFunc.
+func (mock *EKSClientMock) ListNodegroups(ctx context.Context, params *eks.ListNodegroupsInput, optFns ...func(*eks.Options)) (*eks.ListNodegroupsOutput, error) {
+	if mock.ListNodegroupsFunc == nil {
+		panic("EKSClientMock.ListNodegroupsFunc: method is nil but EKSClient.ListNodegroups was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Params *eks.ListNodegroupsInput
+		OptFns []func(*eks.Options)
+	}{
+		Ctx:    ctx,
+		Params: params,
+		OptFns: optFns,
+	}
+	mock.lockListNodegroups.Lock()
+	mock.calls.ListNodegroups = append(mock.calls.ListNodegroups, callInfo)
+	mock.lockListNodegroups.Unlock()
+	return mock.ListNodegroupsFunc(ctx, params, optFns...)
+}
+
+// ListNodegroupsCalls gets all the calls that were made to ListNodegroups.
+// Check the length with:
+//
+//	len(mockedEKSClient.ListNodegroupsCalls())
+func (mock *EKSClientMock) ListNodegroupsCalls() []struct {
+	Ctx    context.Context
+	Params *eks.ListNodegroupsInput
+	OptFns []func(*eks.Options)
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Params *eks.ListNodegroupsInput
+		OptFns []func(*eks.Options)
+	}
+	mock.lockListNodegroups.RLock()
+	calls = mock.calls.ListNodegroups
+	mock.lockListNodegroups.RUnlock()
+	return calls
+}
@@ -0,0 +1,375 @@
+package clusters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	eksTypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/clusters/types"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/wellknown"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+)
+
+const (
+	eksCloudProviderID          = "aws"
+	eksCloudProviderDisplayName = "Amazon Web Services"
+	eksClusterNamePrefix        = "rhacs-"
+	eksDefaultNodegroupName     = "rhacs-workers"
+)
+
+// EKSProviderSpec is the provider-specific configuration of an EKS cluster. It is read from the ProviderSpec of
+// the cluster.
+type EKSProviderSpec struct {
+	// ClusterName is the name of the EKS cluster. It is only used if the cluster was not registered with a
+	// predefined cluster ID, which is used as name otherwise. A name is generated if neither is set.
+	ClusterName string `json:"cluster_name,omitempty"`
+	// KubernetesVersion is the Kubernetes version of the control plane. The EKS default is used if it is not set.
+	KubernetesVersion string `json:"kubernetes_version,omitempty"`
+	// RoleARN is the IAM role that allows the EKS control plane to manage AWS resources
+	RoleARN string `json:"role_arn"`
+	// SubnetIDs are the subnets of the control plane and the worker nodes
+	SubnetIDs []string `json:"subnet_ids"`
+	// SecurityGroupIDs are additional security groups of the control plane network interfaces
+	SecurityGroupIDs []string `json:"security_group_ids,omitempty"`
+	// IngressDomain is the wildcard domain served by the ingress controller of the cluster. EKS does not provide
+	// one, so it has to be configured to resolve Central hosts.
+	IngressDomain string `json:"ingress_domain"`
+	// Tags are added to the cluster and the node group
+	Tags map[string]string `json:"tags,omitempty"`
+	// Nodegroup is the managed node group running the tenants
+	Nodegroup EKSNodegroupSpec `json:"nodegroup"`
+}
+
+// EKSNodegroupSpec is the configuration of the managed node group of an EKS cluster
+type EKSNodegroupSpec struct {
+	Name          string   `json:"name,omitempty"`
+	NodeRoleARN   string   `json:"node_role_arn"`
+	InstanceTypes []string `json:"instance_types,omitempty"`
+	MinSize       int32    `json:"min_size"`
+	MaxSize       int32    `json:"max_size"`
+	DesiredSize   int32    `json:"desired_size"`
+}
+
+// eksClusterInfo is stored as AdditionalInfo of the cluster spec, because the region and the node group settings
+// are not passed to the provider functions called after Create
+type eksClusterInfo struct {
+	Region string          `json:"region"`
+	Spec   EKSProviderSpec `json:"spec"`
+}
+
+// EKSProvider provisions data plane clusters as AWS EKS clusters with a single managed node group
+type EKSProvider struct {
+	clientFactory  EKSClientFactory
+	providerConfig *config.ProviderConfig
+}
+
+var _ Provider = &EKSProvider{}
+
+func newEKSProvider(clientFactory EKSClientFactory, providerConfig *config.ProviderConfig) *EKSProvider {
+	return &EKSProvider{
+		clientFactory:  clientFactory,
+		providerConfig: providerConfig,
+	}
+}
+
+// Create ...
+func (p *EKSProvider) Create(request *types.ClusterRequest) (*types.ClusterSpec, error) {
+	spec, err := parseEKSProviderSpec(request.AdditionalSpec)
+	if err != nil {
+		return nil, err
+	}
+	if request.ClusterID != "" {
+		spec.ClusterName = request.ClusterID
+	} else if spec.ClusterName == "" {
+		spec.ClusterName = eksClusterNamePrefix + api.NewID()
+	}
+	if spec.Nodegroup.Name == "" {
+		spec.Nodegroup.Name = eksDefaultNodegroupName
+	}
+
+	client, err := p.clientFactory(request.Region)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create EKS client for region %s", request.Region)
+	}
+
+	input := &eks.CreateClusterInput{
+		Name:    aws.String(spec.ClusterName),
+		RoleArn: aws.String(spec.RoleARN),
+		ResourcesVpcConfig: &eksTypes.VpcConfigRequest{
+			SubnetIds:        spec.SubnetIDs,
+			SecurityGroupIds: spec.SecurityGroupIDs,
+		},
+		Tags: spec.Tags,
+	}
+	if spec.KubernetesVersion != "" {
+		input.Version = aws.String(spec.KubernetesVersion)
+	}
+	if _, err := client.CreateCluster(context.Background(), input); err != nil {
+		var inUse *eksTypes.ResourceInUseException
+		if !errors.As(err, &inUse) {
+			return nil, errors.Wrapf(err, "failed to create EKS cluster %s", spec.ClusterName)
+		}
+		glog.Infof("EKS cluster %s already exists", spec.ClusterName)
+	}
+
+	info, err := json.Marshal(eksClusterInfo{Region: request.Region, Spec: spec})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal EKS cluster info")
+	}
+	return &types.ClusterSpec{
+		InternalID:     spec.ClusterName,
+		Status:         api.ClusterProvisioning,
+		AdditionalInfo: info,
+	}, nil
+}
+
+// CheckClusterStatus creates the node group once the control plane is active. The cluster is provisioned when
+// the node group is active.
+func (p *EKSProvider) CheckClusterStatus(spec *types.ClusterSpec) (*types.ClusterSpec, error) {
+	info, client, err := p.clusterInfoAndClient(spec)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	if spec.Status == "" {
+		spec.Status = api.ClusterProvisioning
+	}
+
+	out, err := client.DescribeCluster(ctx, &eks.DescribeClusterInput{Name: aws.String(spec.InternalID)})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe EKS cluster %s", spec.InternalID)
+	}
+	cluster := out.Cluster
+	switch cluster.Status {
+	case eksTypes.ClusterStatusFailed:
+		spec.Status = api.ClusterFailed
+		spec.StatusDetails = fmt.Sprintf("EKS cluster %s failed", spec.InternalID)
+		return spec, nil
+	case eksTypes.ClusterStatusActive:
+	default:
+		return spec, nil
+	}
+	if spec.ExternalID == "" {
+		spec.ExternalID = aws.ToString(cluster.Arn)
+	}
+
+	nodegroupName := info.Spec.Nodegroup.Name
+	nodegroupOut, err := client.DescribeNodegroup(ctx, &eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(spec.InternalID),
+		NodegroupName: aws.String(nodegroupName),
+	})
+	if err != nil {
+		var notFound *eksTypes.ResourceNotFoundException
+		if !errors.As(err, &notFound) {
+			return nil, errors.Wrapf(err, "failed to describe node group %s of EKS cluster %s", nodegroupName, spec.InternalID)
+		}
+		if err := p.createNodegroup(ctx, client, spec.InternalID, info.Spec); err != nil {
+			return nil, err
+		}
+		return spec, nil
+	}
+
+	nodegroup := nodegroupOut.Nodegroup
+	switch nodegroup.Status {
+	case eksTypes.NodegroupStatusActive:
+		spec.Status = api.ClusterProvisioned
+		spec.StatusDetails = ""
+	case eksTypes.NodegroupStatusCreateFailed:
+		spec.Status = api.ClusterFailed
+		spec.StatusDetails = nodegroupHealthIssues(nodegroup)
+	}
+	return spec, nil
+}
+
+// GetClusterDNS returns the configured ingress domain, EKS does not provide a domain for workloads
+func (p *EKSProvider) GetClusterDNS(clusterSpec *types.ClusterSpec) (string, error) {
+	info, err := parseEKSClusterInfo(clusterSpec)
+	if err != nil {
+		return "", err
+	}
+	if info.Spec.IngressDomain == "" {
+		return "", errors.Errorf("no ingress domain configured for EKS cluster %s", clusterSpec.InternalID)
+	}
+	return info.Spec.IngressDomain, nil
+}
+
+// Delete deletes the node groups of the cluster first and the cluster once all node groups are gone. It returns
+// true once the cluster no longer exists.
+func (p *EKSProvider) Delete(spec *types.ClusterSpec) (bool, error) {
+	_, client, err := p.clusterInfoAndClient(spec)
+	if err != nil {
+		return false, err
+	}
+	ctx := context.Background()
+	var notFound *eksTypes.ResourceNotFoundException
+
+	nodegroups, err := client.ListNodegroups(ctx, &eks.ListNodegroupsInput{ClusterName: aws.String(spec.InternalID)})
+	if err != nil {
+		if errors.As(err, &notFound) {
+			return true, nil
+		}
+		return false, errors.Wrapf(err, "failed to list node groups of EKS cluster %s", spec.InternalID)
+	}
+	if len(nodegroups.Nodegroups) > 0 {
+		for _, nodegroup := range nodegroups.Nodegroups {
+			_, err := client.DeleteNodegroup(ctx, &eks.DeleteNodegroupInput{
+				ClusterName:   aws.String(spec.InternalID),
+				NodegroupName: aws.String(nodegroup),
+			})
+			var inUse *eksTypes.ResourceInUseException
+			if err != nil && !errors.As(err, &notFound) && !errors.As(err, &inUse) {
+				return false, errors.Wrapf(err, "failed to delete node group %s of EKS cluster %s", nodegroup, spec.InternalID)
+			}
+		}
+		return false, nil
+	}
+
+	if _, err := client.DeleteCluster(ctx, &eks.DeleteClusterInput{Name: aws.String(spec.InternalID)}); err != nil {
+		if errors.As(err, &notFound) {
+			return true, nil
+		}
+		var inUse *eksTypes.ResourceInUseException
+		if errors.As(err, &inUse) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to delete EKS cluster %s", spec.InternalID)
+	}
+	return false, nil
+}
+
+// GetCloudProviders ...
+func (p *EKSProvider) GetCloudProviders() (*types.CloudProviderInfoList, error) {
+	return &types.CloudProviderInfoList{
+		Items: []types.CloudProviderInfo{{
+			ID:          eksCloudProviderID,
+			Name:        eksCloudProviderID,
+			DisplayName: eksCloudProviderDisplayName,
+		}},
+	}, nil
+}
+
+// GetCloudProviderRegions returns the supported AWS regions in which the EKS API can be reached with the
+// credentials of the fleet manager
+func (p *EKSProvider) GetCloudProviderRegions(providerInf types.CloudProviderInfo) (*types.CloudProviderRegionInfoList, error) {
+	list := &types.CloudProviderRegionInfoList{Items: []types.CloudProviderRegionInfo{}}
+	if providerInf.ID != eksCloudProviderID {
+		return list, nil
+	}
+	provider, ok := p.providerConfig.ProvidersConfig.SupportedProviders.GetByName(eksCloudProviderID)
+	if !ok {
+		return list, nil
+	}
+
+	for _, region := range provider.Regions {
+		client, err := p.clientFactory(region.Name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create EKS client for region %s", region.Name)
+		}
+		if _, err := client.ListClusters(context.Background(), &eks.ListClustersInput{MaxResults: aws.Int32(1)}); err != nil {
+			glog.Warningf("EKS is not available in region %s: %v", region.Name, err)
+			continue
+		}
+		list.Items = append(list.Items, types.CloudProviderRegionInfo{
+			ID:              region.Name,
+			CloudProviderID: eksCloudProviderID,
+			Name:            region.Name,
+			DisplayName:     wellknown.GetCloudRegionDisplayName(eksCloudProviderID, region.Name),
+			SupportsMultiAZ: true,
+		})
+	}
+	return list, nil
+}
+
+func (p *EKSProvider) createNodegroup(ctx context.Context, client EKSClient, clusterName string, spec EKSProviderSpec) error {
+	nodegroup := spec.Nodegroup
+	input := &eks.CreateNodegroupInput{
+		ClusterName:   aws.String(clusterName),
+		NodegroupName: aws.String(nodegroup.Name),
+		NodeRole:      aws.String(nodegroup.NodeRoleARN),
+		Subnets:       spec.SubnetIDs,
+		InstanceTypes: nodegroup.InstanceTypes,
+		ScalingConfig: &eksTypes.NodegroupScalingConfig{
+			MinSize:     aws.Int32(nodegroup.MinSize),
+			MaxSize:     aws.Int32(nodegroup.MaxSize),
+			DesiredSize: aws.Int32(nodegroup.DesiredSize),
+		},
+		Tags: spec.Tags,
+	}
+	if _, err := client.CreateNodegroup(ctx, input); err != nil {
+		var inUse *eksTypes.ResourceInUseException
+		if errors.As(err, &inUse) {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to create node group %s of EKS cluster %s", nodegroup.Name, clusterName)
+	}
+	glog.Infof("Creating node group %s of EKS cluster %s", nodegroup.Name, clusterName)
+	return nil
+}
+
+func (p *EKSProvider) clusterInfoAndClient(spec *types.ClusterSpec) (*eksClusterInfo, EKSClient, error) {
+	info, err := parseEKSClusterInfo(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := p.clientFactory(info.Region)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create EKS client for region %s", info.Region)
+	}
+	return info, client, nil
+}
+
+func parseEKSProviderSpec(providerSpec api.JSON) (EKSProviderSpec, error) {
+	spec := EKSProviderSpec{}
+	if len(providerSpec) == 0 {
+		return spec, errors.New("EKS cluster has no provider spec")
+	}
+	if err := json.Unmarshal(providerSpec, &spec); err != nil {
+		return spec, errors.Wrap(err, "failed to unmarshal EKS provider spec")
+	}
+	var missing []string
+	if spec.RoleARN == "" {
+		missing = append(missing, "role_arn")
+	}
+	if len(spec.SubnetIDs) == 0 {
+		missing = append(missing, "subnet_ids")
+	}
+	if spec.Nodegroup.NodeRoleARN == "" {
+		missing = append(missing, "nodegroup.node_role_arn")
+	}
+	if spec.Nodegroup.MaxSize <= 0 {
+		missing = append(missing, "nodegroup.max_size")
+	}
+	if len(missing) > 0 {
+		return spec, errors.Errorf("EKS provider spec is missing %s", strings.Join(missing, ", "))
+	}
+	return spec, nil
+}
+
+func parseEKSClusterInfo(spec *types.ClusterSpec) (*eksClusterInfo, error) {
+	info := &eksClusterInfo{}
+	if len(spec.AdditionalInfo) == 0 {
+		return nil, errors.Errorf("EKS cluster %s has no cluster info", spec.InternalID)
+	}
+	if err := json.Unmarshal(spec.AdditionalInfo, info); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal info of EKS cluster %s", spec.InternalID)
+	}
+	return info, nil
+}
+
+func nodegroupHealthIssues(nodegroup *eksTypes.Nodegroup) string {
+	if nodegroup.Health == nil || len(nodegroup.Health.Issues) == 0 {
+		return fmt.Sprintf("node group %s failed", aws.ToString(nodegroup.NodegroupName))
+	}
+	issues := make([]string, 0, len(nodegroup.Health.Issues))
+	for _, issue := range nodegroup.Health.Issues {
+		issues = append(issues, fmt.Sprintf("%s: %s", issue.Code, aws.ToString(issue.Message)))
+	}
+	return strings.Join(issues, "; ")
+}
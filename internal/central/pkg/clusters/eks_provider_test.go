@@ -0,0 +1,240 @@
+package clusters
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	eksTypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/clusters/types"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testEKSClusterName = "acs-prod-eks-1"
+
+var testEKSProviderSpec = EKSProviderSpec{
+	RoleARN:       "arn:aws:iam::123456789012:role/eks-cluster",
+	SubnetIDs:     []string{"subnet-a", "subnet-b"},
+	IngressDomain: "apps.acs-prod-eks-1.example.com",
+	Nodegroup: EKSNodegroupSpec{
+		NodeRoleARN:   "arn:aws:iam::123456789012:role/eks-node",
+		InstanceTypes: []string{"m5.2xlarge"},
+		MinSize:       3,
+		MaxSize:       9,
+		DesiredSize:   3,
+	},
+}
+
+func newTestEKSProvider(client EKSClient, regions ...string) *EKSProvider {
+	regionList := config.RegionList{}
+	for _, region := range regions {
+		regionList = append(regionList, config.Region{Name: region})
+	}
+	providerConfig := &config.ProviderConfig{
+		ProvidersConfig: config.ProviderConfiguration{
+			SupportedProviders: config.ProviderList{{Name: "aws", Regions: regionList}},
+		},
+	}
+	return newEKSProvider(func(region string) (EKSClient, error) {
+		if region == "" {
+			return nil, errors.New("region is required")
+		}
+		return client, nil
+	}, providerConfig)
+}
+
+func testEKSClusterSpec(t *testing.T, status api.ClusterStatus) *types.ClusterSpec {
+	spec := testEKSProviderSpec
+	spec.ClusterName = testEKSClusterName
+	spec.Nodegroup.Name = eksDefaultNodegroupName
+	info, err := json.Marshal(eksClusterInfo{Region: "us-east-1", Spec: spec})
+	require.NoError(t, err)
+	return &types.ClusterSpec{InternalID: testEKSClusterName, Status: status, AdditionalInfo: info}
+}
+
+func TestEKSProviderCreate(t *testing.T) {
+	client := &EKSClientMock{
+		CreateClusterFunc: func(_ context.Context, _ *eks.CreateClusterInput, _ ...func(*eks.Options)) (*eks.CreateClusterOutput, error) {
+			return &eks.CreateClusterOutput{}, nil
+		},
+	}
+	providerSpec, err := json.Marshal(testEKSProviderSpec)
+	require.NoError(t, err)
+
+	spec, err := newTestEKSProvider(client).Create(&types.ClusterRequest{
+		ClusterID:      testEKSClusterName,
+		CloudProvider:  "aws",
+		Region:         "us-east-1",
+		AdditionalSpec: providerSpec,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, testEKSClusterName, spec.InternalID)
+	assert.Equal(t, api.ClusterProvisioning, spec.Status)
+	require.Len(t, client.CreateClusterCalls(), 1)
+	input := client.CreateClusterCalls()[0].Params
+	assert.Equal(t, testEKSClusterName, aws.ToString(input.Name))
+	assert.Equal(t, testEKSProviderSpec.SubnetIDs, input.ResourcesVpcConfig.SubnetIds)
+
+	info, err := parseEKSClusterInfo(spec)
+	require.NoError(t, err)
+	assert.Equal(t, "us-east-1", info.Region)
+	assert.Equal(t, eksDefaultNodegroupName, info.Spec.Nodegroup.Name)
+}
+
+func TestEKSProviderCreateInvalidSpec(t *testing.T) {
+	client := &EKSClientMock{}
+	_, err := newTestEKSProvider(client).Create(&types.ClusterRequest{
+		Region:         "us-east-1",
+		AdditionalSpec: api.JSON(`{"role_arn": "arn"}`),
+	})
+	require.ErrorContains(t, err, "subnet_ids")
+	assert.Empty(t, client.CreateClusterCalls())
+}
+
+func TestEKSProviderCheckClusterStatus(t *testing.T) {
+	tests := map[string]struct {
+		clusterStatus    eksTypes.ClusterStatus
+		nodegroupStatus  eksTypes.NodegroupStatus
+		nodegroupMissing bool
+		wantStatus       api.ClusterStatus
+		wantNodegroup    bool
+	}{
+		"control plane creating": {
+			clusterStatus: eksTypes.ClusterStatusCreating,
+			wantStatus:    api.ClusterProvisioning,
+		},
+		"control plane failed": {
+			clusterStatus: eksTypes.ClusterStatusFailed,
+			wantStatus:    api.ClusterFailed,
+		},
+		"node group is created once the control plane is active": {
+			clusterStatus:    eksTypes.ClusterStatusActive,
+			nodegroupMissing: true,
+			wantStatus:       api.ClusterProvisioning,
+			wantNodegroup:    true,
+		},
+		"node group creating": {
+			clusterStatus:   eksTypes.ClusterStatusActive,
+			nodegroupStatus: eksTypes.NodegroupStatusCreating,
+			wantStatus:      api.ClusterProvisioning,
+		},
+		"node group active": {
+			clusterStatus:   eksTypes.ClusterStatusActive,
+			nodegroupStatus: eksTypes.NodegroupStatusActive,
+			wantStatus:      api.ClusterProvisioned,
+		},
+		"node group failed": {
+			clusterStatus:   eksTypes.ClusterStatusActive,
+			nodegroupStatus: eksTypes.NodegroupStatusCreateFailed,
+			wantStatus:      api.ClusterFailed,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &EKSClientMock{
+				DescribeClusterFunc: func(_ context.Context, _ *eks.DescribeClusterInput, _ ...func(*eks.Options)) (*eks.DescribeClusterOutput, error) {
+					return &eks.DescribeClusterOutput{Cluster: &eksTypes.Cluster{
+						Name:   aws.String(testEKSClusterName),
+						Arn:    aws.String("arn:aws:eks:us-east-1:123456789012:cluster/" + testEKSClusterName),
+						Status: tc.clusterStatus,
+					}}, nil
+				},
+				DescribeNodegroupFunc: func(_ context.Context, _ *eks.DescribeNodegroupInput, _ ...func(*eks.Options)) (*eks.DescribeNodegroupOutput, error) {
+					if tc.nodegroupMissing {
+						return nil, &eksTypes.ResourceNotFoundException{}
+					}
+					return &eks.DescribeNodegroupOutput{Nodegroup: &eksTypes.Nodegroup{
+						NodegroupName: aws.String(eksDefaultNodegroupName),
+						Status:        tc.nodegroupStatus,
+					}}, nil
+				},
+				CreateNodegroupFunc: func(_ context.Context, _ *eks.CreateNodegroupInput, _ ...func(*eks.Options)) (*eks.CreateNodegroupOutput, error) {
+					return &eks.CreateNodegroupOutput{}, nil
+				},
+			}
+
+			spec, err := newTestEKSProvider(client).CheckClusterStatus(testEKSClusterSpec(t, api.ClusterProvisioning))
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, spec.Status)
+			if tc.wantNodegroup {
+				require.Len(t, client.CreateNodegroupCalls(), 1)
+				input := client.CreateNodegroupCalls()[0].Params
+				assert.Equal(t, eksDefaultNodegroupName, aws.ToString(input.NodegroupName))
+				assert.Equal(t, int32(9), aws.ToInt32(input.ScalingConfig.MaxSize))
+			} else {
+				assert.Empty(t, client.CreateNodegroupCalls())
+			}
+		})
+	}
+}
+
+func TestEKSProviderGetClusterDNS(t *testing.T) {
+	dns, err := newTestEKSProvider(&EKSClientMock{}).GetClusterDNS(testEKSClusterSpec(t, api.ClusterProvisioned))
+	require.NoError(t, err)
+	assert.Equal(t, "apps.acs-prod-eks-1.example.com", dns)
+}
+
+func TestEKSProviderDelete(t *testing.T) {
+	nodegroups := []string{eksDefaultNodegroupName}
+	clusterDeleted := false
+	client := &EKSClientMock{
+		ListNodegroupsFunc: func(_ context.Context, _ *eks.ListNodegroupsInput, _ ...func(*eks.Options)) (*eks.ListNodegroupsOutput, error) {
+			if clusterDeleted {
+				return nil, &eksTypes.ResourceNotFoundException{}
+			}
+			return &eks.ListNodegroupsOutput{Nodegroups: nodegroups}, nil
+		},
+		DeleteNodegroupFunc: func(_ context.Context, _ *eks.DeleteNodegroupInput, _ ...func(*eks.Options)) (*eks.DeleteNodegroupOutput, error) {
+			return &eks.DeleteNodegroupOutput{}, nil
+		},
+		DeleteClusterFunc: func(_ context.Context, _ *eks.DeleteClusterInput, _ ...func(*eks.Options)) (*eks.DeleteClusterOutput, error) {
+			clusterDeleted = true
+			return &eks.DeleteClusterOutput{}, nil
+		},
+	}
+	provider := newTestEKSProvider(client)
+	spec := testEKSClusterSpec(t, api.ClusterDeprovisioning)
+
+	deleted, err := provider.Delete(spec)
+	require.NoError(t, err)
+	assert.False(t, deleted)
+	assert.Len(t, client.DeleteNodegroupCalls(), 1)
+	assert.Empty(t, client.DeleteClusterCalls(), "the cluster is deleted after its node groups")
+
+	nodegroups = nil
+	deleted, err = provider.Delete(spec)
+	require.NoError(t, err)
+	assert.False(t, deleted)
+	assert.Len(t, client.DeleteClusterCalls(), 1)
+
+	deleted, err = provider.Delete(spec)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+}
+
+func TestEKSProviderGetCloudProviderRegions(t *testing.T) {
+	client := &EKSClientMock{
+		ListClustersFunc: func(_ context.Context, _ *eks.ListClustersInput, _ ...func(*eks.Options)) (*eks.ListClustersOutput, error) {
+			return &eks.ListClustersOutput{}, nil
+		},
+	}
+	provider := newTestEKSProvider(client, "us-east-1", "eu-west-1")
+
+	regions, err := provider.GetCloudProviderRegions(types.CloudProviderInfo{ID: "aws"})
+	require.NoError(t, err)
+	require.Len(t, regions.Items, 2)
+	assert.Equal(t, "us-east-1", regions.Items[0].ID)
+	assert.Equal(t, "aws", regions.Items[0].CloudProviderID)
+	assert.Len(t, client.ListClustersCalls(), 2)
+
+	regions, err = provider.GetCloudProviderRegions(types.CloudProviderInfo{ID: "gcp"})
+	require.NoError(t, err)
+	assert.Empty(t, regions.Items)
+}
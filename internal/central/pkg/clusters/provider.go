@@ -48,13 +48,16 @@ func NewDefaultProviderFactory(
 	ocmConfig *ocm.OCMConfig,
 	awsConfig *config.AWSConfig,
 	dataplaneClusterConfig *config.DataplaneClusterConfig,
+	providerConfig *config.ProviderConfig,
 ) *DefaultProviderFactory {
 	ocmProvider := newOCMProvider(ocmClient, NewClusterBuilder(awsConfig, dataplaneClusterConfig), ocmConfig)
 	standaloneProvider := newStandaloneProvider(connectionFactory, dataplaneClusterConfig)
+	eksProvider := newEKSProvider(NewEKSClientFactory(awsConfig), providerConfig)
 	return &DefaultProviderFactory{
 		providerContainer: map[api.ClusterProviderType]Provider{
 			api.ClusterProviderStandalone: standaloneProvider,
 			api.ClusterProviderOCM:        ocmProvider,
+			api.ClusterProviderAwsEKS:     eksProvider,
 		},
 	}
 }
@@ -32,6 +32,8 @@ type ResourceSet struct {
 
 // ClusterRequest information about the cluster creation request
 type ClusterRequest struct {
+	// ClusterID is set if the cluster was registered with a predefined ID, e.g. by the cluster configuration file
+	ClusterID string
 	// cloud provider requirement
 	CloudProvider string
 	// region of the cluster
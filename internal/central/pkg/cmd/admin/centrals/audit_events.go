@@ -0,0 +1,45 @@
+package centrals
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/cmd/fleetmanagerclient"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+	"github.com/stackrox/acs-fleet-manager/pkg/flags"
+)
+
+// NewAdminCentralsAuditEventsCommand creates a new command for listing the audit events of a central.
+func NewAdminCentralsAuditEventsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit-events",
+		Short: "lists the audit events of a central",
+		Long:  "lists the audit events of admin mutations of a central in the order they were recorded",
+		Run: func(cmd *cobra.Command, args []string) {
+			runAuditEvents(fleetmanagerclient.AuthenticatedClientWithRHOASToken(cmd.Context()), cmd, args)
+		},
+	}
+	cmd.Flags().String(FlagID, "", "Central ID (required)")
+	flags.MarkFlagRequired(FlagID, cmd)
+	return cmd
+}
+
+func runAuditEvents(client *fleetmanager.Client, cmd *cobra.Command, _ []string) {
+	id := flags.MustGetDefinedString(FlagID, cmd.Flags())
+
+	events, _, err := client.AdminAPI().GetCentralAuditEvents(cmd.Context(), id)
+	if err != nil {
+		glog.Errorf(apiErrorMsg, "list audit events of", err)
+		return
+	}
+
+	eventsJSON, err := json.Marshal(events)
+	if err != nil {
+		glog.Errorf("Failed to marshal audit events: %s", err)
+		return
+	}
+
+	fmt.Println(string(eventsJSON))
+}
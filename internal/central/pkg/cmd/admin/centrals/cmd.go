@@ -5,6 +5,9 @@ import "github.com/spf13/cobra"
 
 const (
 	apiErrorMsg = "%s admin Central failed: To fix this ensure you are authenticated, fleet-manager endpoint is configured and reachable. Status Code: %s."
+
+	// FlagID is the flag for the central ID
+	FlagID = "id"
 )
 
 // NewAdminCentralsCommand creates a new admin central command.
@@ -18,6 +21,7 @@ func NewAdminCentralsCommand() *cobra.Command {
 	}
 	cmd.AddCommand(
 		NewAdminCentralsListCommand(),
+		NewAdminCentralsAuditEventsCommand(),
 	)
 
 	return cmd
@@ -0,0 +1,36 @@
+// Package clusters contains the admin data plane cluster CLI interface.
+package clusters
+
+import "github.com/spf13/cobra"
+
+const (
+	apiErrorMsg = "%s admin data plane cluster failed: To fix this ensure you are authenticated, fleet-manager endpoint is configured and reachable. Status Code: %s."
+
+	// FlagID is the flag for the cluster ID
+	FlagID = "id"
+	// FlagSearch is the flag for the search criteria of the cluster list
+	FlagSearch = "search"
+	// FlagOrderBy is the flag for the order of the cluster list
+	FlagOrderBy = "order-by"
+	// FlagPage is the flag for the page index of the cluster list
+	FlagPage = "page"
+	// FlagSize is the flag for the page size of the cluster list
+	FlagSize = "size"
+)
+
+// NewAdminClustersCommand creates a new admin data plane cluster command.
+func NewAdminClustersCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:              "clusters",
+		Aliases:          []string{"cluster"},
+		Short:            "Perform admin data plane cluster API calls.",
+		Long:             "Perform admin data plane cluster API calls.",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {},
+	}
+	cmd.AddCommand(
+		NewAdminClustersListCommand(),
+		NewAdminClustersGetCommand(),
+	)
+
+	return cmd
+}
@@ -0,0 +1,45 @@
+package clusters
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/cmd/fleetmanagerclient"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+	"github.com/stackrox/acs-fleet-manager/pkg/flags"
+)
+
+// NewAdminClustersGetCommand creates a new command for getting a data plane cluster.
+func NewAdminClustersGetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get a data plane cluster",
+		Long:  "Get a data plane cluster with its tenant count.",
+		Run: func(cmd *cobra.Command, args []string) {
+			runGet(fleetmanagerclient.AuthenticatedClientWithRHOASToken(cmd.Context()), cmd, args)
+		},
+	}
+	cmd.Flags().String(FlagID, "", "Cluster ID (required)")
+	flags.MarkFlagRequired(FlagID, cmd)
+
+	return cmd
+}
+
+func runGet(client *fleetmanager.Client, cmd *cobra.Command, _ []string) {
+	id := flags.MustGetDefinedString(FlagID, cmd.Flags())
+
+	cluster, _, err := client.AdminAPI().GetDataPlaneCluster(cmd.Context(), id)
+	if err != nil {
+		glog.Errorf(apiErrorMsg, "get", err)
+		return
+	}
+
+	clusterJSON, err := json.Marshal(cluster)
+	if err != nil {
+		glog.Errorf("Failed to marshal data plane cluster: %s", err)
+		return
+	}
+	fmt.Println(string(clusterJSON))
+}
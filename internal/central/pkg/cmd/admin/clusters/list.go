@@ -0,0 +1,58 @@
+package clusters
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/antihax/optional"
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	admin "github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/cmd/fleetmanagerclient"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
+	"github.com/stackrox/acs-fleet-manager/pkg/flags"
+)
+
+// NewAdminClustersListCommand creates a new command for listing data plane clusters.
+func NewAdminClustersListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "lists data plane clusters",
+		Long:  "lists data plane clusters with their tenant count",
+		Run: func(cmd *cobra.Command, args []string) {
+			runList(fleetmanagerclient.AuthenticatedClientWithRHOASToken(cmd.Context()), cmd, args)
+		},
+	}
+	cmd.Flags().String(FlagSearch, "", "Search criteria, for example \"region = us-east-1 and schedulable = true\"")
+	cmd.Flags().String(FlagOrderBy, "", "Order by criteria, for example \"created_at desc\"")
+	cmd.Flags().String(FlagPage, "1", "Page index")
+	cmd.Flags().String(FlagSize, "100", "Number of clusters per page")
+	return cmd
+}
+
+func runList(client *fleetmanager.Client, cmd *cobra.Command, _ []string) {
+	opts := &admin.GetDataPlaneClustersOpts{
+		Page: optional.NewString(flags.MustGetString(FlagPage, cmd.Flags())),
+		Size: optional.NewString(flags.MustGetString(FlagSize, cmd.Flags())),
+	}
+	if search := flags.MustGetString(FlagSearch, cmd.Flags()); search != "" {
+		opts.Search = optional.NewString(search)
+	}
+	if orderBy := flags.MustGetString(FlagOrderBy, cmd.Flags()); orderBy != "" {
+		opts.OrderBy = optional.NewString(orderBy)
+	}
+
+	clusters, _, err := client.AdminAPI().GetDataPlaneClusters(cmd.Context(), opts)
+	if err != nil {
+		glog.Errorf(apiErrorMsg, "list", err)
+		return
+	}
+
+	clusterJSON, err := json.Marshal(clusters)
+	if err != nil {
+		glog.Errorf("Failed to marshal data plane clusters: %s", err)
+		return
+	}
+
+	fmt.Println(string(clusterJSON))
+}
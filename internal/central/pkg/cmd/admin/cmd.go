@@ -4,6 +4,7 @@ package admin
 import (
 	"github.com/spf13/cobra"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/cmd/admin/centrals"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/cmd/admin/clusters"
 )
 
 // NewAdminCommand creates a new admin command.
@@ -16,6 +17,7 @@ func NewAdminCommand() *cobra.Command {
 	}
 	cmd.AddCommand(
 		centrals.NewAdminCentralsCommand(),
+		clusters.NewAdminClustersCommand(),
 	)
 
 	return cmd
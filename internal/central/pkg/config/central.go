@@ -1,13 +1,20 @@
 package config
 
 import (
+	"encoding/base64"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
+	"github.com/stackrox/acs-fleet-manager/pkg/features"
 	"github.com/stackrox/acs-fleet-manager/pkg/shared"
 )
 
+// authProviderEncryptionKeySize is the size of AES-256 keys
+const authProviderEncryptionKeySize = 32
+
 // CentralConfig ...
 type CentralConfig struct {
 	EnableCentralExternalDomain bool   `json:"enable_central_external_domain"`
@@ -24,18 +31,38 @@ type CentralConfig struct {
 	// CentralRetentionPeriod configures how long it should be possible to restore a central tenant
 	// that has been deleted via API
 	CentralRetentionPeriodDays int `json:"central_retention_period_days"`
+	// CentralMigrationPhaseTimeout is the time after which a phase of a migration between dataplane clusters fails
+	CentralMigrationPhaseTimeout time.Duration `json:"central_migration_phase_timeout"`
+	// RHSSOClientGC configures the garbage collection of orphaned RHSSO dynamic clients
+	RHSSOClientGC *RHSSOClientGCConfig `json:"rhsso_client_gc"`
+	// OIDCClientRotation configures the scheduled rotation of the OIDC clients of Centrals
+	OIDCClientRotation *OIDCClientRotationConfig `json:"oidc_client_rotation"`
+	// AuthProviderEncryptionKey is the base64 encoded AES-256 key which encrypts the client secrets of the auth
+	// providers customers add to their Centrals
+	AuthProviderEncryptionKey     string `json:"auth_provider_encryption_key"`
+	AuthProviderEncryptionKeyFile string `json:"auth_provider_encryption_key_file"`
+	// AuthProviderTenantChartSupported confirms that the tenant resources chart, which is maintained outside of this
+	// repository, renders the auth providers of customers. Auth providers cannot be added to Centrals until it is set.
+	AuthProviderTenantChartSupported bool `json:"auth_provider_tenant_chart_supported"`
+	// CentralUsageRetention is how long the usage reported for Central tenants is kept for cost reporting
+	CentralUsageRetention time.Duration `json:"central_usage_retention"`
 }
 
 // NewCentralConfig ...
 func NewCentralConfig() *CentralConfig {
 	return &CentralConfig{
-		EnableCentralExternalDomain: false,
-		CentralDomainName:           "rhacs-dev.com",
-		CentralLifespan:             NewCentralLifespanConfig(),
-		Quota:                       NewCentralQuotaConfig(),
-		CentralIDPClientSecretFile:  "secrets/central.idp-client-secret", //pragma: allowlist secret
-		CentralIDPIssuer:            "https://sso.redhat.com/auth/realms/redhat-external",
-		CentralRetentionPeriodDays:  7,
+		EnableCentralExternalDomain:   false,
+		CentralDomainName:             "rhacs-dev.com",
+		CentralLifespan:               NewCentralLifespanConfig(),
+		Quota:                         NewCentralQuotaConfig(),
+		CentralIDPClientSecretFile:    "secrets/central.idp-client-secret", //pragma: allowlist secret
+		CentralIDPIssuer:              "https://sso.redhat.com/auth/realms/redhat-external",
+		CentralRetentionPeriodDays:    7,
+		CentralMigrationPhaseTimeout:  30 * time.Minute,
+		RHSSOClientGC:                 NewRHSSOClientGCConfig(),
+		OIDCClientRotation:            NewOIDCClientRotationConfig(),
+		AuthProviderEncryptionKeyFile: "secrets/central.auth-provider-encryption-key", //pragma: allowlist secret
+		CentralUsageRetention:         2 * 365 * 24 * time.Hour,
 	}
 }
 
@@ -53,10 +80,31 @@ func (c *CentralConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.CentralIDPClientSecretFile, "central-idp-client-secret-file", c.CentralIDPClientSecretFile, "File containing OIDC client_secret to pass to Central's auth config")
 	fs.StringVar(&c.CentralIDPIssuer, "central-idp-issuer", c.CentralIDPIssuer, "OIDC issuer URL to pass to Central's auth config")
 	fs.IntVar(&c.CentralRetentionPeriodDays, "central-retention-period-days", c.CentralRetentionPeriodDays, "The number of days after deletion until central tenants can no longer be restored")
+	fs.DurationVar(&c.CentralMigrationPhaseTimeout, "central-migration-phase-timeout", c.CentralMigrationPhaseTimeout, "Time after which a phase of a central migration between data plane clusters fails and can be rolled back")
+	fs.DurationVar(&c.RHSSOClientGC.Interval, "rhsso-client-gc-interval", c.RHSSOClientGC.Interval, "Interval at which RHSSO dynamic clients are checked for clients which no longer belong to a Central instance")
+	fs.DurationVar(&c.RHSSOClientGC.GracePeriod, "rhsso-client-gc-grace-period", c.RHSSOClientGC.GracePeriod, "Minimum age of an orphaned RHSSO dynamic client before it is deleted")
+	fs.BoolVar(&c.RHSSOClientGC.DryRun, "rhsso-client-gc-dry-run", c.RHSSOClientGC.DryRun, "Only report orphaned RHSSO dynamic clients without deleting them")
+	fs.DurationVar(&c.OIDCClientRotation.Interval, "oidc-client-rotation-interval", c.OIDCClientRotation.Interval, "Interval at which the OIDC clients of Central instances are checked for rotation")
+	fs.StringToStringVar(&c.OIDCClientRotation.MaxAge, "oidc-client-rotation-max-age", c.OIDCClientRotation.MaxAge, "Age per client origin after which the OIDC clients of Central instances are rotated, e.g. dedicated_dynamic_rhsso=2160h")
+	fs.StringVar(&c.OIDCClientRotation.Window, "oidc-client-rotation-window", c.OIDCClientRotation.Window, "Default daily time window in UTC in which OIDC clients are rotated, e.g. 02:00-06:00. Empty allows rotations at any time")
+	fs.DurationVar(&c.OIDCClientRotation.PropagationTimeout, "oidc-client-rotation-propagation-timeout", c.OIDCClientRotation.PropagationTimeout, "Time after which the previous OIDC client is deleted, even if the data plane did not report that it applied the new client")
+	fs.StringVar(&c.AuthProviderEncryptionKeyFile, "auth-provider-encryption-key-file", c.AuthProviderEncryptionKeyFile, "File containing the base64 encoded AES-256 key which encrypts the client secrets of customer auth providers")
+	fs.BoolVar(&c.AuthProviderTenantChartSupported, "auth-provider-tenant-chart-supported", c.AuthProviderTenantChartSupported, "Confirm that the tenant resources chart renders declarativeConfig.additionalAuthProviders, so that customers can add auth providers to Central instances")
+	fs.IntVar(&c.OIDCClientRotation.BatchSize, "oidc-client-rotation-batch-size", c.OIDCClientRotation.BatchSize, "Maximum number of OIDC clients of Central instances rotated per interval")
+	fs.DurationVar(&c.CentralUsageRetention, "central-usage-retention", c.CentralUsageRetention, "Time after which the usage reported for Central instances is deleted")
 }
 
 // ReadFiles ...
 func (c *CentralConfig) ReadFiles() error {
+	if c.RHSSOClientGC.Interval <= 0 {
+		return errors.Errorf("RHSSO client garbage collection interval must be positive, got %s", c.RHSSOClientGC.Interval)
+	}
+	if c.CentralUsageRetention <= 0 {
+		return errors.Errorf("central usage retention must be positive, got %s", c.CentralUsageRetention)
+	}
+	if err := c.OIDCClientRotation.Validate(); err != nil {
+		return err
+	}
 
 	// Initialise and check that all parts of static auth config are present.
 	if c.HasStaticAuth() {
@@ -74,9 +122,31 @@ func (c *CentralConfig) ReadFiles() error {
 		}
 	}
 
+	if features.CustomerAuthProviders.Enabled() {
+		err := shared.ReadFileValueString(c.AuthProviderEncryptionKeyFile, &c.AuthProviderEncryptionKey)
+		if err != nil {
+			return fmt.Errorf("reading auth provider encryption key file: %w", err)
+		}
+		if _, err := c.GetAuthProviderEncryptionKey(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// GetAuthProviderEncryptionKey decodes the key which encrypts the client secrets of customer auth providers
+func (c *CentralConfig) GetAuthProviderEncryptionKey() ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(c.AuthProviderEncryptionKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding auth provider encryption key")
+	}
+	if len(key) != authProviderEncryptionKeySize {
+		return nil, errors.Errorf("auth provider encryption key must be %d bytes long, got %d", authProviderEncryptionKeySize, len(key))
+	}
+	return key, nil
+}
+
 // HasStaticAuth returns true if the static auth config for Centrals has been
 // specified and false otherwise.
 func (c *CentralConfig) HasStaticAuth() bool {
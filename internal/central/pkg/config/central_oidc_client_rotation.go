@@ -0,0 +1,134 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+)
+
+// OIDCClientRotationConfig configures the scheduled rotation of the OIDC clients of Centrals
+type OIDCClientRotationConfig struct {
+	// Interval is the interval at which the age of the clients is checked
+	Interval time.Duration `json:"interval"`
+	// MaxAge maps client origins to the age after which their clients are rotated, e.g. dedicated_dynamic_rhsso=2160h.
+	// Clients of origins without max age are never rotated automatically.
+	MaxAge map[string]string `json:"max_age"`
+	// Window is the default daily time window in UTC in which clients are rotated, e.g. 02:00-06:00. Centrals can
+	// have their own window. An empty window allows rotations at any time.
+	Window string `json:"window"`
+	// PropagationTimeout is the time after which the previous client is deleted, even if the data plane did not report
+	// that it applied the new client
+	PropagationTimeout time.Duration `json:"propagation_timeout"`
+	// BatchSize is the maximum number of clients rotated per interval, so that enabling a max age does not rotate
+	// all clients at once
+	BatchSize int `json:"batch_size"`
+
+	maxAges       map[string]time.Duration
+	defaultWindow DailyWindow
+}
+
+// NewOIDCClientRotationConfig ...
+func NewOIDCClientRotationConfig() *OIDCClientRotationConfig {
+	return &OIDCClientRotationConfig{
+		Interval:           time.Hour,
+		MaxAge:             map[string]string{},
+		Window:             "02:00-06:00",
+		PropagationTimeout: time.Hour,
+		BatchSize:          20,
+	}
+}
+
+// Validate parses the max ages and the default window
+func (c *OIDCClientRotationConfig) Validate() error {
+	if c.Interval <= 0 {
+		return errors.Errorf("OIDC client rotation interval must be positive, got %s", c.Interval)
+	}
+	if c.BatchSize <= 0 {
+		return errors.Errorf("OIDC client rotation batch size must be positive, got %d", c.BatchSize)
+	}
+	maxAges := make(map[string]time.Duration, len(c.MaxAge))
+	for origin, value := range c.MaxAge {
+		if origin != dbapi.AuthConfigDynamicClientOrigin {
+			return errors.Errorf("OIDC clients of origin %q cannot be rotated per Central", origin)
+		}
+		maxAge, err := time.ParseDuration(value)
+		if err != nil {
+			return errors.Wrapf(err, "parsing OIDC client max age of origin %q", origin)
+		}
+		if maxAge <= 0 {
+			return errors.Errorf("OIDC client max age of origin %q must be positive, got %s", origin, maxAge)
+		}
+		maxAges[origin] = maxAge
+	}
+	window, err := ParseDailyWindow(c.Window)
+	if err != nil {
+		return errors.Wrap(err, "parsing OIDC client rotation window")
+	}
+	c.maxAges = maxAges
+	c.defaultWindow = window
+	return nil
+}
+
+// MaxAgeOf returns the age after which the clients of the origin are rotated, and false if they are not rotated
+func (c *OIDCClientRotationConfig) MaxAgeOf(origin string) (time.Duration, bool) {
+	maxAge, ok := c.maxAges[origin]
+	return maxAge, ok
+}
+
+// DefaultWindow returns the window of Centrals without their own window
+func (c *OIDCClientRotationConfig) DefaultWindow() DailyWindow {
+	return c.defaultWindow
+}
+
+// DailyWindow is a time window repeating every day in UTC. The window wraps around midnight if it ends before it
+// starts. The zero value covers the whole day.
+type DailyWindow struct {
+	start time.Duration
+	end   time.Duration
+}
+
+// ParseDailyWindow parses windows of the form HH:MM-HH:MM. An empty string covers the whole day.
+func ParseDailyWindow(s string) (DailyWindow, error) {
+	if s == "" {
+		return DailyWindow{}, nil
+	}
+	var startHour, startMinute, endHour, endMinute int
+	n, _ := fmt.Sscanf(s, "%02d:%02d-%02d:%02d", &startHour, &startMinute, &endHour, &endMinute)
+	if n != 4 || len(s) != len("HH:MM-HH:MM") {
+		return DailyWindow{}, errors.Errorf("window %q is not of the form HH:MM-HH:MM", s)
+	}
+	start, err := timeOfDay(startHour, startMinute)
+	if err != nil {
+		return DailyWindow{}, errors.Wrapf(err, "window %q", s)
+	}
+	end, err := timeOfDay(endHour, endMinute)
+	if err != nil {
+		return DailyWindow{}, errors.Wrapf(err, "window %q", s)
+	}
+	if start == end {
+		return DailyWindow{}, errors.Errorf("window %q is empty", s)
+	}
+	return DailyWindow{start: start, end: end}, nil
+}
+
+func timeOfDay(hour, minute int) (time.Duration, error) {
+	if hour < 0 || hour > 23 || minute < 0 || minute > 59 {
+		return 0, errors.Errorf("%02d:%02d is not a valid time of day", hour, minute)
+	}
+	return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute, nil
+}
+
+// Contains returns true if the time is within the window
+func (w DailyWindow) Contains(t time.Time) bool {
+	if w.start == w.end {
+		return true
+	}
+	t = t.UTC()
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	if w.start < w.end {
+		return offset >= w.start && offset < w.end
+	}
+	return offset >= w.start || offset < w.end
+}
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDailyWindow(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2026, 5, 1, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		window string
+		time   time.Time
+		want   bool
+	}{
+		{window: "", time: at(12, 0), want: true},
+		{window: "02:00-06:00", time: at(2, 0), want: true},
+		{window: "02:00-06:00", time: at(5, 59), want: true},
+		{window: "02:00-06:00", time: at(6, 0), want: false},
+		{window: "02:00-06:00", time: at(1, 59), want: false},
+		{window: "22:00-01:30", time: at(23, 0), want: true},
+		{window: "22:00-01:30", time: at(1, 0), want: true},
+		{window: "22:00-01:30", time: at(12, 0), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.window+"@"+tt.time.Format("15:04"), func(t *testing.T) {
+			window, err := ParseDailyWindow(tt.window)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, window.Contains(tt.time))
+		})
+	}
+	assert.True(t, DailyWindow{}.Contains(at(3, 0).In(time.FixedZone("UTC+2", 2*60*60))))
+}
+
+func TestParseDailyWindowInvalid(t *testing.T) {
+	for _, window := range []string{"02:00", "2:00-06:00", "02:00-24:00", "02:60-03:00", "03:00-03:00", "02:00-06:00x"} {
+		_, err := ParseDailyWindow(window)
+		assert.Error(t, err, window)
+	}
+}
+
+func TestOIDCClientRotationConfigValidate(t *testing.T) {
+	c := NewOIDCClientRotationConfig()
+	c.MaxAge = map[string]string{"dedicated_dynamic_rhsso": "2160h"}
+	require.NoError(t, c.Validate())
+	maxAge, ok := c.MaxAgeOf("dedicated_dynamic_rhsso")
+	assert.True(t, ok)
+	assert.Equal(t, 2160*time.Hour, maxAge)
+	_, ok = c.MaxAgeOf("shared_static_rhsso")
+	assert.False(t, ok)
+
+	c.MaxAge = map[string]string{"shared_static_rhsso": "2160h"}
+	assert.Error(t, c.Validate(), "shared clients cannot be rotated per central")
+	c.MaxAge = map[string]string{"dedicated_dynamic_rhsso": "-1h"}
+	assert.Error(t, c.Validate())
+}
@@ -0,0 +1,23 @@
+package config
+
+import "time"
+
+// RHSSOClientGCConfig configures the garbage collection of RHSSO dynamic clients which no longer belong to a Central
+type RHSSOClientGCConfig struct {
+	// Interval is the interval at which the dynamic clients of the realm are compared with the Centrals
+	Interval time.Duration `json:"interval"`
+	// GracePeriod is the minimum age of an orphaned client before it is deleted. It covers clients which are created
+	// but not yet stored with their Central.
+	GracePeriod time.Duration `json:"grace_period"`
+	// DryRun only reports orphaned clients without deleting them
+	DryRun bool `json:"dry_run"`
+}
+
+// NewRHSSOClientGCConfig ...
+func NewRHSSOClientGCConfig() *RHSSOClientGCConfig {
+	return &RHSSOClientGCConfig{
+		Interval:    time.Hour,
+		GracePeriod: 24 * time.Hour,
+		DryRun:      true,
+	}
+}
@@ -1,7 +1,10 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/url"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stackrox/acs-fleet-manager/pkg/shared"
@@ -26,6 +29,20 @@ type DataplaneClusterConfig struct {
 	ReadOnlyUserListFile                  string
 	ClusterConfig                         *ClusterConfig `json:"clusters_config"`
 	EnableReadyDataPlaneClustersReconcile bool           `json:"enable_ready_dataplane_clusters_reconcile"`
+	// ClusterHeartbeatTimeout is the time without a heartbeat from fleetshard-sync after which a cluster is silent
+	ClusterHeartbeatTimeout time.Duration `json:"cluster_heartbeat_timeout"`
+	// ClusterMinHealthScore is the health score below which no Centrals are placed on a cluster
+	ClusterMinHealthScore int `json:"cluster_min_health_score"`
+	// UnscheduleSilentClusters makes silent clusters unschedulable until their heartbeat returns
+	UnscheduleSilentClusters bool `json:"unschedule_silent_clusters"`
+	// CapacityForecastWindow is the period over which the growth of Centrals per region is measured
+	CapacityForecastWindow time.Duration `json:"capacity_forecast_window"`
+	// CapacityForecastHorizon is how far ahead the growth is projected when forecasting the remaining capacity
+	CapacityForecastHorizon time.Duration `json:"capacity_forecast_horizon"`
+	// CapacityScaleOutThreshold is the projected remaining capacity in Centrals below which a new cluster is requested
+	CapacityScaleOutThreshold int `json:"capacity_scale_out_threshold"`
+	// CapacityScaleOutDryRun only reports scale-out decisions without requesting new clusters
+	CapacityScaleOutDryRun bool `json:"capacity_scale_out_dry_run"`
 }
 
 // ManualScaling ...
@@ -48,9 +65,19 @@ func NewDataplaneClusterConfig() *DataplaneClusterConfig {
 		DataPlaneClusterScalingType:           ManualScaling,
 		ClusterConfig:                         &ClusterConfig{},
 		EnableReadyDataPlaneClustersReconcile: true,
+		ClusterHeartbeatTimeout:               10 * time.Minute,
+		ClusterMinHealthScore:                 50,
+		UnscheduleSilentClusters:              true,
+		CapacityForecastWindow:                7 * 24 * time.Hour,
+		CapacityForecastHorizon:               3 * 24 * time.Hour,
+		CapacityScaleOutThreshold:             5,
+		CapacityScaleOutDryRun:                false,
 	}
 }
 
+// UnlimitedCentralInstanceLimit is the central instance limit of clusters without a limit
+const UnlimitedCentralInstanceLimit = -1
+
 // ManualCluster manual cluster configuration
 type ManualCluster struct {
 	Name                  string                  `yaml:"name"`
@@ -64,26 +91,55 @@ type ManualCluster struct {
 	ProviderType          api.ClusterProviderType `yaml:"provider_type"`
 	ClusterDNS            string                  `yaml:"cluster_dns"`
 	SupportedInstanceType string                  `yaml:"supported_instance_type"`
+	// ProviderSpec holds provider-specific settings, e.g. the VPC and node group configuration of EKS clusters
+	ProviderSpec map[string]interface{} `yaml:"provider_spec"`
+	// AuthIssuer and AuthSubject bind the identity of the fleetshard of the cluster. Only tokens with this issuer and
+	// subject can access the cluster's endpoints of the private API once they are set.
+	AuthIssuer  string `yaml:"auth_issuer"`
+	AuthSubject string `yaml:"auth_subject"`
 }
 
-// UnmarshalYAML ...
-func (c *ManualCluster) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	type t ManualCluster
-	temp := t{
+// NewManualCluster returns a cluster configuration with the defaults of clusters in the configuration file
+func NewManualCluster() ManualCluster {
+	return ManualCluster{
 		Status:                api.ClusterProvisioning,
 		ProviderType:          api.ClusterProviderOCM,
 		ClusterDNS:            "",
 		SupportedInstanceType: api.AllInstanceTypeSupport.String(), // by default support both instance type
 	}
+}
+
+// UnmarshalYAML ...
+func (c *ManualCluster) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type t ManualCluster
+	temp := t(NewManualCluster())
 	err := unmarshal(&temp)
 	if err != nil {
 		return err
 	}
 	*c = ManualCluster(temp)
+	return c.Validate()
+}
+
+// Validate checks the cluster configuration and adjusts the settings which depend on the provider type.
+// Clusters registered through the admin API are validated the same way as clusters in the configuration file.
+func (c *ManualCluster) Validate() error {
 	if c.ClusterID == "" {
 		return fmt.Errorf("cluster_id is empty")
 	}
 
+	switch c.Status {
+	case api.ClusterProvisioning, api.ClusterProvisioned, api.ClusterReady:
+	default:
+		return errors.Errorf("cluster with id %s has invalid status %q", c.ClusterID, c.Status)
+	}
+
+	switch c.ProviderType {
+	case api.ClusterProviderOCM, api.ClusterProviderAwsEKS, api.ClusterProviderStandalone:
+	default:
+		return errors.Errorf("cluster with id %s has invalid provider_type %q", c.ClusterID, c.ProviderType)
+	}
+
 	if c.ProviderType == api.ClusterProviderStandalone {
 		if c.ClusterDNS == "" {
 			return errors.Errorf("Standalone cluster with id %s does not have the cluster dns field provided", c.ClusterID)
@@ -99,12 +155,104 @@ func (c *ManualCluster) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		}
 	}
 
+	if c.ProviderType == api.ClusterProviderAwsEKS {
+		if len(c.ProviderSpec) == 0 {
+			return errors.Errorf("EKS cluster with id %s does not have the provider_spec field provided", c.ClusterID)
+		}
+
+		if c.Status == api.ClusterProvisioning {
+			// EKS clusters are created by the EKS provider, unless they are declared as already provisioned.
+			c.Status = api.ClusterAccepted
+		}
+	}
+
+	if c.ProviderSpec != nil {
+		providerSpec, err := toJSONCompatible(c.ProviderSpec)
+		if err != nil {
+			return errors.Wrapf(err, "invalid provider_spec of cluster with id %s", c.ClusterID)
+		}
+		c.ProviderSpec = providerSpec.(map[string]interface{})
+	}
+
 	if c.SupportedInstanceType == "" {
 		c.SupportedInstanceType = api.AllInstanceTypeSupport.String()
 	}
+	if err := ValidateClusterAuthIdentity(c.AuthIssuer, c.AuthSubject); err != nil {
+		return errors.Wrapf(err, "cluster with id %s has an invalid fleetshard identity", c.ClusterID)
+	}
+	return nil
+}
+
+// ValidateClusterAuthIdentity checks that either both or none of issuer and subject of a fleetshard identity are set,
+// and that the issuer is an https URL its keys can be discovered from
+func ValidateClusterAuthIdentity(issuer, subject string) error {
+	if issuer == "" && subject == "" {
+		return nil
+	}
+	if issuer == "" || subject == "" {
+		return errors.New("auth_issuer and auth_subject must be set together")
+	}
+	u, err := url.Parse(issuer)
+	if err != nil || u.Scheme != "https" || u.Host == "" || u.RawQuery != "" || u.Fragment != "" {
+		return errors.Errorf("auth_issuer %q is not an https URL without query and fragment", issuer)
+	}
 	return nil
 }
 
+// ProviderSpecJSON returns the provider spec of the cluster as JSON
+func (c *ManualCluster) ProviderSpecJSON() (api.JSON, error) {
+	if c.ProviderSpec == nil {
+		return nil, nil
+	}
+	providerSpec, err := json.Marshal(c.ProviderSpec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshalling provider spec of cluster %s", c.ClusterID)
+	}
+	return providerSpec, nil
+}
+
+// toJSONCompatible converts the maps with interface{} keys produced by the YAML decoder to maps with string keys
+func toJSONCompatible(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			keyString, ok := key.(string)
+			if !ok {
+				return nil, errors.Errorf("key %v is not a string", key)
+			}
+			convertedItem, err := toJSONCompatible(item)
+			if err != nil {
+				return nil, err
+			}
+			converted[keyString] = convertedItem
+		}
+		return converted, nil
+	case map[string]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			convertedItem, err := toJSONCompatible(item)
+			if err != nil {
+				return nil, err
+			}
+			converted[key] = convertedItem
+		}
+		return converted, nil
+	case []interface{}:
+		converted := make([]interface{}, len(v))
+		for i, item := range v {
+			convertedItem, err := toJSONCompatible(item)
+			if err != nil {
+				return nil, err
+			}
+			converted[i] = convertedItem
+		}
+		return converted, nil
+	default:
+		return value, nil
+	}
+}
+
 // ClusterList ...
 type ClusterList []ManualCluster
 
@@ -141,7 +289,7 @@ func (conf *ClusterConfig) GetCapacityForRegion(region string) int {
 func (conf *ClusterConfig) IsNumberOfCentralWithinClusterLimit(clusterID string, count int) bool {
 	if _, exist := conf.clusterConfigMap[clusterID]; exist {
 		limit := conf.clusterConfigMap[clusterID].CentralInstanceLimit
-		return limit == -1 || count <= limit
+		return limit == UnlimitedCentralInstanceLimit || count <= limit
 	}
 	return true
 }
@@ -172,6 +320,12 @@ func (conf *ClusterConfig) ExcessClusters(clusterList map[string]api.Cluster) []
 	return res
 }
 
+// HasCluster returns true if the cluster is listed in the configuration file
+func (conf *ClusterConfig) HasCluster(clusterID string) bool {
+	_, exist := conf.clusterConfigMap[clusterID]
+	return exist
+}
+
 // GetManualClusters ...
 func (conf *ClusterConfig) GetManualClusters() []ManualCluster {
 	return conf.clusterList
@@ -222,10 +376,17 @@ func (c *DataplaneClusterConfig) IsReadyDataPlaneClustersReconcileEnabled() bool
 func (c *DataplaneClusterConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.OpenshiftVersion, "cluster-openshift-version", c.OpenshiftVersion, "The version of openshift installed on the cluster. An empty string indicates that the latest stable version should be used")
 	fs.StringVar(&c.ComputeMachineType, "cluster-compute-machine-type", c.ComputeMachineType, "The compute machine type")
-	fs.StringVar(&c.DataPlaneClusterConfigFile, "dataplane-cluster-config-file", c.DataPlaneClusterConfigFile, "File contains properties for manually configuring OSD cluster.")
+	fs.StringVar(&c.DataPlaneClusterConfigFile, "dataplane-cluster-config-file", c.DataPlaneClusterConfigFile, "Optional file with data plane clusters to register in addition to the clusters registered through the admin API.")
 	fs.StringVar(&c.DataPlaneClusterScalingType, "dataplane-cluster-scaling-type", c.DataPlaneClusterScalingType, "Set to use cluster configuration to configure clusters. Its value should be either 'none' for no scaling, 'manual' or 'auto'.")
 	fs.StringVar(&c.ReadOnlyUserListFile, "read-only-user-list-file", c.ReadOnlyUserListFile, "File contains a list of users with read-only permissions to data plane clusters")
 	fs.BoolVar(&c.EnableReadyDataPlaneClustersReconcile, "enable-ready-dataplane-clusters-reconcile", c.EnableReadyDataPlaneClustersReconcile, "Enables reconciliation for data plane clusters in the 'Ready' state")
+	fs.DurationVar(&c.ClusterHeartbeatTimeout, "cluster-heartbeat-timeout", c.ClusterHeartbeatTimeout, "Time without a heartbeat from fleetshard-sync after which a data plane cluster is considered silent")
+	fs.IntVar(&c.ClusterMinHealthScore, "cluster-min-health-score", c.ClusterMinHealthScore, "Health score between 0 and 100 below which no Centrals are placed on a data plane cluster")
+	fs.BoolVar(&c.UnscheduleSilentClusters, "unschedule-silent-clusters", c.UnscheduleSilentClusters, "Makes silent data plane clusters unschedulable until fleetshard-sync sends heartbeats again")
+	fs.DurationVar(&c.CapacityForecastWindow, "capacity-forecast-window", c.CapacityForecastWindow, "Period over which the growth of Centrals per region is measured for capacity forecasting with 'auto' scaling")
+	fs.DurationVar(&c.CapacityForecastHorizon, "capacity-forecast-horizon", c.CapacityForecastHorizon, "How far ahead the growth of Centrals is projected when forecasting the remaining capacity with 'auto' scaling")
+	fs.IntVar(&c.CapacityScaleOutThreshold, "capacity-scale-out-threshold", c.CapacityScaleOutThreshold, "Projected remaining capacity in Centrals below which a new data plane cluster is requested with 'auto' scaling")
+	fs.BoolVar(&c.CapacityScaleOutDryRun, "capacity-scale-out-dry-run", c.CapacityScaleOutDryRun, "Only reports the scale-out decisions of 'auto' scaling without requesting new data plane clusters")
 }
 
 // ReadFiles ...
@@ -260,6 +421,18 @@ func readDataPlaneClusterConfig(file string) (ClusterList, error) {
 	if err = yaml.Unmarshal([]byte(fileContents), &c); err != nil {
 		return nil, fmt.Errorf("reading data plane cluster config file: %w", err)
 	}
+	// an identity bound to several clusters would let their fleetshards access each other's tenants
+	identities := make(map[[2]string]string, len(c.ClusterList))
+	for _, cluster := range c.ClusterList {
+		if cluster.AuthIssuer == "" {
+			continue
+		}
+		identity := [2]string{cluster.AuthIssuer, cluster.AuthSubject}
+		if other, exists := identities[identity]; exists {
+			return nil, fmt.Errorf("reading data plane cluster config file: clusters %s and %s have the same auth_issuer and auth_subject", other, cluster.ClusterID)
+		}
+		identities[identity] = cluster.ClusterID
+	}
 	return c.ClusterList, nil
 }
 
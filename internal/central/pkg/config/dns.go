@@ -0,0 +1,100 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/stackrox/acs-fleet-manager/pkg/environments"
+	"github.com/stackrox/acs-fleet-manager/pkg/shared"
+)
+
+// DNS providers managing the records of Central tenants
+const (
+	// DNSProviderRoute53 manages the records in an AWS Route53 hosted zone
+	DNSProviderRoute53 = "route53"
+	// DNSProviderRFC2136 manages the records with RFC 2136 dynamic updates sent to a DNS server
+	DNSProviderRFC2136 = "rfc2136"
+	// DNSProviderMemory keeps the records in memory. It is meant for local development and tests.
+	DNSProviderMemory = "memory"
+)
+
+// DNSConfig ...
+type DNSConfig struct {
+	// Provider is the DNS provider managing the CNAME records of Central tenants
+	Provider string `json:"provider"`
+	// ReconcileInterval is the interval at which the records of the zone are compared with the routes of Central tenants
+	ReconcileInterval time.Duration `json:"reconcile_interval"`
+	// ReconcileRepair enables repairing drifted records. Drift is only reported if it is disabled, which is the default.
+	// Only records with an owner record are deleted.
+	ReconcileRepair bool `json:"reconcile_repair"`
+
+	// RFC2136Server is the address (host:port) of the DNS server accepting dynamic updates
+	RFC2136Server string `json:"rfc2136_server"`
+	// RFC2136TSIGKeyName is the name of the TSIG key used to sign updates. Updates are not signed if it is empty.
+	RFC2136TSIGKeyName string `json:"rfc2136_tsig_key_name"`
+	// RFC2136TSIGAlgorithm is the HMAC algorithm of the TSIG key, e.g. hmac-sha256
+	RFC2136TSIGAlgorithm  string        `json:"rfc2136_tsig_algorithm"`
+	RFC2136TSIGSecret     string        `json:"rfc2136_tsig_secret"`
+	RFC2136TSIGSecretFile string        `json:"rfc2136_tsig_secret_file"`
+	RFC2136Timeout        time.Duration `json:"rfc2136_timeout"`
+}
+
+// NewDNSConfig ...
+func NewDNSConfig() *DNSConfig {
+	return &DNSConfig{
+		Provider:              DNSProviderRoute53,
+		ReconcileInterval:     10 * time.Minute,
+		ReconcileRepair:       false,
+		RFC2136Server:         "127.0.0.1:53",
+		RFC2136TSIGAlgorithm:  "hmac-sha256",
+		RFC2136TSIGSecretFile: "secrets/dns.rfc2136-tsig-secret", // pragma: allowlist secret
+		RFC2136Timeout:        10 * time.Second,
+	}
+}
+
+var _ environments.ServiceValidator = &DNSConfig{}
+
+// AddFlags ...
+func (c *DNSConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.Provider, "central-dns-provider", c.Provider, "The DNS provider managing the CNAME records of Central instances. The available options are: 'route53' (default), 'rfc2136' and 'memory'")
+	fs.DurationVar(&c.ReconcileInterval, "central-dns-reconcile-interval", c.ReconcileInterval, "Interval at which the DNS records of Central instances are checked for drift")
+	fs.BoolVar(&c.ReconcileRepair, "central-dns-reconcile-repair", c.ReconcileRepair, "Repair drifted DNS records of Central instances. Only records with a fleet-manager owner record are deleted. Drift is only reported if disabled")
+	fs.StringVar(&c.RFC2136Server, "central-dns-rfc2136-server", c.RFC2136Server, "Address (host:port) of the DNS server accepting RFC 2136 dynamic updates")
+	fs.StringVar(&c.RFC2136TSIGKeyName, "central-dns-rfc2136-tsig-key-name", c.RFC2136TSIGKeyName, "Name of the TSIG key used to sign RFC 2136 dynamic updates. Updates are not signed if empty")
+	fs.StringVar(&c.RFC2136TSIGAlgorithm, "central-dns-rfc2136-tsig-algorithm", c.RFC2136TSIGAlgorithm, "HMAC algorithm of the TSIG key. The available options are: 'hmac-sha1', 'hmac-sha256' and 'hmac-sha512'")
+	fs.StringVar(&c.RFC2136TSIGSecretFile, "central-dns-rfc2136-tsig-secret-file", c.RFC2136TSIGSecretFile, "File containing the base64 encoded secret of the TSIG key")
+	fs.DurationVar(&c.RFC2136Timeout, "central-dns-rfc2136-timeout", c.RFC2136Timeout, "Timeout of RFC 2136 dynamic updates")
+}
+
+// ReadFiles ...
+func (c *DNSConfig) ReadFiles() error {
+	if c.Provider != DNSProviderRFC2136 || c.RFC2136TSIGKeyName == "" {
+		return nil
+	}
+	if err := shared.ReadFileValueString(c.RFC2136TSIGSecretFile, &c.RFC2136TSIGSecret); err != nil {
+		return fmt.Errorf("reading RFC 2136 TSIG secret file: %w", err)
+	}
+	return nil
+}
+
+// Validate ...
+func (c *DNSConfig) Validate() error {
+	if c.ReconcileInterval <= 0 {
+		return fmt.Errorf("DNS reconcile interval must be positive, got %s", c.ReconcileInterval)
+	}
+	switch c.Provider {
+	case DNSProviderRoute53, DNSProviderMemory:
+		return nil
+	case DNSProviderRFC2136:
+		if c.RFC2136Server == "" {
+			return fmt.Errorf("no server specified for DNS provider %q", c.Provider)
+		}
+		if c.RFC2136TSIGKeyName != "" && c.RFC2136TSIGSecret == "" {
+			return fmt.Errorf("no secret specified for TSIG key %q", c.RFC2136TSIGKeyName)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown DNS provider %q, expected one of %q, %q or %q", c.Provider, DNSProviderRoute53, DNSProviderRFC2136, DNSProviderMemory)
+	}
+}
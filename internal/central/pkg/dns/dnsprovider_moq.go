@@ -0,0 +1,243 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package dns
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that DNSProviderMock does implement DNSProvider.
+// If this is not the case, regenerate this file with moq.
+var _ DNSProvider = &DNSProviderMock{}
+
+// DNSProviderMock is a mock implementation of DNSProvider.
+//
+//	func TestSomethingThatUsesDNSProvider(t *testing.T) {
+//
+//		// make and configure a mocked DNSProvider
+//		mockedDNSProvider := &DNSProviderMock{
+//			DeleteRecordsFunc: func(ctx context.Context, zone string, records []Record) (*Change, error) {
+//				panic("mock out the DeleteRecords method")
+//			},
+//			GetChangeStatusFunc: func(ctx context.Context, changeID string) (*Change, error) {
+//				panic("mock out the GetChangeStatus method")
+//			},
+//			ListRecordsFunc: func(ctx context.Context, zone string) ([]Record, error) {
+//				panic("mock out the ListRecords method")
+//			},
+//			UpsertRecordsFunc: func(ctx context.Context, zone string, records []Record) (*Change, error) {
+//				panic("mock out the UpsertRecords method")
+//			},
+//		}
+//
+//		// use mockedDNSProvider in code that requires DNSProvider
+//		// and then make assertions.
+//
+//	}
+type DNSProviderMock struct {
+	// DeleteRecordsFunc mocks the DeleteRecords method.
+	DeleteRecordsFunc func(ctx context.Context, zone string, records []Record) (*Change, error)
+
+	// GetChangeStatusFunc mocks the GetChangeStatus method.
+	GetChangeStatusFunc func(ctx context.Context, changeID string) (*Change, error)
+
+	// ListRecordsFunc mocks the ListRecords method.
+	ListRecordsFunc func(ctx context.Context, zone string) ([]Record, error)
+
+	// UpsertRecordsFunc mocks the UpsertRecords method.
+	UpsertRecordsFunc func(ctx context.Context, zone string, records []Record) (*Change, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteRecords holds details about calls to the DeleteRecords method.
+		DeleteRecords []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Zone is the zone argument value.
+			Zone string
+			// Records is the records argument value.
+			Records []Record
+		}
+		// GetChangeStatus holds details about calls to the GetChangeStatus method.
+		GetChangeStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ChangeID is the changeID argument value.
+			ChangeID string
+		}
+		// ListRecords holds details about calls to the ListRecords method.
+		ListRecords []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Zone is the zone argument value.
+			Zone string
+		}
+		// UpsertRecords holds details about calls to the UpsertRecords method.
+		UpsertRecords []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Zone is the zone argument value.
+			Zone string
+			// Records is the records argument value.
+			Records []Record
+		}
+	}
+	lockDeleteRecords   sync.RWMutex
+	lockGetChangeStatus sync.RWMutex
+	lockListRecords     sync.RWMutex
+	lockUpsertRecords   sync.RWMutex
+}
+
+// DeleteRecords calls DeleteRecordsFunc.
+func (mock *DNSProviderMock) DeleteRecords(ctx context.Context, zone string, records []Record) (*Change, error) {
+	if mock.DeleteRecordsFunc == nil {
+		panic("DNSProviderMock.DeleteRecordsFunc: method is nil but DNSProvider.DeleteRecords was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Zone    string
+		Records []Record
+	}{
+		Ctx:     ctx,
+		Zone:    zone,
+		Records: records,
+	}
+	mock.lockDeleteRecords.Lock()
+	mock.calls.DeleteRecords = append(mock.calls.DeleteRecords, callInfo)
+	mock.lockDeleteRecords.Unlock()
+	return mock.DeleteRecordsFunc(ctx, zone, records)
+}
+
+// DeleteRecordsCalls gets all the calls that were made to DeleteRecords.
+// Check the length with:
+//
+//	len(mockedDNSProvider.DeleteRecordsCalls())
+func (mock *DNSProviderMock) DeleteRecordsCalls() []struct {
+	Ctx     context.Context
+	Zone    string
+	Records []Record
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Zone    string
+		Records []Record
+	}
+	mock.lockDeleteRecords.RLock()
+	calls = mock.calls.DeleteRecords
+	mock.lockDeleteRecords.RUnlock()
+	return calls
+}
+
+// GetChangeStatus calls GetChangeStatusFunc.
+func (mock *DNSProviderMock) GetChangeStatus(ctx context.Context, changeID string) (*Change, error) {
+	if mock.GetChangeStatusFunc == nil {
+		panic("DNSProviderMock.GetChangeStatusFunc: method is nil but DNSProvider.GetChangeStatus was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ChangeID string
+	}{
+		Ctx:      ctx,
+		ChangeID: changeID,
+	}
+	mock.lockGetChangeStatus.Lock()
+	mock.calls.GetChangeStatus = append(mock.calls.GetChangeStatus, callInfo)
+	mock.lockGetChangeStatus.Unlock()
+	return mock.GetChangeStatusFunc(ctx, changeID)
+}
+
+// GetChangeStatusCalls gets all the calls that were made to GetChangeStatus.
+// Check the length with:
+//
+//	len(mockedDNSProvider.GetChangeStatusCalls())
+func (mock *DNSProviderMock) GetChangeStatusCalls() []struct {
+	Ctx      context.Context
+	ChangeID string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ChangeID string
+	}
+	mock.lockGetChangeStatus.RLock()
+	calls = mock.calls.GetChangeStatus
+	mock.lockGetChangeStatus.RUnlock()
+	return calls
+}
+
+// ListRecords calls ListRecordsFunc.
+func (mock *DNSProviderMock) ListRecords(ctx context.Context, zone string) ([]Record, error) {
+	if mock.ListRecordsFunc == nil {
+		panic("DNSProviderMock.ListRecordsFunc: method is nil but DNSProvider.ListRecords was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Zone string
+	}{
+		Ctx:  ctx,
+		Zone: zone,
+	}
+	mock.lockListRecords.Lock()
+	mock.calls.ListRecords = append(mock.calls.ListRecords, callInfo)
+	mock.lockListRecords.Unlock()
+	return mock.ListRecordsFunc(ctx, zone)
+}
+
+// ListRecordsCalls gets all the calls that were made to ListRecords.
+// Check the length with:
+//
+//	len(mockedDNSProvider.ListRecordsCalls())
+func (mock *DNSProviderMock) ListRecordsCalls() []struct {
+	Ctx  context.Context
+	Zone string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Zone string
+	}
+	mock.lockListRecords.RLock()
+	calls = mock.calls.ListRecords
+	mock.lockListRecords.RUnlock()
+	return calls
+}
+
+// UpsertRecords calls UpsertRecordsFunc.
+func (mock *DNSProviderMock) UpsertRecords(ctx context.Context, zone string, records []Record) (*Change, error) {
+	if mock.UpsertRecordsFunc == nil {
+		panic("DNSProviderMock.UpsertRecordsFunc: method is nil but DNSProvider.UpsertRecords was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Zone    string
+		Records []Record
+	}{
+		Ctx:     ctx,
+		Zone:    zone,
+		Records: records,
+	}
+	mock.lockUpsertRecords.Lock()
+	mock.calls.UpsertRecords = append(mock.calls.UpsertRecords, callInfo)
+	mock.lockUpsertRecords.Unlock()
+	return mock.UpsertRecordsFunc(ctx, zone, records)
+}
+
+// UpsertRecordsCalls gets all the calls that were made to UpsertRecords.
+// Check the length with:
+//
+//	len(mockedDNSProvider.UpsertRecordsCalls())
+func (mock *DNSProviderMock) UpsertRecordsCalls() []struct {
+	Ctx     context.Context
+	Zone    string
+	Records []Record
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Zone    string
+		Records []Record
+	}
+	mock.lockUpsertRecords.RLock()
+	calls = mock.calls.UpsertRecords
+	mock.lockUpsertRecords.RUnlock()
+	return calls
+}
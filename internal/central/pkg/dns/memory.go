@@ -0,0 +1,114 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// MemoryProvider keeps the records in memory. Changes are in sync immediately.
+// It is meant for local development and tests.
+type MemoryProvider struct {
+	mu      sync.Mutex
+	records map[string]Record
+	changes map[string]*Change
+}
+
+var _ DNSProvider = &MemoryProvider{}
+
+// NewMemoryProvider ...
+func NewMemoryProvider() *MemoryProvider {
+	return &MemoryProvider{
+		records: map[string]Record{},
+		changes: map[string]*Change{},
+	}
+}
+
+// UpsertRecords ...
+func (p *MemoryProvider) UpsertRecords(_ context.Context, zone string, records []Record) (*Change, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, r := range records {
+		if recordType, ok := replacedRecordType(r.Type); ok {
+			delete(p.records, memoryRecordKey(zone, Record{Name: r.Name, Type: recordType}))
+		}
+		p.records[memoryRecordKey(zone, r)] = r
+	}
+	return p.newChange(), nil
+}
+
+// DeleteRecords ...
+func (p *MemoryProvider) DeleteRecords(_ context.Context, zone string, records []Record) (*Change, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, r := range records {
+		key := memoryRecordKey(zone, r)
+		if _, ok := p.records[key]; !ok {
+			return nil, fmt.Errorf("record %s %s not found in zone %s", r.Type, r.Name, zone)
+		}
+	}
+	for _, r := range records {
+		delete(p.records, memoryRecordKey(zone, r))
+	}
+	return p.newChange(), nil
+}
+
+// GetChangeStatus ...
+func (p *MemoryProvider) GetChangeStatus(_ context.Context, changeID string) (*Change, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	change, ok := p.changes[changeID]
+	if !ok {
+		return nil, fmt.Errorf("change %q not found", changeID)
+	}
+	c := *change
+	return &c, nil
+}
+
+// ListRecords ...
+func (p *MemoryProvider) ListRecords(_ context.Context, zone string) ([]Record, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	prefix := strings.ToLower(fqdn(zone) + "/")
+	var records []Record
+	for key, r := range p.records {
+		if strings.HasPrefix(key, prefix) {
+			records = append(records, r)
+		}
+	}
+	sortRecords(records)
+	return records, nil
+}
+
+// Records returns the records of all zones sorted by name
+func (p *MemoryProvider) Records() []Record {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	records := make([]Record, 0, len(p.records))
+	for _, r := range p.records {
+		records = append(records, r)
+	}
+	sortRecords(records)
+	return records
+}
+
+func sortRecords(records []Record) {
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Name < records[j].Name
+	})
+}
+
+func (p *MemoryProvider) newChange() *Change {
+	change := &Change{ID: uuid.New().String(), Status: ChangeStatusInSync}
+	p.changes[change.ID] = change
+	c := *change
+	return &c
+}
+
+func memoryRecordKey(zone string, r Record) string {
+	return strings.ToLower(fqdn(zone) + "/" + fqdn(r.Name) + "/" + r.Type)
+}
@@ -0,0 +1,169 @@
+// Package dns manages the DNS records of Central tenants with pluggable DNS providers.
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/aws"
+)
+
+// Record types supported by the DNS providers
+const (
+	// RecordTypeCNAME is the type of the records pointing the Central hosts to the router of their cluster
+	RecordTypeCNAME = "CNAME"
+	// RecordTypeA is the type of the records pointing the Central hosts to routers which only have an IPv4 address
+	RecordTypeA = "A"
+	// RecordTypeTXT is the type of the owner records
+	RecordTypeTXT = "TXT"
+)
+
+const defaultRecordTTL = int64(300)
+
+// Owner records are TXT records marking the records of the Central hosts as managed by fleet-manager. Only records with
+// an owner record are deleted when the records of the zone are reconciled.
+const (
+	ownerRecordPrefix = "_acs-fleet-manager."
+	// OwnerRecordValue is the value of the owner records
+	OwnerRecordValue = "heritage=acs-fleet-manager"
+)
+
+// ChangeStatus is the propagation status of a change of records
+type ChangeStatus string
+
+const (
+	// ChangeStatusPending means the change is not yet applied to all name servers of the zone
+	ChangeStatusPending ChangeStatus = "PENDING"
+	// ChangeStatusInSync means the change is applied to all name servers of the zone
+	ChangeStatusInSync ChangeStatus = "INSYNC"
+)
+
+// Record is a DNS resource record set with a single value. Names are returned by the providers without trailing dot.
+type Record struct {
+	Name  string
+	Type  string
+	TTL   int64
+	Value string
+}
+
+// Change is a change of records submitted to a DNS provider
+type Change struct {
+	ID     string
+	Status ChangeStatus
+}
+
+// IsInSync returns true if the change is applied to all name servers of the zone
+func (c *Change) IsInSync() bool {
+	return c != nil && c.Status == ChangeStatusInSync
+}
+
+// DNSProvider manages the records of a DNS zone
+//
+//go:generate moq -out dnsprovider_moq.go . DNSProvider
+type DNSProvider interface {
+	// UpsertRecords creates the records in the zone or replaces the existing records with the same name and type. CNAME
+	// records also replace the A records with the same name and vice versa.
+	UpsertRecords(ctx context.Context, zone string, records []Record) (*Change, error)
+	// DeleteRecords deletes the records from the zone
+	DeleteRecords(ctx context.Context, zone string, records []Record) (*Change, error)
+	// GetChangeStatus returns the status of a change previously returned by the provider
+	GetChangeStatus(ctx context.Context, changeID string) (*Change, error)
+	// ListRecords returns the CNAME, A and TXT records of the zone. Record sets with multiple values are returned as
+	// one record per value.
+	ListRecords(ctx context.Context, zone string) ([]Record, error)
+}
+
+// NewDNSProvider returns the DNS provider selected in the configuration
+func NewDNSProvider(dnsConfig *config.DNSConfig, awsConfig *config.AWSConfig, awsClientFactory aws.ClientFactory) (DNSProvider, error) {
+	switch dnsConfig.Provider {
+	case config.DNSProviderRoute53:
+		return NewRoute53Provider(awsClientFactory, aws.Config{
+			AccessKeyID:     awsConfig.Route53AccessKey,
+			SecretAccessKey: awsConfig.Route53SecretAccessKey, // pragma: allowlist secret
+		}), nil
+	case config.DNSProviderRFC2136:
+		return NewRFC2136Provider(RFC2136Options{
+			Server:        dnsConfig.RFC2136Server,
+			TSIGKeyName:   dnsConfig.RFC2136TSIGKeyName,
+			TSIGAlgorithm: dnsConfig.RFC2136TSIGAlgorithm,
+			TSIGSecret:    dnsConfig.RFC2136TSIGSecret,
+			Timeout:       dnsConfig.RFC2136Timeout,
+		})
+	case config.DNSProviderMemory:
+		return NewMemoryProvider(), nil
+	default:
+		return nil, fmt.Errorf("unknown DNS provider %q", dnsConfig.Provider)
+	}
+}
+
+// RouteRecords returns the records pointing the hosts of the routes to their router. Routers are host names, which
+// are pointed to with CNAME records, or IPv4 addresses of load balancers without host name, which get A records.
+func RouteRecords(routes []dbapi.DataPlaneCentralRoute) []Record {
+	records := make([]Record, 0, len(routes))
+	for _, r := range routes {
+		recordType := RecordTypeCNAME
+		if ip := net.ParseIP(r.Router); ip != nil && ip.To4() != nil {
+			recordType = RecordTypeA
+		}
+		records = append(records, Record{
+			Name:  r.Domain,
+			Type:  recordType,
+			TTL:   defaultRecordTTL,
+			Value: r.Router,
+		})
+	}
+	return records
+}
+
+// replacedRecordType returns the type of the records replaced by an upserted record of the type, as a name can either
+// have a CNAME record or A records
+func replacedRecordType(recordType string) (string, bool) {
+	switch strings.ToUpper(recordType) {
+	case RecordTypeCNAME:
+		return RecordTypeA, true
+	case RecordTypeA:
+		return RecordTypeCNAME, true
+	default:
+		return "", false
+	}
+}
+
+// OwnerRecords returns the owner records of the records. Owner records have their own name, as CNAME records cannot
+// share their name with records of other types.
+func OwnerRecords(records []Record) []Record {
+	owners := make([]Record, 0, len(records))
+	for _, r := range records {
+		owners = append(owners, Record{
+			Name:  ownerRecordPrefix + NormalizeName(r.Name),
+			Type:  RecordTypeTXT,
+			TTL:   r.TTL,
+			Value: OwnerRecordValue,
+		})
+	}
+	return owners
+}
+
+// OwnedRecordName returns the name of the records owned by the record, if it is an owner record
+func OwnedRecordName(r Record) (string, bool) {
+	if r.Type != RecordTypeTXT || r.Value != OwnerRecordValue {
+		return "", false
+	}
+	return strings.CutPrefix(NormalizeName(r.Name), ownerRecordPrefix)
+}
+
+// NormalizeName returns the lower case name without trailing dot, the format of the names returned by the providers
+func NormalizeName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, "."))
+}
+
+// fqdn returns the name with a trailing dot
+func fqdn(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
@@ -0,0 +1,331 @@
+package dns
+
+import (
+	"context"
+	"encoding/base64"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+	mdns "github.com/miekg/dns"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	awsClient "github.com/stackrox/acs-fleet-manager/pkg/client/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testZone = "acs.test"
+
+var testRecords = RouteRecords([]dbapi.DataPlaneCentralRoute{
+	{Domain: "acs-tenant.acs.test", Router: "router.cluster.test"},
+	{Domain: "acs-data-tenant.acs.test", Router: "router.cluster.test"},
+})
+
+func TestRouteRecords(t *testing.T) {
+	records := RouteRecords([]dbapi.DataPlaneCentralRoute{
+		{Domain: "acs-tenant.acs.test", Router: "router.cluster.test"},
+		{Domain: "acs-data-tenant.acs.test", Router: "172.18.0.2"},
+	})
+	assert.Equal(t, []Record{
+		{Name: "acs-tenant.acs.test", Type: RecordTypeCNAME, TTL: defaultRecordTTL, Value: "router.cluster.test"},
+		{Name: "acs-data-tenant.acs.test", Type: RecordTypeA, TTL: defaultRecordTTL, Value: "172.18.0.2"},
+	}, records)
+}
+
+func TestMemoryProviderReplacesRecordsOfOtherType(t *testing.T) {
+	ctx := context.Background()
+	p := NewMemoryProvider()
+	_, err := p.UpsertRecords(ctx, testZone, testRecords[:1])
+	require.NoError(t, err)
+
+	aRecord := Record{Name: testRecords[0].Name, Type: RecordTypeA, TTL: defaultRecordTTL, Value: "172.18.0.2"}
+	_, err = p.UpsertRecords(ctx, testZone, []Record{aRecord})
+	require.NoError(t, err)
+	assert.Equal(t, []Record{aRecord}, p.Records())
+}
+
+func TestMemoryProvider(t *testing.T) {
+	ctx := context.Background()
+	p := NewMemoryProvider()
+
+	change, err := p.UpsertRecords(ctx, testZone, testRecords)
+	require.NoError(t, err)
+	assert.True(t, change.IsInSync())
+	assert.Equal(t, []Record{testRecords[1], testRecords[0]}, p.Records())
+
+	status, err := p.GetChangeStatus(ctx, change.ID)
+	require.NoError(t, err)
+	assert.Equal(t, change, status)
+
+	_, err = p.DeleteRecords(ctx, testZone, testRecords[:1])
+	require.NoError(t, err)
+	assert.Equal(t, []Record{testRecords[1]}, p.Records())
+
+	_, err = p.DeleteRecords(ctx, testZone, testRecords[:1])
+	require.Error(t, err)
+	_, err = p.GetChangeStatus(ctx, "unknown")
+	require.Error(t, err)
+}
+
+func TestRoute53Provider(t *testing.T) {
+	var batch *types.ChangeBatch
+	client := &awsClient.ClientMock{
+		ChangeResourceRecordSetsFunc: func(dnsName string, recordChangeBatch *types.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
+			assert.Equal(t, testZone, dnsName)
+			batch = recordChangeBatch
+			return &route53.ChangeResourceRecordSetsOutput{
+				ChangeInfo: &types.ChangeInfo{Id: aws.String("change-1"), Status: types.ChangeStatusPending},
+			}, nil
+		},
+		GetChangeFunc: func(changeID string) (*route53.GetChangeOutput, error) {
+			return &route53.GetChangeOutput{
+				ChangeInfo: &types.ChangeInfo{Id: aws.String(changeID), Status: types.ChangeStatusInsync},
+			}, nil
+		},
+	}
+	factory := &clientFactory{client: client}
+	p := NewRoute53Provider(factory, awsClient.Config{})
+
+	change, err := p.DeleteRecords(context.Background(), testZone, testRecords)
+	require.NoError(t, err)
+	assert.Equal(t, &Change{ID: "change-1", Status: ChangeStatusPending}, change)
+	require.Len(t, batch.Changes, 2)
+	assert.Equal(t, types.ChangeActionDelete, batch.Changes[0].Action)
+	assert.Equal(t, "acs-tenant.acs.test", *batch.Changes[0].ResourceRecordSet.Name)
+	assert.Equal(t, types.RRTypeCname, batch.Changes[0].ResourceRecordSet.Type)
+	assert.Equal(t, "router.cluster.test", *batch.Changes[0].ResourceRecordSet.ResourceRecords[0].Value)
+
+	change, err = p.GetChangeStatus(context.Background(), "change-1")
+	require.NoError(t, err)
+	assert.True(t, change.IsInSync())
+}
+
+func TestRoute53ProviderReplacesRecordsOfOtherType(t *testing.T) {
+	var batch *types.ChangeBatch
+	existing := types.ResourceRecordSet{
+		Name:            aws.String("acs-tenant.acs.test."),
+		Type:            types.RRTypeA,
+		TTL:             aws.Int64(300),
+		ResourceRecords: []types.ResourceRecord{{Value: aws.String("172.18.0.2")}},
+	}
+	client := &awsClient.ClientMock{
+		ChangeResourceRecordSetsFunc: func(dnsName string, recordChangeBatch *types.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
+			batch = recordChangeBatch
+			return &route53.ChangeResourceRecordSetsOutput{
+				ChangeInfo: &types.ChangeInfo{Id: aws.String("change-1"), Status: types.ChangeStatusPending},
+			}, nil
+		},
+		ListResourceRecordSetsFunc: func(dnsName string) ([]types.ResourceRecordSet, error) {
+			return []types.ResourceRecordSet{existing, {
+				Name:            aws.String("acs-data-tenant.acs.test."),
+				Type:            types.RRTypeCname,
+				ResourceRecords: []types.ResourceRecord{{Value: aws.String("router.cluster.test")}},
+			}}, nil
+		},
+	}
+	p := NewRoute53Provider(&clientFactory{client: client}, awsClient.Config{})
+
+	_, err := p.UpsertRecords(context.Background(), testZone, testRecords)
+	require.NoError(t, err)
+	require.Len(t, batch.Changes, 3)
+	assert.Equal(t, types.ChangeActionDelete, batch.Changes[0].Action)
+	assert.Equal(t, existing, *batch.Changes[0].ResourceRecordSet)
+	for _, change := range batch.Changes[1:] {
+		assert.Equal(t, types.ChangeActionUpsert, change.Action)
+		assert.Equal(t, types.RRTypeCname, change.ResourceRecordSet.Type)
+	}
+}
+
+func TestRoute53ProviderTXTRecords(t *testing.T) {
+	var batch *types.ChangeBatch
+	client := &awsClient.ClientMock{
+		ChangeResourceRecordSetsFunc: func(dnsName string, recordChangeBatch *types.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
+			batch = recordChangeBatch
+			return &route53.ChangeResourceRecordSetsOutput{
+				ChangeInfo: &types.ChangeInfo{Id: aws.String("change-1"), Status: types.ChangeStatusInsync},
+			}, nil
+		},
+		ListResourceRecordSetsFunc: func(dnsName string) ([]types.ResourceRecordSet, error) {
+			return []types.ResourceRecordSet{{
+				Name:            aws.String("_acs-fleet-manager.acs-tenant.acs.test."),
+				Type:            types.RRTypeTxt,
+				ResourceRecords: []types.ResourceRecord{{Value: aws.String(`"heritage=acs-" "fleet-manager"`)}},
+			}}, nil
+		},
+	}
+	p := NewRoute53Provider(&clientFactory{client: client}, awsClient.Config{})
+
+	owners := OwnerRecords(testRecords[:1])
+	_, err := p.UpsertRecords(context.Background(), testZone, owners)
+	require.NoError(t, err)
+	assert.Equal(t, `"heritage=acs-fleet-manager"`, *batch.Changes[0].ResourceRecordSet.ResourceRecords[0].Value)
+
+	records, err := p.ListRecords(context.Background(), testZone)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	name, ok := OwnedRecordName(records[0])
+	assert.True(t, ok)
+	assert.Equal(t, "acs-tenant.acs.test", name)
+}
+
+type clientFactory struct {
+	client awsClient.Client
+}
+
+func (f *clientFactory) NewClient(_ awsClient.Config, _ string) (awsClient.Client, error) {
+	return f.client, nil
+}
+
+func TestRFC2136ProviderUpsertRecordsSignsUpdate(t *testing.T) {
+	secret := base64.StdEncoding.EncodeToString([]byte("test-secret"))
+	server, requests := startDNSServer(t, map[string]string{"tsig-key.": secret}, mdns.RcodeSuccess)
+	p, err := NewRFC2136Provider(RFC2136Options{
+		Server:        server,
+		TSIGKeyName:   "TSIG-Key",
+		TSIGAlgorithm: "hmac-sha256",
+		TSIGSecret:    secret,
+		Timeout:       5 * time.Second,
+	})
+	require.NoError(t, err)
+
+	change, err := p.UpsertRecords(context.Background(), testZone, testRecords)
+	require.NoError(t, err)
+	assert.True(t, change.IsInSync())
+
+	request := <-requests
+	require.NoError(t, request.tsigStatus)
+	msg := request.msg
+	assert.Equal(t, mdns.OpcodeUpdate, msg.Opcode)
+	require.Len(t, msg.Question, 1)
+	assert.Equal(t, "acs.test.", msg.Question[0].Name)
+	assert.Equal(t, mdns.TypeSOA, msg.Question[0].Qtype)
+
+	var updates []string
+	for _, rr := range msg.Ns {
+		if rr.Header().Class == mdns.ClassANY {
+			updates = append(updates, "delete "+mdns.TypeToString[rr.Header().Rrtype]+" "+rr.Header().Name)
+			continue
+		}
+		cname, ok := rr.(*mdns.CNAME)
+		require.True(t, ok)
+		assert.Equal(t, uint32(300), cname.Hdr.Ttl)
+		updates = append(updates, "add "+cname.Hdr.Name+" "+cname.Target)
+	}
+	// The A records of the hosts are deleted, as a host either has a CNAME record or A records
+	assert.Equal(t, []string{
+		"delete CNAME acs-tenant.acs.test.", "delete A acs-tenant.acs.test.", "add acs-tenant.acs.test. router.cluster.test.",
+		"delete CNAME acs-data-tenant.acs.test.", "delete A acs-data-tenant.acs.test.", "add acs-data-tenant.acs.test. router.cluster.test.",
+	}, updates)
+	tsig := msg.IsTsig()
+	require.NotNil(t, tsig)
+	assert.Equal(t, mdns.HmacSHA256, tsig.Algorithm)
+}
+
+func TestRFC2136ProviderUpsertRecordsWrongSecret(t *testing.T) {
+	server, requests := startDNSServer(t, map[string]string{"tsig-key.": base64.StdEncoding.EncodeToString([]byte("server-secret"))}, mdns.RcodeSuccess)
+	p, err := NewRFC2136Provider(RFC2136Options{
+		Server:        server,
+		TSIGKeyName:   "tsig-key",
+		TSIGAlgorithm: "hmac-sha256",
+		TSIGSecret:    base64.StdEncoding.EncodeToString([]byte("client-secret")),
+		Timeout:       5 * time.Second,
+	})
+	require.NoError(t, err)
+
+	_, err = p.UpsertRecords(context.Background(), testZone, testRecords)
+	require.ErrorContains(t, err, "rejected")
+	assert.ErrorIs(t, (<-requests).tsigStatus, mdns.ErrSig)
+}
+
+func TestRFC2136ProviderDeleteRecordsRejected(t *testing.T) {
+	server, _ := startDNSServer(t, nil, mdns.RcodeRefused)
+	p, err := NewRFC2136Provider(RFC2136Options{Server: server, Timeout: 5 * time.Second})
+	require.NoError(t, err)
+
+	_, err = p.DeleteRecords(context.Background(), testZone, testRecords)
+	require.ErrorContains(t, err, "rejected")
+}
+
+func TestNewRFC2136ProviderUnsupportedAlgorithm(t *testing.T) {
+	_, err := NewRFC2136Provider(RFC2136Options{Server: "127.0.0.1:53", TSIGKeyName: "key", TSIGAlgorithm: "hmac-md5"})
+	require.Error(t, err)
+}
+
+func TestRFC2136ProviderListRecordsTransfersZone(t *testing.T) {
+	soa := &mdns.SOA{
+		Hdr: mdns.RR_Header{Name: "acs.test.", Rrtype: mdns.TypeSOA, Class: mdns.ClassINET},
+		Ns:  "ns.acs.test.", Mbox: "admin.acs.test.",
+	}
+	server, requests := startDNSServer(t, nil, mdns.RcodeSuccess,
+		soa,
+		&mdns.CNAME{
+			Hdr:    mdns.RR_Header{Name: "ACS-Tenant.acs.test.", Rrtype: mdns.TypeCNAME, Class: mdns.ClassINET, Ttl: 300},
+			Target: "router.cluster.test.",
+		},
+		&mdns.A{
+			Hdr: mdns.RR_Header{Name: "acs-data-tenant.acs.test.", Rrtype: mdns.TypeA, Class: mdns.ClassINET, Ttl: 300},
+			A:   net.ParseIP("172.18.0.2"),
+		},
+		soa,
+	)
+	p, err := NewRFC2136Provider(RFC2136Options{Server: server, Timeout: 5 * time.Second})
+	require.NoError(t, err)
+
+	records, err := p.ListRecords(context.Background(), testZone)
+	require.NoError(t, err)
+	assert.Equal(t, []Record{
+		{Name: "acs-tenant.acs.test", Type: RecordTypeCNAME, TTL: 300, Value: "router.cluster.test"},
+		{Name: "acs-data-tenant.acs.test", Type: RecordTypeA, TTL: 300, Value: "172.18.0.2"},
+	}, records)
+
+	msg := (<-requests).msg
+	require.Len(t, msg.Question, 1)
+	assert.Equal(t, mdns.TypeAXFR, msg.Question[0].Qtype)
+}
+
+type dnsRequest struct {
+	msg        *mdns.Msg
+	tsigStatus error
+}
+
+// startDNSServer serves DNS over TCP with the TSIG secrets. It sends the first request to the returned channel and
+// responds to the requests with the rcode and the answers. Requests with an invalid signature are rejected.
+func startDNSServer(t *testing.T, tsigSecret map[string]string, rcode int, answers ...mdns.RR) (string, <-chan dnsRequest) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	requests := make(chan dnsRequest, 1)
+
+	started := make(chan struct{})
+	server := &mdns.Server{
+		Listener:          listener,
+		TsigSecret:        tsigSecret,
+		NotifyStartedFunc: func() { close(started) },
+		// The default accepts only queries and notifies
+		MsgAcceptFunc: func(mdns.Header) mdns.MsgAcceptAction { return mdns.MsgAccept },
+		Handler: mdns.HandlerFunc(func(w mdns.ResponseWriter, r *mdns.Msg) {
+			request := dnsRequest{msg: r}
+			response := new(mdns.Msg).SetRcode(r, rcode)
+			if tsig := r.IsTsig(); tsig != nil {
+				request.tsigStatus = w.TsigStatus()
+				if request.tsigStatus != nil {
+					response.SetRcode(r, mdns.RcodeNotAuth)
+				} else {
+					response.SetTsig(tsig.Hdr.Name, tsig.Algorithm, tsigFudge, time.Now().Unix())
+				}
+			}
+			select {
+			case requests <- request:
+			default:
+			}
+			response.Answer = answers
+			_ = w.WriteMsg(response)
+		}),
+	}
+	go func() { _ = server.ActivateAndServe() }()
+	<-started
+	t.Cleanup(func() { _ = server.Shutdown() })
+	return listener.Addr().String(), requests
+}
@@ -0,0 +1,206 @@
+package dns
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	mdns "github.com/miekg/dns"
+	"github.com/pkg/errors"
+)
+
+// tsigFudge is the permitted clock skew in seconds between fleet-manager and the DNS server
+const tsigFudge = 300
+
+var tsigAlgorithms = map[string]string{
+	"hmac-sha1":   mdns.HmacSHA1,
+	"hmac-sha256": mdns.HmacSHA256,
+	"hmac-sha512": mdns.HmacSHA512,
+}
+
+// RFC2136Options ...
+type RFC2136Options struct {
+	// Server is the address (host:port) of the DNS server accepting dynamic updates
+	Server string
+	// TSIGKeyName is the name of the key used to sign the updates. Updates are not signed if it is empty.
+	TSIGKeyName string
+	// TSIGAlgorithm is one of hmac-sha1, hmac-sha256 or hmac-sha512
+	TSIGAlgorithm string
+	// TSIGSecret is the base64 encoded secret of the key
+	TSIGSecret string
+	Timeout    time.Duration
+}
+
+// RFC2136Provider manages the records with RFC 2136 dynamic updates sent over TCP to the primary DNS server of the zone.
+// The server applies an update before it responds, so that changes are in sync as soon as they are accepted.
+type RFC2136Provider struct {
+	server        string
+	tsigKeyName   string
+	tsigAlgorithm string
+	tsigSecret    map[string]string
+	timeout       time.Duration
+}
+
+var _ DNSProvider = &RFC2136Provider{}
+
+// NewRFC2136Provider ...
+func NewRFC2136Provider(opts RFC2136Options) (*RFC2136Provider, error) {
+	p := &RFC2136Provider{
+		server:  opts.Server,
+		timeout: opts.Timeout,
+	}
+	if opts.TSIGKeyName == "" {
+		return p, nil
+	}
+	algorithm, ok := tsigAlgorithms[strings.TrimSuffix(strings.ToLower(opts.TSIGAlgorithm), ".")]
+	if !ok {
+		return nil, fmt.Errorf("unsupported TSIG algorithm %q", opts.TSIGAlgorithm)
+	}
+	secret := strings.TrimSpace(opts.TSIGSecret)
+	if _, err := base64.StdEncoding.DecodeString(secret); err != nil {
+		return nil, errors.Wrap(err, "decoding TSIG secret")
+	}
+	p.tsigKeyName = mdns.CanonicalName(opts.TSIGKeyName)
+	p.tsigAlgorithm = algorithm
+	p.tsigSecret = map[string]string{p.tsigKeyName: secret}
+	return p, nil
+}
+
+// UpsertRecords replaces the record sets with the same name and type in a single update
+func (p *RFC2136Provider) UpsertRecords(ctx context.Context, zone string, records []Record) (*Change, error) {
+	return p.update(ctx, zone, records, true)
+}
+
+// DeleteRecords deletes the record sets with the same name and type in a single update
+func (p *RFC2136Provider) DeleteRecords(ctx context.Context, zone string, records []Record) (*Change, error) {
+	return p.update(ctx, zone, records, false)
+}
+
+// GetChangeStatus returns in sync for every change, as updates are applied before the server responds
+func (p *RFC2136Provider) GetChangeStatus(_ context.Context, changeID string) (*Change, error) {
+	return &Change{ID: changeID, Status: ChangeStatusInSync}, nil
+}
+
+// ListRecords transfers the zone from the server with AXFR, see RFC 5936. The server must allow zone transfers to
+// fleet-manager.
+func (p *RFC2136Provider) ListRecords(ctx context.Context, zone string) ([]Record, error) {
+	msg := new(mdns.Msg).SetAxfr(fqdn(zone))
+	p.sign(msg)
+
+	dialer := &net.Dialer{Timeout: p.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", p.server)
+	if err != nil {
+		return nil, errors.Wrapf(err, "connecting to %s", p.server)
+	}
+	transfer := &mdns.Transfer{
+		Conn:         &mdns.Conn{Conn: conn},
+		ReadTimeout:  p.timeout,
+		WriteTimeout: p.timeout,
+		TsigSecret:   p.tsigSecret,
+	}
+	envelopes, err := transfer.In(msg, p.server)
+	if err != nil {
+		_ = conn.Close()
+		return nil, errors.Wrapf(err, "transferring zone %s from %s", zone, p.server)
+	}
+	var records []Record
+	var transferErr error
+	// The channel has to be drained so that the transfer closes the connection
+	for envelope := range envelopes {
+		if envelope.Error != nil {
+			transferErr = envelope.Error
+			continue
+		}
+		for _, rr := range envelope.RR {
+			if record := toRecord(rr); record != nil {
+				records = append(records, *record)
+			}
+		}
+	}
+	if transferErr != nil {
+		return nil, errors.Wrapf(transferErr, "transferring zone %s from %s", zone, p.server)
+	}
+	return records, nil
+}
+
+func (p *RFC2136Provider) update(ctx context.Context, zone string, records []Record, add bool) (*Change, error) {
+	msg := new(mdns.Msg).SetUpdate(fqdn(zone))
+	// See RFC 2136 section 2.5: the update deletes the record sets of the records and adds them again if add is set
+	for _, r := range records {
+		rr, err := toRR(r)
+		if err != nil {
+			return nil, errors.Wrap(err, "building DNS update")
+		}
+		msg.RemoveRRset([]mdns.RR{rr})
+		if add {
+			if recordType, ok := replacedRecordType(r.Type); ok {
+				msg.RemoveRRset([]mdns.RR{&mdns.ANY{Hdr: mdns.RR_Header{Name: fqdn(r.Name), Rrtype: mdns.StringToType[recordType]}}})
+			}
+			msg.Insert([]mdns.RR{rr})
+		}
+	}
+	p.sign(msg)
+
+	client := &mdns.Client{Net: "tcp", Timeout: p.timeout, TsigSecret: p.tsigSecret}
+	response, _, err := client.ExchangeContext(ctx, msg, p.server)
+	if err != nil {
+		return nil, errors.Wrapf(err, "updating zone %s on %s", zone, p.server)
+	}
+	if response.Rcode != mdns.RcodeSuccess {
+		return nil, fmt.Errorf("updating zone %s on %s: request rejected: %s", zone, p.server, mdns.RcodeToString[response.Rcode])
+	}
+	return &Change{ID: uuid.New().String(), Status: ChangeStatusInSync}, nil
+}
+
+// sign adds a transaction signature to the message if a TSIG key is configured. The signature is computed when the
+// message is sent.
+func (p *RFC2136Provider) sign(msg *mdns.Msg) {
+	if p.tsigKeyName != "" {
+		msg.SetTsig(p.tsigKeyName, p.tsigAlgorithm, tsigFudge, time.Now().Unix())
+	}
+}
+
+func toRR(r Record) (mdns.RR, error) {
+	header := mdns.RR_Header{Name: fqdn(r.Name), Class: mdns.ClassINET, Ttl: uint32(r.TTL)}
+	switch strings.ToUpper(r.Type) {
+	case RecordTypeCNAME:
+		header.Rrtype = mdns.TypeCNAME
+		return &mdns.CNAME{Hdr: header, Target: fqdn(r.Value)}, nil
+	case RecordTypeA:
+		ip := net.ParseIP(r.Value).To4()
+		if ip == nil {
+			return nil, fmt.Errorf("invalid IPv4 address %q", r.Value)
+		}
+		header.Rrtype = mdns.TypeA
+		return &mdns.A{Hdr: header, A: ip}, nil
+	case RecordTypeTXT:
+		header.Rrtype = mdns.TypeTXT
+		return &mdns.TXT{Hdr: header, Txt: []string{r.Value}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported record type %q", r.Type)
+	}
+}
+
+// toRecord returns the record of a CNAME, A or TXT resource record and nil for all other types
+func toRecord(rr mdns.RR) *Record {
+	h := rr.Header()
+	record := &Record{Name: NormalizeName(h.Name), TTL: int64(h.Ttl)}
+	switch r := rr.(type) {
+	case *mdns.CNAME:
+		record.Type = RecordTypeCNAME
+		record.Value = NormalizeName(r.Target)
+	case *mdns.A:
+		record.Type = RecordTypeA
+		record.Value = r.A.String()
+	case *mdns.TXT:
+		record.Type = RecordTypeTXT
+		record.Value = strings.Join(r.Txt, "")
+	default:
+		return nil
+	}
+	return record
+}
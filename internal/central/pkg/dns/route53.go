@@ -0,0 +1,189 @@
+package dns
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/aws"
+)
+
+// route53Region is the region of the Route53 API. Route53 is a global service, all regions serve the same zones.
+const route53Region = "us-east-1"
+
+// Route53Provider manages the records in the AWS Route53 hosted zone of the zone name
+type Route53Provider struct {
+	clientFactory aws.ClientFactory
+	credentials   aws.Config
+}
+
+var _ DNSProvider = &Route53Provider{}
+
+// NewRoute53Provider ...
+func NewRoute53Provider(clientFactory aws.ClientFactory, credentials aws.Config) *Route53Provider {
+	return &Route53Provider{
+		clientFactory: clientFactory,
+		credentials:   credentials,
+	}
+}
+
+// UpsertRecords ...
+func (p *Route53Provider) UpsertRecords(_ context.Context, zone string, records []Record) (*Change, error) {
+	client, err := p.clientFactory.NewClient(p.credentials, route53Region)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create aws client")
+	}
+	// Route53 rejects the upsert of a CNAME record for a name with A records and vice versa, so the record sets of the
+	// other type are deleted in the same batch
+	deletes, err := replacedRecordSets(client, zone, records)
+	if err != nil {
+		return nil, err
+	}
+	return changeRecords(client, zone, deletes, records, types.ChangeActionUpsert)
+}
+
+// DeleteRecords ...
+func (p *Route53Provider) DeleteRecords(_ context.Context, zone string, records []Record) (*Change, error) {
+	client, err := p.clientFactory.NewClient(p.credentials, route53Region)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create aws client")
+	}
+	return changeRecords(client, zone, nil, records, types.ChangeActionDelete)
+}
+
+// GetChangeStatus ...
+func (p *Route53Provider) GetChangeStatus(_ context.Context, changeID string) (*Change, error) {
+	client, err := p.clientFactory.NewClient(p.credentials, route53Region)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create aws client")
+	}
+	output, err := client.GetChange(changeID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get status of change %q", changeID)
+	}
+	return route53Change(output.ChangeInfo)
+}
+
+// ListRecords ...
+func (p *Route53Provider) ListRecords(_ context.Context, zone string) ([]Record, error) {
+	client, err := p.clientFactory.NewClient(p.credentials, route53Region)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create aws client")
+	}
+	recordSets, err := client.ListResourceRecordSets(zone)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list record sets of zone %s", zone)
+	}
+	var records []Record
+	for _, rs := range recordSets {
+		if rs.Name == nil {
+			continue
+		}
+		switch rs.Type {
+		case types.RRTypeCname, types.RRTypeA, types.RRTypeTxt:
+		default:
+			continue
+		}
+		// Alias records have no values and are not returned
+		for _, rr := range rs.ResourceRecords {
+			if rr.Value == nil {
+				continue
+			}
+			record := Record{Name: NormalizeName(*rs.Name), Type: string(rs.Type), Value: *rr.Value}
+			if rs.TTL != nil {
+				record.TTL = *rs.TTL
+			}
+			switch rs.Type {
+			case types.RRTypeCname:
+				record.Value = NormalizeName(record.Value)
+			case types.RRTypeTxt:
+				record.Value = unquoteTXT(record.Value)
+			}
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+// replacedRecordSets returns the deletions of the record sets which are replaced by records of another type with the
+// same name
+func replacedRecordSets(client aws.Client, zone string, records []Record) ([]types.Change, error) {
+	replaced := map[string]bool{}
+	for _, r := range records {
+		if recordType, ok := replacedRecordType(r.Type); ok {
+			replaced[NormalizeName(r.Name)+"/"+recordType] = true
+		}
+	}
+	if len(replaced) == 0 {
+		return nil, nil
+	}
+	recordSets, err := client.ListResourceRecordSets(zone)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list record sets of zone %s", zone)
+	}
+	var changes []types.Change
+	for i := range recordSets {
+		rs := recordSets[i]
+		if rs.Name == nil || !replaced[NormalizeName(*rs.Name)+"/"+string(rs.Type)] {
+			continue
+		}
+		changes = append(changes, types.Change{Action: types.ChangeActionDelete, ResourceRecordSet: &rs})
+	}
+	return changes, nil
+}
+
+// changeRecords applies the action to the records in a single batch, after the given changes
+func changeRecords(client aws.Client, zone string, changes []types.Change, records []Record, action types.ChangeAction) (*Change, error) {
+	batch := &types.ChangeBatch{Changes: changes}
+	for _, r := range records {
+		value := r.Value
+		if r.Type == RecordTypeTXT {
+			value = strconv.Quote(value)
+		}
+		batch.Changes = append(batch.Changes, types.Change{
+			Action: action,
+			ResourceRecordSet: &types.ResourceRecordSet{
+				Name: &r.Name,
+				Type: types.RRType(r.Type),
+				TTL:  &r.TTL,
+				ResourceRecords: []types.ResourceRecord{
+					{Value: &value},
+				},
+			},
+		})
+	}
+	output, err := client.ChangeResourceRecordSets(zone, batch)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to change record sets")
+	}
+	return route53Change(output.ChangeInfo)
+}
+
+// unquoteTXT returns the value of a TXT record, which Route53 returns as one or more quoted strings
+func unquoteTXT(value string) string {
+	var b strings.Builder
+	rest := strings.TrimSpace(value)
+	for rest != "" {
+		quoted, err := strconv.QuotedPrefix(rest)
+		if err != nil {
+			b.WriteString(rest)
+			break
+		}
+		unquoted, _ := strconv.Unquote(quoted)
+		b.WriteString(unquoted)
+		rest = strings.TrimSpace(rest[len(quoted):])
+	}
+	return b.String()
+}
+
+func route53Change(info *types.ChangeInfo) (*Change, error) {
+	if info == nil || info.Id == nil || info.Status == "" {
+		return nil, errors.New("route53 returned no change info")
+	}
+	return &Change{
+		ID:     *info.Id,
+		Status: ChangeStatus(info.Status),
+	}, nil
+}
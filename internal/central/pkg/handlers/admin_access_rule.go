@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/presenters"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/acl"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
+)
+
+// AdminAccessRuleHandler is the interface for the admin access rule handler
+type AdminAccessRuleHandler interface {
+	// Create adds a deny or allow rule for users of the public API
+	Create(w http.ResponseWriter, r *http.Request)
+	// List returns all rules including expired ones
+	List(w http.ResponseWriter, r *http.Request)
+	// Delete removes a rule
+	Delete(w http.ResponseWriter, r *http.Request)
+}
+
+type adminAccessRuleHandler struct {
+	service     services.AccessRuleService
+	accessRules *acl.AccessRuleCache
+}
+
+var _ AdminAccessRuleHandler = (*adminAccessRuleHandler)(nil)
+
+// NewAdminAccessRuleHandler ...
+func NewAdminAccessRuleHandler(service services.AccessRuleService, accessRules *acl.AccessRuleCache) AdminAccessRuleHandler {
+	return &adminAccessRuleHandler{
+		service:     service,
+		accessRules: accessRules,
+	}
+}
+
+// Create ...
+func (h adminAccessRuleHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ruleRequest := private.AccessRuleRequest{}
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &ruleRequest,
+		Action: func() (interface{}, *errors.ServiceError) {
+			rule, svcErr := h.service.Create(r.Context(), presenters.ConvertAccessRuleRequest(ruleRequest))
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			// other replicas pick the rule up with their next refresh
+			h.accessRules.Invalidate()
+			return presenters.PresentAccessRule(rule), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+// List ...
+func (h adminAccessRuleHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			rules, svcErr := h.service.List()
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			ruleList := private.AccessRuleList{
+				Kind:  "AccessRuleList",
+				Page:  1,
+				Size:  int32(len(rules)),
+				Total: int32(len(rules)),
+				Items: make([]private.AccessRule, 0, len(rules)),
+			}
+			for _, rule := range rules {
+				ruleList.Items = append(ruleList.Items, presenters.PresentAccessRule(rule))
+			}
+			return ruleList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Delete ...
+func (h adminAccessRuleHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			if svcErr := h.service.Delete(mux.Vars(r)["id"]); svcErr != nil {
+				return nil, svcErr
+			}
+			h.accessRules.Invalidate()
+			return nil, nil
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/acl"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAccessRuleCache(service services.AccessRuleService) *acl.AccessRuleCache {
+	return acl.NewAccessRuleCache(service, &acl.AccessControlListConfig{AccessRulesRefreshInterval: time.Hour})
+}
+
+func TestAdminAccessRuleCreate(t *testing.T) {
+	activeRules := acl.AccessRules{}
+	service := &services.AccessRuleServiceMock{
+		CreateFunc: func(ctx context.Context, rule *dbapi.AccessRule) (*dbapi.AccessRule, *errors.ServiceError) {
+			rule.Meta = api.Meta{ID: "rule-1"}
+			activeRules = append(activeRules, &acl.AccessRule{ID: rule.ID, Action: acl.AccessRuleAction(rule.Action),
+				Type: acl.AccessRuleType(rule.Type), Value: rule.Value})
+			return rule, nil
+		},
+		ListActiveAccessRulesFunc: func() (acl.AccessRules, error) {
+			return activeRules, nil
+		},
+	}
+	cache := newTestAccessRuleCache(service)
+	subject := acl.AccessSubject{Username: "user", OrgID: "12345"}
+	require.Nil(t, cache.Match(subject))
+
+	rec := httptest.NewRecorder()
+	NewAdminAccessRuleHandler(service, cache).Create(rec, httptest.NewRequest(http.MethodPost,
+		"/api/rhacs/v1/admin/access-rules",
+		strings.NewReader(`{"action":"deny","type":"organisation_id","value":"12345","reason":"abuse"}`)))
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	require.Len(t, service.CreateCalls(), 1)
+	assert.Equal(t, "abuse", service.CreateCalls()[0].Rule.Reason)
+	var rule private.AccessRule
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&rule))
+	assert.Equal(t, "rule-1", rule.Id)
+	assert.Equal(t, "/api/rhacs/v1/admin/access-rules/rule-1", rule.Href)
+	assert.NotNil(t, cache.Match(subject), "the cache should be reloaded after creating a rule")
+}
+
+func TestAdminAccessRuleCreateInvalid(t *testing.T) {
+	service := &services.AccessRuleServiceMock{
+		CreateFunc: func(ctx context.Context, rule *dbapi.AccessRule) (*dbapi.AccessRule, *errors.ServiceError) {
+			return nil, errors.Validation("invalid access rule")
+		},
+	}
+	rec := httptest.NewRecorder()
+	NewAdminAccessRuleHandler(service, newTestAccessRuleCache(service)).Create(rec, httptest.NewRequest(http.MethodPost,
+		"/api/rhacs/v1/admin/access-rules", strings.NewReader(`{"action":"block","type":"username","value":"user"}`)))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestAdminAccessRuleList(t *testing.T) {
+	service := &services.AccessRuleServiceMock{
+		ListFunc: func() ([]*dbapi.AccessRule, *errors.ServiceError) {
+			return []*dbapi.AccessRule{
+				{Meta: api.Meta{ID: "rule-2"}, Action: "allow", Type: "username", Value: "user"},
+				{Meta: api.Meta{ID: "rule-1"}, Action: "deny", Type: "pattern", Value: "spam-*"},
+			}, nil
+		},
+	}
+	rec := httptest.NewRecorder()
+	NewAdminAccessRuleHandler(service, newTestAccessRuleCache(service)).List(rec,
+		httptest.NewRequest(http.MethodGet, "/api/rhacs/v1/admin/access-rules", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var ruleList private.AccessRuleList
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&ruleList))
+	assert.Equal(t, int32(2), ruleList.Total)
+	require.Len(t, ruleList.Items, 2)
+	assert.Equal(t, "rule-2", ruleList.Items[0].Id)
+	assert.Equal(t, "spam-*", ruleList.Items[1].Value)
+}
+
+func TestAdminAccessRuleDelete(t *testing.T) {
+	service := &services.AccessRuleServiceMock{
+		DeleteFunc: func(id string) *errors.ServiceError {
+			if id != "rule-1" {
+				return errors.NotFound("AccessRule with id='%s' not found", id)
+			}
+			return nil
+		},
+	}
+	handler := NewAdminAccessRuleHandler(service, newTestAccessRuleCache(service))
+
+	rec := httptest.NewRecorder()
+	handler.Delete(rec, mux.SetURLVars(httptest.NewRequest(http.MethodDelete, "/api/rhacs/v1/admin/access-rules/rule-1", nil),
+		map[string]string{"id": "rule-1"}))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.Delete(rec, mux.SetURLVars(httptest.NewRequest(http.MethodDelete, "/api/rhacs/v1/admin/access-rules/unknown", nil),
+		map[string]string{"id": "unknown"}))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/presenters"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
+	"github.com/stackrox/acs-fleet-manager/pkg/logger"
+	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+	"github.com/stackrox/acs-fleet-manager/pkg/shared"
+)
+
+// AdminAuditHandler is the interface for the admin audit handler
+type AdminAuditHandler interface {
+	// Audit wraps a handler mutating the central of the `id` path variable. The central is recorded in the audit
+	// trail before and after each successful request, in the transaction of the request. The request fails and its
+	// transaction is rolled back if the event cannot be recorded.
+	Audit(next http.HandlerFunc) http.HandlerFunc
+	// ListCentralAuditEvents returns the audit events of a central
+	ListCentralAuditEvents(w http.ResponseWriter, r *http.Request)
+	// VerifyChain verifies the hash chain of all audit events
+	VerifyChain(w http.ResponseWriter, r *http.Request)
+}
+
+type adminAuditHandler struct {
+	service services.AdminAuditService
+}
+
+var _ AdminAuditHandler = (*adminAuditHandler)(nil)
+
+// NewAdminAuditHandler ...
+func NewAdminAuditHandler(service services.AdminAuditService) AdminAuditHandler {
+	return &adminAuditHandler{service: service}
+}
+
+// Audit ...
+func (h adminAuditHandler) Audit(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		centralID := mux.Vars(r)["id"]
+		before, svcErr := h.service.SnapshotCentral(r.Context(), centralID)
+		if svcErr != nil {
+			// the mutation is not made if it cannot be audited
+			shared.HandleError(r, w, svcErr)
+			return
+		}
+
+		// the response is held back until the event is recorded, so that the request fails if it cannot be recorded
+		response := newBufferedResponse()
+		next(response, r)
+		if response.statusCode >= http.StatusBadRequest {
+			response.flush(w)
+			return
+		}
+
+		if svcErr := h.record(r, centralID, before); svcErr != nil {
+			glog.Errorf("Failed to record audit event of %s %s: %v", r.Method, r.URL.Path, svcErr)
+			metrics.IncreaseAdminAuditRecordFailureCountMetric()
+			// the database changes of the request are discarded, changes of external systems are not reverted
+			db.MarkForRollback(r.Context(), svcErr)
+			shared.HandleError(r, w, errors.GeneralError("the change of central %s was rolled back as it could not be recorded in the audit trail", centralID))
+			return
+		}
+		response.flush(w)
+	}
+}
+
+// record snapshots the central after the mutation and records the audit event
+func (h adminAuditHandler) record(r *http.Request, centralID string, before api.JSON) *errors.ServiceError {
+	after, svcErr := h.service.SnapshotCentral(r.Context(), centralID)
+	if svcErr != nil {
+		return svcErr
+	}
+	event := &dbapi.AuditEvent{
+		Method:   r.Method,
+		TargetID: centralID,
+		Before:   before,
+		After:    after,
+	}
+	if route := mux.CurrentRoute(r); route != nil {
+		event.RouteName = logger.NewLogEventFromString(route.GetName()).Type
+	}
+	if claims, err := auth.GetClaimsFromContext(r.Context()); err == nil {
+		event.Actor, _ = claims.GetUsername()
+	}
+	return h.service.Record(r.Context(), event)
+}
+
+// ListCentralAuditEvents ...
+func (h adminAuditHandler) ListCentralAuditEvents(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			events, svcErr := h.service.ListByTargetID(mux.Vars(r)["id"])
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			eventList := private.AuditEventList{
+				Kind:  "AuditEventList",
+				Page:  1,
+				Size:  int32(len(events)),
+				Total: int32(len(events)),
+				Items: make([]private.AuditEvent, 0, len(events)),
+			}
+			for _, event := range events {
+				item, svcErr := presenters.PresentAuditEvent(event)
+				if svcErr != nil {
+					return nil, svcErr
+				}
+				eventList.Items = append(eventList.Items, item)
+			}
+			return eventList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// VerifyChain ...
+func (h adminAuditHandler) VerifyChain(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			verification, svcErr := h.service.VerifyChain()
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return presenters.PresentAuditChainVerification(verification), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// bufferedResponse keeps the response of the audited handler until it is flushed
+type bufferedResponse struct {
+	header      http.Header
+	statusCode  int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func newBufferedResponse() *bufferedResponse {
+	return &bufferedResponse{header: http.Header{}, statusCode: http.StatusOK}
+}
+
+// Header ...
+func (b *bufferedResponse) Header() http.Header {
+	return b.header
+}
+
+// Write ...
+func (b *bufferedResponse) Write(p []byte) (int, error) {
+	b.wroteHeader = true
+	return b.body.Write(p)
+}
+
+// WriteHeader ...
+func (b *bufferedResponse) WriteHeader(statusCode int) {
+	if b.wroteHeader {
+		return
+	}
+	b.wroteHeader = true
+	b.statusCode = statusCode
+}
+
+// flush writes the response to w
+func (b *bufferedResponse) flush(w http.ResponseWriter) {
+	for key, values := range b.header {
+		w.Header()[key] = values
+	}
+	w.WriteHeader(b.statusCode)
+	_, _ = w.Write(b.body.Bytes())
+}
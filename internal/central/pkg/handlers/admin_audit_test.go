@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	mocket "github.com/selvatico/go-mocket"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const auditedCentralID = "audited-central"
+
+func newAuditedRouter(auditHandler AdminAuditHandler, status int) *mux.Router {
+	router := mux.NewRouter()
+	router.HandleFunc("/centrals/{id}/name", auditHandler.Audit(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	})).Name(logger.NewLogEvent("admin-name", "[admin] set `name` central property").ToString())
+	return router
+}
+
+func TestAdminAuditRecordsSuccessfulMutation(t *testing.T) {
+	snapshots := []api.JSON{api.JSON(`{"name":"before"}`), api.JSON(`{"name":"after"}`)}
+	auditService := &services.AdminAuditServiceMock{
+		SnapshotCentralFunc: func(_ context.Context, centralID string) (api.JSON, *errors.ServiceError) {
+			snapshot := snapshots[0]
+			snapshots = snapshots[1:]
+			return snapshot, nil
+		},
+		RecordFunc: func(_ context.Context, event *dbapi.AuditEvent) *errors.ServiceError {
+			return nil
+		},
+	}
+	rec := httptest.NewRecorder()
+	newAuditedRouter(NewAdminAuditHandler(auditService), http.StatusOK).
+		ServeHTTP(rec, httptest.NewRequest(http.MethodPatch, "/centrals/"+auditedCentralID+"/name", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, auditService.RecordCalls(), 1)
+	event := auditService.RecordCalls()[0].Event
+	assert.Equal(t, "admin-name", event.RouteName)
+	assert.Equal(t, http.MethodPatch, event.Method)
+	assert.Equal(t, auditedCentralID, event.TargetID)
+	assert.JSONEq(t, `{"name":"before"}`, string(event.Before))
+	assert.JSONEq(t, `{"name":"after"}`, string(event.After))
+}
+
+func TestAdminAuditSkipsFailedMutation(t *testing.T) {
+	auditService := &services.AdminAuditServiceMock{
+		SnapshotCentralFunc: func(_ context.Context, centralID string) (api.JSON, *errors.ServiceError) {
+			return api.JSON(`{}`), nil
+		},
+	}
+	rec := httptest.NewRecorder()
+	newAuditedRouter(NewAdminAuditHandler(auditService), http.StatusBadRequest).
+		ServeHTTP(rec, httptest.NewRequest(http.MethodPatch, "/centrals/"+auditedCentralID+"/name", nil))
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Len(t, auditService.SnapshotCentralCalls(), 1)
+	assert.Empty(t, auditService.RecordCalls())
+}
+
+func TestAdminAuditRejectsMutationWhichCannotBeAudited(t *testing.T) {
+	auditService := &services.AdminAuditServiceMock{
+		SnapshotCentralFunc: func(_ context.Context, centralID string) (api.JSON, *errors.ServiceError) {
+			return nil, errors.GeneralError("database unavailable")
+		},
+	}
+	called := false
+	router := mux.NewRouter()
+	router.HandleFunc("/centrals/{id}/name", NewAdminAuditHandler(auditService).Audit(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPatch, "/centrals/"+auditedCentralID+"/name", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.False(t, called)
+}
+
+func TestAdminAuditFailsMutationWhichCannotBeRecorded(t *testing.T) {
+	connectionFactory := db.NewMockConnectionFactory(nil)
+	mocket.Catcher.Reset().NewMock().WithQuery("txid_current").WithReply([]map[string]interface{}{{"txid_current": 1}})
+	committed, rolledBack := false, false
+	mocket.HookBadCommit = func() bool {
+		committed = true
+		return false
+	}
+	mocket.HookBadRollback = func() bool {
+		rolledBack = true
+		return false
+	}
+	t.Cleanup(func() {
+		mocket.HookBadCommit = nil
+		mocket.HookBadRollback = nil
+	})
+	auditService := &services.AdminAuditServiceMock{
+		SnapshotCentralFunc: func(_ context.Context, centralID string) (api.JSON, *errors.ServiceError) {
+			return api.JSON(`{}`), nil
+		},
+		RecordFunc: func(_ context.Context, event *dbapi.AuditEvent) *errors.ServiceError {
+			return errors.GeneralError("database unavailable")
+		},
+	}
+	router := mux.NewRouter()
+	router.Use(db.TransactionMiddleware(connectionFactory))
+	router.HandleFunc("/centrals/{id}/name", NewAdminAuditHandler(auditService).Audit(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"name":"after"}`))
+	}))
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPatch, "/centrals/"+auditedCentralID+"/name", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.NotContains(t, rec.Body.String(), `"after"`)
+	assert.Len(t, auditService.RecordCalls(), 1)
+	assert.True(t, rolledBack, "the mutation is rolled back with the transaction of the request")
+	assert.False(t, committed)
+}
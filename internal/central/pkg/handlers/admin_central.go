@@ -52,6 +52,8 @@ type AdminCentralHandler interface {
 	// a tenant. In particular, avoid two Central CRs appearing in the same
 	// tenant namespace. This may cause conflicts due to mixed resource ownership.
 	PatchName(w http.ResponseWriter, r *http.Request)
+	// PatchClientRotationWindow sets the daily time window in which the OIDC client of the central is rotated
+	PatchClientRotationWindow(w http.ResponseWriter, r *http.Request)
 	// AssignCluster assigns the dataplane cluster_id of the central tenant to
 	// the given cluster_id in the requests body.
 	AssignCluster(w http.ResponseWriter, r *http.Request)
@@ -232,7 +234,7 @@ func (h adminCentralHandler) DbDelete(w http.ResponseWriter, r *http.Request) {
 				return nil, err
 			}
 
-			err = h.service.Delete(centralRequest, true)
+			err = h.service.Delete(r.Context(), centralRequest, true)
 			return nil, err
 		},
 	}
@@ -299,7 +301,7 @@ func (h adminCentralHandler) PatchExpiredAt(w http.ResponseWriter, r *http.Reque
 			}
 			glog.Warningf("Setting expired_at to %q for central %q: %s", expired_at, id, reason)
 			central := &dbapi.CentralRequest{Meta: api.Meta{ID: id}}
-			return nil, h.service.Updates(central, map[string]interface{}{
+			return nil, h.service.Updates(r.Context(), central, map[string]interface{}{
 				"expired_at": &expired_at,
 			})
 		},
@@ -321,7 +323,7 @@ func (h adminCentralHandler) PatchName(w http.ResponseWriter, r *http.Request) {
 			id := mux.Vars(r)["id"]
 			glog.Infof("Setting name to %q for central %q: %s", updateNameRequest.Name, id, updateNameRequest.Reason)
 			central := &dbapi.CentralRequest{Meta: api.Meta{ID: id}}
-			return nil, h.service.Updates(central, map[string]interface{}{
+			return nil, h.service.Updates(r.Context(), central, map[string]interface{}{
 				"name": &updateNameRequest.Name,
 			})
 		},
@@ -329,6 +331,31 @@ func (h adminCentralHandler) PatchName(w http.ResponseWriter, r *http.Request) {
 	handlers.Handle(w, r, cfg, http.StatusOK)
 }
 
+func (h adminCentralHandler) PatchClientRotationWindow(w http.ResponseWriter, r *http.Request) {
+	windowRequest := private.CentralUpdateClientRotationWindowRequest{}
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &windowRequest,
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&windowRequest.Reason, "reason", &handlers.MinRequiredFieldLength, &handlers.MaxServiceAccountDescLength),
+			func() *errors.ServiceError {
+				if _, err := config.ParseDailyWindow(windowRequest.Window); err != nil {
+					return errors.Validation("invalid client rotation window: %s", err.Error())
+				}
+				return nil
+			},
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			glog.Infof("Setting OIDC client rotation window to %q for central %q: %s", windowRequest.Window, id, windowRequest.Reason)
+			central := &dbapi.CentralRequest{Meta: api.Meta{ID: id}}
+			return nil, h.service.Updates(r.Context(), central, map[string]interface{}{
+				"client_rotation_window": windowRequest.Window,
+			})
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
 func (h adminCentralHandler) AssignCluster(w http.ResponseWriter, r *http.Request) {
 	assignClusterRequest := private.CentralAssignClusterRequest{}
 	centralID := mux.Vars(r)["id"]
@@ -390,7 +417,7 @@ func (h adminCentralHandler) AddTrait(w http.ResponseWriter, r *http.Request) {
 			id := mux.Vars(r)["id"]
 			trait := mux.Vars(r)["trait"]
 			central := &dbapi.CentralRequest{Meta: api.Meta{ID: id}}
-			if svcErr := h.service.Updates(central, map[string]interface{}{
+			if svcErr := h.service.Updates(r.Context(), central, map[string]interface{}{
 				"traits": gorm.Expr(`(SELECT array_agg(DISTINCT v) FROM unnest(array_append(traits, ?)) AS traits_tmp(v))`, trait),
 			}); svcErr != nil {
 				return nil, errors.NewWithCause(svcErr.Code, svcErr, "Could not update central traits")
@@ -408,7 +435,7 @@ func (h adminCentralHandler) DeleteTrait(w http.ResponseWriter, r *http.Request)
 			id := mux.Vars(r)["id"]
 			trait := mux.Vars(r)["trait"]
 			central := &dbapi.CentralRequest{Meta: api.Meta{ID: id}}
-			if svcErr := h.service.Updates(central, map[string]interface{}{
+			if svcErr := h.service.Updates(r.Context(), central, map[string]interface{}{
 				"traits": gorm.Expr(`array_remove(traits, ?)`, trait),
 			}); svcErr != nil {
 				return nil, errors.NewWithCause(svcErr.Code, svcErr, "Could not update central traits")
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/presenters"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
+)
+
+// AdminCentralMigrationHandler is the interface for the admin central migration handler
+type AdminCentralMigrationHandler interface {
+	// Create starts migrating a central to another data plane cluster
+	Create(w http.ResponseWriter, r *http.Request)
+	// List returns the migrations of a central
+	List(w http.ResponseWriter, r *http.Request)
+	// Rollback moves a central whose migration failed back to its source cluster
+	Rollback(w http.ResponseWriter, r *http.Request)
+}
+
+type adminCentralMigrationHandler struct {
+	service services.CentralMigrationService
+}
+
+var _ AdminCentralMigrationHandler = (*adminCentralMigrationHandler)(nil)
+
+// NewAdminCentralMigrationHandler ...
+func NewAdminCentralMigrationHandler(service services.CentralMigrationService) AdminCentralMigrationHandler {
+	return &adminCentralMigrationHandler{service: service}
+}
+
+// Create ...
+func (h adminCentralMigrationHandler) Create(w http.ResponseWriter, r *http.Request) {
+	migrationRequest := private.CentralMigrationRequest{}
+	centralID := mux.Vars(r)["id"]
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &migrationRequest,
+		Validate: []handlers.Validate{
+			handlers.ValidateMinLength(&centralID, "id", handlers.MinRequiredFieldLength),
+			handlers.ValidateMinLength(&migrationRequest.TargetClusterId, "target_cluster_id", handlers.MinRequiredFieldLength),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			migration, svcErr := h.service.Start(r.Context(), centralID, migrationRequest.TargetClusterId)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return presenters.PresentCentralMigration(migration), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// List ...
+func (h adminCentralMigrationHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			migrations, svcErr := h.service.List(mux.Vars(r)["id"])
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			migrationList := private.CentralMigrationList{
+				Kind:  "CentralMigrationList",
+				Page:  1,
+				Size:  int32(len(migrations)),
+				Total: int32(len(migrations)),
+				Items: make([]private.CentralMigration, 0, len(migrations)),
+			}
+			for _, migration := range migrations {
+				migrationList.Items = append(migrationList.Items, presenters.PresentCentralMigration(migration))
+			}
+			return migrationList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Rollback ...
+func (h adminCentralMigrationHandler) Rollback(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			migration, svcErr := h.service.Rollback(vars["id"], vars["migration_id"])
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return presenters.PresentCentralMigration(migration), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
@@ -0,0 +1,403 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/centrals/types"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/presenters"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
+	coreServices "github.com/stackrox/acs-fleet-manager/pkg/services"
+)
+
+// AdminClusterHandler is the interface for the admin data plane cluster handler
+type AdminClusterHandler interface {
+	// List returns the data plane clusters matching the search
+	List(w http.ResponseWriter, r *http.Request)
+	// Get returns a data plane cluster
+	Get(w http.ResponseWriter, r *http.Request)
+	// Create registers a data plane cluster
+	Create(w http.ResponseWriter, r *http.Request)
+	// Update changes the scheduling settings of a data plane cluster. Clusters from the configuration file are
+	// managed through the admin API from then on.
+	Update(w http.ResponseWriter, r *http.Request)
+	// Delete deregisters an empty data plane cluster
+	Delete(w http.ResponseWriter, r *http.Request)
+}
+
+type adminClusterHandler struct {
+	clusterService         services.ClusterService
+	clusterDrainService    services.ClusterDrainService
+	clusterIdentities      auth.ClusterIdentitySource
+	dataplaneClusterConfig *config.DataplaneClusterConfig
+}
+
+var _ AdminClusterHandler = (*adminClusterHandler)(nil)
+
+// NewAdminClusterHandler ...
+func NewAdminClusterHandler(clusterService services.ClusterService, clusterDrainService services.ClusterDrainService,
+	clusterIdentities auth.ClusterIdentitySource, dataplaneClusterConfig *config.DataplaneClusterConfig) AdminClusterHandler {
+	return &adminClusterHandler{
+		clusterService:         clusterService,
+		clusterDrainService:    clusterDrainService,
+		clusterIdentities:      clusterIdentities,
+		dataplaneClusterConfig: dataplaneClusterConfig,
+	}
+}
+
+// List ...
+func (h adminClusterHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			if err := listArgs.ValidateWithOrderByParams(services.GetAcceptedClusterOrderByParams()); err != nil {
+				return nil, errors.NewWithCause(errors.ErrorMalformedRequest, err, "Unable to list clusters: %s", err.Error())
+			}
+
+			clusters, paging, svcErr := h.clusterService.List(listArgs)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			clusterList := private.DataPlaneClusterList{
+				Kind:  "DataPlaneClusterList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: make([]private.DataPlaneCluster, 0, len(clusters)),
+			}
+			if len(clusters) == 0 {
+				return clusterList, nil
+			}
+
+			clusterIDs := make([]string, 0, len(clusters))
+			for _, cluster := range clusters {
+				clusterIDs = append(clusterIDs, cluster.ClusterID)
+			}
+			centralCounts, svcErr := h.centralCounts(clusterIDs)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			for _, cluster := range clusters {
+				converted, svcErr := presenters.PresentDataPlaneCluster(cluster, centralCounts[cluster.ClusterID])
+				if svcErr != nil {
+					return nil, svcErr
+				}
+				clusterList.Items = append(clusterList.Items, converted)
+			}
+			return clusterList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Get ...
+func (h adminClusterHandler) Get(w http.ResponseWriter, r *http.Request) {
+	clusterID := mux.Vars(r)["id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.ValidateMinLength(&clusterID, "id", handlers.MinRequiredFieldLength),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			cluster, svcErr := h.findCluster(clusterID)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return h.presentCluster(cluster)
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Create ...
+func (h adminClusterHandler) Create(w http.ResponseWriter, r *http.Request) {
+	clusterRequest := private.DataPlaneClusterRequest{}
+	manualCluster := config.NewManualCluster()
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &clusterRequest,
+		Validate: []handlers.Validate{
+			handlers.ValidateMinLength(&clusterRequest.ClusterId, "cluster_id", handlers.MinRequiredFieldLength),
+			handlers.ValidateMinLength(&clusterRequest.CloudProvider, "cloud_provider", handlers.MinRequiredFieldLength),
+			handlers.ValidateMinLength(&clusterRequest.Region, "region", handlers.MinRequiredFieldLength),
+			validateCentralInstanceLimit(&clusterRequest.CentralInstanceLimit),
+			func() *errors.ServiceError {
+				applyDataPlaneClusterRequest(&manualCluster, &clusterRequest)
+				if err := manualCluster.Validate(); err != nil {
+					return errors.Validation("%s", err.Error())
+				}
+				return validateSupportedInstanceType(manualCluster.SupportedInstanceType)
+			},
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			if h.dataplaneClusterConfig.ClusterConfig.HasCluster(manualCluster.ClusterID) {
+				return nil, errors.Conflict("cluster %q is listed in the data plane cluster configuration file", manualCluster.ClusterID)
+			}
+			existing, svcErr := h.clusterService.FindClusterByID(manualCluster.ClusterID)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			if existing != nil {
+				return nil, errors.Conflict("cluster %q is already registered", manualCluster.ClusterID)
+			}
+			if svcErr := h.checkIdentityNotBound(manualCluster.ClusterID, manualCluster.AuthIssuer, manualCluster.AuthSubject); svcErr != nil {
+				return nil, svcErr
+			}
+
+			providerSpec, err := manualCluster.ProviderSpecJSON()
+			if err != nil {
+				return nil, errors.Validation("invalid provider_spec: %v", err)
+			}
+			cluster := &api.Cluster{
+				CloudProvider:         manualCluster.CloudProvider,
+				Region:                manualCluster.Region,
+				MultiAZ:               manualCluster.MultiAZ,
+				ClusterID:             manualCluster.ClusterID,
+				Status:                manualCluster.Status,
+				ProviderType:          manualCluster.ProviderType,
+				ClusterDNS:            manualCluster.ClusterDNS,
+				SupportedInstanceType: manualCluster.SupportedInstanceType,
+				Schedulable:           manualCluster.Schedulable,
+				ProviderSpec:          providerSpec,
+				Name:                  manualCluster.Name,
+				CentralInstanceLimit:  manualCluster.CentralInstanceLimit,
+				RegistrationSource:    api.ClusterRegistrationSourceAPI,
+				AuthIssuer:            manualCluster.AuthIssuer,
+				AuthSubject:           manualCluster.AuthSubject,
+			}
+			if svcErr := h.clusterService.RegisterClusterJob(cluster); svcErr != nil {
+				return nil, svcErr
+			}
+			glog.Infof("Registered data plane cluster %s (%s) through the admin API", cluster.ClusterID, cluster.Name)
+			return presenters.PresentDataPlaneCluster(cluster, 0)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+// Update ...
+func (h adminClusterHandler) Update(w http.ResponseWriter, r *http.Request) {
+	updateRequest := private.DataPlaneClusterUpdateRequest{}
+	clusterID := mux.Vars(r)["id"]
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &updateRequest,
+		Validate: []handlers.Validate{
+			handlers.ValidateMinLength(&clusterID, "id", handlers.MinRequiredFieldLength),
+			func() *errors.ServiceError {
+				if updateRequest.CentralInstanceLimit == nil {
+					return nil
+				}
+				return validateCentralInstanceLimit(updateRequest.CentralInstanceLimit)()
+			},
+			func() *errors.ServiceError {
+				if updateRequest.SupportedInstanceType == nil {
+					return nil
+				}
+				return validateSupportedInstanceType(*updateRequest.SupportedInstanceType)
+			},
+			func() *errors.ServiceError {
+				if updateRequest.AuthIssuer == nil && updateRequest.AuthSubject == nil {
+					return nil
+				}
+				if updateRequest.AuthIssuer == nil || updateRequest.AuthSubject == nil {
+					return errors.Validation("auth_issuer and auth_subject must be updated together")
+				}
+				if err := config.ValidateClusterAuthIdentity(*updateRequest.AuthIssuer, *updateRequest.AuthSubject); err != nil {
+					return errors.Validation("%s", err.Error())
+				}
+				return nil
+			},
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			cluster, svcErr := h.findCluster(clusterID)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+
+			// Updating a cluster from the configuration file hands it over to the admin API, as the next
+			// configuration sync would otherwise revert the change.
+			values := map[string]interface{}{"registration_source": api.ClusterRegistrationSourceAPI}
+			if updateRequest.Schedulable != nil {
+				if *updateRequest.Schedulable {
+					if svcErr := h.checkNotDrained(clusterID); svcErr != nil {
+						return nil, svcErr
+					}
+					// Making the cluster schedulable is the only way to lift the cordon of a drained cluster
+					values["cordoned"] = false
+				}
+				values["schedulable"] = *updateRequest.Schedulable
+			}
+			if updateRequest.CentralInstanceLimit != nil {
+				values["central_instance_limit"] = int(*updateRequest.CentralInstanceLimit)
+			}
+			if updateRequest.SupportedInstanceType != nil {
+				values["supported_instance_type"] = *updateRequest.SupportedInstanceType
+			}
+			if updateRequest.AuthIssuer != nil {
+				if svcErr := h.checkIdentityNotBound(clusterID, *updateRequest.AuthIssuer, *updateRequest.AuthSubject); svcErr != nil {
+					return nil, svcErr
+				}
+				values["auth_issuer"] = *updateRequest.AuthIssuer
+				values["auth_subject"] = *updateRequest.AuthSubject
+			}
+			if svcErr := h.clusterService.Updates(*cluster, values); svcErr != nil {
+				return nil, svcErr
+			}
+			glog.Infof("Updated data plane cluster %s through the admin API: %v", clusterID, values)
+
+			cluster, svcErr = h.findCluster(clusterID)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return h.presentCluster(cluster)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// Delete ...
+func (h adminClusterHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	clusterID := mux.Vars(r)["id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.ValidateMinLength(&clusterID, "id", handlers.MinRequiredFieldLength),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			cluster, svcErr := h.findCluster(clusterID)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			// The configuration sync would register the cluster again once it is deleted
+			if h.dataplaneClusterConfig.ClusterConfig.HasCluster(clusterID) {
+				return nil, errors.Conflict("cluster %q is listed in the data plane cluster configuration file, remove it there", clusterID)
+			}
+
+			counts, svcErr := h.centralCounts([]string{clusterID})
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			if count := counts[clusterID]; count > 0 {
+				return nil, errors.Conflict("cluster %q still has %d centrals, drain it first", clusterID, count)
+			}
+
+			if svcErr := h.clusterService.Updates(*cluster, map[string]interface{}{
+				"status":              api.ClusterDeprovisioning,
+				"schedulable":         false,
+				"registration_source": api.ClusterRegistrationSourceAPI,
+			}); svcErr != nil {
+				return nil, svcErr
+			}
+			glog.Infof("Deregistered data plane cluster %s through the admin API", clusterID)
+			return nil, nil
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusAccepted)
+}
+
+func (h adminClusterHandler) findCluster(clusterID string) (*api.Cluster, *errors.ServiceError) {
+	cluster, svcErr := h.clusterService.FindClusterByID(clusterID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if cluster == nil {
+		return nil, errors.NotFound("cluster %q not found", clusterID)
+	}
+	return cluster, nil
+}
+
+func (h adminClusterHandler) presentCluster(cluster *api.Cluster) (interface{}, *errors.ServiceError) {
+	counts, svcErr := h.centralCounts([]string{cluster.ClusterID})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return presenters.PresentDataPlaneCluster(cluster, counts[cluster.ClusterID])
+}
+
+// centralCounts returns the number of Centrals on each of the given clusters
+func (h adminClusterHandler) centralCounts(clusterIDs []string) (map[string]int, *errors.ServiceError) {
+	counts, svcErr := h.clusterService.FindCentralInstanceCount(clusterIDs)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	result := make(map[string]int, len(counts))
+	for _, count := range counts {
+		result[count.Clusterid] = count.Count
+	}
+	return result, nil
+}
+
+func (h adminClusterHandler) checkNotDrained(clusterID string) *errors.ServiceError {
+	drains, svcErr := h.clusterDrainService.List(clusterID)
+	if svcErr != nil {
+		return svcErr
+	}
+	for _, drain := range drains {
+		if drain.Status.IsActive() {
+			return errors.Conflict("cluster %q is being drained by drain %s", clusterID, drain.ID)
+		}
+	}
+	return nil
+}
+
+// checkIdentityNotBound makes sure that a fleetshard identity is bound to a single cluster, as the fleetshard could
+// access the tenants of all clusters it is bound to otherwise
+func (h adminClusterHandler) checkIdentityNotBound(clusterID, issuer, subject string) *errors.ServiceError {
+	if issuer == "" {
+		return nil
+	}
+	boundClusterID, err := h.clusterIdentities.FindClusterByIdentity(auth.ClusterIdentity{Issuer: issuer, Subject: subject})
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to check the fleetshard identity of cluster %q", clusterID)
+	}
+	if boundClusterID != "" && boundClusterID != clusterID {
+		return errors.Conflict("the fleetshard identity is already bound to cluster %q", boundClusterID)
+	}
+	return nil
+}
+
+func applyDataPlaneClusterRequest(manualCluster *config.ManualCluster, clusterRequest *private.DataPlaneClusterRequest) {
+	manualCluster.ClusterID = clusterRequest.ClusterId
+	manualCluster.Name = clusterRequest.Name
+	manualCluster.CloudProvider = clusterRequest.CloudProvider
+	manualCluster.Region = clusterRequest.Region
+	manualCluster.MultiAZ = clusterRequest.MultiAz
+	manualCluster.Schedulable = clusterRequest.Schedulable
+	manualCluster.CentralInstanceLimit = int(clusterRequest.CentralInstanceLimit)
+	manualCluster.ClusterDNS = clusterRequest.ClusterDns
+	manualCluster.ProviderSpec = clusterRequest.ProviderSpec
+	manualCluster.AuthIssuer = clusterRequest.AuthIssuer
+	manualCluster.AuthSubject = clusterRequest.AuthSubject
+	if clusterRequest.Status != "" {
+		manualCluster.Status = api.ClusterStatus(clusterRequest.Status)
+	}
+	if clusterRequest.ProviderType != "" {
+		manualCluster.ProviderType = api.ClusterProviderType(clusterRequest.ProviderType)
+	}
+	if clusterRequest.SupportedInstanceType != "" {
+		manualCluster.SupportedInstanceType = clusterRequest.SupportedInstanceType
+	}
+}
+
+func validateCentralInstanceLimit(limit *int32) handlers.Validate {
+	return func() *errors.ServiceError {
+		if *limit < -1 {
+			return errors.Validation("central_instance_limit must be -1 for no limit or a non-negative number")
+		}
+		return nil
+	}
+}
+
+func validateSupportedInstanceType(supportedInstanceType string) *errors.ServiceError {
+	for _, instanceType := range strings.Split(supportedInstanceType, ",") {
+		if instanceType != types.STANDARD.String() && instanceType != types.EVAL.String() {
+			return errors.Validation("supported_instance_type must be a comma separated list of %s and %s", types.STANDARD, types.EVAL)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/presenters"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
+)
+
+// AdminClusterDrainHandler is the interface for the admin cluster drain handler
+type AdminClusterDrainHandler interface {
+	// Create starts draining a cluster
+	Create(w http.ResponseWriter, r *http.Request)
+	// List returns the drains of a cluster
+	List(w http.ResponseWriter, r *http.Request)
+	// Get returns a drain including the progress of every tenant it moved
+	Get(w http.ResponseWriter, r *http.Request)
+	// Pause stops a drain from moving further tenants
+	Pause(w http.ResponseWriter, r *http.Request)
+	// Resume continues a paused drain
+	Resume(w http.ResponseWriter, r *http.Request)
+	// Cancel stops a drain for good
+	Cancel(w http.ResponseWriter, r *http.Request)
+}
+
+type adminClusterDrainHandler struct {
+	service services.ClusterDrainService
+}
+
+var _ AdminClusterDrainHandler = (*adminClusterDrainHandler)(nil)
+
+// NewAdminClusterDrainHandler ...
+func NewAdminClusterDrainHandler(service services.ClusterDrainService) AdminClusterDrainHandler {
+	return &adminClusterDrainHandler{service: service}
+}
+
+// Create ...
+func (h adminClusterDrainHandler) Create(w http.ResponseWriter, r *http.Request) {
+	drainRequest := private.ClusterDrainRequest{}
+	clusterID := mux.Vars(r)["id"]
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &drainRequest,
+		Validate: []handlers.Validate{
+			handlers.ValidateMinLength(&clusterID, "id", handlers.MinRequiredFieldLength),
+			func() *errors.ServiceError {
+				if drainRequest.Concurrency < 0 {
+					return errors.Validation("concurrency must not be negative")
+				}
+				return nil
+			},
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			drain, svcErr := h.service.Start(r.Context(), clusterID, int(drainRequest.Concurrency))
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return presenters.PresentClusterDrain(drain), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// List ...
+func (h adminClusterDrainHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			drains, svcErr := h.service.List(mux.Vars(r)["id"])
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			drainList := private.ClusterDrainList{
+				Kind:  "ClusterDrainList",
+				Page:  1,
+				Size:  int32(len(drains)),
+				Total: int32(len(drains)),
+				Items: make([]private.ClusterDrain, 0, len(drains)),
+			}
+			for _, drain := range drains {
+				drainList.Items = append(drainList.Items, presenters.PresentClusterDrain(drain))
+			}
+			return drainList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Get ...
+func (h adminClusterDrainHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			drain, svcErr := h.getDrain(r)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return presenters.PresentClusterDrain(drain), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Pause ...
+func (h adminClusterDrainHandler) Pause(w http.ResponseWriter, r *http.Request) {
+	h.transition(w, r, h.service.Pause)
+}
+
+// Resume ...
+func (h adminClusterDrainHandler) Resume(w http.ResponseWriter, r *http.Request) {
+	h.transition(w, r, h.service.Resume)
+}
+
+// Cancel ...
+func (h adminClusterDrainHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	h.transition(w, r, h.service.Cancel)
+}
+
+func (h adminClusterDrainHandler) transition(w http.ResponseWriter, r *http.Request,
+	transition func(id string) (*dbapi.ClusterDrain, *errors.ServiceError)) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			drain, svcErr := h.getDrain(r)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			drain, svcErr = transition(drain.ID)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return presenters.PresentClusterDrain(drain), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// getDrain returns the drain referenced by the request if it belongs to the cluster in the request path
+func (h adminClusterDrainHandler) getDrain(r *http.Request) (*dbapi.ClusterDrain, *errors.ServiceError) {
+	vars := mux.Vars(r)
+	drain, svcErr := h.service.Get(vars["drain_id"])
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if drain.ClusterID != vars["id"] {
+		return nil, errors.NotFound("ClusterDrain with id='%s' not found", vars["drain_id"])
+	}
+	return drain, nil
+}
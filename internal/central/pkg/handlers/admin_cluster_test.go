@@ -0,0 +1,391 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	coreServices "github.com/stackrox/acs-fleet-manager/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const configuredClusterID = "configured-cluster"
+
+// boundIdentity is the fleetshard identity bound to boundClusterID
+var (
+	boundClusterID = "bound-cluster"
+	boundIdentity  = auth.ClusterIdentity{Issuer: "https://oidc.example.com/bound", Subject: "system:serviceaccount:rhacs:fleetshard-sync"}
+)
+
+func newTestAdminClusterHandler(clusterService services.ClusterService, drainService services.ClusterDrainService) AdminClusterHandler {
+	dataplaneClusterConfig := config.NewDataplaneClusterConfig()
+	dataplaneClusterConfig.ClusterConfig = config.NewClusterConfig(config.ClusterList{{ClusterID: configuredClusterID}})
+	clusterIdentities := &auth.ClusterIdentitySourceMock{
+		FindClusterByIdentityFunc: func(identity auth.ClusterIdentity) (string, error) {
+			if identity == boundIdentity {
+				return boundClusterID, nil
+			}
+			return "", nil
+		},
+	}
+	return NewAdminClusterHandler(clusterService, drainService, clusterIdentities, dataplaneClusterConfig)
+}
+
+func newAdminClusterRequest(method, clusterID, body string) *http.Request {
+	req := httptest.NewRequest(method, "/api/rhacs/v1/admin/clusters/"+clusterID, strings.NewReader(body))
+	return mux.SetURLVars(req, map[string]string{"id": clusterID})
+}
+
+func TestAdminClusterList(t *testing.T) {
+	clusterService := &services.ClusterServiceMock{
+		ListFunc: func(listArgs *coreServices.ListArguments) ([]*api.Cluster, *api.PagingMeta, *errors.ServiceError) {
+			clusters := []*api.Cluster{
+				{ClusterID: "cluster-1", Region: "us-east-1", Schedulable: true},
+				{ClusterID: "cluster-2", Region: "us-east-1"},
+			}
+			return clusters, &api.PagingMeta{Page: 1, Size: 2, Total: 2}, nil
+		},
+		FindCentralInstanceCountFunc: func(clusterIDs []string) ([]services.ResCentralInstanceCount, *errors.ServiceError) {
+			return []services.ResCentralInstanceCount{{Clusterid: "cluster-1", Count: 3}, {Clusterid: "cluster-2", Count: 0}}, nil
+		},
+	}
+	rec := httptest.NewRecorder()
+	newTestAdminClusterHandler(clusterService, nil).List(rec,
+		httptest.NewRequest(http.MethodGet, "/api/rhacs/v1/admin/clusters?search=region+%3D+us-east-1", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Len(t, clusterService.ListCalls(), 1)
+	assert.Equal(t, "region = us-east-1", clusterService.ListCalls()[0].ListArgs.Search)
+	require.Len(t, clusterService.FindCentralInstanceCountCalls(), 1)
+	assert.Equal(t, []string{"cluster-1", "cluster-2"}, clusterService.FindCentralInstanceCountCalls()[0].ClusterIDs)
+
+	var clusterList private.DataPlaneClusterList
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&clusterList))
+	assert.Equal(t, int32(2), clusterList.Total)
+	require.Len(t, clusterList.Items, 2)
+	assert.Equal(t, int32(3), clusterList.Items[0].CentralCount)
+	assert.Equal(t, "config", clusterList.Items[0].RegistrationSource)
+	assert.Equal(t, int32(0), clusterList.Items[1].CentralCount)
+}
+
+func TestAdminClusterListInvalidOrderBy(t *testing.T) {
+	// owner is a field of Centrals, not of clusters
+	for _, orderBy := range []string{"unknown", "owner"} {
+		t.Run(orderBy, func(t *testing.T) {
+			clusterService := &services.ClusterServiceMock{}
+			rec := httptest.NewRecorder()
+			newTestAdminClusterHandler(clusterService, nil).List(rec,
+				httptest.NewRequest(http.MethodGet, "/api/rhacs/v1/admin/clusters?orderBy="+orderBy, nil))
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Empty(t, clusterService.ListCalls())
+		})
+	}
+}
+
+func TestAdminClusterListOrderByClusterField(t *testing.T) {
+	clusterService := &services.ClusterServiceMock{
+		ListFunc: func(listArgs *coreServices.ListArguments) ([]*api.Cluster, *api.PagingMeta, *errors.ServiceError) {
+			return nil, &api.PagingMeta{Page: 1}, nil
+		},
+	}
+	rec := httptest.NewRecorder()
+	newTestAdminClusterHandler(clusterService, nil).List(rec,
+		httptest.NewRequest(http.MethodGet, "/api/rhacs/v1/admin/clusters?orderBy=provider_type+desc", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Len(t, clusterService.ListCalls(), 1)
+	assert.Equal(t, []string{"provider_type desc"}, clusterService.ListCalls()[0].ListArgs.OrderBy)
+}
+
+func TestAdminClusterGet(t *testing.T) {
+	clusterService := &services.ClusterServiceMock{
+		FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+			if clusterID != "cluster-1" {
+				return nil, nil
+			}
+			return &api.Cluster{ClusterID: clusterID, ProviderType: api.ClusterProviderStandalone}, nil
+		},
+		FindCentralInstanceCountFunc: func(clusterIDs []string) ([]services.ResCentralInstanceCount, *errors.ServiceError) {
+			return []services.ResCentralInstanceCount{{Clusterid: clusterIDs[0], Count: 5}}, nil
+		},
+	}
+	handler := newTestAdminClusterHandler(clusterService, nil)
+
+	rec := httptest.NewRecorder()
+	handler.Get(rec, newAdminClusterRequest(http.MethodGet, "cluster-1", ""))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var cluster private.DataPlaneCluster
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&cluster))
+	assert.Equal(t, "standalone", cluster.ProviderType)
+	assert.Equal(t, int32(5), cluster.CentralCount)
+
+	rec = httptest.NewRecorder()
+	handler.Get(rec, newAdminClusterRequest(http.MethodGet, "unknown", ""))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestAdminClusterCreate(t *testing.T) {
+	var registered *api.Cluster
+	clusterService := &services.ClusterServiceMock{
+		FindClusterByIDFunc: func(_ string) (*api.Cluster, *errors.ServiceError) {
+			return nil, nil
+		},
+		RegisterClusterJobFunc: func(cluster *api.Cluster) *errors.ServiceError {
+			registered = cluster
+			return nil
+		},
+	}
+	rec := httptest.NewRecorder()
+	body := `{"cluster_id": "new-cluster", "name": "eu-1", "cloud_provider": "aws", "region": "eu-west-1", "central_instance_limit": 10}`
+	newTestAdminClusterHandler(clusterService, nil).Create(rec,
+		httptest.NewRequest(http.MethodPost, "/api/rhacs/v1/admin/clusters", strings.NewReader(body)))
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	require.NotNil(t, registered)
+	assert.Equal(t, "eu-1", registered.Name)
+	assert.Equal(t, 10, registered.CentralInstanceLimit)
+	assert.Equal(t, api.ClusterRegistrationSourceAPI, registered.RegistrationSource)
+	assert.Equal(t, api.ClusterProviderOCM, registered.ProviderType)
+
+	var cluster private.DataPlaneCluster
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&cluster))
+	assert.Equal(t, "new-cluster", cluster.ClusterId)
+	assert.Equal(t, "api", cluster.RegistrationSource)
+}
+
+func TestAdminClusterCreateValidation(t *testing.T) {
+	tests := map[string]string{
+		"missing region":         `{"cluster_id": "new-cluster", "cloud_provider": "aws"}`,
+		"invalid limit":          `{"cluster_id": "new-cluster", "cloud_provider": "aws", "region": "eu-west-1", "central_instance_limit": -2}`,
+		"invalid status":         `{"cluster_id": "new-cluster", "cloud_provider": "aws", "region": "eu-west-1", "status": "failed"}`,
+		"invalid instance type":  `{"cluster_id": "new-cluster", "cloud_provider": "aws", "region": "eu-west-1", "supported_instance_type": "large"}`,
+		"eks without a provider": `{"cluster_id": "new-cluster", "cloud_provider": "aws", "region": "eu-west-1", "provider_type": "aws_eks"}`,
+	}
+	for name, body := range tests {
+		t.Run(name, func(t *testing.T) {
+			clusterService := &services.ClusterServiceMock{}
+			rec := httptest.NewRecorder()
+			newTestAdminClusterHandler(clusterService, nil).Create(rec,
+				httptest.NewRequest(http.MethodPost, "/api/rhacs/v1/admin/clusters", strings.NewReader(body)))
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+			assert.Empty(t, clusterService.RegisterClusterJobCalls())
+		})
+	}
+}
+
+func TestAdminClusterCreateConfiguredCluster(t *testing.T) {
+	clusterService := &services.ClusterServiceMock{}
+	rec := httptest.NewRecorder()
+	body := `{"cluster_id": "` + configuredClusterID + `", "cloud_provider": "aws", "region": "eu-west-1"}`
+	newTestAdminClusterHandler(clusterService, nil).Create(rec,
+		httptest.NewRequest(http.MethodPost, "/api/rhacs/v1/admin/clusters", strings.NewReader(body)))
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Empty(t, clusterService.RegisterClusterJobCalls())
+}
+
+func TestAdminClusterUpdateRejectsSchedulingDrainedCluster(t *testing.T) {
+	clusterService := &services.ClusterServiceMock{
+		FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+			return &api.Cluster{ClusterID: clusterID}, nil
+		},
+	}
+	drainService := &services.ClusterDrainServiceMock{
+		ListFunc: func(_ string) ([]*dbapi.ClusterDrain, *errors.ServiceError) {
+			return []*dbapi.ClusterDrain{{Status: dbapi.ClusterDrainStatusPaused}}, nil
+		},
+	}
+	rec := httptest.NewRecorder()
+	newTestAdminClusterHandler(clusterService, drainService).Update(rec,
+		newAdminClusterRequest(http.MethodPatch, "drained-cluster", `{"schedulable": true}`))
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Empty(t, clusterService.UpdatesCalls())
+}
+
+func TestAdminClusterUpdate(t *testing.T) {
+	clusterService := &services.ClusterServiceMock{
+		FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+			return &api.Cluster{ClusterID: clusterID}, nil
+		},
+		FindCentralInstanceCountFunc: func(clusterIDs []string) ([]services.ResCentralInstanceCount, *errors.ServiceError) {
+			return []services.ResCentralInstanceCount{{Clusterid: clusterIDs[0], Count: 0}}, nil
+		},
+		UpdatesFunc: func(_ api.Cluster, _ map[string]interface{}) *errors.ServiceError {
+			return nil
+		},
+	}
+	rec := httptest.NewRecorder()
+	newTestAdminClusterHandler(clusterService, nil).Update(rec,
+		newAdminClusterRequest(http.MethodPatch, configuredClusterID, `{"schedulable": false, "central_instance_limit": 5}`))
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Len(t, clusterService.UpdatesCalls(), 1)
+	assert.Equal(t, map[string]interface{}{
+		"registration_source":    api.ClusterRegistrationSourceAPI,
+		"schedulable":            false,
+		"central_instance_limit": 5,
+	}, clusterService.UpdatesCalls()[0].Values)
+}
+
+func TestAdminClusterUpdateLiftsCordon(t *testing.T) {
+	clusterService := &services.ClusterServiceMock{
+		FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+			return &api.Cluster{ClusterID: clusterID, Cordoned: true}, nil
+		},
+		FindCentralInstanceCountFunc: func(clusterIDs []string) ([]services.ResCentralInstanceCount, *errors.ServiceError) {
+			return []services.ResCentralInstanceCount{{Clusterid: clusterIDs[0], Count: 0}}, nil
+		},
+		UpdatesFunc: func(_ api.Cluster, _ map[string]interface{}) *errors.ServiceError {
+			return nil
+		},
+	}
+	drainService := &services.ClusterDrainServiceMock{
+		ListFunc: func(_ string) ([]*dbapi.ClusterDrain, *errors.ServiceError) {
+			return []*dbapi.ClusterDrain{{Status: dbapi.ClusterDrainStatusCompleted}}, nil
+		},
+	}
+	rec := httptest.NewRecorder()
+	newTestAdminClusterHandler(clusterService, drainService).Update(rec,
+		newAdminClusterRequest(http.MethodPatch, "drained-cluster", `{"schedulable": true}`))
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Len(t, clusterService.UpdatesCalls(), 1)
+	assert.Equal(t, map[string]interface{}{
+		"registration_source": api.ClusterRegistrationSourceAPI,
+		"schedulable":         true,
+		"cordoned":            false,
+	}, clusterService.UpdatesCalls()[0].Values)
+}
+
+func TestAdminClusterUpdateAuthIdentity(t *testing.T) {
+	tests := map[string]struct {
+		clusterID      string
+		body           string
+		expectedStatus int
+		expectedValues map[string]interface{}
+	}{
+		"binds the identity": {
+			clusterID:      "api-cluster",
+			body:           `{"auth_issuer": "https://oidc.example.com/api-cluster", "auth_subject": "fleetshard-sync"}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: map[string]interface{}{
+				"registration_source": api.ClusterRegistrationSourceAPI,
+				"auth_issuer":         "https://oidc.example.com/api-cluster",
+				"auth_subject":        "fleetshard-sync",
+			},
+		},
+		"removes the identity": {
+			clusterID:      boundClusterID,
+			body:           `{"auth_issuer": "", "auth_subject": ""}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: map[string]interface{}{
+				"registration_source": api.ClusterRegistrationSourceAPI,
+				"auth_issuer":         "",
+				"auth_subject":        "",
+			},
+		},
+		"keeps the identity of the same cluster": {
+			clusterID:      boundClusterID,
+			body:           `{"auth_issuer": "` + boundIdentity.Issuer + `", "auth_subject": "` + boundIdentity.Subject + `"}`,
+			expectedStatus: http.StatusOK,
+			expectedValues: map[string]interface{}{
+				"registration_source": api.ClusterRegistrationSourceAPI,
+				"auth_issuer":         boundIdentity.Issuer,
+				"auth_subject":        boundIdentity.Subject,
+			},
+		},
+		"rejects the identity of another cluster": {
+			clusterID:      "api-cluster",
+			body:           `{"auth_issuer": "` + boundIdentity.Issuer + `", "auth_subject": "` + boundIdentity.Subject + `"}`,
+			expectedStatus: http.StatusConflict,
+		},
+		"rejects an issuer without subject": {
+			clusterID:      "api-cluster",
+			body:           `{"auth_issuer": "https://oidc.example.com/api-cluster"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		"rejects an issuer which is not an https URL": {
+			clusterID:      "api-cluster",
+			body:           `{"auth_issuer": "http://oidc.example.com", "auth_subject": "fleetshard-sync"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			clusterService := &services.ClusterServiceMock{
+				FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					return &api.Cluster{ClusterID: clusterID}, nil
+				},
+				FindCentralInstanceCountFunc: func(clusterIDs []string) ([]services.ResCentralInstanceCount, *errors.ServiceError) {
+					return nil, nil
+				},
+				UpdatesFunc: func(_ api.Cluster, _ map[string]interface{}) *errors.ServiceError {
+					return nil
+				},
+			}
+			rec := httptest.NewRecorder()
+			newTestAdminClusterHandler(clusterService, nil).Update(rec,
+				newAdminClusterRequest(http.MethodPatch, tc.clusterID, tc.body))
+
+			require.Equal(t, tc.expectedStatus, rec.Code, rec.Body.String())
+			if tc.expectedValues == nil {
+				assert.Empty(t, clusterService.UpdatesCalls())
+				return
+			}
+			require.Len(t, clusterService.UpdatesCalls(), 1)
+			assert.Equal(t, tc.expectedValues, clusterService.UpdatesCalls()[0].Values)
+		})
+	}
+}
+
+func TestAdminClusterDelete(t *testing.T) {
+	tests := map[string]struct {
+		clusterID      string
+		count          int
+		expectedStatus int
+	}{
+		"empty cluster":        {clusterID: "api-cluster", expectedStatus: http.StatusAccepted},
+		"cluster with tenants": {clusterID: "api-cluster", count: 2, expectedStatus: http.StatusConflict},
+		"configured cluster":   {clusterID: configuredClusterID, expectedStatus: http.StatusConflict},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			clusterService := &services.ClusterServiceMock{
+				FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					return &api.Cluster{ClusterID: clusterID}, nil
+				},
+				FindCentralInstanceCountFunc: func(clusterIDs []string) ([]services.ResCentralInstanceCount, *errors.ServiceError) {
+					return []services.ResCentralInstanceCount{{Clusterid: clusterIDs[0], Count: tc.count}}, nil
+				},
+				UpdatesFunc: func(_ api.Cluster, _ map[string]interface{}) *errors.ServiceError {
+					return nil
+				},
+			}
+			rec := httptest.NewRecorder()
+			newTestAdminClusterHandler(clusterService, nil).Delete(rec,
+				newAdminClusterRequest(http.MethodDelete, tc.clusterID, ""))
+
+			require.Equal(t, tc.expectedStatus, rec.Code, rec.Body.String())
+			if tc.expectedStatus != http.StatusAccepted {
+				assert.Empty(t, clusterService.UpdatesCalls())
+				return
+			}
+			require.Len(t, clusterService.UpdatesCalls(), 1)
+			assert.Equal(t, api.ClusterDeprovisioning, clusterService.UpdatesCalls()[0].Values["status"])
+		})
+	}
+}
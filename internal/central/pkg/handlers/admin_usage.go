@@ -0,0 +1,195 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
+	"github.com/stackrox/acs-fleet-manager/pkg/shared"
+)
+
+const (
+	usageFormatJSON = "json"
+	usageFormatCSV  = "csv"
+
+	defaultUsageReportRange = 30 * 24 * time.Hour
+)
+
+var centralUsageCSVHeader = []string{
+	"group", "period_start", "tenants",
+	"cpu_requests_millicore_hours", "cpu_usage_millicore_hours",
+	"memory_requests_byte_hours", "memory_usage_byte_hours",
+	"storage_byte_hours", "db_min_capacity_acu_hours", "db_max_capacity_acu_hours",
+}
+
+// AdminUsageHandler is the interface for the admin tenant usage handler
+type AdminUsageHandler interface {
+	// List returns the aggregated tenant usage as JSON or CSV
+	List(w http.ResponseWriter, r *http.Request)
+}
+
+// CentralUsageReport is the JSON representation of the aggregated tenant usage
+type CentralUsageReport struct {
+	Kind        string                             `json:"kind"`
+	From        time.Time                          `json:"from"`
+	To          time.Time                          `json:"to"`
+	GroupBy     services.CentralUsageGroupBy       `json:"group_by"`
+	Granularity services.CentralUsageGranularity   `json:"granularity"`
+	Items       []services.CentralUsageReportEntry `json:"items"`
+}
+
+type adminUsageHandler struct {
+	service services.CentralUsageService
+	now     func() time.Time
+}
+
+var _ AdminUsageHandler = (*adminUsageHandler)(nil)
+
+// NewAdminUsageHandler ...
+func NewAdminUsageHandler(service services.CentralUsageService) AdminUsageHandler {
+	return &adminUsageHandler{
+		service: service,
+		now:     time.Now,
+	}
+}
+
+// List ...
+//
+// Supported query parameters are from and to (RFC 3339, defaults to the last 30 days), group_by (organisation,
+// cluster or instance_type, defaults to organisation), granularity (hour, day or month, defaults to day) and
+// format (json or csv, defaults to json).
+func (h adminUsageHandler) List(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	format := params.Get("format")
+	if format == "" {
+		format = usageFormatJSON
+	}
+	now := h.now().UTC()
+	query := services.CentralUsageQuery{
+		From:        now.Add(-defaultUsageReportRange),
+		To:          now,
+		GroupBy:     services.CentralUsageGroupByOrganisation,
+		Granularity: services.CentralUsageGranularityDay,
+	}
+
+	validate := []handlers.Validate{
+		validateUsageTime(params.Get("from"), "from", &query.From),
+		validateUsageTime(params.Get("to"), "to", &query.To),
+		func() *errors.ServiceError {
+			if value := params.Get("group_by"); value != "" {
+				query.GroupBy = services.CentralUsageGroupBy(value)
+			}
+			if !query.GroupBy.IsValid() {
+				return errors.Validation("group_by must be one of organisation, cluster or instance_type")
+			}
+			return nil
+		},
+		func() *errors.ServiceError {
+			if value := params.Get("granularity"); value != "" {
+				query.Granularity = services.CentralUsageGranularity(value)
+			}
+			if !query.Granularity.IsValid() {
+				return errors.Validation("granularity must be one of hour, day or month")
+			}
+			return nil
+		},
+		func() *errors.ServiceError {
+			if !query.From.Before(query.To) {
+				return errors.Validation("from must be before to")
+			}
+			return nil
+		},
+		func() *errors.ServiceError {
+			if format != usageFormatJSON && format != usageFormatCSV {
+				return errors.Validation("format must be one of json or csv")
+			}
+			return nil
+		},
+	}
+
+	cfg := &handlers.HandlerConfig{
+		Validate: validate,
+		Action: func() (interface{}, *errors.ServiceError) {
+			entries, err := h.service.ListUsage(query)
+			if err != nil {
+				return nil, err
+			}
+			if entries == nil {
+				entries = []services.CentralUsageReportEntry{}
+			}
+			return CentralUsageReport{
+				Kind:        "CentralUsageReport",
+				From:        query.From,
+				To:          query.To,
+				GroupBy:     query.GroupBy,
+				Granularity: query.Granularity,
+				Items:       entries,
+			}, nil
+		},
+	}
+
+	if format != usageFormatCSV {
+		handlers.HandleGet(w, r, cfg)
+		return
+	}
+
+	// The CSV export bypasses HandleGet, which always writes JSON
+	for _, v := range cfg.Validate {
+		if err := v(); err != nil {
+			shared.HandleError(r, w, err)
+			return
+		}
+	}
+	report, err := cfg.Action()
+	if err != nil {
+		shared.HandleError(r, w, err)
+		return
+	}
+	writeCentralUsageCSV(w, report.(CentralUsageReport).Items)
+}
+
+func validateUsageTime(value string, field string, target *time.Time) handlers.Validate {
+	return func() *errors.ServiceError {
+		if value == "" {
+			return nil
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return errors.Validation("%s must be a RFC 3339 timestamp: %v", field, err)
+		}
+		*target = t
+		return nil
+	}
+}
+
+func writeCentralUsageCSV(w http.ResponseWriter, entries []services.CentralUsageReportEntry) {
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", `attachment; filename="central-usage.csv"`)
+	w.WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(w)
+	records := make([][]string, 0, len(entries)+1)
+	records = append(records, centralUsageCSVHeader)
+	for _, entry := range entries {
+		records = append(records, []string{
+			entry.Group,
+			entry.PeriodStart.UTC().Format(time.RFC3339),
+			strconv.FormatInt(entry.Tenants, 10),
+			strconv.FormatInt(entry.CPURequestsMillicoreHours, 10),
+			strconv.FormatInt(entry.CPUUsageMillicoreHours, 10),
+			strconv.FormatInt(entry.MemoryRequestsByteHours, 10),
+			strconv.FormatInt(entry.MemoryUsageByteHours, 10),
+			strconv.FormatInt(entry.StorageByteHours, 10),
+			strconv.FormatFloat(entry.DBMinCapacityACUHours, 'f', -1, 64),
+			strconv.FormatFloat(entry.DBMaxCapacityACUHours, 'f', -1, 64),
+		})
+	}
+	if err := writer.WriteAll(records); err != nil {
+		glog.Errorf("Failed to write central usage CSV: %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeCentralUsageService struct {
+	services.CentralUsageService
+	queries []services.CentralUsageQuery
+	entries []services.CentralUsageReportEntry
+}
+
+func (f *fakeCentralUsageService) ListUsage(query services.CentralUsageQuery) ([]services.CentralUsageReportEntry, *errors.ServiceError) {
+	f.queries = append(f.queries, query)
+	return f.entries, nil
+}
+
+var testUsageNow = time.Date(2026, 3, 15, 12, 30, 0, 0, time.UTC)
+
+func newTestAdminUsageHandler(service services.CentralUsageService) *adminUsageHandler {
+	return &adminUsageHandler{
+		service: service,
+		now:     func() time.Time { return testUsageNow },
+	}
+}
+
+func TestAdminUsageListDefaults(t *testing.T) {
+	service := &fakeCentralUsageService{}
+	rec := httptest.NewRecorder()
+	newTestAdminUsageHandler(service).List(rec, httptest.NewRequest(http.MethodGet, "/api/rhacs/v1/admin/usage", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, service.queries, 1)
+	assert.Equal(t, services.CentralUsageQuery{
+		From:        testUsageNow.Add(-30 * 24 * time.Hour),
+		To:          testUsageNow,
+		GroupBy:     services.CentralUsageGroupByOrganisation,
+		Granularity: services.CentralUsageGranularityDay,
+	}, service.queries[0])
+
+	var report CentralUsageReport
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&report))
+	assert.Equal(t, "CentralUsageReport", report.Kind)
+	assert.Empty(t, report.Items)
+}
+
+func TestAdminUsageListCSV(t *testing.T) {
+	service := &fakeCentralUsageService{
+		entries: []services.CentralUsageReportEntry{{
+			Group:                     "standard",
+			PeriodStart:               time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			Tenants:                   2,
+			CPURequestsMillicoreHours: 48000,
+			DBMaxCapacityACUHours:     12.5,
+		}},
+	}
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet,
+		"/api/rhacs/v1/admin/usage?format=csv&group_by=instance_type&granularity=month&from=2026-03-01T00:00:00Z&to=2026-04-01T00:00:00Z", nil)
+	newTestAdminUsageHandler(service).List(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/csv", rec.Header().Get("Content-Type"))
+	require.Len(t, service.queries, 1)
+	assert.Equal(t, services.CentralUsageGroupByInstanceType, service.queries[0].GroupBy)
+	assert.Equal(t, services.CentralUsageGranularityMonth, service.queries[0].Granularity)
+
+	records, err := csv.NewReader(rec.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, centralUsageCSVHeader, records[0])
+	assert.Equal(t, []string{"standard", "2026-03-01T00:00:00Z", "2", "48000", "0", "0", "0", "0", "0", "12.5"}, records[1])
+}
+
+func TestAdminUsageListValidation(t *testing.T) {
+	tests := map[string]string{
+		"invalid group_by":    "group_by=region",
+		"invalid granularity": "granularity=week",
+		"invalid format":      "format=xml",
+		"invalid from":        "from=yesterday",
+		"from after to":       "from=2026-03-02T00:00:00Z&to=2026-03-01T00:00:00Z",
+	}
+	for name, query := range tests {
+		t.Run(name, func(t *testing.T) {
+			service := &fakeCentralUsageService{}
+			rec := httptest.NewRecorder()
+			newTestAdminUsageHandler(service).List(rec, httptest.NewRequest(http.MethodGet, "/api/rhacs/v1/admin/usage?"+query, nil))
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Empty(t, service.queries)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/public"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/presenters"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
+)
+
+type apiKeyHandler struct {
+	service services.APIKeyService
+}
+
+// NewAPIKeyHandler ...
+func NewAPIKeyHandler(service services.APIKeyService) *apiKeyHandler {
+	return &apiKeyHandler{
+		service: service,
+	}
+}
+
+// Create creates an API key. The response is the only one which contains the key itself.
+func (h apiKeyHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var apiKeyRequest public.ApiKeyRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &apiKeyRequest,
+		Action: func() (interface{}, *errors.ServiceError) {
+			created, key, svcErr := h.service.Create(r.Context(), apiKeyRequest.Name, apiKeyRequest.Scopes, apiKeyRequest.ExpiresAt)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			presented := presenters.PresentAPIKey(created)
+			presented.Key = key
+			return presented, nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+// List ...
+func (h apiKeyHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			apiKeys, svcErr := h.service.List(r.Context())
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			apiKeyList := public.ApiKeyList{
+				Kind:  "ApiKeyList",
+				Page:  1,
+				Size:  int32(len(apiKeys)),
+				Total: int32(len(apiKeys)),
+				Items: make([]public.ApiKey, 0, len(apiKeys)),
+			}
+			for _, apiKey := range apiKeys {
+				apiKeyList.Items = append(apiKeyList.Items, presenters.PresentAPIKey(apiKey))
+			}
+			return apiKeyList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Get ...
+func (h apiKeyHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			apiKey, svcErr := h.service.Get(r.Context(), mux.Vars(r)["id"])
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return presenters.PresentAPIKey(apiKey), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Delete revokes an API key
+func (h apiKeyHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			return nil, h.service.Revoke(r.Context(), mux.Vars(r)["id"])
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/public"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKeyCreate(t *testing.T) {
+	service := &services.APIKeyServiceMock{
+		CreateFunc: func(ctx context.Context, name string, scopes []string, expiresAt *time.Time) (*dbapi.APIKey, string, *errors.ServiceError) {
+			return &dbapi.APIKey{
+				Meta:           api.Meta{ID: "key-1"},
+				Name:           name,
+				OrganisationID: "org-1",
+				Owner:          "owner",
+				Scopes:         strings.Join(scopes, " "),
+				SecretHash:     []byte("hash"),
+			}, "rhacs_key-1_secret", nil // pragma: allowlist secret
+		},
+	}
+	handler := NewAPIKeyHandler(service)
+
+	rec := httptest.NewRecorder()
+	handler.Create(rec, httptest.NewRequest(http.MethodPost, "/api/rhacs/v1/api_keys",
+		strings.NewReader(`{"name":"terraform","scopes":["centrals:read","centrals:write"]}`)))
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	require.Len(t, service.CreateCalls(), 1)
+	assert.Nil(t, service.CreateCalls()[0].ExpiresAt)
+	var created public.ApiKey
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	assert.Equal(t, "rhacs_key-1_secret", created.Key)
+	assert.Equal(t, []string{"centrals:read", "centrals:write"}, created.Scopes)
+	assert.NotContains(t, rec.Body.String(), "hash")
+}
+
+func TestAPIKeyGetDoesNotPresentKey(t *testing.T) {
+	service := &services.APIKeyServiceMock{
+		GetFunc: func(ctx context.Context, id string) (*dbapi.APIKey, *errors.ServiceError) {
+			return &dbapi.APIKey{Meta: api.Meta{ID: id}, Name: "terraform", Scopes: "centrals:read"}, nil
+		},
+	}
+	handler := NewAPIKeyHandler(service)
+
+	rec := httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/api/rhacs/v1/api_keys/key-1", nil), map[string]string{"id": "key-1"})
+	handler.Get(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var presented public.ApiKey
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &presented))
+	assert.Equal(t, "key-1", presented.Id)
+	assert.Empty(t, presented.Key)
+}
+
+func TestAPIKeyDelete(t *testing.T) {
+	service := &services.APIKeyServiceMock{
+		RevokeFunc: func(ctx context.Context, id string) *errors.ServiceError {
+			return errors.NotFound("APIKey with id='%s' not found", id)
+		},
+	}
+	handler := NewAPIKeyHandler(service)
+
+	rec := httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodDelete, "/api/rhacs/v1/api_keys/key-2", nil), map[string]string{"id": "key-2"})
+	handler.Delete(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	require.Len(t, service.RevokeCalls(), 1)
+	assert.Equal(t, "key-2", service.RevokeCalls()[0].ID)
+}
@@ -9,8 +9,10 @@ import (
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	"github.com/openshift-online/ocm-sdk-go/authentication"
 	"github.com/stackrox/acs-fleet-manager/internal/central/routes"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
 	"github.com/stackrox/acs-fleet-manager/pkg/client/iam"
 	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/features"
 )
 
 type compositeAuthenticationHandler struct {
@@ -36,8 +38,10 @@ func (h *compositeAuthenticationHandler) ServeHTTP(w http.ResponseWriter, r *htt
 	h.defaultHandler.ServeHTTP(w, r)
 }
 
-// NewAuthenticationHandler creates a new instance of authentication handler
-func NewAuthenticationHandler(IAMConfig *iam.IAMConfig, next http.Handler) (http.Handler, error) {
+// NewAuthenticationHandler creates a new instance of authentication handler. The tokens of the issuers bound to data
+// plane clusters are authenticated for the private API in addition to the configured data plane issuers. Customer API
+// keys are authenticated for the public API in addition to JWTs.
+func NewAuthenticationHandler(IAMConfig *iam.IAMConfig, clusterIdentities auth.ClusterIdentitySource, apiKeys auth.APIKeyAuthenticator, next http.Handler) (http.Handler, error) {
 	authnLogger, err := sdk.NewGlogLoggerBuilder().
 		InfoV(glog.Level(1)).
 		DebugV(glog.Level(5)).
@@ -64,10 +68,14 @@ func NewAuthenticationHandler(IAMConfig *iam.IAMConfig, next http.Handler) (http
 		defaultHandlerBuilder.KeysURL(jwksEndpointURI)
 	}
 
-	defaultHandler, err := defaultHandlerBuilder.Next(next).Build()
+	jwtHandler, err := defaultHandlerBuilder.Next(next).Build()
 	if err != nil {
 		return nil, fmt.Errorf("unable to create default authN handler: %w", err)
 	}
+	var defaultHandler http.Handler = jwtHandler
+	if apiKeys != nil && features.CustomerAPIKeys.Enabled() {
+		defaultHandler = auth.NewAPIKeyAuthenticationHandler(apiKeys, jwtHandler, next)
+	}
 
 	privateAPIHandlerBuilder := authentication.NewHandler().
 		Logger(authnLogger).
@@ -83,10 +91,14 @@ func NewAuthenticationHandler(IAMConfig *iam.IAMConfig, next http.Handler) (http
 		privateAPIHandlerBuilder.KeysFile(IAMConfig.KubernetesIssuer.JWKSFile)
 	}
 
-	privateAPIHandler, err := privateAPIHandlerBuilder.Next(next).Build()
+	staticIssuersHandler, err := privateAPIHandlerBuilder.Next(next).Build()
 	if err != nil {
 		return nil, fmt.Errorf("unable to create private authN handler: %w", err)
 	}
+	var privateAPIHandler http.Handler = staticIssuersHandler
+	if clusterIdentities != nil {
+		privateAPIHandler = auth.NewClusterIssuerAuthenticationHandler(clusterIdentities, auth.NewJWKSCache(), staticIssuersHandler, next)
+	}
 
 	adminAPIHandler, err := authentication.NewHandler().
 		Logger(authnLogger).
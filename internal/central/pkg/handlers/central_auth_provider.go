@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/public"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/presenters"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
+)
+
+type centralAuthProviderHandler struct {
+	service        services.CentralAuthProviderService
+	centralService services.CentralService
+}
+
+// NewCentralAuthProviderHandler ...
+func NewCentralAuthProviderHandler(service services.CentralAuthProviderService, centralService services.CentralService) *centralAuthProviderHandler {
+	return &centralAuthProviderHandler{
+		service:        service,
+		centralService: centralService,
+	}
+}
+
+// Create adds an auth provider to the Central. Only owners can change how users log in to the Central.
+func (h centralAuthProviderHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var authProvider public.AuthProvider
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &authProvider,
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			centralRequest, svcErr := h.getCentral(ctx, mux.Vars(r)["id"], dbapi.CentralRoleOwner)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			created, svcErr := h.service.Create(ctx, centralRequest, presenters.ConvertAuthProvider(authProvider))
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return presentAuthProvider(created)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+// List ...
+func (h centralAuthProviderHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			centralRequest, svcErr := h.getCentral(r.Context(), mux.Vars(r)["id"], dbapi.CentralRoleViewer)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			authProviders, svcErr := h.service.List(centralRequest.ID)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			authProviderList := public.AuthProviderList{
+				Kind:  "AuthProviderList",
+				Page:  1,
+				Size:  int32(len(authProviders)),
+				Total: int32(len(authProviders)),
+				Items: make([]public.AuthProvider, 0, len(authProviders)),
+			}
+			for _, authProvider := range authProviders {
+				presented, svcErr := presentAuthProvider(authProvider)
+				if svcErr != nil {
+					return nil, svcErr
+				}
+				authProviderList.Items = append(authProviderList.Items, presented)
+			}
+			return authProviderList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Get ...
+func (h centralAuthProviderHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			centralRequest, svcErr := h.getCentral(r.Context(), mux.Vars(r)["id"], dbapi.CentralRoleViewer)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			authProvider, svcErr := h.service.Get(centralRequest.ID, mux.Vars(r)["auth_provider_id"])
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return presentAuthProvider(authProvider)
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Delete removes an auth provider from the Central. Only owners can change how users log in to the Central.
+func (h centralAuthProviderHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			centralRequest, svcErr := h.getCentral(r.Context(), mux.Vars(r)["id"], dbapi.CentralRoleOwner)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return nil, h.service.Delete(centralRequest.ID, mux.Vars(r)["auth_provider_id"])
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
+
+// getCentral returns the Central if the user has at least the required role for it. Centrals the user cannot view
+// are not found.
+func (h centralAuthProviderHandler) getCentral(ctx context.Context, id string, required dbapi.CentralRole) (*dbapi.CentralRequest, *errors.ServiceError) {
+	centralRequest, svcErr := h.centralService.Get(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	authorized, svcErr := h.centralService.HasCentralRole(ctx, centralRequest, required)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if !authorized {
+		return nil, errors.Unauthorized("user not authorized to manage auth providers of central %q", id)
+	}
+	return centralRequest, nil
+}
+
+func presentAuthProvider(authProvider *dbapi.CentralAuthProvider) (public.AuthProvider, *errors.ServiceError) {
+	presented, err := presenters.PresentAuthProvider(authProvider)
+	if err != nil {
+		return public.AuthProvider{}, errors.NewWithCause(errors.ErrorGeneral, err, "unable to present auth provider %s", authProvider.ID)
+	}
+	return presented, nil
+}
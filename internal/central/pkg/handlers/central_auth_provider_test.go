@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/public"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/gitops"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAuthProviderPayload = `{"name":"Corporate SSO","groups":[{"key":"groups","value":"admins","role":"Admin"}],` +
+	`"oidc":{"issuer":"https://sso.example.com","client_id":"acs","client_secret":"client-secret"}}` // pragma: allowlist secret
+
+func newTestCentralServiceWithRole(role dbapi.CentralRole) *services.CentralServiceMock {
+	return &services.CentralServiceMock{
+		GetFunc: func(ctx context.Context, id string) (*dbapi.CentralRequest, *errors.ServiceError) {
+			return &dbapi.CentralRequest{Meta: api.Meta{ID: id}}, nil
+		},
+		HasCentralRoleFunc: func(ctx context.Context, centralRequest *dbapi.CentralRequest, required dbapi.CentralRole) (bool, *errors.ServiceError) {
+			return role.Includes(required), nil
+		},
+	}
+}
+
+func newAuthProviderRequest(method, body string) *http.Request {
+	req := httptest.NewRequest(method, "/api/rhacs/v1/centrals/central-1/auth-providers", strings.NewReader(body))
+	return mux.SetURLVars(req, map[string]string{"id": "central-1"})
+}
+
+func TestCentralAuthProviderCreate(t *testing.T) {
+	service := &services.CentralAuthProviderServiceMock{
+		CreateFunc: func(ctx context.Context, centralRequest *dbapi.CentralRequest, provider gitops.AuthProvider) (*dbapi.CentralAuthProvider, *errors.ServiceError) {
+			oidc := *provider.OIDC
+			oidc.ClientSecret = "" // pragma: allowlist secret
+			provider.OIDC = &oidc
+			config, err := json.Marshal(provider)
+			require.NoError(t, err)
+			return &dbapi.CentralAuthProvider{Meta: api.Meta{ID: "provider-1"}, CentralID: centralRequest.ID, Name: provider.Name, Config: config}, nil
+		},
+	}
+	handler := NewCentralAuthProviderHandler(service, newTestCentralServiceWithRole(dbapi.CentralRoleOwner))
+
+	rec := httptest.NewRecorder()
+	handler.Create(rec, newAuthProviderRequest(http.MethodPost, testAuthProviderPayload))
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	require.Len(t, service.CreateCalls(), 1)
+	created := service.CreateCalls()[0].Provider
+	assert.Equal(t, "client-secret", created.OIDC.ClientSecret)
+	assert.Equal(t, []gitops.AuthProviderGroup{{Key: "groups", Value: "admins", Role: "Admin"}}, created.Groups)
+	var authProvider public.AuthProvider
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&authProvider))
+	assert.Equal(t, "/api/rhacs/v1/centrals/central-1/auth-providers/provider-1", authProvider.Href)
+	assert.Empty(t, authProvider.Oidc.ClientSecret)
+	assert.Equal(t, "acs", authProvider.Oidc.ClientId)
+}
+
+func TestCentralAuthProviderCreateRequiresOwner(t *testing.T) {
+	service := &services.CentralAuthProviderServiceMock{}
+	handler := NewCentralAuthProviderHandler(service, newTestCentralServiceWithRole(dbapi.CentralRoleEditor))
+
+	rec := httptest.NewRecorder()
+	handler.Create(rec, newAuthProviderRequest(http.MethodPost, testAuthProviderPayload))
+
+	assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+	assert.Empty(t, service.CreateCalls())
+}
+
+func TestCentralAuthProviderListAllowsViewers(t *testing.T) {
+	service := &services.CentralAuthProviderServiceMock{
+		ListFunc: func(centralID string) ([]*dbapi.CentralAuthProvider, *errors.ServiceError) {
+			return []*dbapi.CentralAuthProvider{{Meta: api.Meta{ID: "provider-1"}, CentralID: centralID,
+				Config: api.JSON(`{"name":"Corporate SSO","oidc":{"issuer":"https://sso.example.com"}}`)}}, nil
+		},
+	}
+	handler := NewCentralAuthProviderHandler(service, newTestCentralServiceWithRole(dbapi.CentralRoleViewer))
+
+	rec := httptest.NewRecorder()
+	handler.List(rec, newAuthProviderRequest(http.MethodGet, ""))
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var list public.AuthProviderList
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&list))
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "Corporate SSO", list.Items[0].Name)
+}
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/public"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/presenters"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
+)
+
+type centralGrantHandler struct {
+	service        services.CentralGrantService
+	centralService services.CentralService
+}
+
+// NewCentralGrantHandler ...
+func NewCentralGrantHandler(service services.CentralGrantService, centralService services.CentralService) *centralGrantHandler {
+	return &centralGrantHandler{
+		service:        service,
+		centralService: centralService,
+	}
+}
+
+// Create grants a member of the Central's organisation a role for the Central. Only owners can grant roles.
+func (h centralGrantHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var grantRequest public.CentralGrantRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &grantRequest,
+		Action: func() (interface{}, *errors.ServiceError) {
+			centralRequest, svcErr := h.getCentral(r.Context(), mux.Vars(r)["id"], dbapi.CentralRoleOwner)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			grant, svcErr := h.service.Create(centralRequest.ID, grantRequest.Username, dbapi.CentralRole(grantRequest.Role))
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return presenters.PresentCentralGrant(grant), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+// List ...
+func (h centralGrantHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			centralRequest, svcErr := h.getCentral(r.Context(), mux.Vars(r)["id"], dbapi.CentralRoleViewer)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			grants, svcErr := h.service.List(centralRequest.ID)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			grantList := public.CentralGrantList{
+				Kind:  "CentralGrantList",
+				Page:  1,
+				Size:  int32(len(grants)),
+				Total: int32(len(grants)),
+				Items: make([]public.CentralGrant, 0, len(grants)),
+			}
+			for _, grant := range grants {
+				grantList.Items = append(grantList.Items, presenters.PresentCentralGrant(grant))
+			}
+			return grantList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Delete revokes a grant of the Central. Only owners can revoke roles.
+func (h centralGrantHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			centralRequest, svcErr := h.getCentral(r.Context(), mux.Vars(r)["id"], dbapi.CentralRoleOwner)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return nil, h.service.Delete(centralRequest.ID, mux.Vars(r)["grant_id"])
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
+
+// getCentral returns the Central if the user has at least the required role for it. Centrals the user cannot view
+// are not found.
+func (h centralGrantHandler) getCentral(ctx context.Context, id string, required dbapi.CentralRole) (*dbapi.CentralRequest, *errors.ServiceError) {
+	centralRequest, svcErr := h.centralService.Get(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	authorized, svcErr := h.centralService.HasCentralRole(ctx, centralRequest, required)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if !authorized {
+		return nil, errors.Unauthorized("user not authorized to manage grants of central %q", id)
+	}
+	return centralRequest, nil
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/public"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newGrantRequest(method, body string, vars map[string]string) *http.Request {
+	req := httptest.NewRequest(method, "/api/rhacs/v1/centrals/central-1/grants", strings.NewReader(body))
+	return mux.SetURLVars(req, vars)
+}
+
+func TestCentralGrantCreate(t *testing.T) {
+	service := &services.CentralGrantServiceMock{
+		CreateFunc: func(centralID, username string, role dbapi.CentralRole) (*dbapi.CentralGrant, *errors.ServiceError) {
+			return &dbapi.CentralGrant{Meta: api.Meta{ID: "grant-1"}, CentralID: centralID, Username: username, Role: role}, nil
+		},
+	}
+	handler := NewCentralGrantHandler(service, newTestCentralServiceWithRole(dbapi.CentralRoleOwner))
+
+	rec := httptest.NewRecorder()
+	handler.Create(rec, newGrantRequest(http.MethodPost, `{"username":"alice","role":"editor"}`, map[string]string{"id": "central-1"}))
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	require.Len(t, service.CreateCalls(), 1)
+	assert.Equal(t, "central-1", service.CreateCalls()[0].CentralID)
+	assert.Equal(t, dbapi.CentralRoleEditor, service.CreateCalls()[0].Role)
+	var grant public.CentralGrant
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&grant))
+	assert.Equal(t, "/api/rhacs/v1/centrals/central-1/grants/grant-1", grant.Href)
+	assert.Equal(t, "alice", grant.Username)
+}
+
+func TestCentralGrantManagementRequiresOwner(t *testing.T) {
+	service := &services.CentralGrantServiceMock{}
+	handler := NewCentralGrantHandler(service, newTestCentralServiceWithRole(dbapi.CentralRoleEditor))
+
+	rec := httptest.NewRecorder()
+	handler.Create(rec, newGrantRequest(http.MethodPost, `{"username":"alice","role":"owner"}`, map[string]string{"id": "central-1"}))
+	assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	handler.Delete(rec, newGrantRequest(http.MethodDelete, "", map[string]string{"id": "central-1", "grant_id": "grant-1"}))
+	assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+
+	assert.Empty(t, service.CreateCalls())
+	assert.Empty(t, service.DeleteCalls())
+}
+
+func TestCentralGrantListAllowsViewers(t *testing.T) {
+	service := &services.CentralGrantServiceMock{
+		ListFunc: func(centralID string) ([]*dbapi.CentralGrant, *errors.ServiceError) {
+			return []*dbapi.CentralGrant{{Meta: api.Meta{ID: "grant-1"}, CentralID: centralID, Username: "alice", Role: dbapi.CentralRoleEditor}}, nil
+		},
+	}
+	handler := NewCentralGrantHandler(service, newTestCentralServiceWithRole(dbapi.CentralRoleViewer))
+
+	rec := httptest.NewRecorder()
+	handler.List(rec, newGrantRequest(http.MethodGet, "", map[string]string{"id": "central-1"}))
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var list public.CentralGrantList
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&list))
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "editor", list.Items[0].Role)
+}
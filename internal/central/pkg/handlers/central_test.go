@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/centrals/types"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	coreServices "github.com/stackrox/acs-fleet-manager/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCentralCreateWithAPIKeyIsOwnedByTheCreatorOfTheKey(t *testing.T) {
+	limit := 1
+	providerConfig := &config.ProviderConfig{
+		ProvidersConfig: config.ProviderConfiguration{
+			SupportedProviders: config.ProviderList{
+				config.Provider{
+					Name:    "aws",
+					Default: true,
+					Regions: config.RegionList{
+						config.Region{
+							Name:                   "us-east-1",
+							Default:                true,
+							SupportedInstanceTypes: config.InstanceTypeMap{"eval": {Limit: &limit}},
+						},
+					},
+				},
+			},
+		},
+	}
+	centralService := &services.CentralServiceMock{
+		ListFunc: func(ctx context.Context, listArgs *coreServices.ListArguments) (dbapi.CentralList, *api.PagingMeta, *errors.ServiceError) {
+			return nil, &api.PagingMeta{}, nil
+		},
+		DetectInstanceTypeFunc: func(centralRequest *dbapi.CentralRequest) types.CentralInstanceType {
+			return types.EVAL
+		},
+		RegisterCentralJobFunc: func(ctx context.Context, centralRequest *dbapi.CentralRequest) *errors.ServiceError {
+			return nil
+		},
+	}
+	keys := &auth.APIKeyAuthenticatorMock{
+		AuthenticateFunc: func(key string) (*auth.APIKeyIdentity, error) {
+			return &auth.APIKeyIdentity{
+				ID:              "key-1",
+				Username:        "automation-owner",
+				UserID:          "owner-sub",
+				AlternateUserID: "owner-deprecated-sub",
+				AccountID:       "owner-account",
+				OrgID:           "org-1",
+				Scopes:          auth.APIKeyScopes,
+				ExpiresAt:       time.Now().Add(time.Hour),
+			}, nil
+		},
+	}
+	centralHandler := NewCentralHandler(centralService, providerConfig, nil, nil, &config.CentralRequestConfig{})
+	handler := auth.NewAPIKeyAuthenticationHandler(keys,
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("API keys must not be authenticated as JWTs")
+		}),
+		http.HandlerFunc(centralHandler.Create))
+
+	req := httptest.NewRequest(http.MethodPost, "/api/rhacs/v1/centrals?async=true",
+		strings.NewReader(`{"name":"automated","multi_az":true}`))
+	req.Header.Set("Authorization", "Bearer rhacs_key-1_secret") // pragma: allowlist secret
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusAccepted, rec.Code, rec.Body.String())
+	require.Len(t, centralService.RegisterCentralJobCalls(), 1)
+	central := centralService.RegisterCentralJobCalls()[0].CentralRequest
+	assert.Equal(t, "automation-owner", central.Owner)
+	assert.Equal(t, "owner-sub", central.OwnerUserID)
+	assert.Equal(t, "owner-deprecated-sub", central.OwnerAlternateUserID)
+	assert.Equal(t, "owner-account", central.OwnerAccountID)
+	assert.Equal(t, "org-1", central.OrganisationID)
+}
@@ -3,15 +3,21 @@ package handlers
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
+
+	"github.com/golang/glog"
 
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/gitops"
 
 	"github.com/gorilla/mux"
 
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/private"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/presenters"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/fleetmanager"
 	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/features"
 	"github.com/stackrox/acs-fleet-manager/pkg/handlers"
 )
 
@@ -19,6 +25,9 @@ type dataPlaneCentralHandler struct {
 	service              services.DataPlaneCentralService
 	centralService       services.CentralService
 	clusterService       services.ClusterService
+	clusterHealthService services.ClusterHealthService
+	migrationService     services.CentralMigrationService
+	authProviderService  services.CentralAuthProviderService
 	presenter            *presenters.ManagedCentralPresenter
 	gitopsConfigProvider gitops.ConfigProvider
 }
@@ -28,6 +37,9 @@ func NewDataPlaneCentralHandler(
 	service services.DataPlaneCentralService,
 	centralService services.CentralService,
 	clusterService services.ClusterService,
+	clusterHealthService services.ClusterHealthService,
+	migrationService services.CentralMigrationService,
+	authProviderService services.CentralAuthProviderService,
 	presenter *presenters.ManagedCentralPresenter,
 	gitopsConfigProvider gitops.ConfigProvider,
 ) *dataPlaneCentralHandler {
@@ -35,6 +47,9 @@ func NewDataPlaneCentralHandler(
 		service:              service,
 		centralService:       centralService,
 		clusterService:       clusterService,
+		clusterHealthService: clusterHealthService,
+		migrationService:     migrationService,
+		authProviderService:  authProviderService,
 		presenter:            presenter,
 		gitopsConfigProvider: gitopsConfigProvider,
 	}
@@ -52,6 +67,9 @@ func (h *dataPlaneCentralHandler) UpdateCentralStatuses(w http.ResponseWriter, r
 			ctx := r.Context()
 			dataPlaneCentralStatus := presenters.ConvertDataPlaneCentralStatus(data)
 			err := h.service.UpdateDataPlaneCentralService(ctx, clusterID, dataPlaneCentralStatus)
+			if err == nil {
+				h.recordHeartbeat(r, clusterID)
+			}
 			return nil, err
 		},
 	}
@@ -59,6 +77,30 @@ func (h *dataPlaneCentralHandler) UpdateCentralStatuses(w http.ResponseWriter, r
 	handlers.Handle(w, r, cfg, http.StatusOK)
 }
 
+// GetCentralIDs returns the IDs of the centrals known to fleet-manager which are placed on the cluster or migrated from
+// or to it. Fleetshard uses them to detect orphaned resources, so deleted centrals which can still be restored are
+// included.
+func (h *dataPlaneCentralHandler) GetCentralIDs(w http.ResponseWriter, r *http.Request) {
+	clusterID := mux.Vars(r)["id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&clusterID, "id", &handlers.MinRequiredFieldLength, nil),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			ids, err := h.centralService.ListKnownCentralIDs(clusterID)
+			if err != nil {
+				return nil, err
+			}
+			return private.CentralIdList{
+				Kind:  "CentralIdList",
+				Items: ids,
+			}, nil
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
+
 // GetAll ...
 func (h *dataPlaneCentralHandler) GetAll(w http.ResponseWriter, r *http.Request) {
 	clusterID := mux.Vars(r)["id"]
@@ -112,8 +154,17 @@ func (h *dataPlaneCentralHandler) GetAll(w http.ResponseWriter, r *http.Request)
 			if presentErr != nil {
 				return nil, errors.GeneralError("failed to convert central request to managed central: %v", presentErr)
 			}
+			if err := h.setMigrations(clusterID, managedCentrals); err != nil {
+				return nil, err
+			}
+			if features.CustomerAuthProviders.Enabled() {
+				if err := h.setAuthProviders(managedCentrals); err != nil {
+					return nil, err
+				}
+			}
 			managedCentralList.Items = managedCentrals
 
+			h.recordHeartbeat(r, clusterID)
 			return managedCentralList, nil
 		},
 	}
@@ -142,3 +193,60 @@ func (h *dataPlaneCentralHandler) GetByID(w http.ResponseWriter, r *http.Request
 
 	handlers.HandleGet(w, r, cfg)
 }
+
+// setMigrations tells the cluster which Centrals are migrated from or to it, and which part it plays
+func (h *dataPlaneCentralHandler) setMigrations(clusterID string, managedCentrals []private.ManagedCentral) *errors.ServiceError {
+	migrations, err := h.migrationService.ListActiveByClusterID(clusterID)
+	if err != nil {
+		return err
+	}
+	if len(migrations) == 0 {
+		return nil
+	}
+	byCentralID := make(map[string]*dbapi.CentralMigration, len(migrations))
+	for _, migration := range migrations {
+		byCentralID[migration.CentralID] = migration
+	}
+	for i := range managedCentrals {
+		migration, ok := byCentralID[managedCentrals[i].Id]
+		if !ok {
+			continue
+		}
+		role, _ := migration.RoleOf(clusterID)
+		managedCentrals[i].Spec.Migration = private.ManagedCentralAllOfSpecMigration{
+			Phase: string(migration.DataPlanePhase()),
+			Role:  string(role),
+		}
+	}
+	return nil
+}
+
+// setAuthProviders adds the auth providers customers added to their Centrals
+func (h *dataPlaneCentralHandler) setAuthProviders(managedCentrals []private.ManagedCentral) *errors.ServiceError {
+	centralIDs := make([]string, 0, len(managedCentrals))
+	for _, managedCentral := range managedCentrals {
+		centralIDs = append(centralIDs, managedCentral.Id)
+	}
+	authProviders, err := h.authProviderService.ListByCentralIDs(centralIDs)
+	if err != nil {
+		return err
+	}
+	for i := range managedCentrals {
+		for _, authProvider := range authProviders[managedCentrals[i].Id] {
+			managedCentrals[i].Spec.AuthProviders = append(managedCentrals[i].Spec.AuthProviders,
+				presenters.PresentManagedAuthProvider(authProvider.ID, authProvider.AuthProvider))
+		}
+	}
+	return nil
+}
+
+// recordHeartbeat records the request of fleetshard-sync as heartbeat of the cluster. Failing to do so does not fail
+// the request, as the cluster would otherwise look silent.
+func (h *dataPlaneCentralHandler) recordHeartbeat(r *http.Request, clusterID string) {
+	// Older fleetshard-sync versions do not send the header, which counts as no reconcile errors
+	reconcileErrors, _ := strconv.Atoi(r.Header.Get(fleetmanager.FleetshardReconcileErrorsHeader))
+	version := r.Header.Get(fleetmanager.FleetshardVersionHeader)
+	if svcErr := h.clusterHealthService.RecordHeartbeat(clusterID, version, max(reconcileErrors, 0)); svcErr != nil {
+		glog.Errorf("Failed to record heartbeat of cluster %s: %v", clusterID, svcErr)
+	}
+}
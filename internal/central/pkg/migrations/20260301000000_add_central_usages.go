@@ -0,0 +1,51 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+func addCentralUsages() *gormigrate.Migration {
+	type CentralUsage struct {
+		api.Meta
+		CentralID             string    `json:"central_id" gorm:"uniqueIndex:idx_central_usages_central_period"`
+		OrganisationID        string    `json:"organisation_id" gorm:"index"`
+		ClusterID             string    `json:"cluster_id"`
+		InstanceType          string    `json:"instance_type"`
+		PeriodStart           time.Time `json:"period_start" gorm:"uniqueIndex:idx_central_usages_central_period"`
+		CPURequestsMillicores int64     `json:"cpu_requests_millicores"`
+		CPUUsageMillicores    int64     `json:"cpu_usage_millicores"`
+		MemoryRequestsBytes   int64     `json:"memory_requests_bytes"`
+		MemoryUsageBytes      int64     `json:"memory_usage_bytes"`
+		StorageBytes          int64     `json:"storage_bytes"`
+		DBMinCapacityACU      float32   `json:"db_min_capacity_acu"`
+		DBMaxCapacityACU      float32   `json:"db_max_capacity_acu"`
+	}
+
+	migrationID := "20260301000000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&CentralUsage{}); err != nil {
+				return fmt.Errorf("migrating %s: %w", migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable(&CentralUsage{}); err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
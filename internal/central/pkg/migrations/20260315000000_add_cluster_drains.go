@@ -0,0 +1,68 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"gorm.io/gorm"
+)
+
+const clusterDrainLeaseType = "cluster_drain_worker"
+
+func addClusterDrains() *gormigrate.Migration {
+	type ClusterDrain struct {
+		api.Meta
+		ClusterID   string `gorm:"index"`
+		Status      string `gorm:"index"`
+		Concurrency int
+		Owner       string
+		CompletedAt sql.NullTime
+	}
+
+	type ClusterDrainCentral struct {
+		api.Meta
+		DrainID         string `gorm:"index"`
+		CentralID       string
+		TargetClusterID string
+		Status          string
+		Reason          string
+	}
+
+	migrationID := "20260315000000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&ClusterDrain{}, &ClusterDrainCentral{}); err != nil {
+				return fmt.Errorf("migrating %s: %w", migrationID, err)
+			}
+			// Set an initial already expired lease for the cluster drain worker.
+			err := tx.Create(&api.LeaderLease{
+				Expires:   &db.CentralAdditionalLeasesExpireTime,
+				LeaseType: clusterDrainLeaseType,
+				Leader:    api.NewID(),
+			}).Error
+			if err != nil {
+				return fmt.Errorf("adding %s lease in %s: %w", clusterDrainLeaseType, migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Where("lease_type = ?", clusterDrainLeaseType).Delete(&api.LeaderLease{}).Error; err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			if err := tx.Migrator().DropTable(&ClusterDrainCentral{}, &ClusterDrain{}); err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,59 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"gorm.io/gorm"
+)
+
+const clusterHealthLeaseType = "cluster_health_worker"
+
+func addClusterHeartbeats() *gormigrate.Migration {
+	type ClusterHeartbeat struct {
+		api.Meta
+		ClusterID           string    `json:"cluster_id" gorm:"uniqueIndex"`
+		FleetshardVersion   string    `json:"fleetshard_version"`
+		ReconcileErrors     int       `json:"reconcile_errors"`
+		LastSeenAt          time.Time `json:"last_seen_at"`
+		UnscheduledAsSilent bool      `json:"unscheduled_as_silent"`
+	}
+
+	migrationID := "20260325000000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&ClusterHeartbeat{}); err != nil {
+				return fmt.Errorf("migrating %s: %w", migrationID, err)
+			}
+			// Set an initial already expired lease for the cluster health worker.
+			err := tx.Create(&api.LeaderLease{
+				Expires:   &db.CentralAdditionalLeasesExpireTime,
+				LeaseType: clusterHealthLeaseType,
+				Leader:    api.NewID(),
+			}).Error
+			if err != nil {
+				return fmt.Errorf("adding %s lease in %s: %w", clusterHealthLeaseType, migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Where("lease_type = ?", clusterHealthLeaseType).Delete(&api.LeaderLease{}).Error; err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			if err := tx.Migrator().DropTable(&ClusterHeartbeat{}); err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
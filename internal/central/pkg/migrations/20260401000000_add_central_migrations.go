@@ -0,0 +1,79 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"gorm.io/gorm"
+)
+
+const centralMigrationLeaseType = "central_migration_worker"
+
+func addCentralMigrations() *gormigrate.Migration {
+	type CentralMigration struct {
+		api.Meta
+		CentralID       string    `json:"central_id" gorm:"index"`
+		SourceClusterID string    `json:"source_cluster_id"`
+		TargetClusterID string    `json:"target_cluster_id"`
+		Phase           string    `json:"phase" gorm:"index"`
+		PhaseStartedAt  time.Time `json:"phase_started_at"`
+		SourceCompleted bool      `json:"source_completed"`
+		TargetCompleted bool      `json:"target_completed"`
+		FailedPhase     string    `json:"failed_phase"`
+		FailedReason    string    `json:"failed_reason"`
+		SourceRoutes    api.JSON  `json:"source_routes"`
+		TargetRoutes    api.JSON  `json:"target_routes"`
+		DNSChangeID     string    `json:"dns_change_id"`
+		Owner           string    `json:"owner"`
+		CompletedAt     sql.NullTime
+	}
+	type CentralRequest struct {
+		db.Model
+		MigrationPhase string `json:"migration_phase"`
+	}
+
+	migrationID := "20260401000000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&CentralMigration{}); err != nil {
+				return fmt.Errorf("migrating %s: %w", migrationID, err)
+			}
+			if err := addColumnIfNotExists(tx, &CentralRequest{}, "migration_phase"); err != nil {
+				return fmt.Errorf("migrating %s: %w", migrationID, err)
+			}
+			// Set an initial already expired lease for the central migration worker.
+			err := tx.Create(&api.LeaderLease{
+				Expires:   &db.CentralAdditionalLeasesExpireTime,
+				LeaseType: centralMigrationLeaseType,
+				Leader:    api.NewID(),
+			}).Error
+			if err != nil {
+				return fmt.Errorf("adding %s lease in %s: %w", centralMigrationLeaseType, migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Where("lease_type = ?", centralMigrationLeaseType).Delete(&api.LeaderLease{}).Error; err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			if err := tx.Migrator().DropColumn(&CentralRequest{}, "migration_phase"); err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			if err := tx.Migrator().DropTable(&CentralMigration{}); err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,38 @@
+package migrations
+
+import (
+	"fmt"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"gorm.io/gorm"
+)
+
+const centralDNSReconcileLeaseType = "central_dns_reconcile_worker"
+
+func addCentralDNSReconcileLease() *gormigrate.Migration {
+	migrationID := "20260410000000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			// Set an initial already expired lease for the central DNS reconcile worker.
+			err := tx.Create(&api.LeaderLease{
+				Expires:   &db.CentralAdditionalLeasesExpireTime,
+				LeaseType: centralDNSReconcileLeaseType,
+				Leader:    api.NewID(),
+			}).Error
+			if err != nil {
+				return fmt.Errorf("adding %s lease in %s: %w", centralDNSReconcileLeaseType, migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Where("lease_type = ?", centralDNSReconcileLeaseType).Delete(&api.LeaderLease{}).Error; err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,47 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"fmt"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+func addAuditEvents() *gormigrate.Migration {
+	type AuditEvent struct {
+		api.Meta
+		Sequence  int64    `json:"sequence" gorm:"uniqueIndex"`
+		Actor     string   `json:"actor"`
+		RouteName string   `json:"route_name"`
+		Method    string   `json:"method"`
+		TargetID  string   `json:"target_id" gorm:"index"`
+		Before    api.JSON `json:"before"`
+		After     api.JSON `json:"after"`
+		PrevHash  string   `json:"prev_hash"`
+		Hash      string   `json:"hash"`
+	}
+
+	migrationID := "20260415000000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&AuditEvent{}); err != nil {
+				return fmt.Errorf("migrating %s: %w", migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable(&AuditEvent{}); err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,41 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"fmt"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+func addCentralGrants() *gormigrate.Migration {
+	type CentralGrant struct {
+		api.Meta
+		CentralID string `json:"central_id" gorm:"uniqueIndex:idx_central_grants_central_id_username"`
+		Username  string `json:"username" gorm:"uniqueIndex:idx_central_grants_central_id_username"`
+		Role      string `json:"role"`
+	}
+
+	migrationID := "20260420000000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&CentralGrant{}); err != nil {
+				return fmt.Errorf("migrating %s: %w", migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable(&CentralGrant{}); err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,45 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+func addAccessRules() *gormigrate.Migration {
+	type AccessRule struct {
+		api.Meta
+		Action    string     `json:"action"`
+		Type      string     `json:"type"`
+		Value     string     `json:"value"`
+		Reason    string     `json:"reason"`
+		ExpiresAt *time.Time `json:"expires_at" gorm:"index"`
+		Owner     string     `json:"owner"`
+	}
+
+	migrationID := "20260425000000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&AccessRule{}); err != nil {
+				return fmt.Errorf("migrating %s: %w", migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable(&AccessRule{}); err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
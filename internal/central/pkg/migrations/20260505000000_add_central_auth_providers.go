@@ -0,0 +1,43 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"fmt"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+func addCentralAuthProviders() *gormigrate.Migration {
+	type CentralAuthProvider struct {
+		api.Meta
+		CentralID             string   `json:"central_id" gorm:"uniqueIndex:idx_central_auth_providers_central_id_name"`
+		Name                  string   `json:"name" gorm:"uniqueIndex:idx_central_auth_providers_central_id_name"`
+		Config                api.JSON `json:"config"`
+		EncryptedClientSecret []byte   `json:"-"`
+		Owner                 string   `json:"owner"`
+	}
+
+	migrationID := "20260505000000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&CentralAuthProvider{}); err != nil {
+				return fmt.Errorf("migrating %s: %w", migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable(&CentralAuthProvider{}); err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,46 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+func addAPIKeys() *gormigrate.Migration {
+	type APIKey struct {
+		api.Meta
+		Name           string     `json:"name"`
+		OrganisationID string     `json:"organisation_id" gorm:"index"`
+		Owner          string     `json:"owner"`
+		Scopes         string     `json:"scopes"`
+		SecretHash     []byte     `json:"-"`
+		ExpiresAt      time.Time  `json:"expires_at"`
+		LastUsedAt     *time.Time `json:"last_used_at"`
+	}
+
+	migrationID := "20260519000000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&APIKey{}); err != nil {
+				return fmt.Errorf("migrating %s: %w", migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable(&APIKey{}); err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,38 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addCentralUsagesPeriodStartIndex() *gormigrate.Migration {
+	type CentralUsage struct {
+		PeriodStart time.Time `json:"period_start" gorm:"index:idx_central_usages_period_start"`
+	}
+
+	migrationID := "20260526000000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.Migrator().CreateIndex(&CentralUsage{}, "idx_central_usages_period_start"); err != nil {
+				return fmt.Errorf("migrating %s: %w", migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropIndex(&CentralUsage{}, "idx_central_usages_period_start"); err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,52 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"fmt"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+func addClusterCordoned() *gormigrate.Migration {
+	type Cluster struct {
+		api.Meta
+		ClusterID string `json:"cluster_id"`
+		Cordoned  bool   `json:"cordoned"`
+	}
+	type ClusterDrain struct {
+		api.Meta
+		ClusterID string `json:"cluster_id"`
+		Status    string `json:"status"`
+	}
+
+	migrationID := "20260609000000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := addColumnIfNotExists(tx, &Cluster{}, "cordoned"); err != nil {
+				return fmt.Errorf("adding column cordoned in migration %s: %w", migrationID, err)
+			}
+			// Clusters which are being drained or were drained already stay unschedulable
+			drained := tx.Model(&ClusterDrain{}).Select("cluster_id").
+				Where("status IN ?", []string{"running", "paused", "completed"})
+			if err := tx.Model(&Cluster{}).Where("cluster_id IN (?)", drained).
+				Update("cordoned", true).Error; err != nil {
+				return fmt.Errorf("cordoning drained clusters in migration %s: %w", migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := dropIfColumnExists(tx, &Cluster{}, "cordoned"); err != nil {
+				return fmt.Errorf("dropping column cordoned in rollback of migration %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
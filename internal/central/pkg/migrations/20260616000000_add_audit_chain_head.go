@@ -0,0 +1,47 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addAuditChainHead() *gormigrate.Migration {
+	type AuditChainHead struct {
+		ID        string `gorm:"primaryKey"`
+		Sequence  int64
+		Hash      string
+		UpdatedAt time.Time
+	}
+
+	migrationID := "20260616000000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&AuditChainHead{}); err != nil {
+				return fmt.Errorf("migrating %s: %w", migrationID, err)
+			}
+			// The chain is anchored at the last event recorded so far
+			if err := tx.Exec(`INSERT INTO audit_chain_heads (id, sequence, hash, updated_at)
+				SELECT 'audit_events', sequence, hash, now() FROM audit_events ORDER BY sequence DESC LIMIT 1
+				ON CONFLICT (id) DO NOTHING`).Error; err != nil {
+				return fmt.Errorf("anchoring audit event chain in migration %s: %w", migrationID, err)
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable(&AuditChainHead{}); err != nil {
+				return fmt.Errorf("rolling back %s: %w", migrationID, err)
+			}
+			return nil
+		},
+	}
+}
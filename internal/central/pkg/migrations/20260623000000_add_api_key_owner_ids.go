@@ -0,0 +1,46 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"fmt"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+func addAPIKeyOwnerIDs() *gormigrate.Migration {
+	type APIKey struct {
+		api.Meta
+		OwnerUserID          string `json:"owner_user_id"`
+		OwnerAlternateUserID string `json:"owner_alternate_user_id"`
+		OwnerAccountID       string `json:"owner_account_id"`
+	}
+	columns := []string{"owner_user_id", "owner_alternate_user_id", "owner_account_id"}
+
+	migrationID := "20260623000000"
+
+	return &gormigrate.Migration{
+		ID: migrationID,
+		Migrate: func(tx *gorm.DB) error {
+			for _, column := range columns {
+				if err := addColumnIfNotExists(tx, &APIKey{}, column); err != nil {
+					return fmt.Errorf("adding column %s in migration %s: %w", column, migrationID, err)
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, column := range columns {
+				if err := dropIfColumnExists(tx, &APIKey{}, column); err != nil {
+					return fmt.Errorf("dropping column %s in rollback of migration %s: %w", column, migrationID, err)
+				}
+			}
+			return nil
+		},
+	}
+}
@@ -58,6 +58,24 @@ func getMigrations() []*gormigrate.Migration {
 		addEnteredProvisioningAtToCentralRequest(),
 		renameLeaderLeaseTypes(),
 		dropClusterAddons(),
+		addCentralUsages(),
+		addClusterDrains(),
+		addClusterRegistrationFields(),
+		addClusterHeartbeats(),
+		addCentralMigrations(),
+		addCentralDNSReconcileLease(),
+		addAuditEvents(),
+		addCentralGrants(),
+		addAccessRules(),
+		addCentralClientRotation(),
+		addCentralAuthProviders(),
+		addClusterAuthIdentity(),
+		addAPIKeys(),
+		addCentralUsagesPeriodStartIndex(),
+		addCentralDBUpgradeStatus(),
+		addClusterCordoned(),
+		addAuditChainHead(),
+		addAPIKeyOwnerIDs(),
 	}
 }
 
@@ -0,0 +1,35 @@
+package presenters
+
+import (
+	"fmt"
+
+	admin "github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+)
+
+// ConvertAccessRuleRequest converts an admin.AccessRuleRequest to a dbapi.AccessRule.
+func ConvertAccessRuleRequest(request admin.AccessRuleRequest) *dbapi.AccessRule {
+	return &dbapi.AccessRule{
+		Action:    request.Action,
+		Type:      request.Type,
+		Value:     request.Value,
+		Reason:    request.Reason,
+		ExpiresAt: request.ExpiresAt,
+	}
+}
+
+// PresentAccessRule presents a dbapi.AccessRule as an admin.AccessRule.
+func PresentAccessRule(rule *dbapi.AccessRule) admin.AccessRule {
+	return admin.AccessRule{
+		Id:        rule.ID,
+		Kind:      "AccessRule",
+		Href:      fmt.Sprintf("/api/rhacs/v1/admin/access-rules/%s", rule.ID),
+		Action:    rule.Action,
+		Type:      rule.Type,
+		Value:     rule.Value,
+		Reason:    rule.Reason,
+		ExpiresAt: rule.ExpiresAt,
+		Owner:     rule.Owner,
+		CreatedAt: rule.CreatedAt,
+	}
+}
@@ -0,0 +1,44 @@
+package presenters
+
+import (
+	admin "github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+)
+
+// PresentAuditEvent presents a dbapi.AuditEvent as an admin.AuditEvent.
+func PresentAuditEvent(event *dbapi.AuditEvent) (admin.AuditEvent, *errors.ServiceError) {
+	before, err := event.Before.Object()
+	if err != nil {
+		return admin.AuditEvent{}, errors.NewWithCause(errors.ErrorGeneral, err, "unable to present snapshot before audit event %s", event.ID)
+	}
+	after, err := event.After.Object()
+	if err != nil {
+		return admin.AuditEvent{}, errors.NewWithCause(errors.ErrorGeneral, err, "unable to present snapshot after audit event %s", event.ID)
+	}
+	return admin.AuditEvent{
+		Id:        event.ID,
+		Kind:      "AuditEvent",
+		Sequence:  event.Sequence,
+		Actor:     event.Actor,
+		RouteName: event.RouteName,
+		Method:    event.Method,
+		TargetId:  event.TargetID,
+		Before:    before,
+		After:     after,
+		PrevHash:  event.PrevHash,
+		Hash:      event.Hash,
+		CreatedAt: event.CreatedAt,
+	}, nil
+}
+
+// PresentAuditChainVerification presents a dbapi.AuditChainVerification as an admin.AuditChainVerification.
+func PresentAuditChainVerification(verification *dbapi.AuditChainVerification) admin.AuditChainVerification {
+	return admin.AuditChainVerification{
+		Kind:          "AuditChainVerification",
+		Valid:         verification.Valid,
+		Checked:       int32(verification.Checked),
+		BrokenEventId: verification.BrokenEventID,
+		Reason:        verification.Reason,
+	}
+}
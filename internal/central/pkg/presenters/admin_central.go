@@ -30,5 +30,13 @@ func PresentCentralRequestAdminEndpoint(request *dbapi.CentralRequest, _ account
 		InstanceType:  request.InstanceType,
 		Traits:        request.Traits,
 		ClusterId:     request.ClusterID,
+
+		MigrationPhase: string(request.MigrationPhase),
+
+		ClientCreatedAt:      dbapi.NullTimeToTimePtr(request.ClientCreatedAt),
+		ClientRotationWindow: request.ClientRotationWindow,
+
+		DbUpgradeStatus:  request.DBUpgradeStatus,
+		DbUpgradeMessage: request.DBUpgradeMessage,
 	}, nil
 }
@@ -0,0 +1,28 @@
+package presenters
+
+import (
+	"fmt"
+
+	admin "github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+)
+
+// PresentCentralMigration presents a dbapi.CentralMigration as an admin.CentralMigration.
+func PresentCentralMigration(migration *dbapi.CentralMigration) admin.CentralMigration {
+	return admin.CentralMigration{
+		Id:              migration.ID,
+		Kind:            "CentralMigration",
+		Href:            fmt.Sprintf("/api/rhacs/v1/admin/centrals/%s/migrations/%s", migration.CentralID, migration.ID),
+		CentralId:       migration.CentralID,
+		SourceClusterId: migration.SourceClusterID,
+		TargetClusterId: migration.TargetClusterID,
+		Phase:           string(migration.Phase),
+		PhaseStartedAt:  migration.PhaseStartedAt,
+		FailedPhase:     string(migration.FailedPhase),
+		FailedReason:    migration.FailedReason,
+		Owner:           migration.Owner,
+		CreatedAt:       migration.CreatedAt,
+		UpdatedAt:       migration.UpdatedAt,
+		CompletedAt:     dbapi.NullTimeToTimePtr(migration.CompletedAt),
+	}
+}
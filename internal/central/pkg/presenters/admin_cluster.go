@@ -0,0 +1,48 @@
+package presenters
+
+import (
+	"encoding/json"
+	"fmt"
+
+	admin "github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+)
+
+// PresentDataPlaneCluster presents an api.Cluster with the number of Centrals on it as an admin.DataPlaneCluster.
+func PresentDataPlaneCluster(cluster *api.Cluster, centralCount int) (admin.DataPlaneCluster, *errors.ServiceError) {
+	registrationSource := cluster.RegistrationSource
+	if registrationSource == "" {
+		registrationSource = api.ClusterRegistrationSourceConfig
+	}
+	result := admin.DataPlaneCluster{
+		Id:                    cluster.ID,
+		Kind:                  "DataPlaneCluster",
+		Href:                  fmt.Sprintf("/api/rhacs/v1/admin/clusters/%s", cluster.ClusterID),
+		ClusterId:             cluster.ClusterID,
+		ExternalId:            cluster.ExternalID,
+		Name:                  cluster.Name,
+		CloudProvider:         cluster.CloudProvider,
+		Region:                cluster.Region,
+		MultiAz:               cluster.MultiAZ,
+		Status:                cluster.Status.String(),
+		ProviderType:          cluster.ProviderType.String(),
+		ClusterDns:            cluster.ClusterDNS,
+		SupportedInstanceType: cluster.SupportedInstanceType,
+		Schedulable:           cluster.Schedulable,
+		Cordoned:              cluster.Cordoned,
+		CentralInstanceLimit:  int32(cluster.CentralInstanceLimit),
+		CentralCount:          int32(centralCount),
+		RegistrationSource:    string(registrationSource),
+		AuthIssuer:            cluster.AuthIssuer,
+		AuthSubject:           cluster.AuthSubject,
+		CreatedAt:             cluster.CreatedAt,
+		UpdatedAt:             cluster.UpdatedAt,
+	}
+	if len(cluster.ProviderSpec) > 0 {
+		if err := json.Unmarshal(cluster.ProviderSpec, &result.ProviderSpec); err != nil {
+			return result, errors.NewWithCause(errors.ErrorGeneral, err, "unable to read provider spec of cluster %s", cluster.ClusterID)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,44 @@
+package presenters
+
+import (
+	"fmt"
+
+	admin "github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/admin/private"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+)
+
+// PresentClusterDrain presents a dbapi.ClusterDrain as an admin.ClusterDrain.
+func PresentClusterDrain(drain *dbapi.ClusterDrain) admin.ClusterDrain {
+	result := admin.ClusterDrain{
+		Id:          drain.ID,
+		Kind:        "ClusterDrain",
+		Href:        fmt.Sprintf("/api/rhacs/v1/admin/clusters/%s/drains/%s", drain.ClusterID, drain.ID),
+		ClusterId:   drain.ClusterID,
+		Status:      string(drain.Status),
+		Concurrency: int32(drain.Concurrency),
+		Owner:       drain.Owner,
+		CreatedAt:   drain.CreatedAt,
+		UpdatedAt:   drain.UpdatedAt,
+		CompletedAt: dbapi.NullTimeToTimePtr(drain.CompletedAt),
+		Centrals:    make([]admin.ClusterDrainCentral, 0, len(drain.Centrals)),
+	}
+	for _, drainCentral := range drain.Centrals {
+		switch drainCentral.Status {
+		case dbapi.ClusterDrainCentralStatusMigrated:
+			result.Migrated++
+		case dbapi.ClusterDrainCentralStatusMigrating:
+			result.Migrating++
+		case dbapi.ClusterDrainCentralStatusFailed:
+			result.Failed++
+		}
+		result.Centrals = append(result.Centrals, admin.ClusterDrainCentral{
+			CentralId:       drainCentral.CentralID,
+			TargetClusterId: drainCentral.TargetClusterID,
+			Status:          string(drainCentral.Status),
+			Reason:          drainCentral.Reason,
+			CreatedAt:       drainCentral.CreatedAt,
+			UpdatedAt:       drainCentral.UpdatedAt,
+		})
+	}
+	return result
+}
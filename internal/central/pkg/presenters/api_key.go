@@ -0,0 +1,29 @@
+package presenters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/public"
+)
+
+// KindAPIKey is a string identifier for the type public.ApiKey
+const KindAPIKey = "ApiKey"
+
+// PresentAPIKey presents a dbapi.APIKey as a public.ApiKey. The key itself is only known when it is created, and must
+// be set by the caller.
+func PresentAPIKey(from *dbapi.APIKey) public.ApiKey {
+	return public.ApiKey{
+		Id:             from.ID,
+		Kind:           KindAPIKey,
+		Href:           fmt.Sprintf("%s/api_keys/%s", BasePath, from.ID),
+		Name:           from.Name,
+		Scopes:         strings.Fields(from.Scopes),
+		OrganisationId: from.OrganisationID,
+		Owner:          from.Owner,
+		CreatedAt:      from.CreatedAt,
+		ExpiresAt:      from.ExpiresAt,
+		LastUsedAt:     from.LastUsedAt,
+	}
+}
@@ -0,0 +1,101 @@
+package presenters
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/private"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/public"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/gitops"
+)
+
+// KindAuthProvider is a string identifier for the type public.AuthProvider
+const KindAuthProvider = "AuthProvider"
+
+// ConvertAuthProvider converts a public.AuthProvider to the gitops.AuthProvider shape used by declarative config.
+func ConvertAuthProvider(from public.AuthProvider) gitops.AuthProvider {
+	to := gitops.AuthProvider{
+		Name:        from.Name,
+		MinimumRole: from.MinimumRole,
+		OIDC: &gitops.AuthProviderOIDCConfig{
+			Issuer:                    from.Oidc.Issuer,
+			Mode:                      from.Oidc.Mode,
+			ClientID:                  from.Oidc.ClientId,
+			ClientSecret:              from.Oidc.ClientSecret, // pragma: allowlist secret
+			DisableOfflineAccessScope: from.Oidc.DisableOfflineAccessScope,
+		},
+	}
+	for _, group := range from.Groups {
+		to.Groups = append(to.Groups, gitops.AuthProviderGroup{Key: group.Key, Value: group.Value, Role: group.Role})
+	}
+	for _, attribute := range from.RequiredAttributes {
+		to.RequiredAttributes = append(to.RequiredAttributes, gitops.AuthProviderRequiredAttribute{Key: attribute.Key, Value: attribute.Value})
+	}
+	for _, mapping := range from.ClaimMappings {
+		to.ClaimMappings = append(to.ClaimMappings, gitops.AuthProviderClaimMapping{Path: mapping.Path, Name: mapping.Name})
+	}
+	return to
+}
+
+// PresentAuthProvider presents a dbapi.CentralAuthProvider as a public.AuthProvider. The client secret is never
+// presented.
+func PresentAuthProvider(from *dbapi.CentralAuthProvider) (public.AuthProvider, error) {
+	authProvider, err := from.AuthProvider()
+	if err != nil {
+		return public.AuthProvider{}, errors.Wrap(err, "presenting auth provider")
+	}
+	to := public.AuthProvider{
+		Id:          from.ID,
+		Kind:        KindAuthProvider,
+		Href:        fmt.Sprintf("%s/centrals/%s/auth-providers/%s", BasePath, from.CentralID, from.ID),
+		CentralId:   from.CentralID,
+		Name:        authProvider.Name,
+		MinimumRole: authProvider.MinimumRole,
+		Oidc: public.AuthProviderOidcConfig{
+			Issuer:                    authProvider.OIDC.Issuer,
+			Mode:                      authProvider.OIDC.Mode,
+			ClientId:                  authProvider.OIDC.ClientID,
+			DisableOfflineAccessScope: authProvider.OIDC.DisableOfflineAccessScope,
+		},
+		CreatedAt: from.CreatedAt,
+	}
+	for _, group := range authProvider.Groups {
+		to.Groups = append(to.Groups, public.AuthProviderGroup{Key: group.Key, Value: group.Value, Role: group.Role})
+	}
+	for _, attribute := range authProvider.RequiredAttributes {
+		to.RequiredAttributes = append(to.RequiredAttributes, public.AuthProviderRequiredAttribute{Key: attribute.Key, Value: attribute.Value})
+	}
+	for _, mapping := range authProvider.ClaimMappings {
+		to.ClaimMappings = append(to.ClaimMappings, public.AuthProviderClaimMapping{Path: mapping.Path, Name: mapping.Name})
+	}
+	return to, nil
+}
+
+// PresentManagedAuthProvider presents a gitops.AuthProvider including its client secret to the data plane.
+func PresentManagedAuthProvider(id string, from gitops.AuthProvider) private.AuthProvider {
+	to := private.AuthProvider{
+		Id:          id,
+		Name:        from.Name,
+		MinimumRole: from.MinimumRole,
+	}
+	if from.OIDC != nil {
+		to.Oidc = private.AuthProviderOidc{
+			Issuer:                    from.OIDC.Issuer,
+			Mode:                      from.OIDC.Mode,
+			ClientId:                  from.OIDC.ClientID,
+			ClientSecret:              from.OIDC.ClientSecret, // pragma: allowlist secret
+			DisableOfflineAccessScope: from.OIDC.DisableOfflineAccessScope,
+		}
+	}
+	for _, group := range from.Groups {
+		to.Groups = append(to.Groups, private.AuthProviderGroups{Key: group.Key, Value: group.Value, Role: group.Role})
+	}
+	for _, attribute := range from.RequiredAttributes {
+		to.RequiredAttributes = append(to.RequiredAttributes, private.AuthProviderRequiredAttributes{Key: attribute.Key, Value: attribute.Value})
+	}
+	for _, mapping := range from.ClaimMappings {
+		to.ClaimMappings = append(to.ClaimMappings, private.AuthProviderClaimMappings{Path: mapping.Path, Name: mapping.Name})
+	}
+	return to
+}
@@ -0,0 +1,24 @@
+package presenters
+
+import (
+	"fmt"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/public"
+)
+
+// KindCentralGrant is a string identifier for the type public.CentralGrant
+const KindCentralGrant = "CentralGrant"
+
+// PresentCentralGrant presents a dbapi.CentralGrant as a public.CentralGrant
+func PresentCentralGrant(from *dbapi.CentralGrant) public.CentralGrant {
+	return public.CentralGrant{
+		Id:        from.ID,
+		Kind:      KindCentralGrant,
+		Href:      fmt.Sprintf("%s/centrals/%s/grants/%s", BasePath, from.CentralID, from.ID),
+		CentralId: from.CentralID,
+		Username:  from.Username,
+		Role:      string(from.Role),
+		CreatedAt: from.CreatedAt,
+	}
+}
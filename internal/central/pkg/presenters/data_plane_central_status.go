@@ -30,12 +30,29 @@ func ConvertDataPlaneCentralStatus(status map[string]private.DataPlaneCentralSta
 			}
 		}
 
+		var usage *dbapi.DataPlaneCentralUsage
+		if v.Usage != (private.DataPlaneCentralStatusUsage{}) {
+			usage = &dbapi.DataPlaneCentralUsage{
+				CPURequestsMillicores: v.Usage.CpuRequestsMillicores,
+				CPUUsageMillicores:    v.Usage.CpuUsageMillicores,
+				MemoryRequestsBytes:   v.Usage.MemoryRequestsBytes,
+				MemoryUsageBytes:      v.Usage.MemoryUsageBytes,
+				StorageBytes:          v.Usage.StorageBytes,
+				DBMinCapacityACU:      v.Usage.DbMinCapacityACU,
+				DBMaxCapacityACU:      v.Usage.DbMaxCapacityACU,
+			}
+		}
+
 		res = append(res, &dbapi.DataPlaneCentralStatus{
 			CentralClusterID:    k,
 			Conditions:          c,
 			Routes:              routes,
 			Secrets:             v.Secrets,             // pragma: allowlist secret
 			SecretDataSha256Sum: v.SecretDataSha256Sum, // pragma: allowlist secret
+			Usage:               usage,
+
+			MigrationPhaseCompleted: dbapi.CentralMigrationPhase(v.MigrationPhaseCompleted),
+			AuthClientID:            v.AuthClientId,
 		})
 	}
 
@@ -2,7 +2,9 @@ package rhsso
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
@@ -13,6 +15,9 @@ import (
 )
 
 const (
+	// DynamicClientNamePrefix is the prefix of the names of the RHSSO dynamic clients created for Centrals
+	DynamicClientNamePrefix = "acscs-"
+
 	oidcProviderCallbackPath    = "/sso/providers/oidc/callback"
 	dynamicClientsNameMaxLength = 50
 )
@@ -20,7 +25,7 @@ const (
 func AugmentWithDynamicAuthConfig(ctx context.Context, r *dbapi.CentralRequest, realmConfig *iam.IAMRealmConfig, apiClient *api.AcsTenantsApiService) error {
 	// There is a limit on name length of the dynamic client. To avoid unnecessary errors,
 	// we truncate name here.
-	name := stringutils.Truncate(fmt.Sprintf("acscs-%s", r.Name), dynamicClientsNameMaxLength)
+	name := stringutils.Truncate(DynamicClientNamePrefix+r.Name, dynamicClientsNameMaxLength)
 	orgID := r.OrganisationID
 	redirectURIs := []string{fmt.Sprintf("https://%s%s", r.GetUIHost(), oidcProviderCallbackPath)}
 
@@ -40,5 +45,6 @@ func AugmentWithDynamicAuthConfig(ctx context.Context, r *dbapi.CentralRequest,
 	r.AuthConfig.ClientSecret = dynamicClientData.Secret // pragma: allowlist secret
 	r.AuthConfig.Issuer = realmConfig.ValidIssuerURI
 	r.ClientOrigin = dbapi.AuthConfigDynamicClientOrigin
+	r.ClientCreatedAt = sql.NullTime{Time: time.Now(), Valid: true}
 	return nil
 }
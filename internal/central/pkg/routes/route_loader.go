@@ -4,6 +4,7 @@ package routes
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/stackrox/acs-fleet-manager/openapi"
 
@@ -34,17 +35,28 @@ import (
 
 type options struct {
 	di.Inject
-	ServerConfig         *server.ServerConfig
-	OCMConfig            *ocm.OCMConfig
-	ProviderConfig       *config.ProviderConfig
-	IAMConfig            *iam.IAMConfig
-	CentralRequestConfig *config.CentralRequestConfig
+	ServerConfig           *server.ServerConfig
+	OCMConfig              *ocm.OCMConfig
+	ProviderConfig         *config.ProviderConfig
+	IAMConfig              *iam.IAMConfig
+	CentralRequestConfig   *config.CentralRequestConfig
+	DataplaneClusterConfig *config.DataplaneClusterConfig
 
 	AMSClient               ocm.AMSClient
 	Central                 services.CentralService
 	ClusterService          services.ClusterService
 	CloudProviders          services.CloudProvidersService
 	DataPlaneCentralService services.DataPlaneCentralService
+	CentralUsageService     services.CentralUsageService
+	ClusterDrainService     services.ClusterDrainService
+	ClusterHealthService    services.ClusterHealthService
+	CentralMigrationService services.CentralMigrationService
+	AdminAuditService       services.AdminAuditService
+	AccessRuleService       services.AccessRuleService
+	AuthProviderService     services.CentralAuthProviderService
+	CentralGrantService     services.CentralGrantService
+	APIKeyService           services.APIKeyService
+	ClusterIdentities       auth.ClusterIdentitySource
 	AccountService          account.AccountService
 	AuthService             authorization.Authorization
 	DB                      *db.ConnectionFactory
@@ -52,6 +64,7 @@ type options struct {
 
 	AccessControlListMiddleware *acl.AccessControlListMiddleware
 	AccessControlListConfig     *acl.AccessControlListConfig
+	AccessRuleCache             *acl.AccessRuleCache
 	FleetShardAuthZConfig       *auth.FleetShardAuthZConfig
 	AdminRoleAuthZConfig        *auth.AdminRoleAuthZConfig
 
@@ -85,8 +98,11 @@ func (s *options) buildAPIBaseRouter(mainRouter *mux.Router, basePath string) er
 
 	authorizeMiddleware := s.AccessControlListMiddleware.Authorize
 	requireOrgID := auth.NewRequireOrgIDMiddleware().RequireOrgID(errors.ErrorUnauthenticated)
-	requireIssuer := auth.NewRequireIssuerMiddleware().RequireIssuer(
-		append(s.IAMConfig.AdditionalSSOIssuers.GetURIs(), s.IAMConfig.RedhatSSORealm.ValidIssuerURI), errors.ErrorUnauthenticated)
+	publicAPIIssuers := append(s.IAMConfig.AdditionalSSOIssuers.GetURIs(), s.IAMConfig.RedhatSSORealm.ValidIssuerURI)
+	if features.CustomerAPIKeys.Enabled() {
+		publicAPIIssuers = append(publicAPIIssuers, auth.APIKeyIssuer)
+	}
+	requireIssuer := auth.NewRequireIssuerMiddleware().RequireIssuer(publicAPIIssuers, errors.ErrorUnauthenticated)
 	requireTermsAcceptance := auth.NewRequireTermsAcceptanceMiddleware().RequireTermsAcceptance(s.ServerConfig.EnableTermsAcceptance, s.AMSClient, errors.ErrorTermsNotAccepted)
 
 	// base path.
@@ -128,6 +144,36 @@ func (s *options) buildAPIBaseRouter(mainRouter *mux.Router, basePath string) er
 	apiV1CentralsRouter.Use(requireIssuer)
 	apiV1CentralsRouter.Use(requireOrgID)
 	apiV1CentralsRouter.Use(authorizeMiddleware)
+	apiV1CentralsRouter.Use(auth.RequireAPIKeyScope(auth.APIKeyScopeCentralsRead, auth.APIKeyScopeCentralsWrite))
+
+	centralGrantHandler := handlers.NewCentralGrantHandler(s.CentralGrantService, s.Central)
+	// who can access a Central is not changed with API keys
+	apiV1CentralsRouter.Handle("/{id}/grants", auth.DenyAPIKeys(http.HandlerFunc(centralGrantHandler.Create))).
+		Name(logger.NewLogEvent("create-central-grant", "grant a user a role for a central instance").ToString()).
+		Methods(http.MethodPost)
+	apiV1CentralsRouter.HandleFunc("/{id}/grants", centralGrantHandler.List).
+		Name(logger.NewLogEvent("list-central-grants", "list the grants of a central instance").ToString()).
+		Methods(http.MethodGet)
+	apiV1CentralsRouter.Handle("/{id}/grants/{grant_id}", auth.DenyAPIKeys(http.HandlerFunc(centralGrantHandler.Delete))).
+		Name(logger.NewLogEvent("delete-central-grant", "revoke a grant of a central instance").ToString()).
+		Methods(http.MethodDelete)
+
+	if features.CustomerAuthProviders.Enabled() {
+		centralAuthProviderHandler := handlers.NewCentralAuthProviderHandler(s.AuthProviderService, s.Central)
+		// how users log in to Central is not changed with API keys
+		apiV1CentralsRouter.Handle("/{id}/auth-providers", auth.DenyAPIKeys(http.HandlerFunc(centralAuthProviderHandler.Create))).
+			Name(logger.NewLogEvent("create-central-auth-provider", "add an auth provider to a central instance").ToString()).
+			Methods(http.MethodPost)
+		apiV1CentralsRouter.HandleFunc("/{id}/auth-providers", centralAuthProviderHandler.List).
+			Name(logger.NewLogEvent("list-central-auth-providers", "list the auth providers of a central instance").ToString()).
+			Methods(http.MethodGet)
+		apiV1CentralsRouter.HandleFunc("/{id}/auth-providers/{auth_provider_id}", centralAuthProviderHandler.Get).
+			Name(logger.NewLogEvent("get-central-auth-provider", "get an auth provider of a central instance").ToString()).
+			Methods(http.MethodGet)
+		apiV1CentralsRouter.Handle("/{id}/auth-providers/{auth_provider_id}", auth.DenyAPIKeys(http.HandlerFunc(centralAuthProviderHandler.Delete))).
+			Name(logger.NewLogEvent("delete-central-auth-provider", "delete an auth provider of a central instance").ToString()).
+			Methods(http.MethodDelete)
+	}
 
 	apiV1CentralsCreateRouter := apiV1CentralsRouter.NewRoute().Subrouter()
 	apiV1CentralsCreateRouter.HandleFunc("", centralHandler.Create).Methods(http.MethodPost)
@@ -145,11 +191,40 @@ func (s *options) buildAPIBaseRouter(mainRouter *mux.Router, basePath string) er
 	apiV1CloudProvidersRouter.HandleFunc("/{id}/regions", cloudProvidersHandler.ListCloudProviderRegions).
 		Name(logger.NewLogEvent("list-regions", "list cloud provider regions").ToString()).
 		Methods(http.MethodGet)
+	apiV1CloudProvidersRouter.Use(auth.RequireAPIKeyReadScope(auth.APIKeyScopeCloudProvidersRead))
 
 	apiV1CloudAccountsRouter := apiV1Router.PathPrefix("/cloud_accounts").Subrouter()
 	apiV1CloudAccountsRouter.HandleFunc("", cloudAccountsHandler.Get).
 		Name(logger.NewLogEvent("get-cloud-accounts", "list all cloud accounts belonging to user org").ToString()).
 		Methods(http.MethodGet)
+	apiV1CloudAccountsRouter.Use(auth.RequireAPIKeyReadScope(auth.APIKeyScopeCloudAccountsRead))
+
+	//  /api_keys
+	if features.CustomerAPIKeys.Enabled() {
+		v1Collections = append(v1Collections, api.CollectionMetadata{
+			ID:   "api_keys",
+			Kind: "ApiKeyList",
+		})
+		apiKeyHandler := handlers.NewAPIKeyHandler(s.APIKeyService)
+		apiV1APIKeysRouter := apiV1Router.PathPrefix("/api_keys").Subrouter()
+		apiV1APIKeysRouter.HandleFunc("", apiKeyHandler.Create).
+			Name(logger.NewLogEvent("create-api-key", "create an API key").ToString()).
+			Methods(http.MethodPost)
+		apiV1APIKeysRouter.HandleFunc("", apiKeyHandler.List).
+			Name(logger.NewLogEvent("list-api-keys", "list API keys").ToString()).
+			Methods(http.MethodGet)
+		apiV1APIKeysRouter.HandleFunc("/{id}", apiKeyHandler.Get).
+			Name(logger.NewLogEvent("get-api-key", "get an API key").ToString()).
+			Methods(http.MethodGet)
+		apiV1APIKeysRouter.HandleFunc("/{id}", apiKeyHandler.Delete).
+			Name(logger.NewLogEvent("revoke-api-key", "revoke an API key").ToString()).
+			Methods(http.MethodDelete)
+		apiV1APIKeysRouter.Use(requireIssuer)
+		apiV1APIKeysRouter.Use(requireOrgID)
+		apiV1APIKeysRouter.Use(authorizeMiddleware)
+		// API keys are managed by users, API keys cannot create further keys
+		apiV1APIKeysRouter.Use(auth.DenyAPIKeys)
+	}
 
 	v1Metadata := api.VersionMetadata{
 		ID:          "v1",
@@ -169,7 +244,7 @@ func (s *options) buildAPIBaseRouter(mainRouter *mux.Router, basePath string) er
 	apiV1Router.HandleFunc("", v1Metadata.ServeHTTP).Methods(http.MethodGet)
 
 	// /agent-clusters/{id}
-	dataPlaneCentralHandler := handlers.NewDataPlaneCentralHandler(s.DataPlaneCentralService, s.Central, s.ClusterService, s.ManagedCentralPresenter, s.GitopsProvider)
+	dataPlaneCentralHandler := handlers.NewDataPlaneCentralHandler(s.DataPlaneCentralService, s.Central, s.ClusterService, s.ClusterHealthService, s.CentralMigrationService, s.AuthProviderService, s.ManagedCentralPresenter, s.GitopsProvider)
 	apiV1DataPlaneRequestsRouter := apiV1Router.PathPrefix(routes.PrivateAPIPrefix).Subrouter()
 	apiV1DataPlaneRequestsRouter.HandleFunc("/{id}/centrals/status", dataPlaneCentralHandler.UpdateCentralStatuses).
 		Name(logger.NewLogEvent("update-dataplane-centrals-status", "update dataplane centrals status by id").ToString()).
@@ -177,6 +252,9 @@ func (s *options) buildAPIBaseRouter(mainRouter *mux.Router, basePath string) er
 	apiV1DataPlaneRequestsRouter.HandleFunc("/{id}/centrals", dataPlaneCentralHandler.GetAll).
 		Name(logger.NewLogEvent("list-dataplane-centrals", "list all dataplane centrals").ToString()).
 		Methods(http.MethodGet)
+	apiV1DataPlaneRequestsRouter.HandleFunc("/{id}/centrals/ids", dataPlaneCentralHandler.GetCentralIDs).
+		Name(logger.NewLogEvent("list-known-central-ids", "list the IDs of the centrals known to fleet-manager on a dataplane cluster").ToString()).
+		Methods(http.MethodGet)
 
 	// /agent-clusters/
 	// used for lazy loading additional data not added to the list requests e.g secrets
@@ -185,71 +263,176 @@ func (s *options) buildAPIBaseRouter(mainRouter *mux.Router, basePath string) er
 		Methods(http.MethodGet)
 
 	// deliberately returns 404 here if the request doesn't have the required role, so that it will appear as if the endpoint doesn't exist
-	auth.UseFleetShardAuthorizationMiddleware(apiV1DataPlaneRequestsRouter, s.IAMConfig, s.FleetShardAuthZConfig)
+	auth.UseFleetShardAuthorizationMiddleware(apiV1DataPlaneRequestsRouter, s.IAMConfig, s.FleetShardAuthZConfig,
+		s.ClusterIdentities, s.dataPlaneRequestClusterID)
 
 	adminCentralHandler := handlers.NewAdminCentralHandler(s.Central, s.AccountService, s.ProviderConfig, s.Telemetry)
+	adminAuditHandler := handlers.NewAdminAuditHandler(s.AdminAuditService)
 	adminRouter := apiV1Router.PathPrefix(routes.AdminAPIPrefix).Subrouter()
 
 	adminRouter.Use(auth.NewRequireIssuerMiddleware().RequireIssuer(
 		[]string{s.IAMConfig.InternalSSORealm.ValidIssuerURI}, errors.ErrorNotFound))
-	adminRouter.Use(auth.NewRolesAuhzMiddleware(s.AdminRoleAuthZConfig).RequireRolesForMethods(errors.ErrorNotFound))
+	// the audit log middleware runs before the authZ middleware, so that denied requests are audited as well
+	adminPermissions := auth.RoutePermissions{}
 	adminRouter.Use(auth.NewAuditLogMiddleware().AuditLog(errors.ErrorNotFound))
+	adminRouter.Use(auth.NewRolesAuhzMiddleware(s.AdminRoleAuthZConfig).RequirePermissions(adminPermissions, errors.ErrorNotFound))
 	adminCentralsRouter := adminRouter.PathPrefix("/centrals").Subrouter()
 
 	adminDbCentralsRouter := adminCentralsRouter.PathPrefix("/db").Subrouter()
-	adminDbCentralsRouter.HandleFunc("/{id}", adminCentralHandler.DbDelete).
-		Name(logger.NewLogEvent("admin-db-delete-central", "[admin] delete central by id").ToString()).
+	adminDbCentralsRouter.HandleFunc("/{id}", adminAuditHandler.Audit(adminCentralHandler.DbDelete)).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-db-delete-central", "[admin] delete central by id").ToString(), "centrals.db.delete")).
 		Methods(http.MethodDelete)
 
 	adminCentralsRouter.HandleFunc("", adminCentralHandler.List).
-		Name(logger.NewLogEvent("admin-list-centrals", "[admin] list all centrals").ToString()).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-list-centrals", "[admin] list all centrals").ToString(), "centrals.read")).
 		Methods(http.MethodGet)
 	adminCentralsRouter.HandleFunc("/{id}", adminCentralHandler.Get).
-		Name(logger.NewLogEvent("admin-get-central", "[admin] get central by id").ToString()).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-get-central", "[admin] get central by id").ToString(), "centrals.read")).
 		Methods(http.MethodGet)
-	adminCentralsRouter.HandleFunc("/{id}", adminCentralHandler.Delete).
-		Name(logger.NewLogEvent("admin-delete-central", "[admin] delete central by id").ToString()).
+	adminCentralsRouter.HandleFunc("/{id}", adminAuditHandler.Audit(adminCentralHandler.Delete)).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-delete-central", "[admin] delete central by id").ToString(), "centrals.delete")).
 		Methods(http.MethodDelete)
-	adminCentralsRouter.HandleFunc("/{id}/restore", adminCentralHandler.Restore).
-		Name(logger.NewLogEvent("admin-restore-central", "[admin] restore central by id").ToString()).
+	adminCentralsRouter.HandleFunc("/{id}/restore", adminAuditHandler.Audit(adminCentralHandler.Restore)).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-restore-central", "[admin] restore central by id").ToString(), "centrals.restore")).
 		Methods(http.MethodPost)
-	adminCentralsRouter.HandleFunc("/{id}/rotate-secrets", adminCentralHandler.RotateSecrets).
-		Name(logger.NewLogEvent("admin-rotate-central-secrets", "[admin] rotate central secrets by id").ToString()).
+	adminCentralsRouter.HandleFunc("/{id}/rotate-secrets", adminAuditHandler.Audit(adminCentralHandler.RotateSecrets)).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-rotate-central-secrets", "[admin] rotate central secrets by id").ToString(), "centrals.secrets.rotate")).
 		Methods(http.MethodPost)
-	adminCentralsRouter.HandleFunc("/{id}/expired-at", adminCentralHandler.PatchExpiredAt).
-		Name(logger.NewLogEvent("admin-expired-at", "[admin] set `expired_at` central property").ToString()).
+	adminCentralsRouter.HandleFunc("/{id}/expired-at", adminAuditHandler.Audit(adminCentralHandler.PatchExpiredAt)).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-expired-at", "[admin] set `expired_at` central property").ToString(), "centrals.expiration.write")).
+		Methods(http.MethodPatch)
+	adminCentralsRouter.HandleFunc("/{id}/name", adminAuditHandler.Audit(adminCentralHandler.PatchName)).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-name", "[admin] set `name` central property").ToString(), "centrals.name.write")).
 		Methods(http.MethodPatch)
-	adminCentralsRouter.HandleFunc("/{id}/name", adminCentralHandler.PatchName).
-		Name(logger.NewLogEvent("admin-name", "[admin] set `name` central property").ToString()).
+	adminCentralsRouter.HandleFunc("/{id}/billing", adminAuditHandler.Audit(adminCentralHandler.PatchBillingParameters)).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-billing", "[admin] change central billing parameters").ToString(), "centrals.billing.write")).
 		Methods(http.MethodPatch)
-	adminCentralsRouter.HandleFunc("/{id}/billing", adminCentralHandler.PatchBillingParameters).
-		Name(logger.NewLogEvent("admin-billing", "[admin] change central billing parameters").ToString()).
+	adminCentralsRouter.HandleFunc("/{id}/subscription", adminAuditHandler.Audit(adminCentralHandler.PatchSubscriptionParameters)).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-subscription", "[admin] change central subscription parameters").ToString(), "centrals.subscription.write")).
 		Methods(http.MethodPatch)
-	adminCentralsRouter.HandleFunc("/{id}/subscription", adminCentralHandler.PatchSubscriptionParameters).
-		Name(logger.NewLogEvent("admin-subscription", "[admin] change central subscription parameters").ToString()).
+	adminCentralsRouter.HandleFunc("/{id}/client-rotation-window", adminAuditHandler.Audit(adminCentralHandler.PatchClientRotationWindow)).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-client-rotation-window", "[admin] set OIDC client rotation window of central").ToString(), "centrals.client-rotation.write")).
 		Methods(http.MethodPatch)
 
 	if features.ClusterMigration.Enabled() {
-		adminCentralsRouter.HandleFunc("/{id}/assign-cluster", adminCentralHandler.AssignCluster).
-			Name(logger.NewLogEvent("admin-central-assign-cluster", "[admin] change central cluster assignment").ToString()).
+		adminCentralsRouter.HandleFunc("/{id}/assign-cluster", adminAuditHandler.Audit(adminCentralHandler.AssignCluster)).
+			Name(adminPermissions.Declare(logger.NewLogEvent("admin-central-assign-cluster", "[admin] change central cluster assignment").ToString(), "centrals.cluster.write")).
+			Methods(http.MethodPost)
+
+		adminCentralMigrationHandler := handlers.NewAdminCentralMigrationHandler(s.CentralMigrationService)
+		adminCentralMigrationsRouter := adminCentralsRouter.PathPrefix("/{id}/migrations").Subrouter()
+		adminCentralMigrationsRouter.HandleFunc("", adminCentralMigrationHandler.Create).
+			Name(adminPermissions.Declare(logger.NewLogEvent("admin-create-central-migration", "[admin] start migrating a central to another cluster").ToString(), "centrals.migrations.write")).
+			Methods(http.MethodPost)
+		adminCentralMigrationsRouter.HandleFunc("", adminCentralMigrationHandler.List).
+			Name(adminPermissions.Declare(logger.NewLogEvent("admin-list-central-migrations", "[admin] list migrations of a central").ToString(), "centrals.migrations.read")).
+			Methods(http.MethodGet)
+		adminCentralMigrationsRouter.HandleFunc("/{migration_id}/rollback", adminCentralMigrationHandler.Rollback).
+			Name(adminPermissions.Declare(logger.NewLogEvent("admin-rollback-central-migration", "[admin] roll back failed central migration").ToString(), "centrals.migrations.write")).
 			Methods(http.MethodPost)
 	}
 
+	adminCentralsRouter.HandleFunc("/{id}/audit-events", adminAuditHandler.ListCentralAuditEvents).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-list-central-audit-events", "[admin] list audit events of a central").ToString(), "centrals.audit.read")).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/audit-events/verify", adminAuditHandler.VerifyChain).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-verify-audit-events", "[admin] verify hash chain of audit events").ToString(), "audit.read")).
+		Methods(http.MethodGet)
+
 	adminCentralsRouter.HandleFunc("/{id}/traits", adminCentralHandler.ListTraits).
-		Name(logger.NewLogEvent("admin-list-traits", "[admin] list central traits").ToString()).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-list-traits", "[admin] list central traits").ToString(), "centrals.traits.read")).
 		Methods(http.MethodGet)
 	adminCentralsRouter.HandleFunc("/{id}/traits/{trait}", adminCentralHandler.GetTrait).
-		Name(logger.NewLogEvent("admin-get-trait", "[admin] check existence of a central trait").ToString()).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-get-trait", "[admin] check existence of a central trait").ToString(), "centrals.traits.read")).
 		Methods(http.MethodGet)
-	adminCentralsRouter.HandleFunc("/{id}/traits/{trait}", adminCentralHandler.AddTrait).
-		Name(logger.NewLogEvent("admin-put-trait", "[admin] add a central trait").ToString()).
+	adminCentralsRouter.HandleFunc("/{id}/traits/{trait}", adminAuditHandler.Audit(adminCentralHandler.AddTrait)).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-put-trait", "[admin] add a central trait").ToString(), "centrals.traits.write")).
 		Methods(http.MethodPut)
-	adminCentralsRouter.HandleFunc("/{id}/traits/{trait}", adminCentralHandler.DeleteTrait).
-		Name(logger.NewLogEvent("admin-delete-trait", "[admin] delete central trait").ToString()).
+	adminCentralsRouter.HandleFunc("/{id}/traits/{trait}", adminAuditHandler.Audit(adminCentralHandler.DeleteTrait)).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-delete-trait", "[admin] delete central trait").ToString(), "centrals.traits.delete")).
 		Methods(http.MethodDelete)
 
+	adminUsageHandler := handlers.NewAdminUsageHandler(s.CentralUsageService)
+	adminRouter.HandleFunc("/usage", adminUsageHandler.List).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-list-usage", "[admin] list tenant resource usage").ToString(), "usage.read")).
+		Methods(http.MethodGet)
+
+	adminAccessRuleHandler := handlers.NewAdminAccessRuleHandler(s.AccessRuleService, s.AccessRuleCache)
+	adminRouter.HandleFunc("/access-rules", adminAccessRuleHandler.Create).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-create-access-rule", "[admin] create access rule").ToString(), "access-rules.write")).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/access-rules", adminAccessRuleHandler.List).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-list-access-rules", "[admin] list access rules").ToString(), "access-rules.read")).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/access-rules/{id}", adminAccessRuleHandler.Delete).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-delete-access-rule", "[admin] delete access rule").ToString(), "access-rules.delete")).
+		Methods(http.MethodDelete)
+
+	adminClusterHandler := handlers.NewAdminClusterHandler(s.ClusterService, s.ClusterDrainService, s.ClusterIdentities, s.DataplaneClusterConfig)
+	adminClustersRouter := adminRouter.PathPrefix("/clusters").Subrouter()
+	adminClustersRouter.HandleFunc("", adminClusterHandler.List).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-list-clusters", "[admin] list data plane clusters").ToString(), "clusters.read")).
+		Methods(http.MethodGet)
+	adminClustersRouter.HandleFunc("/{id}", adminClusterHandler.Get).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-get-cluster", "[admin] get data plane cluster by id").ToString(), "clusters.read")).
+		Methods(http.MethodGet)
+	adminClustersRouter.HandleFunc("", adminClusterHandler.Create).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-create-cluster", "[admin] register data plane cluster").ToString(), "clusters.create")).
+		Methods(http.MethodPost)
+	adminClustersRouter.HandleFunc("/{id}", adminClusterHandler.Update).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-update-cluster", "[admin] update data plane cluster").ToString(), "clusters.update")).
+		Methods(http.MethodPatch)
+	adminClustersRouter.HandleFunc("/{id}", adminClusterHandler.Delete).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-delete-cluster", "[admin] deregister data plane cluster").ToString(), "clusters.delete")).
+		Methods(http.MethodDelete)
+
+	adminClusterDrainHandler := handlers.NewAdminClusterDrainHandler(s.ClusterDrainService)
+	adminClusterDrainsRouter := adminClustersRouter.PathPrefix("/{id}/drains").Subrouter()
+	adminClusterDrainsRouter.HandleFunc("", adminClusterDrainHandler.Create).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-create-cluster-drain", "[admin] start draining a cluster").ToString(), "clusters.drains.write")).
+		Methods(http.MethodPost)
+	adminClusterDrainsRouter.HandleFunc("", adminClusterDrainHandler.List).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-list-cluster-drains", "[admin] list drains of a cluster").ToString(), "clusters.drains.read")).
+		Methods(http.MethodGet)
+	adminClusterDrainsRouter.HandleFunc("/{drain_id}", adminClusterDrainHandler.Get).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-get-cluster-drain", "[admin] get cluster drain by id").ToString(), "clusters.drains.read")).
+		Methods(http.MethodGet)
+	adminClusterDrainsRouter.HandleFunc("/{drain_id}/pause", adminClusterDrainHandler.Pause).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-pause-cluster-drain", "[admin] pause cluster drain").ToString(), "clusters.drains.write")).
+		Methods(http.MethodPost)
+	adminClusterDrainsRouter.HandleFunc("/{drain_id}/resume", adminClusterDrainHandler.Resume).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-resume-cluster-drain", "[admin] resume cluster drain").ToString(), "clusters.drains.write")).
+		Methods(http.MethodPost)
+	adminClusterDrainsRouter.HandleFunc("/{drain_id}/cancel", adminClusterDrainHandler.Cancel).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-cancel-cluster-drain", "[admin] cancel cluster drain").ToString(), "clusters.drains.write")).
+		Methods(http.MethodPost)
+
 	adminCreateRouter := adminCentralsRouter.NewRoute().Subrouter()
-	adminCreateRouter.HandleFunc("", adminCentralHandler.Create).Methods(http.MethodPost)
+	adminCreateRouter.HandleFunc("", adminCentralHandler.Create).
+		Name(adminPermissions.Declare(logger.NewLogEvent("admin-create-central", "[admin] create central").ToString(), "centrals.create")).
+		Methods(http.MethodPost)
 
 	return nil
 }
+
+// dataPlaneRequestClusterID returns the cluster a request of the private API is for. Centrals requested by ID belong
+// to the cluster they are placed on.
+func (s *options) dataPlaneRequestClusterID(r *http.Request) (string, error) {
+	id := mux.Vars(r)["id"]
+	route := mux.CurrentRoute(r)
+	if route == nil {
+		return "", fmt.Errorf("no route matched %s", r.URL.Path)
+	}
+	pathTemplate, err := route.GetPathTemplate()
+	if err != nil {
+		return "", fmt.Errorf("getting path template of %s: %w", r.URL.Path, err)
+	}
+	if !strings.HasSuffix(pathTemplate, routes.PrivateAPIPrefix+"/centrals/{id}") {
+		return id, nil
+	}
+	centralRequest, svcErr := s.Central.GetByID(id)
+	if svcErr != nil {
+		return "", fmt.Errorf("getting central %s: %w", id, svcErr)
+	}
+	return centralRequest.ClusterID, nil
+}
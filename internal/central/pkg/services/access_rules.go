@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/acl"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/services"
+)
+
+// AccessRuleService persists the deny and allow rules for users of the public API. The rules are enforced by the
+// access control list middleware.
+//
+//go:generate moq -out access_rules_moq.go . AccessRuleService
+type AccessRuleService interface {
+	acl.AccessRuleSource
+	// Create validates and stores a new rule
+	Create(ctx context.Context, rule *dbapi.AccessRule) (*dbapi.AccessRule, *serviceError.ServiceError)
+	// List returns all rules including expired ones, most recent first
+	List() ([]*dbapi.AccessRule, *serviceError.ServiceError)
+	// Delete removes the rule
+	Delete(id string) *serviceError.ServiceError
+}
+
+type accessRuleService struct {
+	connectionFactory *db.ConnectionFactory
+	now               func() time.Time
+}
+
+var _ AccessRuleService = (*accessRuleService)(nil)
+
+// NewAccessRuleService ...
+func NewAccessRuleService(connectionFactory *db.ConnectionFactory) AccessRuleService {
+	return &accessRuleService{
+		connectionFactory: connectionFactory,
+		now:               time.Now,
+	}
+}
+
+// Create ...
+func (s *accessRuleService) Create(ctx context.Context, rule *dbapi.AccessRule) (*dbapi.AccessRule, *serviceError.ServiceError) {
+	if err := toACLAccessRule(rule).Validate(); err != nil {
+		return nil, serviceError.Validation("invalid access rule: %s", err.Error())
+	}
+	if rule.ExpiresAt != nil && !rule.ExpiresAt.After(s.now()) {
+		return nil, serviceError.Validation("invalid access rule: expires_at must be in the future")
+	}
+
+	rule.ID = api.NewID()
+	if claims, err := auth.GetClaimsFromContext(ctx); err == nil {
+		rule.Owner, _ = claims.GetUsername()
+	}
+	if err := s.connectionFactory.New().Create(rule).Error; err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to create access rule")
+	}
+	glog.Infof("Access rule %s created by %q", toACLAccessRule(rule), rule.Owner)
+	return rule, nil
+}
+
+// List ...
+func (s *accessRuleService) List() ([]*dbapi.AccessRule, *serviceError.ServiceError) {
+	var rules []*dbapi.AccessRule
+	if err := s.connectionFactory.New().Order("created_at DESC").Find(&rules).Error; err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to list access rules")
+	}
+	return rules, nil
+}
+
+// Delete ...
+func (s *accessRuleService) Delete(id string) *serviceError.ServiceError {
+	if id == "" {
+		return serviceError.Validation("id is undefined")
+	}
+	var rule dbapi.AccessRule
+	if err := s.connectionFactory.New().Where("id = ?", id).First(&rule).Error; err != nil {
+		return services.HandleGetError("AccessRule", "id", id, err)
+	}
+	if err := s.connectionFactory.New().Delete(&rule).Error; err != nil {
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to delete access rule %s", id)
+	}
+	glog.Infof("Access rule %s deleted", toACLAccessRule(&rule))
+	return nil
+}
+
+// ListActiveAccessRules ...
+func (s *accessRuleService) ListActiveAccessRules() (acl.AccessRules, error) {
+	var rules []*dbapi.AccessRule
+	if err := s.connectionFactory.New().
+		Where("expires_at IS NULL OR expires_at > ?", s.now()).
+		Find(&rules).Error; err != nil {
+		return nil, errors.Wrap(err, "listing active access rules")
+	}
+	result := make(acl.AccessRules, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, toACLAccessRule(rule))
+	}
+	return result, nil
+}
+
+func toACLAccessRule(rule *dbapi.AccessRule) *acl.AccessRule {
+	return &acl.AccessRule{
+		ID:        rule.ID,
+		Action:    acl.AccessRuleAction(rule.Action),
+		Type:      acl.AccessRuleType(rule.Type),
+		Value:     rule.Value,
+		ExpiresAt: rule.ExpiresAt,
+	}
+}
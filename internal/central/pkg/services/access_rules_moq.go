@@ -0,0 +1,202 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"context"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/acl"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that AccessRuleServiceMock does implement AccessRuleService.
+// If this is not the case, regenerate this file with moq.
+var _ AccessRuleService = &AccessRuleServiceMock{}
+
+// AccessRuleServiceMock is a mock implementation of AccessRuleService.
+//
+//	func TestSomethingThatUsesAccessRuleService(t *testing.T) {
+//
+//		// make and configure a mocked AccessRuleService
+//		mockedAccessRuleService := &AccessRuleServiceMock{
+//			CreateFunc: func(ctx context.Context, rule *dbapi.AccessRule) (*dbapi.AccessRule, *serviceError.ServiceError) {
+//				panic("mock out the Create method")
+//			},
+//			DeleteFunc: func(id string) *serviceError.ServiceError {
+//				panic("mock out the Delete method")
+//			},
+//			ListFunc: func() ([]*dbapi.AccessRule, *serviceError.ServiceError) {
+//				panic("mock out the List method")
+//			},
+//			ListActiveAccessRulesFunc: func() (acl.AccessRules, error) {
+//				panic("mock out the ListActiveAccessRules method")
+//			},
+//		}
+//
+//		// use mockedAccessRuleService in code that requires AccessRuleService
+//		// and then make assertions.
+//
+//	}
+type AccessRuleServiceMock struct {
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, rule *dbapi.AccessRule) (*dbapi.AccessRule, *serviceError.ServiceError)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(id string) *serviceError.ServiceError
+
+	// ListFunc mocks the List method.
+	ListFunc func() ([]*dbapi.AccessRule, *serviceError.ServiceError)
+
+	// ListActiveAccessRulesFunc mocks the ListActiveAccessRules method.
+	ListActiveAccessRulesFunc func() (acl.AccessRules, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rule is the rule argument value.
+			Rule *dbapi.AccessRule
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// ID is the id argument value.
+			ID string
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+		}
+		// ListActiveAccessRules holds details about calls to the ListActiveAccessRules method.
+		ListActiveAccessRules []struct {
+		}
+	}
+	lockCreate                sync.RWMutex
+	lockDelete                sync.RWMutex
+	lockList                  sync.RWMutex
+	lockListActiveAccessRules sync.RWMutex
+}
+
+// Create calls CreateFunc.
+func (mock *AccessRuleServiceMock) Create(ctx context.Context, rule *dbapi.AccessRule) (*dbapi.AccessRule, *serviceError.ServiceError) {
+	if mock.CreateFunc == nil {
+		panic("AccessRuleServiceMock.CreateFunc: method is nil but AccessRuleService.Create was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Rule *dbapi.AccessRule
+	}{
+		Ctx:  ctx,
+		Rule: rule,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, rule)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedAccessRuleService.CreateCalls())
+func (mock *AccessRuleServiceMock) CreateCalls() []struct {
+	Ctx  context.Context
+	Rule *dbapi.AccessRule
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Rule *dbapi.AccessRule
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// Delete calls DeleteFunc.
+func (mock *AccessRuleServiceMock) Delete(id string) *serviceError.ServiceError {
+	if mock.DeleteFunc == nil {
+		panic("AccessRuleServiceMock.DeleteFunc: method is nil but AccessRuleService.Delete was just called")
+	}
+	callInfo := struct {
+		ID string
+	}{
+		ID: id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(id)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedAccessRuleService.DeleteCalls())
+func (mock *AccessRuleServiceMock) DeleteCalls() []struct {
+	ID string
+} {
+	var calls []struct {
+		ID string
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// List calls ListFunc.
+func (mock *AccessRuleServiceMock) List() ([]*dbapi.AccessRule, *serviceError.ServiceError) {
+	if mock.ListFunc == nil {
+		panic("AccessRuleServiceMock.ListFunc: method is nil but AccessRuleService.List was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc()
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedAccessRuleService.ListCalls())
+func (mock *AccessRuleServiceMock) ListCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListActiveAccessRules calls ListActiveAccessRulesFunc.
+func (mock *AccessRuleServiceMock) ListActiveAccessRules() (acl.AccessRules, error) {
+	if mock.ListActiveAccessRulesFunc == nil {
+		panic("AccessRuleServiceMock.ListActiveAccessRulesFunc: method is nil but AccessRuleService.ListActiveAccessRules was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListActiveAccessRules.Lock()
+	mock.calls.ListActiveAccessRules = append(mock.calls.ListActiveAccessRules, callInfo)
+	mock.lockListActiveAccessRules.Unlock()
+	return mock.ListActiveAccessRulesFunc()
+}
+
+// ListActiveAccessRulesCalls gets all the calls that were made to ListActiveAccessRules.
+// Check the length with:
+//
+//	len(mockedAccessRuleService.ListActiveAccessRulesCalls())
+func (mock *AccessRuleServiceMock) ListActiveAccessRulesCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListActiveAccessRules.RLock()
+	calls = mock.calls.ListActiveAccessRules
+	mock.lockListActiveAccessRules.RUnlock()
+	return calls
+}
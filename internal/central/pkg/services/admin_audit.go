@@ -0,0 +1,202 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// auditEventsLockID is the key of the transaction level advisory lock serializing the appends to the audit event chain
+const auditEventsLockID = 7401203
+
+// auditEventVerifyBatchSize is the number of audit events loaded at once while verifying the chain
+const auditEventVerifyBatchSize = 500
+
+// AdminAuditService persists the audit trail of admin mutations of Central tenants
+//
+//go:generate moq -out admin_audit_moq.go . AdminAuditService
+type AdminAuditService interface {
+	// SnapshotCentral returns the central including soft deleted ones without its secrets, or nil if it does not exist.
+	// It is read in the transaction of the context, so that it includes the changes of the request.
+	SnapshotCentral(ctx context.Context, centralID string) (api.JSON, *serviceError.ServiceError)
+	// Record appends the event to the hash chain of the audit events in the transaction of the context, so that the
+	// event is only stored together with the mutation it records
+	Record(ctx context.Context, event *dbapi.AuditEvent) *serviceError.ServiceError
+	// ListByTargetID returns the audit events of the central in the order they were recorded
+	ListByTargetID(targetID string) ([]*dbapi.AuditEvent, *serviceError.ServiceError)
+	// VerifyChain recomputes the hashes of all audit events and checks the links between them and the chain head
+	VerifyChain() (*dbapi.AuditChainVerification, *serviceError.ServiceError)
+}
+
+type adminAuditService struct {
+	connectionFactory *db.ConnectionFactory
+	now               func() time.Time
+}
+
+// NewAdminAuditService ...
+func NewAdminAuditService(connectionFactory *db.ConnectionFactory) AdminAuditService {
+	return &adminAuditService{
+		connectionFactory: connectionFactory,
+		now:               time.Now,
+	}
+}
+
+// SnapshotCentral ...
+func (s *adminAuditService) SnapshotCentral(ctx context.Context, centralID string) (api.JSON, *serviceError.ServiceError) {
+	var central dbapi.CentralRequest
+	err := s.connectionFactory.FromContext(ctx).Unscoped().Where("id = ?", centralID).First(&central).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to get central %s", centralID)
+	}
+	central.Secrets = nil
+	central.ClientSecret = ""
+	snapshot, err := json.Marshal(central)
+	if err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to marshal central %s", centralID)
+	}
+	return snapshot, nil
+}
+
+// Record ...
+func (s *adminAuditService) Record(ctx context.Context, event *dbapi.AuditEvent) *serviceError.ServiceError {
+	event.ID = api.NewID()
+	// The database stores timestamps with microsecond precision, the hash has to match the stored timestamp
+	event.CreatedAt = s.now().UTC().Truncate(time.Microsecond)
+	// The lock is held until the transaction of the context is resolved, the next event is appended after that
+	err := s.connectionFactory.FromContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", auditEventsLockID).Error; err != nil {
+			return err
+		}
+		var last dbapi.AuditEvent
+		err := tx.Unscoped().Order("sequence DESC").Limit(1).Find(&last).Error
+		if err != nil {
+			return err
+		}
+		event.Sequence = last.Sequence + 1
+		event.PrevHash = last.Hash
+		hash, err := event.ComputeHash()
+		if err != nil {
+			return err
+		}
+		event.Hash = hash
+		if err := tx.Create(event).Error; err != nil {
+			return err
+		}
+		return tx.Save(&dbapi.AuditChainHead{
+			ID:        dbapi.AuditChainHeadID,
+			Sequence:  event.Sequence,
+			Hash:      event.Hash,
+			UpdatedAt: event.CreatedAt,
+		}).Error
+	})
+	if err != nil {
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to record audit event of central %s", event.TargetID)
+	}
+	return nil
+}
+
+// ListByTargetID ...
+func (s *adminAuditService) ListByTargetID(targetID string) ([]*dbapi.AuditEvent, *serviceError.ServiceError) {
+	var events []*dbapi.AuditEvent
+	if err := s.connectionFactory.New().Where("target_id = ?", targetID).Order("sequence").Find(&events).Error; err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to list audit events of central %s", targetID)
+	}
+	return events, nil
+}
+
+// VerifyChain ...
+func (s *adminAuditService) VerifyChain() (*dbapi.AuditChainVerification, *serviceError.ServiceError) {
+	// The head is read first, events recorded after it are not covered by it yet
+	var heads []*dbapi.AuditChainHead
+	if err := s.connectionFactory.New().Where("id = ?", dbapi.AuditChainHeadID).Find(&heads).Error; err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to get audit event chain head")
+	}
+	var head *dbapi.AuditChainHead
+	if len(heads) > 0 {
+		head = heads[0]
+	}
+
+	verification := &dbapi.AuditChainVerification{Valid: true}
+	var prev, anchored *dbapi.AuditEvent
+	var batch []*dbapi.AuditEvent
+	// Soft deleted events are part of the chain as well
+	err := s.connectionFactory.New().Unscoped().Order("sequence").
+		FindInBatches(&batch, auditEventVerifyBatchSize, func(tx *gorm.DB, _ int) error {
+			for _, event := range batch {
+				if reason := verifyAuditEvent(prev, event); reason != "" {
+					verification.Valid = false
+					verification.BrokenEventID = event.ID
+					verification.Reason = reason
+					return errAuditChainBroken
+				}
+				verification.Checked++
+				if head != nil && event.Sequence == head.Sequence {
+					anchored = event
+				}
+				prev = event
+			}
+			return nil
+		}).Error
+	if errors.Is(err, errAuditChainBroken) {
+		return verification, nil
+	}
+	if err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to verify audit events")
+	}
+	if reason := verifyAuditChainHead(head, anchored, verification.Checked); reason != "" {
+		verification.Valid = false
+		verification.Reason = reason
+	}
+	return verification, nil
+}
+
+var errAuditChainBroken = errors.New("audit event chain is broken")
+
+// verifyAuditChainHead returns the reason why the chain does not end in its head, or an empty string if it does.
+// anchored is the event with the sequence of the head.
+func verifyAuditChainHead(head *dbapi.AuditChainHead, anchored *dbapi.AuditEvent, checked int) string {
+	switch {
+	case head == nil && checked > 0:
+		return "chain head is missing"
+	case head == nil:
+		return ""
+	case anchored == nil:
+		return "events at the end of the chain were removed"
+	case anchored.Hash != head.Hash:
+		return "hash of the last event does not match the chain head, events at the end of the chain were replaced"
+	}
+	return ""
+}
+
+// verifyAuditEvent returns the reason why the event does not follow the previous event in the chain, or an empty
+// string if it does
+func verifyAuditEvent(prev *dbapi.AuditEvent, event *dbapi.AuditEvent) string {
+	wantSequence, wantPrevHash := int64(1), ""
+	if prev != nil {
+		wantSequence, wantPrevHash = prev.Sequence+1, prev.Hash
+	}
+	if event.Sequence != wantSequence {
+		return "sequence does not follow the previous event, events were removed"
+	}
+	if event.PrevHash != wantPrevHash {
+		return "previous hash does not match the hash of the previous event"
+	}
+	hash, err := event.ComputeHash()
+	if err != nil {
+		return err.Error()
+	}
+	if hash != event.Hash {
+		return "hash does not match the content of the event, the event was modified"
+	}
+	return ""
+}
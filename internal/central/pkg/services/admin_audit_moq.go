@@ -0,0 +1,215 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"context"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that AdminAuditServiceMock does implement AdminAuditService.
+// If this is not the case, regenerate this file with moq.
+var _ AdminAuditService = &AdminAuditServiceMock{}
+
+// AdminAuditServiceMock is a mock implementation of AdminAuditService.
+//
+//	func TestSomethingThatUsesAdminAuditService(t *testing.T) {
+//
+//		// make and configure a mocked AdminAuditService
+//		mockedAdminAuditService := &AdminAuditServiceMock{
+//			ListByTargetIDFunc: func(targetID string) ([]*dbapi.AuditEvent, *serviceError.ServiceError) {
+//				panic("mock out the ListByTargetID method")
+//			},
+//			RecordFunc: func(ctx context.Context, event *dbapi.AuditEvent) *serviceError.ServiceError {
+//				panic("mock out the Record method")
+//			},
+//			SnapshotCentralFunc: func(ctx context.Context, centralID string) (api.JSON, *serviceError.ServiceError) {
+//				panic("mock out the SnapshotCentral method")
+//			},
+//			VerifyChainFunc: func() (*dbapi.AuditChainVerification, *serviceError.ServiceError) {
+//				panic("mock out the VerifyChain method")
+//			},
+//		}
+//
+//		// use mockedAdminAuditService in code that requires AdminAuditService
+//		// and then make assertions.
+//
+//	}
+type AdminAuditServiceMock struct {
+	// ListByTargetIDFunc mocks the ListByTargetID method.
+	ListByTargetIDFunc func(targetID string) ([]*dbapi.AuditEvent, *serviceError.ServiceError)
+
+	// RecordFunc mocks the Record method.
+	RecordFunc func(ctx context.Context, event *dbapi.AuditEvent) *serviceError.ServiceError
+
+	// SnapshotCentralFunc mocks the SnapshotCentral method.
+	SnapshotCentralFunc func(ctx context.Context, centralID string) (api.JSON, *serviceError.ServiceError)
+
+	// VerifyChainFunc mocks the VerifyChain method.
+	VerifyChainFunc func() (*dbapi.AuditChainVerification, *serviceError.ServiceError)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListByTargetID holds details about calls to the ListByTargetID method.
+		ListByTargetID []struct {
+			// TargetID is the targetID argument value.
+			TargetID string
+		}
+		// Record holds details about calls to the Record method.
+		Record []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Event is the event argument value.
+			Event *dbapi.AuditEvent
+		}
+		// SnapshotCentral holds details about calls to the SnapshotCentral method.
+		SnapshotCentral []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CentralID is the centralID argument value.
+			CentralID string
+		}
+		// VerifyChain holds details about calls to the VerifyChain method.
+		VerifyChain []struct {
+		}
+	}
+	lockListByTargetID  sync.RWMutex
+	lockRecord          sync.RWMutex
+	lockSnapshotCentral sync.RWMutex
+	lockVerifyChain     sync.RWMutex
+}
+
+// ListByTargetID calls ListByTargetIDFunc.
+func (mock *AdminAuditServiceMock) ListByTargetID(targetID string) ([]*dbapi.AuditEvent, *serviceError.ServiceError) {
+	if mock.ListByTargetIDFunc == nil {
+		panic("AdminAuditServiceMock.ListByTargetIDFunc: method is nil but AdminAuditService.ListByTargetID was just called")
+	}
+	callInfo := struct {
+		TargetID string
+	}{
+		TargetID: targetID,
+	}
+	mock.lockListByTargetID.Lock()
+	mock.calls.ListByTargetID = append(mock.calls.ListByTargetID, callInfo)
+	mock.lockListByTargetID.Unlock()
+	return mock.ListByTargetIDFunc(targetID)
+}
+
+// ListByTargetIDCalls gets all the calls that were made to ListByTargetID.
+// Check the length with:
+//
+//	len(mockedAdminAuditService.ListByTargetIDCalls())
+func (mock *AdminAuditServiceMock) ListByTargetIDCalls() []struct {
+	TargetID string
+} {
+	var calls []struct {
+		TargetID string
+	}
+	mock.lockListByTargetID.RLock()
+	calls = mock.calls.ListByTargetID
+	mock.lockListByTargetID.RUnlock()
+	return calls
+}
+
+// Record calls RecordFunc.
+func (mock *AdminAuditServiceMock) Record(ctx context.Context, event *dbapi.AuditEvent) *serviceError.ServiceError {
+	if mock.RecordFunc == nil {
+		panic("AdminAuditServiceMock.RecordFunc: method is nil but AdminAuditService.Record was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Event *dbapi.AuditEvent
+	}{
+		Ctx:   ctx,
+		Event: event,
+	}
+	mock.lockRecord.Lock()
+	mock.calls.Record = append(mock.calls.Record, callInfo)
+	mock.lockRecord.Unlock()
+	return mock.RecordFunc(ctx, event)
+}
+
+// RecordCalls gets all the calls that were made to Record.
+// Check the length with:
+//
+//	len(mockedAdminAuditService.RecordCalls())
+func (mock *AdminAuditServiceMock) RecordCalls() []struct {
+	Ctx   context.Context
+	Event *dbapi.AuditEvent
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Event *dbapi.AuditEvent
+	}
+	mock.lockRecord.RLock()
+	calls = mock.calls.Record
+	mock.lockRecord.RUnlock()
+	return calls
+}
+
+// SnapshotCentral calls SnapshotCentralFunc.
+func (mock *AdminAuditServiceMock) SnapshotCentral(ctx context.Context, centralID string) (api.JSON, *serviceError.ServiceError) {
+	if mock.SnapshotCentralFunc == nil {
+		panic("AdminAuditServiceMock.SnapshotCentralFunc: method is nil but AdminAuditService.SnapshotCentral was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		CentralID string
+	}{
+		Ctx:       ctx,
+		CentralID: centralID,
+	}
+	mock.lockSnapshotCentral.Lock()
+	mock.calls.SnapshotCentral = append(mock.calls.SnapshotCentral, callInfo)
+	mock.lockSnapshotCentral.Unlock()
+	return mock.SnapshotCentralFunc(ctx, centralID)
+}
+
+// SnapshotCentralCalls gets all the calls that were made to SnapshotCentral.
+// Check the length with:
+//
+//	len(mockedAdminAuditService.SnapshotCentralCalls())
+func (mock *AdminAuditServiceMock) SnapshotCentralCalls() []struct {
+	Ctx       context.Context
+	CentralID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		CentralID string
+	}
+	mock.lockSnapshotCentral.RLock()
+	calls = mock.calls.SnapshotCentral
+	mock.lockSnapshotCentral.RUnlock()
+	return calls
+}
+
+// VerifyChain calls VerifyChainFunc.
+func (mock *AdminAuditServiceMock) VerifyChain() (*dbapi.AuditChainVerification, *serviceError.ServiceError) {
+	if mock.VerifyChainFunc == nil {
+		panic("AdminAuditServiceMock.VerifyChainFunc: method is nil but AdminAuditService.VerifyChain was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockVerifyChain.Lock()
+	mock.calls.VerifyChain = append(mock.calls.VerifyChain, callInfo)
+	mock.lockVerifyChain.Unlock()
+	return mock.VerifyChainFunc()
+}
+
+// VerifyChainCalls gets all the calls that were made to VerifyChain.
+// Check the length with:
+//
+//	len(mockedAdminAuditService.VerifyChainCalls())
+func (mock *AdminAuditServiceMock) VerifyChainCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockVerifyChain.RLock()
+	calls = mock.calls.VerifyChain
+	mock.lockVerifyChain.RUnlock()
+	return calls
+}
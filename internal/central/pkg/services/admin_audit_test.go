@@ -0,0 +1,56 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newChainedAuditEvent(t *testing.T, prev *dbapi.AuditEvent, id string) *dbapi.AuditEvent {
+	event := &dbapi.AuditEvent{Sequence: 1, Actor: "admin", RouteName: "admin-name", TargetID: "central-1"}
+	event.ID = id
+	if prev != nil {
+		event.Sequence, event.PrevHash = prev.Sequence+1, prev.Hash
+	}
+	hash, err := event.ComputeHash()
+	require.NoError(t, err)
+	event.Hash = hash
+	return event
+}
+
+func TestVerifyAuditEvent(t *testing.T) {
+	first := newChainedAuditEvent(t, nil, "event-1")
+	second := newChainedAuditEvent(t, first, "event-2")
+	third := newChainedAuditEvent(t, second, "event-3")
+
+	assert.Empty(t, verifyAuditEvent(nil, first))
+	assert.Empty(t, verifyAuditEvent(first, second))
+	assert.NotEmpty(t, verifyAuditEvent(nil, second), "first event of the chain was removed")
+	assert.NotEmpty(t, verifyAuditEvent(first, third), "event in between was removed")
+
+	modified := *second
+	modified.Actor = "attacker"
+	assert.NotEmpty(t, verifyAuditEvent(first, &modified), "event was modified")
+
+	rehashed := modified
+	rehashed.Hash, _ = rehashed.ComputeHash()
+	assert.Empty(t, verifyAuditEvent(first, &rehashed))
+	assert.NotEmpty(t, verifyAuditEvent(&rehashed, third), "rehashed event breaks the link to the next event")
+}
+
+func TestVerifyAuditChainHead(t *testing.T) {
+	first := newChainedAuditEvent(t, nil, "event-1")
+	second := newChainedAuditEvent(t, first, "event-2")
+	head := &dbapi.AuditChainHead{ID: dbapi.AuditChainHeadID, Sequence: second.Sequence, Hash: second.Hash}
+
+	assert.Empty(t, verifyAuditChainHead(nil, nil, 0))
+	assert.Empty(t, verifyAuditChainHead(head, second, 2))
+	assert.NotEmpty(t, verifyAuditChainHead(head, nil, 1), "last event was removed")
+	assert.NotEmpty(t, verifyAuditChainHead(head, nil, 0), "all events were removed")
+	assert.NotEmpty(t, verifyAuditChainHead(nil, nil, 2), "chain head was removed")
+
+	replaced := newChainedAuditEvent(t, first, "event-3")
+	assert.NotEmpty(t, verifyAuditChainHead(head, replaced, 2), "last event was replaced")
+}
@@ -0,0 +1,255 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	pkgErrors "github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/shared/utils/arrays"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultAPIKeyLifetime = 90 * 24 * time.Hour
+	maxAPIKeyLifetime     = 365 * 24 * time.Hour
+	// maxAPIKeysPerOrganisation limits the number of active API keys of an organisation
+	maxAPIKeysPerOrganisation = 50
+	maxAPIKeyNameLength       = 64
+	apiKeySecretLength        = 32
+	// apiKeyLastUsedInterval limits how often the last use of a key is written to the database
+	apiKeyLastUsedInterval = time.Minute
+)
+
+// APIKeyService manages the API keys customers use for machine access to the public API. Keys are managed by users
+// authenticated with JWTs, and act on behalf of the user who created them.
+//
+//go:generate moq -out api_keys_moq.go . APIKeyService
+type APIKeyService interface {
+	auth.APIKeyAuthenticator
+	// Create creates a key of the user in the context. The key itself is only returned by this method.
+	Create(ctx context.Context, name string, scopes []string, expiresAt *time.Time) (*dbapi.APIKey, string, *serviceError.ServiceError)
+	// List returns the keys of the user in the context, or all keys of the organisation for organisation admins
+	List(ctx context.Context) ([]*dbapi.APIKey, *serviceError.ServiceError)
+	// Get returns a key visible to the user in the context
+	Get(ctx context.Context, id string) (*dbapi.APIKey, *serviceError.ServiceError)
+	// Revoke revokes a key visible to the user in the context
+	Revoke(ctx context.Context, id string) *serviceError.ServiceError
+}
+
+type apiKeyService struct {
+	connectionFactory *db.ConnectionFactory
+	now               func() time.Time
+}
+
+var _ APIKeyService = (*apiKeyService)(nil)
+
+// NewAPIKeyService ...
+func NewAPIKeyService(connectionFactory *db.ConnectionFactory) APIKeyService {
+	return &apiKeyService{
+		connectionFactory: connectionFactory,
+		now:               time.Now,
+	}
+}
+
+// Create ...
+func (s *apiKeyService) Create(ctx context.Context, name string, scopes []string, expiresAt *time.Time) (*dbapi.APIKey, string, *serviceError.ServiceError) {
+	owner, orgID, _, svcErr := apiKeyUser(ctx)
+	if svcErr != nil {
+		return nil, "", svcErr
+	}
+	now := s.now()
+	expiry, err := validateAPIKey(name, scopes, expiresAt, now)
+	if err != nil {
+		return nil, "", serviceError.Validation("invalid API key: %s", err.Error())
+	}
+
+	var count int64
+	if err := s.connectionFactory.New().Model(&dbapi.APIKey{}).
+		Where("organisation_id = ? AND expires_at > ?", orgID, now).
+		Count(&count).Error; err != nil {
+		return nil, "", serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to count API keys of organisation %q", orgID)
+	}
+	if count >= maxAPIKeysPerOrganisation {
+		return nil, "", serviceError.Conflict("organisation %q already has the maximum of %d API keys", orgID, maxAPIKeysPerOrganisation)
+	}
+
+	secret := make([]byte, apiKeySecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to generate API key")
+	}
+	encodedSecret := base64.RawURLEncoding.EncodeToString(secret)
+	apiKey := &dbapi.APIKey{
+		Meta:           api.Meta{ID: api.NewID()},
+		Name:           name,
+		OrganisationID: orgID,
+		Owner:          owner,
+		Scopes:         strings.Join(scopes, " "),
+		SecretHash:     hashAPIKeySecret(encodedSecret),
+		ExpiresAt:      expiry,
+	}
+	// the claims are optional like for Centrals created with a token, see ValidateCentralClaims
+	if claims, err := auth.GetClaimsFromContext(ctx); err == nil {
+		apiKey.OwnerUserID, _ = claims.GetSubject()
+		apiKey.OwnerAlternateUserID, _ = claims.GetAlternateUserID()
+		apiKey.OwnerAccountID, _ = claims.GetAccountID()
+	}
+	if err := s.connectionFactory.New().Create(apiKey).Error; err != nil {
+		return nil, "", serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to create API key")
+	}
+	glog.Infof("API key %s with scopes %q created in organisation %q by %q", apiKey.ID, apiKey.Scopes, orgID, owner)
+	return apiKey, auth.APIKeyPrefix + apiKey.ID + "_" + encodedSecret, nil
+}
+
+// List ...
+func (s *apiKeyService) List(ctx context.Context) ([]*dbapi.APIKey, *serviceError.ServiceError) {
+	dbConn, svcErr := s.visibleKeys(ctx)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	var keys []*dbapi.APIKey
+	if err := dbConn.Order("created_at").Find(&keys).Error; err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to list API keys")
+	}
+	return keys, nil
+}
+
+// Get ...
+func (s *apiKeyService) Get(ctx context.Context, id string) (*dbapi.APIKey, *serviceError.ServiceError) {
+	dbConn, svcErr := s.visibleKeys(ctx)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	var apiKey dbapi.APIKey
+	if err := dbConn.Where("id = ?", id).First(&apiKey).Error; err != nil {
+		return nil, services.HandleGetError("APIKey", "id", id, err)
+	}
+	return &apiKey, nil
+}
+
+// Revoke ...
+func (s *apiKeyService) Revoke(ctx context.Context, id string) *serviceError.ServiceError {
+	apiKey, svcErr := s.Get(ctx, id)
+	if svcErr != nil {
+		return svcErr
+	}
+	if err := s.connectionFactory.New().Delete(apiKey).Error; err != nil {
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to revoke API key %s", id)
+	}
+	revokedBy, _, _, _ := apiKeyUser(ctx)
+	glog.Infof("API key %s of %q in organisation %q revoked by %q", id, apiKey.Owner, apiKey.OrganisationID, revokedBy)
+	return nil
+}
+
+// Authenticate ...
+func (s *apiKeyService) Authenticate(key string) (*auth.APIKeyIdentity, error) {
+	id, secret, found := strings.Cut(strings.TrimPrefix(key, auth.APIKeyPrefix), "_")
+	if !found || id == "" || secret == "" {
+		return nil, nil
+	}
+	var apiKey dbapi.APIKey
+	if err := s.connectionFactory.New().Where("id = ?", id).First(&apiKey).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, pkgErrors.Wrapf(err, "finding API key %s", id)
+	}
+	if subtle.ConstantTimeCompare(apiKey.SecretHash, hashAPIKeySecret(secret)) != 1 {
+		return nil, nil
+	}
+	now := s.now()
+	if !now.Before(apiKey.ExpiresAt) {
+		return nil, nil
+	}
+
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= apiKeyLastUsedInterval {
+		if err := s.connectionFactory.New().Model(&apiKey).UpdateColumn("last_used_at", now).Error; err != nil {
+			glog.Warningf("Failed to update the last use of API key %s: %v", id, err)
+		}
+	}
+	return &auth.APIKeyIdentity{
+		ID:              apiKey.ID,
+		Username:        apiKey.Owner,
+		UserID:          apiKey.OwnerUserID,
+		AlternateUserID: apiKey.OwnerAlternateUserID,
+		AccountID:       apiKey.OwnerAccountID,
+		OrgID:           apiKey.OrganisationID,
+		Scopes:          strings.Fields(apiKey.Scopes),
+		ExpiresAt:       apiKey.ExpiresAt,
+	}, nil
+}
+
+// visibleKeys restricts queries to the keys of the user in the context. Organisation admins see all keys of their
+// organisation, e.g. to revoke the keys of users who left.
+func (s *apiKeyService) visibleKeys(ctx context.Context) (*gorm.DB, *serviceError.ServiceError) {
+	owner, orgID, isOrgAdmin, svcErr := apiKeyUser(ctx)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	dbConn := s.connectionFactory.New().Where("organisation_id = ?", orgID)
+	if !isOrgAdmin {
+		dbConn = dbConn.Where("owner = ?", owner)
+	}
+	return dbConn, nil
+}
+
+func apiKeyUser(ctx context.Context) (owner string, orgID string, isOrgAdmin bool, svcErr *serviceError.ServiceError) {
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return "", "", false, serviceError.NewWithCause(serviceError.ErrorUnauthenticated, err, "user not authenticated")
+	}
+	owner, err = claims.GetUsername()
+	if err != nil || owner == "" {
+		return "", "", false, serviceError.NewWithCause(serviceError.ErrorUnauthenticated, err, "user not authenticated")
+	}
+	orgID, err = claims.GetOrgID()
+	if err != nil || orgID == "" {
+		return "", "", false, serviceError.NewWithCause(serviceError.ErrorUnauthenticated, err, "user has no organisation")
+	}
+	return owner, orgID, claims.IsOrgAdmin(), nil
+}
+
+// validateAPIKey checks the request of a key and returns its expiry
+func validateAPIKey(name string, scopes []string, expiresAt *time.Time, now time.Time) (time.Time, error) {
+	if name == "" {
+		return time.Time{}, pkgErrors.New("name is required")
+	}
+	if len(name) > maxAPIKeyNameLength {
+		return time.Time{}, pkgErrors.Errorf("name must not be longer than %d characters", maxAPIKeyNameLength)
+	}
+	if len(scopes) == 0 {
+		return time.Time{}, pkgErrors.New("at least one scope is required")
+	}
+	for _, scope := range scopes {
+		if !arrays.Contains(auth.APIKeyScopes, scope) {
+			return time.Time{}, pkgErrors.Errorf("unknown scope %q, the scopes are %s", scope, strings.Join(auth.APIKeyScopes, ", "))
+		}
+	}
+	if expiresAt == nil {
+		return now.Add(defaultAPIKeyLifetime), nil
+	}
+	if !expiresAt.After(now) {
+		return time.Time{}, pkgErrors.New("expires_at must be in the future")
+	}
+	if expiresAt.Sub(now) > maxAPIKeyLifetime {
+		return time.Time{}, pkgErrors.Errorf("expires_at must not be more than %d days in the future", int(maxAPIKeyLifetime.Hours()/24))
+	}
+	return *expiresAt, nil
+}
+
+func hashAPIKeySecret(secret string) []byte {
+	hash := sha256.Sum256([]byte(secret))
+	return hash[:]
+}
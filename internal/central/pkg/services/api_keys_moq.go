@@ -0,0 +1,285 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"context"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"sync"
+	"time"
+)
+
+// Ensure, that APIKeyServiceMock does implement APIKeyService.
+// If this is not the case, regenerate this file with moq.
+var _ APIKeyService = &APIKeyServiceMock{}
+
+// APIKeyServiceMock is a mock implementation of APIKeyService.
+//
+//	func TestSomethingThatUsesAPIKeyService(t *testing.T) {
+//
+//		// make and configure a mocked APIKeyService
+//		mockedAPIKeyService := &APIKeyServiceMock{
+//			AuthenticateFunc: func(key string) (*auth.APIKeyIdentity, error) {
+//				panic("mock out the Authenticate method")
+//			},
+//			CreateFunc: func(ctx context.Context, name string, scopes []string, expiresAt *time.Time) (*dbapi.APIKey, string, *serviceError.ServiceError) {
+//				panic("mock out the Create method")
+//			},
+//			GetFunc: func(ctx context.Context, id string) (*dbapi.APIKey, *serviceError.ServiceError) {
+//				panic("mock out the Get method")
+//			},
+//			ListFunc: func(ctx context.Context) ([]*dbapi.APIKey, *serviceError.ServiceError) {
+//				panic("mock out the List method")
+//			},
+//			RevokeFunc: func(ctx context.Context, id string) *serviceError.ServiceError {
+//				panic("mock out the Revoke method")
+//			},
+//		}
+//
+//		// use mockedAPIKeyService in code that requires APIKeyService
+//		// and then make assertions.
+//
+//	}
+type APIKeyServiceMock struct {
+	// AuthenticateFunc mocks the Authenticate method.
+	AuthenticateFunc func(key string) (*auth.APIKeyIdentity, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, name string, scopes []string, expiresAt *time.Time) (*dbapi.APIKey, string, *serviceError.ServiceError)
+
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, id string) (*dbapi.APIKey, *serviceError.ServiceError)
+
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context) ([]*dbapi.APIKey, *serviceError.ServiceError)
+
+	// RevokeFunc mocks the Revoke method.
+	RevokeFunc func(ctx context.Context, id string) *serviceError.ServiceError
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Authenticate holds details about calls to the Authenticate method.
+		Authenticate []struct {
+			// Key is the key argument value.
+			Key string
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+			// Scopes is the scopes argument value.
+			Scopes []string
+			// ExpiresAt is the expiresAt argument value.
+			ExpiresAt *time.Time
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// Revoke holds details about calls to the Revoke method.
+		Revoke []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+	}
+	lockAuthenticate sync.RWMutex
+	lockCreate       sync.RWMutex
+	lockGet          sync.RWMutex
+	lockList         sync.RWMutex
+	lockRevoke       sync.RWMutex
+}
+
+// Authenticate calls AuthenticateFunc.
+func (mock *APIKeyServiceMock) Authenticate(key string) (*auth.APIKeyIdentity, error) {
+	if mock.AuthenticateFunc == nil {
+		panic("APIKeyServiceMock.AuthenticateFunc: method is nil but APIKeyService.Authenticate was just called")
+	}
+	callInfo := struct {
+		Key string
+	}{
+		Key: key,
+	}
+	mock.lockAuthenticate.Lock()
+	mock.calls.Authenticate = append(mock.calls.Authenticate, callInfo)
+	mock.lockAuthenticate.Unlock()
+	return mock.AuthenticateFunc(key)
+}
+
+// AuthenticateCalls gets all the calls that were made to Authenticate.
+// Check the length with:
+//
+//	len(mockedAPIKeyService.AuthenticateCalls())
+func (mock *APIKeyServiceMock) AuthenticateCalls() []struct {
+	Key string
+} {
+	var calls []struct {
+		Key string
+	}
+	mock.lockAuthenticate.RLock()
+	calls = mock.calls.Authenticate
+	mock.lockAuthenticate.RUnlock()
+	return calls
+}
+
+// Create calls CreateFunc.
+func (mock *APIKeyServiceMock) Create(ctx context.Context, name string, scopes []string, expiresAt *time.Time) (*dbapi.APIKey, string, *serviceError.ServiceError) {
+	if mock.CreateFunc == nil {
+		panic("APIKeyServiceMock.CreateFunc: method is nil but APIKeyService.Create was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Name      string
+		Scopes    []string
+		ExpiresAt *time.Time
+	}{
+		Ctx:       ctx,
+		Name:      name,
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, name, scopes, expiresAt)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedAPIKeyService.CreateCalls())
+func (mock *APIKeyServiceMock) CreateCalls() []struct {
+	Ctx       context.Context
+	Name      string
+	Scopes    []string
+	ExpiresAt *time.Time
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Name      string
+		Scopes    []string
+		ExpiresAt *time.Time
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *APIKeyServiceMock) Get(ctx context.Context, id string) (*dbapi.APIKey, *serviceError.ServiceError) {
+	if mock.GetFunc == nil {
+		panic("APIKeyServiceMock.GetFunc: method is nil but APIKeyService.Get was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, id)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedAPIKeyService.GetCalls())
+func (mock *APIKeyServiceMock) GetCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// List calls ListFunc.
+func (mock *APIKeyServiceMock) List(ctx context.Context) ([]*dbapi.APIKey, *serviceError.ServiceError) {
+	if mock.ListFunc == nil {
+		panic("APIKeyServiceMock.ListFunc: method is nil but APIKeyService.List was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx)
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedAPIKeyService.ListCalls())
+func (mock *APIKeyServiceMock) ListCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// Revoke calls RevokeFunc.
+func (mock *APIKeyServiceMock) Revoke(ctx context.Context, id string) *serviceError.ServiceError {
+	if mock.RevokeFunc == nil {
+		panic("APIKeyServiceMock.RevokeFunc: method is nil but APIKeyService.Revoke was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockRevoke.Lock()
+	mock.calls.Revoke = append(mock.calls.Revoke, callInfo)
+	mock.lockRevoke.Unlock()
+	return mock.RevokeFunc(ctx, id)
+}
+
+// RevokeCalls gets all the calls that were made to Revoke.
+// Check the length with:
+//
+//	len(mockedAPIKeyService.RevokeCalls())
+func (mock *APIKeyServiceMock) RevokeCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockRevoke.RLock()
+	calls = mock.calls.Revoke
+	mock.lockRevoke.RUnlock()
+	return calls
+}
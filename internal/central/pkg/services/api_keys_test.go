@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	mocket "github.com/selvatico/go-mocket"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAPIKey(t *testing.T) {
+	now := time.Now()
+	scopes := []string{auth.APIKeyScopeCentralsRead}
+
+	expiry, err := validateAPIKey("terraform", scopes, nil, now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(defaultAPIKeyLifetime), expiry)
+
+	requested := now.Add(30 * 24 * time.Hour)
+	expiry, err = validateAPIKey("terraform", scopes, &requested, now)
+	require.NoError(t, err)
+	assert.Equal(t, requested, expiry)
+
+	past := now.Add(-time.Minute)
+	tooLate := now.Add(maxAPIKeyLifetime + time.Minute)
+	tests := map[string]struct {
+		name      string
+		scopes    []string
+		expiresAt *time.Time
+	}{
+		"no name":          {scopes: scopes},
+		"name is too long": {name: string(make([]byte, maxAPIKeyNameLength+1)), scopes: scopes},
+		"no scopes":        {name: "terraform"},
+		"unknown scope":    {name: "terraform", scopes: []string{"centrals:admin"}},
+		"expired":          {name: "terraform", scopes: scopes, expiresAt: &past},
+		"expires too late": {name: "terraform", scopes: scopes, expiresAt: &tooLate},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := validateAPIKey(tt.name, tt.scopes, tt.expiresAt, now)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestAPIKeyService_AuthenticateRejectsMalformedKeys(t *testing.T) {
+	s := &apiKeyService{now: time.Now}
+	for _, key := range []string{"rhacs_", "rhacs_key-1", "rhacs_key-1_", "rhacs__secret"} {
+		identity, err := s.Authenticate(key)
+		assert.NoError(t, err, key)
+		assert.Nil(t, identity, key)
+	}
+}
+
+func TestAPIKeyService_CreateStoresOwnerIDs(t *testing.T) {
+	s := &apiKeyService{connectionFactory: db.NewMockConnectionFactory(nil), now: time.Now}
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().WithQuery(`SELECT count(*) FROM "api_keys"`).WithReply([]map[string]interface{}{{"count": 0}})
+	var inserted []driver.NamedValue
+	insert := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "api_keys"`).WithCallback(
+		func(query string, args []driver.NamedValue) {
+			inserted = args
+		})
+	ctx := auth.SetTokenInContext(context.Background(), &jwt.Token{Claims: jwt.MapClaims{
+		"username":       "owner",
+		"org_id":         "org-1",
+		"sub":            "owner-sub",
+		"deprecated_sub": "owner-deprecated-sub",
+		"account_id":     "owner-account",
+	}})
+
+	apiKey, _, svcErr := s.Create(ctx, "terraform", []string{auth.APIKeyScopeCentralsWrite}, nil)
+
+	require.Nil(t, svcErr)
+	assert.Equal(t, "owner-sub", apiKey.OwnerUserID)
+	assert.Equal(t, "owner-deprecated-sub", apiKey.OwnerAlternateUserID)
+	assert.Equal(t, "owner-account", apiKey.OwnerAccountID)
+	require.True(t, insert.Triggered)
+	values := make([]interface{}, 0, len(inserted))
+	for _, arg := range inserted {
+		values = append(values, arg.Value)
+	}
+	assert.Subset(t, values, []interface{}{"owner-sub", "owner-deprecated-sub", "owner-account"})
+}
@@ -4,24 +4,24 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"net/http"
 	"reflect"
 	"slices"
 	"sync"
 	"time"
 
-	"github.com/aws/aws-sdk-go-v2/service/route53"
 	route53Types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/golang/glog"
 	"github.com/stackrox/acs-fleet-manager/internal/central/constants"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/centrals/types"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/dns"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/externaldns"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/presenters"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/rhsso"
 	"github.com/stackrox/acs-fleet-manager/pkg/api"
 	"github.com/stackrox/acs-fleet-manager/pkg/auth"
-	"github.com/stackrox/acs-fleet-manager/pkg/client/aws"
 	"github.com/stackrox/acs-fleet-manager/pkg/client/iam"
 	ocm "github.com/stackrox/acs-fleet-manager/pkg/client/ocm/impl"
 	dynamicClientAPI "github.com/stackrox/acs-fleet-manager/pkg/client/redhatsso/api"
@@ -33,6 +33,7 @@ import (
 	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
 	"github.com/stackrox/acs-fleet-manager/pkg/services"
 	coreServices "github.com/stackrox/acs-fleet-manager/pkg/services/queryparser"
+	"gorm.io/gorm"
 )
 
 var (
@@ -60,12 +61,6 @@ const CentralRoutesActionDelete CentralRoutesAction = "DELETE"
 
 const gracePeriod = 14 * 24 * time.Hour
 
-// CNameRecordStatus ...
-type CNameRecordStatus struct {
-	ID     *string
-	Status *string
-}
-
 // CentralService ...
 //
 //go:generate moq -out centralservice_moq.go . CentralService
@@ -82,9 +77,11 @@ type CentralService interface {
 	// GetByID method will retrieve the CentralRequest instance from the database without checking any permissions.
 	// You should only use this if you are sure permission check is not required.
 	GetByID(id string) (*dbapi.CentralRequest, *errors.ServiceError)
+	// HasCentralRole returns true if the user of the request context has at least the required role for the Central
+	HasCentralRole(ctx context.Context, centralRequest *dbapi.CentralRequest, required dbapi.CentralRole) (bool, *errors.ServiceError)
 	// Delete cleans up all dependencies for a Central request and soft deletes the Central Request record from the database.
 	// The Central Request in the database will be updated with a deleted_at timestamp.
-	Delete(centralRequest *dbapi.CentralRequest, force bool) *errors.ServiceError
+	Delete(ctx context.Context, centralRequest *dbapi.CentralRequest, force bool) *errors.ServiceError
 	List(ctx context.Context, listArgs *services.ListArguments) (dbapi.CentralList, *api.PagingMeta, *errors.ServiceError)
 	RegisterCentralJob(ctx context.Context, centralRequest *dbapi.CentralRequest) *errors.ServiceError
 	ListByStatus(status ...constants.CentralStatus) ([]*dbapi.CentralRequest, *errors.ServiceError)
@@ -93,15 +90,16 @@ type CentralService interface {
 	// original status is 'deprovision' (cluster in deprovision state can't be change state) or if the final status is the
 	// same as the original status. The error will contain any error encountered when attempting to update or the reason
 	// why no attempt has been done
-	UpdateStatus(id string, status constants.CentralStatus) (bool, *errors.ServiceError)
+	UpdateStatus(ctx context.Context, id string, status constants.CentralStatus) (bool, *errors.ServiceError)
 	// UpdateIgnoreNils does NOT update nullable fields when they're nil in the request. Use Updates() instead.
-	UpdateIgnoreNils(centralRequest *dbapi.CentralRequest) *errors.ServiceError
+	UpdateIgnoreNils(ctx context.Context, centralRequest *dbapi.CentralRequest) *errors.ServiceError
 	// Updates changes the given fields of a central. This takes in a map so that even zero-fields can be updated.
 	// Use this only when you want to update the multiple columns that may contain zero-fields, otherwise use the `CentralService.Update()` method.
 	// See https://gorm.io/docs/update.html#Updates-multiple-columns for more info
-	Updates(centralRequest *dbapi.CentralRequest, values map[string]interface{}) *errors.ServiceError
-	ChangeCentralCNAMErecords(centralRequest *dbapi.CentralRequest, action CentralRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError)
-	GetCNAMERecordStatus(centralRequest *dbapi.CentralRequest) (*CNameRecordStatus, error)
+	Updates(ctx context.Context, centralRequest *dbapi.CentralRequest, values map[string]interface{}) *errors.ServiceError
+	// ChangeCentralCNAMErecords upserts or deletes the CNAME or A records of the central routes and their owner records
+	// with the DNS provider
+	ChangeCentralCNAMErecords(centralRequest *dbapi.CentralRequest, action CentralRoutesAction) (*dns.Change, *errors.ServiceError)
 	DetectInstanceType(centralRequest *dbapi.CentralRequest) types.CentralInstanceType
 	RegisterCentralDeprovisionJob(ctx context.Context, centralRequest *dbapi.CentralRequest) *errors.ServiceError
 	// DeprovisionCentralForUsers registers all centrals for deprovisioning given the list of owners
@@ -110,10 +108,28 @@ type CentralService interface {
 	CountByStatus(status []constants.CentralStatus) ([]CentralStatusCount, error)
 	CountByRegionAndInstanceType() ([]CentralRegionCount, error)
 	ListCentralsWithRoutesNotCreated() ([]*dbapi.CentralRequest, *errors.ServiceError)
+	// ListCentralsByHost returns the centrals whose host is the given domain. The UI and data hosts of these centrals
+	// are subdomains of it.
+	ListCentralsByHost(host string) ([]*dbapi.CentralRequest, *errors.ServiceError)
 	ListCentralsWithoutAuthConfig() ([]*dbapi.CentralRequest, *errors.ServiceError)
+	// ListKnownCentralIDs returns the IDs of the centrals placed on the cluster or migrated from or to it, including
+	// deleted centrals which can still be restored
+	ListKnownCentralIDs(clusterID string) ([]string, *errors.ServiceError)
+	// ListDynamicClientIDs returns the IDs of the RHSSO dynamic clients of all centrals which are not deleted,
+	// including the previous clients of rotations which are not completed yet
+	ListDynamicClientIDs() ([]string, *errors.ServiceError)
+	// ListCentralsWithDynamicClients returns the ready centrals with RHSSO dynamic clients
+	ListCentralsWithDynamicClients() ([]*dbapi.CentralRequest, *errors.ServiceError)
 	VerifyAndUpdateCentralAdmin(ctx context.Context, centralRequest *dbapi.CentralRequest) *errors.ServiceError
 	Restore(ctx context.Context, id string) *errors.ServiceError
+	// RotateCentralRHSSOClient replaces the RHSSO dynamic client of the central and deletes the previous client
+	// immediately
 	RotateCentralRHSSOClient(ctx context.Context, centralRequest *dbapi.CentralRequest) *errors.ServiceError
+	// StartCentralRHSSOClientRotation replaces the RHSSO dynamic client of the central, but keeps the previous client
+	// until the data plane applied the new one. The rotation is completed by CompleteCentralRHSSOClientRotation.
+	StartCentralRHSSOClientRotation(ctx context.Context, centralRequest *dbapi.CentralRequest) *errors.ServiceError
+	// CompleteCentralRHSSOClientRotation deletes the previous RHSSO dynamic client of the central
+	CompleteCentralRHSSOClientRotation(ctx context.Context, centralRequest *dbapi.CentralRequest) *errors.ServiceError
 	// ResetCentralSecretBackup resets the Secret field of centralReqest, which are the backed up secrets
 	// of a tenant. By resetting the field the next update will store new secrets which enables manual rotation.
 	// This is currently the only way to update secret backups, an automatic approach should be implemented
@@ -130,10 +146,9 @@ type centralService struct {
 	connectionFactory        *db.ConnectionFactory
 	clusterService           ClusterService
 	centralConfig            *config.CentralConfig
-	awsConfig                *config.AWSConfig
 	quotaServiceFactory      QuotaServiceFactory
 	mu                       sync.Mutex
-	awsClientFactory         aws.ClientFactory
+	dnsProvider              dns.DNSProvider
 	dataplaneClusterConfig   *config.DataplaneClusterConfig
 	clusterPlacementStrategy ClusterPlacementStrategy
 	amsClient                ocm.AMSClient
@@ -145,17 +160,16 @@ type centralService struct {
 
 // NewCentralService ...
 func NewCentralService(connectionFactory *db.ConnectionFactory, clusterService ClusterService,
-	iamConfig *iam.IAMConfig, centralConfig *config.CentralConfig, dataplaneClusterConfig *config.DataplaneClusterConfig, awsConfig *config.AWSConfig,
-	quotaServiceFactory QuotaServiceFactory, awsClientFactory aws.ClientFactory,
+	iamConfig *iam.IAMConfig, centralConfig *config.CentralConfig, dataplaneClusterConfig *config.DataplaneClusterConfig,
+	quotaServiceFactory QuotaServiceFactory, dnsProvider dns.DNSProvider,
 	clusterPlacementStrategy ClusterPlacementStrategy, amsClient ocm.AMSClient, telemetry *Telemetry, managedCentralPresenter *presenters.ManagedCentralPresenter) CentralService {
 	return &centralService{
 		connectionFactory:        connectionFactory,
 		clusterService:           clusterService,
 		iamConfig:                iamConfig,
 		centralConfig:            centralConfig,
-		awsConfig:                awsConfig,
 		quotaServiceFactory:      quotaServiceFactory,
-		awsClientFactory:         awsClientFactory,
+		dnsProvider:              dnsProvider,
 		dataplaneClusterConfig:   dataplaneClusterConfig,
 		clusterPlacementStrategy: clusterPlacementStrategy,
 		amsClient:                amsClient,
@@ -166,27 +180,83 @@ func NewCentralService(connectionFactory *db.ConnectionFactory, clusterService C
 }
 
 func (k *centralService) RotateCentralRHSSOClient(ctx context.Context, centralRequest *dbapi.CentralRequest) *errors.ServiceError {
+	previousClientID, svcErr := k.replaceCentralRHSSOClient(ctx, centralRequest)
+	if svcErr != nil {
+		return svcErr
+	}
+	if _, err := k.rhSSODynamicClientsAPI.DeleteAcsClient(ctx, previousClientID); err != nil {
+		glog.Errorf("Rotating RHSSO client failed: failed to delete previous RHSSO dynamic client %s, %s", previousClientID, k.orphanedRHSSOClientCleanup())
+		return errors.NewWithCause(errors.ErrorClientRotationFailed, err, "failed to delete previous RHSSO dynamic client")
+	}
+	if centralRequest.PreviousClientID != "" {
+		// the client kept by a scheduled rotation is not needed anymore either
+		return k.CompleteCentralRHSSOClientRotation(ctx, centralRequest)
+	}
+	return nil
+}
+
+func (k *centralService) StartCentralRHSSOClientRotation(ctx context.Context, centralRequest *dbapi.CentralRequest) *errors.ServiceError {
+	if centralRequest.PreviousClientID != "" {
+		return errors.New(errors.ErrorClientRotationFailed, "previous RHSSO dynamic client %s of central %s is not deleted yet", centralRequest.PreviousClientID, centralRequest.ID)
+	}
+	previousClientID, svcErr := k.replaceCentralRHSSOClient(ctx, centralRequest)
+	if svcErr != nil {
+		return svcErr
+	}
+	centralRequest.PreviousClientID = previousClientID
+	if err := k.Updates(ctx, centralRequest, map[string]interface{}{"previous_client_id": previousClientID}); err != nil {
+		glog.Errorf("Rotating RHSSO client failed: failed to store previous RHSSO dynamic client %s of central %s, %s", previousClientID, centralRequest.ID, k.orphanedRHSSOClientCleanup())
+		return errors.NewWithCause(errors.ErrorClientRotationFailed, err, "failed to update database record")
+	}
+	glog.Infof("Rotated RHSSO dynamic client of central %s from %s to %s, the previous client is deleted once the data plane applied the new one", centralRequest.ID, previousClientID, centralRequest.ClientID)
+	return nil
+}
+
+func (k *centralService) CompleteCentralRHSSOClientRotation(ctx context.Context, centralRequest *dbapi.CentralRequest) *errors.ServiceError {
+	previousClientID := centralRequest.PreviousClientID
+	if previousClientID == "" {
+		return nil
+	}
+	resp, err := k.rhSSODynamicClientsAPI.DeleteAcsClient(ctx, previousClientID)
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return errors.NewWithCause(errors.ErrorClientRotationFailed, err, "failed to delete previous RHSSO dynamic client %s", previousClientID)
+	}
+	centralRequest.PreviousClientID = ""
+	if err := k.Updates(ctx, centralRequest, map[string]interface{}{"previous_client_id": ""}); err != nil {
+		return errors.NewWithCause(errors.ErrorClientRotationFailed, err, "failed to update database record")
+	}
+	glog.Infof("Deleted previous RHSSO dynamic client %s of central %s", previousClientID, centralRequest.ID)
+	return nil
+}
+
+// orphanedRHSSOClientCleanup describes how an RHSSO dynamic client which could not be deleted is cleaned up
+func (k *centralService) orphanedRHSSOClientCleanup() string {
+	if k.centralConfig.RHSSOClientGC.DryRun {
+		return "it has to be deleted manually as the orphaned client garbage collection runs in dry run mode"
+	}
+	return "it is removed by the orphaned client garbage collection"
+}
+
+// replaceCentralRHSSOClient creates a new RHSSO dynamic client for the central and stores it. It returns the ID of the
+// replaced client.
+func (k *centralService) replaceCentralRHSSOClient(ctx context.Context, centralRequest *dbapi.CentralRequest) (string, *errors.ServiceError) {
 	realmConfig := k.iamConfig.RedhatSSORealm
 	if k.centralConfig.HasStaticAuth() {
-		return errors.New(errors.ErrorDynamicClientsNotUsed, "RHSSO is configured via static configuration")
+		return "", errors.New(errors.ErrorDynamicClientsNotUsed, "RHSSO is configured via static configuration")
 	}
 	if !realmConfig.IsConfigured() {
-		return errors.New(errors.ErrorDynamicClientsNotUsed, "RHSSO dynamic client configuration is not present")
+		return "", errors.New(errors.ErrorDynamicClientsNotUsed, "RHSSO dynamic client configuration is not present")
 	}
 
-	previousAuthConfig := centralRequest.AuthConfig
+	previousClientID := centralRequest.AuthConfig.ClientID
 	if err := rhsso.AugmentWithDynamicAuthConfig(ctx, centralRequest, k.iamConfig.RedhatSSORealm, k.rhSSODynamicClientsAPI); err != nil {
-		return errors.NewWithCause(errors.ErrorClientRotationFailed, err, "failed to augment auth config")
+		return "", errors.NewWithCause(errors.ErrorClientRotationFailed, err, "failed to augment auth config")
 	}
-	if err := k.UpdateIgnoreNils(centralRequest); err != nil {
+	if err := k.UpdateIgnoreNils(ctx, centralRequest); err != nil {
 		glog.Errorf("Rotating RHSSO client failed: created new RHSSO dynamic client, but failed to update central record, client ID is %s", centralRequest.AuthConfig.ClientID)
-		return errors.NewWithCause(errors.ErrorClientRotationFailed, err, "failed to update database record")
+		return "", errors.NewWithCause(errors.ErrorClientRotationFailed, err, "failed to update database record")
 	}
-	if _, err := k.rhSSODynamicClientsAPI.DeleteAcsClient(ctx, previousAuthConfig.ClientID); err != nil {
-		glog.Errorf("Rotating RHSSO client failed: failed to delete RHSSO dynamic client, client ID is %s", centralRequest.AuthConfig.ClientID)
-		return errors.NewWithCause(errors.ErrorClientRotationFailed, err, "failed to delete previous RHSSO dynamic client")
-	}
-	return nil
+	return previousClientID, nil
 }
 
 func (k *centralService) ResetCentralSecretBackup(ctx context.Context, centralRequest *dbapi.CentralRequest) *errors.ServiceError {
@@ -194,7 +264,7 @@ func (k *centralService) ResetCentralSecretBackup(ctx context.Context, centralRe
 	centralRequest.SecretDataSha256Sum = ""
 	logStateChange("reset secrets", centralRequest.ID, nil)
 
-	dbConn := k.connectionFactory.New()
+	dbConn := k.connectionFactory.FromContext(ctx)
 	if err := dbConn.Model(centralRequest).Select("secrets", "secret_data_sha256_sum").Updates(centralRequest).Error; err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "Unable to reset secrets for central request")
 	}
@@ -204,7 +274,13 @@ func (k *centralService) ResetCentralSecretBackup(ctx context.Context, centralRe
 
 // HasAvailableCapacityInRegion ...
 func (k *centralService) HasAvailableCapacityInRegion(centralRequest *dbapi.CentralRequest) (bool, *errors.ServiceError) {
-	regionCapacity := int64(k.dataplaneClusterConfig.ClusterConfig.GetCapacityForRegion(centralRequest.Region))
+	regionCapacity, svcErr := k.capacityForRegion(centralRequest.Region)
+	if svcErr != nil {
+		return false, svcErr
+	}
+	if regionCapacity == config.UnlimitedCentralInstanceLimit {
+		return true, nil
+	}
 	if regionCapacity <= 0 {
 		return false, nil
 	}
@@ -219,6 +295,44 @@ func (k *centralService) HasAvailableCapacityInRegion(centralRequest *dbapi.Cent
 	return count < regionCapacity, nil
 }
 
+// capacityForRegion sums up the central instance limits of the clusters in the region. Clusters registered through
+// the admin API count with the limit stored in the database, even if they are also listed in the configuration file.
+// If any cluster has no limit, the capacity of the region is unlimited as well.
+func (k *centralService) capacityForRegion(region string) (int64, *errors.ServiceError) {
+	var apiClusters []api.Cluster
+	if err := k.connectionFactory.New().Model(&api.Cluster{}).
+		Select("cluster_id, central_instance_limit").
+		Where("region = ? AND registration_source = ?", region, api.ClusterRegistrationSourceAPI).
+		Where("status NOT IN ?", api.ClusterDeletionStatuses).
+		Scan(&apiClusters).Error; err != nil {
+		return 0, errors.NewWithCause(errors.ErrorGeneral, err, "failed to look up cluster capacity in region %s", region)
+	}
+
+	var capacity int64
+	apiClusterIDs := make(map[string]bool, len(apiClusters))
+	unlimited := false
+	addLimit := func(limit int) {
+		if limit == config.UnlimitedCentralInstanceLimit {
+			unlimited = true
+			return
+		}
+		capacity += int64(limit)
+	}
+	for _, cluster := range apiClusters {
+		apiClusterIDs[cluster.ClusterID] = true
+		addLimit(cluster.CentralInstanceLimit)
+	}
+	for _, cluster := range k.dataplaneClusterConfig.ClusterConfig.GetManualClusters() {
+		if cluster.Region == region && !apiClusterIDs[cluster.ClusterID] {
+			addLimit(cluster.CentralInstanceLimit)
+		}
+	}
+	if unlimited {
+		return config.UnlimitedCentralInstanceLimit, nil
+	}
+	return capacity, nil
+}
+
 // DetectInstanceType - returns standard instance type if quota is available. Otherwise falls back to eval instance type.
 func (k *centralService) DetectInstanceType(centralRequest *dbapi.CentralRequest) types.CentralInstanceType {
 	quotaType := api.QuotaType(k.centralConfig.Quota.Type)
@@ -285,7 +399,7 @@ func (k *centralService) RegisterCentralJob(ctx context.Context, centralRequest
 	if hasCapacity, err := k.HasAvailableCapacityInRegion(centralRequest); err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create central request")
 	} else if !hasCapacity {
-		errorMsg := fmt.Sprintf("Cluster capacity(%d) exhausted in %s region", int64(k.dataplaneClusterConfig.ClusterConfig.GetCapacityForRegion(centralRequest.Region)), centralRequest.Region)
+		errorMsg := fmt.Sprintf("Cluster capacity exhausted in %s region", centralRequest.Region)
 		logger.Logger.Warningf("%s", errorMsg)
 		return errors.TooManyCentralInstancesReached("%s", errorMsg)
 	}
@@ -359,7 +473,7 @@ func (k *centralService) AcceptCentralRequest(centralRequest *dbapi.CentralReque
 		Status:      constants.CentralRequestStatusPreparing.String(),
 		Namespace:   centralRequest.Namespace,
 	}
-	if err := k.UpdateIgnoreNils(updatedCentralRequest); err != nil {
+	if err := k.UpdateIgnoreNils(context.Background(), updatedCentralRequest); err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update central request")
 	}
 
@@ -402,7 +516,7 @@ func (k *centralService) PrepareCentralRequest(centralRequest *dbapi.CentralRequ
 		Status:                constants.CentralRequestStatusProvisioning.String(),
 		EnteredProvisioningAt: dbapi.TimePtrToNullTime(&now),
 	}
-	if err := k.UpdateIgnoreNils(updatedCentralRequest); err != nil {
+	if err := k.UpdateIgnoreNils(context.Background(), updatedCentralRequest); err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update central request")
 	}
 
@@ -431,29 +545,10 @@ func (k *centralService) Get(ctx context.Context, id string) (*dbapi.CentralRequ
 		return nil, errors.Validation("id is undefined")
 	}
 
-	claims, err := auth.GetClaimsFromContext(ctx)
-	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
-	}
-
-	dbConn := k.connectionFactory.New().Where("id = ?", id)
-
-	var user string
-	if !auth.GetIsAdminFromContext(ctx) {
-		user, _ = claims.GetUsername()
-		if user == "" {
-			return nil, errors.Unauthenticated("user not authenticated")
-		}
-
-		orgID, _ := claims.GetOrgID()
-		filterByOrganisationID := auth.GetFilterByOrganisationFromContext(ctx)
-
-		// filter by organisationId if a user is part of an organisation and is not allowed as a service account
-		if filterByOrganisationID {
-			dbConn = dbConn.Where("organisation_id = ?", orgID)
-		} else {
-			dbConn = dbConn.Where("owner = ?", user)
-		}
+	// the query only matches instances the user can view, i.e. the user has at least the viewer role
+	dbConn, user, svcErr := k.scopeToVisibleCentrals(ctx, k.connectionFactory.New().Where("id = ?", id))
+	if svcErr != nil {
+		return nil, svcErr
 	}
 
 	var centralRequest dbapi.CentralRequest
@@ -487,21 +582,10 @@ func (k *centralService) RegisterCentralDeprovisionJob(ctx context.Context, cent
 		return errors.Validation("id is undefined")
 	}
 
-	// filter central request by owner to only retrieve request of the current authenticated user
-	claims, err := auth.GetClaimsFromContext(ctx)
-	if err != nil {
-		return errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
-	}
-
-	isAuthorizedToDelete := false
-	if auth.GetIsAdminFromContext(ctx) {
-		isAuthorizedToDelete = true
-	} else if claims.IsOrgAdmin() {
-		orgID, _ := claims.GetOrgID()
-		isAuthorizedToDelete = centralRequest.OrganisationID == orgID
-	} else {
-		user, _ := claims.GetUsername()
-		isAuthorizedToDelete = centralRequest.Owner == user
+	// deleting an instance requires at least the editor role
+	isAuthorizedToDelete, svcErr := k.HasCentralRole(ctx, centralRequest, dbapi.CentralRoleEditor)
+	if svcErr != nil {
+		return svcErr
 	}
 
 	if !isAuthorizedToDelete {
@@ -511,7 +595,7 @@ func (k *centralService) RegisterCentralDeprovisionJob(ctx context.Context, cent
 	metrics.IncreaseCentralTotalOperationsCountMetric(constants.CentralOperationDeprovision)
 	deprovisionStatus := constants.CentralRequestStatusDeprovision
 
-	if executed, err := k.UpdateStatus(centralRequest.ID, deprovisionStatus); executed {
+	if executed, err := k.UpdateStatus(ctx, centralRequest.ID, deprovisionStatus); executed {
 		if err != nil {
 			return services.HandleGetError("CentralResource", "id", centralRequest.ID, err)
 		}
@@ -591,8 +675,8 @@ func (k *centralService) DeprovisionExpiredCentrals() *errors.ServiceError {
 // The implementation uses soft-deletion (via GORM).
 // If the force flag is true, then any errors prior to the final deletion of the CentralRequest will be logged as warnings
 // but do not interrupt the deletion flow.
-func (k *centralService) Delete(centralRequest *dbapi.CentralRequest, force bool) *errors.ServiceError {
-	dbConn := k.connectionFactory.New()
+func (k *centralService) Delete(ctx context.Context, centralRequest *dbapi.CentralRequest, force bool) *errors.ServiceError {
+	dbConn := k.connectionFactory.FromContext(ctx)
 
 	// if the we don't have the clusterID we can only delete the row from the database
 	if centralRequest.ClusterID != "" {
@@ -620,8 +704,18 @@ func (k *centralService) Delete(centralRequest *dbapi.CentralRequest, force bool
 	}
 
 	logStateChange("delete request", centralRequest.ID, nil)
-	// soft delete the central request
-	if err := dbConn.Delete(centralRequest).Error; err != nil {
+	// soft delete the central request. Its grants and auth providers are removed permanently, they are not restored
+	// with the central.
+	err := dbConn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("central_id = ?", centralRequest.ID).Delete(&dbapi.CentralGrant{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("central_id = ?", centralRequest.ID).Delete(&dbapi.CentralAuthProvider{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(centralRequest).Error
+	})
+	if err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to delete central request with id %s", centralRequest.ID)
 	}
 
@@ -643,28 +737,9 @@ func (k *centralService) List(ctx context.Context, listArgs *services.ListArgume
 		Size: listArgs.Size,
 	}
 
-	claims, err := auth.GetClaimsFromContext(ctx)
-	if err != nil {
-		return nil, nil, errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
-	}
-
-	if !auth.GetIsAdminFromContext(ctx) {
-		user, _ := claims.GetUsername()
-		if user == "" {
-			return nil, nil, errors.Unauthenticated("user not authenticated")
-		}
-
-		orgID, _ := claims.GetOrgID()
-		filterByOrganisationID := auth.GetFilterByOrganisationFromContext(ctx)
-
-		// filter by organisationId if a user is part of an organisation and is not allowed as a service account
-		if filterByOrganisationID {
-			// filter central requests by organisation_id since the user is allowed to see all central requests of my id
-			dbConn = dbConn.Where("organisation_id = ?", orgID)
-		} else {
-			// filter central requests by owner as we are dealing with service accounts which may not have an org id
-			dbConn = dbConn.Where("owner = ?", user)
-		}
+	dbConn, _, svcErr := k.scopeToVisibleCentrals(ctx, dbConn)
+	if svcErr != nil {
+		return nil, nil, svcErr
 	}
 
 	// Apply search query
@@ -704,8 +779,8 @@ func (k *centralService) List(ctx context.Context, listArgs *services.ListArgume
 }
 
 // Update ...
-func (k *centralService) UpdateIgnoreNils(centralRequest *dbapi.CentralRequest) *errors.ServiceError {
-	dbConn := k.connectionFactory.New().
+func (k *centralService) UpdateIgnoreNils(ctx context.Context, centralRequest *dbapi.CentralRequest) *errors.ServiceError {
+	dbConn := k.connectionFactory.FromContext(ctx).
 		Model(centralRequest).
 		Where("status not IN (?)", centralDeletionStatuses) // ignore updates of central under deletion
 
@@ -719,8 +794,8 @@ func (k *centralService) UpdateIgnoreNils(centralRequest *dbapi.CentralRequest)
 }
 
 // Updates ...
-func (k *centralService) Updates(centralRequest *dbapi.CentralRequest, fields map[string]interface{}) *errors.ServiceError {
-	dbConn := k.connectionFactory.New().
+func (k *centralService) Updates(ctx context.Context, centralRequest *dbapi.CentralRequest, fields map[string]interface{}) *errors.ServiceError {
+	dbConn := k.connectionFactory.FromContext(ctx).
 		Model(centralRequest).
 		Where("status not IN (?)", centralDeletionStatuses) // ignore updates of central under deletion
 
@@ -750,12 +825,12 @@ func (k *centralService) VerifyAndUpdateCentralAdmin(ctx context.Context, centra
 		return errors.New(errors.ErrorValidation, "Unable to get cluster for central %s", centralRequest.ID)
 	}
 
-	return k.UpdateIgnoreNils(centralRequest)
+	return k.UpdateIgnoreNils(ctx, centralRequest)
 }
 
 // UpdateStatus ...
-func (k *centralService) UpdateStatus(id string, status constants.CentralStatus) (bool, *errors.ServiceError) {
-	dbConn := k.connectionFactory.New()
+func (k *centralService) UpdateStatus(ctx context.Context, id string, status constants.CentralStatus) (bool, *errors.ServiceError) {
+	dbConn := k.connectionFactory.FromContext(ctx)
 
 	central, err := k.GetByID(id)
 	if err != nil {
@@ -787,58 +862,37 @@ func (k *centralService) UpdateStatus(id string, status constants.CentralStatus)
 }
 
 // ChangeCentralCNAMErecords ...
-func (k *centralService) ChangeCentralCNAMErecords(centralRequest *dbapi.CentralRequest, action CentralRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
+func (k *centralService) ChangeCentralCNAMErecords(centralRequest *dbapi.CentralRequest, action CentralRoutesAction) (*dns.Change, *errors.ServiceError) {
 	routes, err := centralRequest.GetRoutes()
 	if routes == nil || err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get routes")
 	}
 
-	changeAction, err := CentralRoutesActionToRoute53ChangeAction(action)
-	domainRecordBatch := buildCentralClusterCNAMESRecordBatch(routes, changeAction)
-
-	// Create AWS client with the region of this Central Cluster
-	awsConfig := aws.Config{
-		AccessKeyID:     k.awsConfig.Route53AccessKey,
-		SecretAccessKey: k.awsConfig.Route53SecretAccessKey, // pragma: allowlist secret
-	}
-	awsClient, err := k.awsClientFactory.NewClient(awsConfig, centralRequest.Region)
-	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to create aws client")
-	}
-
-	changeRecordsOutput, err := awsClient.ChangeResourceRecordSets(k.centralConfig.CentralDomainName, domainRecordBatch)
-	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to create domain record sets")
-	}
-
-	return changeRecordsOutput, nil
-}
-
-// GetCNAMERecordStatus ...
-func (k *centralService) GetCNAMERecordStatus(centralRequest *dbapi.CentralRequest) (*CNameRecordStatus, error) {
-	awsConfig := aws.Config{
-		AccessKeyID:     k.awsConfig.Route53AccessKey,
-		SecretAccessKey: k.awsConfig.Route53SecretAccessKey, // pragma: allowlist secret
-	}
-	awsClient, err := k.awsClientFactory.NewClient(awsConfig, centralRequest.Region)
-	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to create aws client")
+	records := dns.RouteRecords(routes)
+	var change *dns.Change
+	switch action {
+	case CentralRoutesActionUpsert:
+		change, err = k.dnsProvider.UpsertRecords(context.Background(), k.centralConfig.CentralDomainName, append(records, dns.OwnerRecords(records)...))
+	case CentralRoutesActionDelete:
+		change, err = k.dnsProvider.DeleteRecords(context.Background(), k.centralConfig.CentralDomainName, records)
+		if err == nil {
+			// The owner records are deleted separately, as records created before owner records were introduced have none
+			if _, ownerErr := k.dnsProvider.DeleteRecords(context.Background(), k.centralConfig.CentralDomainName, dns.OwnerRecords(records)); ownerErr != nil {
+				glog.Warningf("Failed to delete DNS owner records of Central tenant %q: %v", centralRequest.ID, ownerErr)
+			}
+		}
+	default:
+		return nil, errors.GeneralError("invalid CentralRoutesAction: %q", action)
 	}
-
-	changeOutput, err := awsClient.GetChange(centralRequest.RoutesCreationID)
 	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to CNAME record status")
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to change domain record sets")
 	}
 
-	status := string(changeOutput.ChangeInfo.Status)
-	return &CNameRecordStatus{
-		ID:     changeOutput.ChangeInfo.Id,
-		Status: &status,
-	}, nil
+	return change, nil
 }
 
 func (k *centralService) Restore(ctx context.Context, id string) *errors.ServiceError {
-	dbConn := k.connectionFactory.New()
+	dbConn := k.connectionFactory.FromContext(ctx)
 	var centralRequest dbapi.CentralRequest
 	if err := dbConn.Unscoped().Where("id = ?", id).First(&centralRequest).Error; err != nil {
 		return services.HandleGetError("CentralRequest", "id", id, err)
@@ -920,7 +974,7 @@ func (k *centralService) AssignCluster(ctx context.Context, centralID string, cl
 	now := time.Now()
 	central.EnteredProvisioningAt = dbapi.TimePtrToNullTime(&now)
 
-	return k.Updates(central, map[string]interface{}{
+	return k.Updates(ctx, central, map[string]interface{}{
 		"cluster_id":              central.ClusterID,
 		"routes_created":          central.RoutesCreated,
 		"routes":                  central.Routes,
@@ -991,6 +1045,64 @@ func (k *centralService) ListCentralsWithRoutesNotCreated() ([]*dbapi.CentralReq
 	return results, nil
 }
 
+// ListCentralsByHost ...
+func (k *centralService) ListCentralsByHost(host string) ([]*dbapi.CentralRequest, *errors.ServiceError) {
+	dbConn := k.connectionFactory.New()
+	var results []*dbapi.CentralRequest
+	if err := dbConn.Where("host = ?", host).Find(&results).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list central requests")
+	}
+	return results, nil
+}
+
+// ListKnownCentralIDs ...
+func (k *centralService) ListKnownCentralIDs(clusterID string) ([]string, *errors.ServiceError) {
+	retentionStart := time.Now().Add(-time.Duration(k.centralConfig.CentralRetentionPeriodDays) * 24 * time.Hour)
+	migrated := k.connectionFactory.New().Model(&dbapi.CentralMigration{}).
+		Select("central_id").
+		Where("phase IN ?", dbapi.ActiveCentralMigrationPhases).
+		Where("source_cluster_id = ? OR target_cluster_id = ?", clusterID, clusterID)
+	var ids []string
+	if err := k.connectionFactory.New().Unscoped().Model(&dbapi.CentralRequest{}).
+		Where("cluster_id = ? OR id IN (?)", clusterID, migrated).
+		Where("deleted_at IS NULL OR deleted_at > ?", retentionStart).
+		Pluck("id", &ids).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list central IDs")
+	}
+	return ids, nil
+}
+
+// ListDynamicClientIDs ...
+func (k *centralService) ListDynamicClientIDs() ([]string, *errors.ServiceError) {
+	var centrals []*dbapi.CentralRequest
+	if err := k.connectionFactory.New().Model(&dbapi.CentralRequest{}).
+		Select("client_id", "previous_client_id").
+		Where("client_origin = ? AND client_id != ''", dbapi.AuthConfigDynamicClientOrigin).
+		Find(&centrals).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list RHSSO dynamic client IDs")
+	}
+	clientIDs := make([]string, 0, len(centrals))
+	for _, central := range centrals {
+		clientIDs = append(clientIDs, central.ClientID)
+		if central.PreviousClientID != "" {
+			clientIDs = append(clientIDs, central.PreviousClientID)
+		}
+	}
+	return clientIDs, nil
+}
+
+// ListCentralsWithDynamicClients ...
+func (k *centralService) ListCentralsWithDynamicClients() ([]*dbapi.CentralRequest, *errors.ServiceError) {
+	var centrals []*dbapi.CentralRequest
+	if err := k.connectionFactory.New().
+		Where("client_origin = ? AND client_id != ''", dbapi.AuthConfigDynamicClientOrigin).
+		Where("status = ?", constants.CentralRequestStatusReady.String()).
+		Find(&centrals).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list centrals with RHSSO dynamic clients")
+	}
+	return centrals, nil
+}
+
 // ListCentralsWithoutAuthConfig returns all _relevant_ central requests with
 // no auth config. For central requests without host set, we cannot compute
 // redirect_uri and hence cannot set up auth config.
@@ -1017,39 +1129,6 @@ func (k *centralService) ListCentralsWithoutAuthConfig() ([]*dbapi.CentralReques
 	return filteredResults, nil
 }
 
-func buildCentralClusterCNAMESRecordBatch(routes []dbapi.DataPlaneCentralRoute, action route53Types.ChangeAction) *route53Types.ChangeBatch {
-	var changes []route53Types.Change
-	for _, r := range routes {
-		c := buildResourceRecordChange(r.Domain, r.Router, action)
-		changes = append(changes, c)
-	}
-	recordChangeBatch := &route53Types.ChangeBatch{
-		Changes: changes,
-	}
-
-	return recordChangeBatch
-}
-
-func buildResourceRecordChange(recordName string, clusterIngress string, action route53Types.ChangeAction) route53Types.Change {
-	recordTTL := int64(300)
-
-	resourceRecordChange := route53Types.Change{
-		Action: action,
-		ResourceRecordSet: &route53Types.ResourceRecordSet{
-			Name: &recordName,
-			Type: route53Types.RRTypeCname,
-			TTL:  &recordTTL,
-			ResourceRecords: []route53Types.ResourceRecord{
-				{
-					Value: &clusterIngress,
-				},
-			},
-		},
-	}
-
-	return resourceRecordChange
-}
-
 func logStateChange(msg, id string, req *dbapi.CentralRequest) {
 	if req != nil {
 		glog.Infof("instance state change: id=%q: message=%s: request=%+v", id, msg, convertCentralRequestToString(req))
@@ -1142,7 +1221,7 @@ func (k *centralService) ChangeBillingParameters(ctx context.Context, centralID
 	centralRequest.SubscriptionID = newSubscriptionID
 
 	if !reflect.DeepEqual(original, updated) {
-		if svcErr = k.UpdateIgnoreNils(centralRequest); svcErr != nil {
+		if svcErr = k.UpdateIgnoreNils(ctx, centralRequest); svcErr != nil {
 			glog.Errorf("Failed to update central %q record with updated billing parameters (%v): %v", centralID, updated, svcErr)
 			return svcErr
 		}
@@ -1164,7 +1243,7 @@ func (k *centralService) ChangeSubscription(ctx context.Context, centralID strin
 	centralRequest.CloudAccountID = cloudAccountID
 	centralRequest.SubscriptionID = subscriptionID
 
-	if svcErr = k.UpdateIgnoreNils(centralRequest); svcErr != nil {
+	if svcErr = k.UpdateIgnoreNils(ctx, centralRequest); svcErr != nil {
 		glog.Errorf("Failed to update central %q record with subscription_id %q and updated cloud account %q: %v", centralID, subscriptionID, cloudAccountID, svcErr)
 		return svcErr
 	}
@@ -0,0 +1,271 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/gitops"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/cipher"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/features"
+	"github.com/stackrox/acs-fleet-manager/pkg/services"
+)
+
+const (
+	// maxAuthProvidersPerCentral limits the number of auth providers customers can add to a Central
+	maxAuthProvidersPerCentral = 5
+	maxAuthProviderNameLength  = 64
+)
+
+// authProviderCallbackModes are the OAuth 2.0 callback modes Central supports. An empty mode is "auto".
+var authProviderCallbackModes = map[string]bool{"": true, "auto": true, "post": true, "query": true, "fragment": true}
+
+// CentralAuthProviderService manages the OIDC auth providers customers add to their Central instances. The providers
+// are delivered to the data plane and applied with declarative configuration.
+//
+//go:generate moq -out central_auth_providers_moq.go . CentralAuthProviderService
+type CentralAuthProviderService interface {
+	// Create validates the provider including the discovery document of its issuer, and adds it to the Central
+	Create(ctx context.Context, centralRequest *dbapi.CentralRequest, provider gitops.AuthProvider) (*dbapi.CentralAuthProvider, *serviceError.ServiceError)
+	// List returns the providers of the Central in the order they were added
+	List(centralID string) ([]*dbapi.CentralAuthProvider, *serviceError.ServiceError)
+	// Get returns a provider of the Central
+	Get(centralID, id string) (*dbapi.CentralAuthProvider, *serviceError.ServiceError)
+	// Delete removes a provider from the Central
+	Delete(centralID, id string) *serviceError.ServiceError
+	// ListByCentralIDs returns the providers with decrypted client secrets by the ID of their Central. Providers whose
+	// client secret cannot be decrypted are skipped.
+	ListByCentralIDs(centralIDs []string) (map[string][]CustomerAuthProvider, *serviceError.ServiceError)
+}
+
+// CustomerAuthProvider is an auth provider of a customer including its client secret
+type CustomerAuthProvider struct {
+	ID string
+	gitops.AuthProvider
+}
+
+type centralAuthProviderService struct {
+	connectionFactory *db.ConnectionFactory
+	discoveryClient   OIDCDiscoveryClient
+	cipher            cipher.Cipher
+	// tenantChartSupported is set once the tenant resources chart is confirmed to render the providers
+	tenantChartSupported bool
+}
+
+var _ CentralAuthProviderService = (*centralAuthProviderService)(nil)
+
+// NewCentralAuthProviderService ...
+func NewCentralAuthProviderService(connectionFactory *db.ConnectionFactory, discoveryClient OIDCDiscoveryClient,
+	centralConfig *config.CentralConfig) (CentralAuthProviderService, error) {
+	s := &centralAuthProviderService{
+		connectionFactory:    connectionFactory,
+		discoveryClient:      discoveryClient,
+		tenantChartSupported: centralConfig.AuthProviderTenantChartSupported,
+	}
+	if !features.CustomerAuthProviders.Enabled() {
+		return s, nil
+	}
+	key, err := centralConfig.GetAuthProviderEncryptionKey()
+	if err != nil {
+		return nil, err
+	}
+	s.cipher, err = cipher.NewAES256Cipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating auth provider cipher")
+	}
+	return s, nil
+}
+
+// Create ...
+func (s *centralAuthProviderService) Create(ctx context.Context, centralRequest *dbapi.CentralRequest, provider gitops.AuthProvider) (*dbapi.CentralAuthProvider, *serviceError.ServiceError) {
+	if s.cipher == nil {
+		return nil, serviceError.New(serviceError.ErrorGeneral, "customer auth providers are not enabled")
+	}
+	if !s.tenantChartSupported {
+		return nil, serviceError.BadRequest("auth providers cannot be added to centrals yet, as the data plane does not apply them")
+	}
+	if err := validateCustomerAuthProvider(provider); err != nil {
+		return nil, serviceError.Validation("invalid auth provider: %s", err.Error())
+	}
+	if _, err := s.discoveryClient.Discover(ctx, provider.OIDC.Issuer); err != nil {
+		return nil, serviceError.Validation("invalid auth provider: OIDC discovery failed: %s", err.Error())
+	}
+
+	existing, svcErr := s.List(centralRequest.ID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if len(existing) >= maxAuthProvidersPerCentral {
+		return nil, serviceError.Validation("central %q already has the maximum of %d auth providers", centralRequest.ID, maxAuthProvidersPerCentral)
+	}
+	for _, p := range existing {
+		if p.Name == provider.Name {
+			return nil, serviceError.Conflict("central %q already has an auth provider named %q", centralRequest.ID, provider.Name)
+		}
+	}
+
+	encryptedSecret, err := s.cipher.Encrypt([]byte(provider.OIDC.ClientSecret))
+	if err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to encrypt auth provider client secret")
+	}
+	withoutSecret := provider
+	oidc := *provider.OIDC
+	oidc.ClientSecret = "" // pragma: allowlist secret
+	withoutSecret.OIDC = &oidc
+	providerConfig, err := json.Marshal(withoutSecret)
+	if err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to marshal auth provider")
+	}
+
+	authProvider := &dbapi.CentralAuthProvider{
+		Meta:                  api.Meta{ID: api.NewID()},
+		CentralID:             centralRequest.ID,
+		Name:                  provider.Name,
+		Config:                providerConfig,
+		EncryptedClientSecret: encryptedSecret,
+	}
+	if claims, err := auth.GetClaimsFromContext(ctx); err == nil {
+		authProvider.Owner, _ = claims.GetUsername()
+	}
+	if err := s.connectionFactory.New().Create(authProvider).Error; err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to create auth provider")
+	}
+	glog.Infof("Auth provider %q with issuer %q added to central %s by %q", provider.Name, provider.OIDC.Issuer, centralRequest.ID, authProvider.Owner)
+	return authProvider, nil
+}
+
+// List ...
+func (s *centralAuthProviderService) List(centralID string) ([]*dbapi.CentralAuthProvider, *serviceError.ServiceError) {
+	var providers []*dbapi.CentralAuthProvider
+	if err := s.connectionFactory.New().
+		Where("central_id = ?", centralID).
+		Order("created_at").
+		Find(&providers).Error; err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to list auth providers of central %q", centralID)
+	}
+	return providers, nil
+}
+
+// Get ...
+func (s *centralAuthProviderService) Get(centralID, id string) (*dbapi.CentralAuthProvider, *serviceError.ServiceError) {
+	var provider dbapi.CentralAuthProvider
+	if err := s.connectionFactory.New().
+		Where("central_id = ? AND id = ?", centralID, id).
+		First(&provider).Error; err != nil {
+		return nil, services.HandleGetError("CentralAuthProvider", "id", id, err)
+	}
+	return &provider, nil
+}
+
+// Delete ...
+func (s *centralAuthProviderService) Delete(centralID, id string) *serviceError.ServiceError {
+	provider, svcErr := s.Get(centralID, id)
+	if svcErr != nil {
+		return svcErr
+	}
+	// deleted providers are removed permanently, so that neither their name nor their secret is kept
+	if err := s.connectionFactory.New().Unscoped().Delete(provider).Error; err != nil {
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to delete auth provider %s", id)
+	}
+	glog.Infof("Auth provider %q deleted from central %s", provider.Name, centralID)
+	return nil
+}
+
+// ListByCentralIDs ...
+func (s *centralAuthProviderService) ListByCentralIDs(centralIDs []string) (map[string][]CustomerAuthProvider, *serviceError.ServiceError) {
+	result := map[string][]CustomerAuthProvider{}
+	if len(centralIDs) == 0 {
+		return result, nil
+	}
+	var providers []*dbapi.CentralAuthProvider
+	if err := s.connectionFactory.New().
+		Where("central_id IN (?)", centralIDs).
+		Order("created_at").
+		Find(&providers).Error; err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to list auth providers")
+	}
+	if len(providers) > 0 && s.cipher == nil {
+		return nil, serviceError.New(serviceError.ErrorGeneral, "customer auth providers are not enabled")
+	}
+	for _, provider := range providers {
+		authProvider, err := s.decrypt(provider)
+		if err != nil {
+			// a single unreadable provider must not block the delivery of all other centrals to the data plane
+			glog.Errorf("Skipping auth provider %s of central %s: %v", provider.ID, provider.CentralID, err)
+			continue
+		}
+		result[provider.CentralID] = append(result[provider.CentralID], CustomerAuthProvider{ID: provider.ID, AuthProvider: authProvider})
+	}
+	return result, nil
+}
+
+func (s *centralAuthProviderService) decrypt(provider *dbapi.CentralAuthProvider) (gitops.AuthProvider, error) {
+	authProvider, err := provider.AuthProvider()
+	if err != nil {
+		return gitops.AuthProvider{}, errors.Wrap(err, "reading auth provider")
+	}
+	clientSecret, err := s.cipher.Decrypt(provider.EncryptedClientSecret)
+	if err != nil {
+		return gitops.AuthProvider{}, errors.Wrap(err, "decrypting client secret")
+	}
+	authProvider.OIDC.ClientSecret = string(clientSecret) // pragma: allowlist secret
+	return authProvider, nil
+}
+
+// validateCustomerAuthProvider checks the fields Central requires for OIDC auth providers. The roles are not checked,
+// as Centrals can have custom roles.
+func validateCustomerAuthProvider(provider gitops.AuthProvider) error {
+	if provider.Name == "" {
+		return errors.New("name is required")
+	}
+	if len(provider.Name) > maxAuthProviderNameLength {
+		return errors.Errorf("name must not be longer than %d characters", maxAuthProviderNameLength)
+	}
+	for _, group := range provider.Groups {
+		if group.Key == "" || group.Value == "" || group.Role == "" {
+			return errors.New("groups require key, value and role")
+		}
+	}
+	for _, attribute := range provider.RequiredAttributes {
+		if attribute.Key == "" || attribute.Value == "" {
+			return errors.New("required attributes require key and value")
+		}
+	}
+	claimNames := make(map[string]bool, len(provider.ClaimMappings))
+	for _, mapping := range provider.ClaimMappings {
+		if mapping.Path == "" || mapping.Name == "" {
+			return errors.New("claim mappings require path and name")
+		}
+		if claimNames[mapping.Name] {
+			return errors.Errorf("claim %q is mapped more than once", mapping.Name)
+		}
+		claimNames[mapping.Name] = true
+	}
+
+	oidc := provider.OIDC
+	if oidc == nil {
+		return errors.New("oidc is required")
+	}
+	if _, err := url.Parse(oidc.Issuer); oidc.Issuer == "" || err != nil {
+		return errors.New("oidc.issuer must be a URL")
+	}
+	if !authProviderCallbackModes[oidc.Mode] {
+		return errors.Errorf("oidc.mode %q is not one of auto, post, query or fragment", oidc.Mode)
+	}
+	if oidc.ClientID == "" {
+		return errors.New("oidc.client_id is required")
+	}
+	// the fragment mode uses the implicit flow, which is the only flow without client secret
+	if oidc.ClientSecret == "" && oidc.Mode != "fragment" {
+		return errors.New("oidc.client_secret is required unless oidc.mode is fragment")
+	}
+	return nil
+}
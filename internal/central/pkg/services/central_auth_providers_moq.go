@@ -0,0 +1,278 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"context"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/gitops"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that CentralAuthProviderServiceMock does implement CentralAuthProviderService.
+// If this is not the case, regenerate this file with moq.
+var _ CentralAuthProviderService = &CentralAuthProviderServiceMock{}
+
+// CentralAuthProviderServiceMock is a mock implementation of CentralAuthProviderService.
+//
+//	func TestSomethingThatUsesCentralAuthProviderService(t *testing.T) {
+//
+//		// make and configure a mocked CentralAuthProviderService
+//		mockedCentralAuthProviderService := &CentralAuthProviderServiceMock{
+//			CreateFunc: func(ctx context.Context, centralRequest *dbapi.CentralRequest, provider gitops.AuthProvider) (*dbapi.CentralAuthProvider, *serviceError.ServiceError) {
+//				panic("mock out the Create method")
+//			},
+//			DeleteFunc: func(centralID string, id string) *serviceError.ServiceError {
+//				panic("mock out the Delete method")
+//			},
+//			GetFunc: func(centralID string, id string) (*dbapi.CentralAuthProvider, *serviceError.ServiceError) {
+//				panic("mock out the Get method")
+//			},
+//			ListFunc: func(centralID string) ([]*dbapi.CentralAuthProvider, *serviceError.ServiceError) {
+//				panic("mock out the List method")
+//			},
+//			ListByCentralIDsFunc: func(centralIDs []string) (map[string][]CustomerAuthProvider, *serviceError.ServiceError) {
+//				panic("mock out the ListByCentralIDs method")
+//			},
+//		}
+//
+//		// use mockedCentralAuthProviderService in code that requires CentralAuthProviderService
+//		// and then make assertions.
+//
+//	}
+type CentralAuthProviderServiceMock struct {
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, centralRequest *dbapi.CentralRequest, provider gitops.AuthProvider) (*dbapi.CentralAuthProvider, *serviceError.ServiceError)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(centralID string, id string) *serviceError.ServiceError
+
+	// GetFunc mocks the Get method.
+	GetFunc func(centralID string, id string) (*dbapi.CentralAuthProvider, *serviceError.ServiceError)
+
+	// ListFunc mocks the List method.
+	ListFunc func(centralID string) ([]*dbapi.CentralAuthProvider, *serviceError.ServiceError)
+
+	// ListByCentralIDsFunc mocks the ListByCentralIDs method.
+	ListByCentralIDsFunc func(centralIDs []string) (map[string][]CustomerAuthProvider, *serviceError.ServiceError)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CentralRequest is the centralRequest argument value.
+			CentralRequest *dbapi.CentralRequest
+			// Provider is the provider argument value.
+			Provider gitops.AuthProvider
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// CentralID is the centralID argument value.
+			CentralID string
+			// ID is the id argument value.
+			ID string
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// CentralID is the centralID argument value.
+			CentralID string
+			// ID is the id argument value.
+			ID string
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// CentralID is the centralID argument value.
+			CentralID string
+		}
+		// ListByCentralIDs holds details about calls to the ListByCentralIDs method.
+		ListByCentralIDs []struct {
+			// CentralIDs is the centralIDs argument value.
+			CentralIDs []string
+		}
+	}
+	lockCreate           sync.RWMutex
+	lockDelete           sync.RWMutex
+	lockGet              sync.RWMutex
+	lockList             sync.RWMutex
+	lockListByCentralIDs sync.RWMutex
+}
+
+// Create calls CreateFunc.
+func (mock *CentralAuthProviderServiceMock) Create(ctx context.Context, centralRequest *dbapi.CentralRequest, provider gitops.AuthProvider) (*dbapi.CentralAuthProvider, *serviceError.ServiceError) {
+	if mock.CreateFunc == nil {
+		panic("CentralAuthProviderServiceMock.CreateFunc: method is nil but CentralAuthProviderService.Create was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		CentralRequest *dbapi.CentralRequest
+		Provider       gitops.AuthProvider
+	}{
+		Ctx:            ctx,
+		CentralRequest: centralRequest,
+		Provider:       provider,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, centralRequest, provider)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedCentralAuthProviderService.CreateCalls())
+func (mock *CentralAuthProviderServiceMock) CreateCalls() []struct {
+	Ctx            context.Context
+	CentralRequest *dbapi.CentralRequest
+	Provider       gitops.AuthProvider
+} {
+	var calls []struct {
+		Ctx            context.Context
+		CentralRequest *dbapi.CentralRequest
+		Provider       gitops.AuthProvider
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// Delete calls DeleteFunc.
+func (mock *CentralAuthProviderServiceMock) Delete(centralID string, id string) *serviceError.ServiceError {
+	if mock.DeleteFunc == nil {
+		panic("CentralAuthProviderServiceMock.DeleteFunc: method is nil but CentralAuthProviderService.Delete was just called")
+	}
+	callInfo := struct {
+		CentralID string
+		ID        string
+	}{
+		CentralID: centralID,
+		ID:        id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(centralID, id)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedCentralAuthProviderService.DeleteCalls())
+func (mock *CentralAuthProviderServiceMock) DeleteCalls() []struct {
+	CentralID string
+	ID        string
+} {
+	var calls []struct {
+		CentralID string
+		ID        string
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *CentralAuthProviderServiceMock) Get(centralID string, id string) (*dbapi.CentralAuthProvider, *serviceError.ServiceError) {
+	if mock.GetFunc == nil {
+		panic("CentralAuthProviderServiceMock.GetFunc: method is nil but CentralAuthProviderService.Get was just called")
+	}
+	callInfo := struct {
+		CentralID string
+		ID        string
+	}{
+		CentralID: centralID,
+		ID:        id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(centralID, id)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedCentralAuthProviderService.GetCalls())
+func (mock *CentralAuthProviderServiceMock) GetCalls() []struct {
+	CentralID string
+	ID        string
+} {
+	var calls []struct {
+		CentralID string
+		ID        string
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// List calls ListFunc.
+func (mock *CentralAuthProviderServiceMock) List(centralID string) ([]*dbapi.CentralAuthProvider, *serviceError.ServiceError) {
+	if mock.ListFunc == nil {
+		panic("CentralAuthProviderServiceMock.ListFunc: method is nil but CentralAuthProviderService.List was just called")
+	}
+	callInfo := struct {
+		CentralID string
+	}{
+		CentralID: centralID,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(centralID)
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedCentralAuthProviderService.ListCalls())
+func (mock *CentralAuthProviderServiceMock) ListCalls() []struct {
+	CentralID string
+} {
+	var calls []struct {
+		CentralID string
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListByCentralIDs calls ListByCentralIDsFunc.
+func (mock *CentralAuthProviderServiceMock) ListByCentralIDs(centralIDs []string) (map[string][]CustomerAuthProvider, *serviceError.ServiceError) {
+	if mock.ListByCentralIDsFunc == nil {
+		panic("CentralAuthProviderServiceMock.ListByCentralIDsFunc: method is nil but CentralAuthProviderService.ListByCentralIDs was just called")
+	}
+	callInfo := struct {
+		CentralIDs []string
+	}{
+		CentralIDs: centralIDs,
+	}
+	mock.lockListByCentralIDs.Lock()
+	mock.calls.ListByCentralIDs = append(mock.calls.ListByCentralIDs, callInfo)
+	mock.lockListByCentralIDs.Unlock()
+	return mock.ListByCentralIDsFunc(centralIDs)
+}
+
+// ListByCentralIDsCalls gets all the calls that were made to ListByCentralIDs.
+// Check the length with:
+//
+//	len(mockedCentralAuthProviderService.ListByCentralIDsCalls())
+func (mock *CentralAuthProviderServiceMock) ListByCentralIDsCalls() []struct {
+	CentralIDs []string
+} {
+	var calls []struct {
+		CentralIDs []string
+	}
+	mock.lockListByCentralIDs.RLock()
+	calls = mock.calls.ListByCentralIDs
+	mock.lockListByCentralIDs.RUnlock()
+	return calls
+}
@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	mocket "github.com/selvatico/go-mocket"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/gitops"
+	"github.com/stackrox/acs-fleet-manager/pkg/cipher"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validCustomerAuthProvider() gitops.AuthProvider {
+	return gitops.AuthProvider{
+		Name:          "Corporate SSO",
+		MinimumRole:   "None",
+		Groups:        []gitops.AuthProviderGroup{{Key: "groups", Value: "admins", Role: "Admin"}},
+		ClaimMappings: []gitops.AuthProviderClaimMapping{{Path: "department", Name: "department"}},
+		OIDC: &gitops.AuthProviderOIDCConfig{
+			Issuer:       "https://sso.example.com/realms/acs",
+			ClientID:     "acs",
+			ClientSecret: "client-secret", // pragma: allowlist secret
+		},
+	}
+}
+
+func TestValidateCustomerAuthProvider(t *testing.T) {
+	require.NoError(t, validateCustomerAuthProvider(validCustomerAuthProvider()))
+
+	tests := map[string]func(provider *gitops.AuthProvider){
+		"no name":          func(provider *gitops.AuthProvider) { provider.Name = "" },
+		"incomplete group": func(provider *gitops.AuthProvider) { provider.Groups[0].Role = "" },
+		"incomplete attribute": func(provider *gitops.AuthProvider) {
+			provider.RequiredAttributes = []gitops.AuthProviderRequiredAttribute{{Key: "email"}}
+		},
+		"duplicate claim": func(provider *gitops.AuthProvider) {
+			provider.ClaimMappings = append(provider.ClaimMappings, gitops.AuthProviderClaimMapping{Path: "dept", Name: "department"})
+		},
+		"no oidc":          func(provider *gitops.AuthProvider) { provider.OIDC = nil },
+		"no issuer":        func(provider *gitops.AuthProvider) { provider.OIDC.Issuer = "" },
+		"unknown mode":     func(provider *gitops.AuthProvider) { provider.OIDC.Mode = "implicit" },
+		"no client id":     func(provider *gitops.AuthProvider) { provider.OIDC.ClientID = "" },
+		"no secret":        func(provider *gitops.AuthProvider) { provider.OIDC.ClientSecret = "" },
+		"name is too long": func(provider *gitops.AuthProvider) { provider.Name = string(make([]byte, maxAuthProviderNameLength+1)) },
+	}
+	for name, modify := range tests {
+		t.Run(name, func(t *testing.T) {
+			provider := validCustomerAuthProvider()
+			modify(&provider)
+			assert.Error(t, validateCustomerAuthProvider(provider))
+		})
+	}
+
+	t.Run("fragment mode without secret", func(t *testing.T) {
+		provider := validCustomerAuthProvider()
+		provider.OIDC.Mode = "fragment"
+		provider.OIDC.ClientSecret = ""
+		assert.NoError(t, validateCustomerAuthProvider(provider))
+	})
+}
+
+func TestCentralAuthProviderService_CreateRequiresDiscovery(t *testing.T) {
+	key, err := cipher.AES256KeyGenerator{}.Generate()
+	require.NoError(t, err)
+	aesCipher, err := cipher.NewAES256Cipher(key)
+	require.NoError(t, err)
+	s := &centralAuthProviderService{
+		discoveryClient: &OIDCDiscoveryClientMock{
+			DiscoverFunc: func(ctx context.Context, issuer string) (*OIDCDiscoveryDocument, error) {
+				return nil, errors.New("connection refused")
+			},
+		},
+		cipher:               aesCipher,
+		tenantChartSupported: true,
+	}
+
+	_, svcErr := s.Create(context.Background(), &dbapi.CentralRequest{}, validCustomerAuthProvider())
+
+	require.NotNil(t, svcErr)
+	assert.Equal(t, serviceError.ErrorValidation, svcErr.Code)
+	assert.Contains(t, svcErr.Reason, "OIDC discovery failed")
+}
+
+func TestCentralAuthProviderService_CreateRequiresTenantChartSupport(t *testing.T) {
+	key, err := cipher.AES256KeyGenerator{}.Generate()
+	require.NoError(t, err)
+	aesCipher, err := cipher.NewAES256Cipher(key)
+	require.NoError(t, err)
+	s := &centralAuthProviderService{
+		discoveryClient: &OIDCDiscoveryClientMock{},
+		cipher:          aesCipher,
+	}
+
+	_, svcErr := s.Create(context.Background(), &dbapi.CentralRequest{}, validCustomerAuthProvider())
+
+	require.NotNil(t, svcErr)
+	assert.Equal(t, serviceError.ErrorBadRequest, svcErr.Code)
+}
+
+func TestCentralAuthProviderService_Decrypt(t *testing.T) {
+	key, err := cipher.AES256KeyGenerator{}.Generate()
+	require.NoError(t, err)
+	aesCipher, err := cipher.NewAES256Cipher(key)
+	require.NoError(t, err)
+	s := &centralAuthProviderService{cipher: aesCipher}
+
+	provider := validCustomerAuthProvider()
+	encryptedSecret, err := aesCipher.Encrypt([]byte(provider.OIDC.ClientSecret))
+	require.NoError(t, err)
+	provider.OIDC.ClientSecret = "" // pragma: allowlist secret
+	config, err := json.Marshal(provider)
+	require.NoError(t, err)
+
+	decrypted, err := s.decrypt(&dbapi.CentralAuthProvider{Config: config, EncryptedClientSecret: encryptedSecret})
+
+	require.NoError(t, err)
+	assert.Equal(t, "client-secret", decrypted.OIDC.ClientSecret)
+	assert.Equal(t, provider.Groups, decrypted.Groups)
+}
+
+func TestCentralAuthProviderService_ListByCentralIDsSkipsUnreadableProviders(t *testing.T) {
+	key, err := cipher.AES256KeyGenerator{}.Generate()
+	require.NoError(t, err)
+	aesCipher, err := cipher.NewAES256Cipher(key)
+	require.NoError(t, err)
+	s := &centralAuthProviderService{
+		connectionFactory: db.NewMockConnectionFactory(nil),
+		cipher:            aesCipher,
+	}
+
+	provider := validCustomerAuthProvider()
+	encryptedSecret, err := aesCipher.Encrypt([]byte(provider.OIDC.ClientSecret))
+	require.NoError(t, err)
+	provider.OIDC.ClientSecret = "" // pragma: allowlist secret
+	config, err := json.Marshal(provider)
+	require.NoError(t, err)
+	otherKey, err := cipher.AES256KeyGenerator{}.Generate()
+	require.NoError(t, err)
+	otherCipher, err := cipher.NewAES256Cipher(otherKey)
+	require.NoError(t, err)
+	secretOfOtherKey, err := otherCipher.Encrypt([]byte("client-secret"))
+	require.NoError(t, err)
+
+	mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "central_auth_providers" WHERE central_id IN ($1,$2)`).
+		WithReply([]map[string]interface{}{
+			{"id": "provider-1", "central_id": "central-1", "config": config, "encrypted_client_secret": encryptedSecret},
+			{"id": "provider-2", "central_id": "central-2", "config": config, "encrypted_client_secret": secretOfOtherKey},
+		})
+
+	providers, svcErr := s.ListByCentralIDs([]string{"central-1", "central-2"})
+
+	require.Nil(t, svcErr)
+	require.Len(t, providers["central-1"], 1)
+	assert.Equal(t, "client-secret", providers["central-1"][0].OIDC.ClientSecret)
+	assert.Empty(t, providers["central-2"])
+}
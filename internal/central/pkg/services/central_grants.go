@@ -0,0 +1,94 @@
+package services
+
+import (
+	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/services"
+)
+
+// CentralGrantService manages the grants giving members of a Central's organisation a higher role for the Central
+//
+//go:generate moq -out central_grants_moq.go . CentralGrantService
+type CentralGrantService interface {
+	// Create grants the user the role for the Central. A user has at most one grant per Central.
+	Create(centralID, username string, role dbapi.CentralRole) (*dbapi.CentralGrant, *serviceError.ServiceError)
+	// List returns the grants of the Central ordered by username
+	List(centralID string) ([]*dbapi.CentralGrant, *serviceError.ServiceError)
+	// Delete removes a grant from the Central
+	Delete(centralID, id string) *serviceError.ServiceError
+}
+
+type centralGrantService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+var _ CentralGrantService = (*centralGrantService)(nil)
+
+// NewCentralGrantService ...
+func NewCentralGrantService(connectionFactory *db.ConnectionFactory) CentralGrantService {
+	return &centralGrantService{connectionFactory: connectionFactory}
+}
+
+// Create ...
+func (s *centralGrantService) Create(centralID, username string, role dbapi.CentralRole) (*dbapi.CentralGrant, *serviceError.ServiceError) {
+	if username == "" {
+		return nil, serviceError.Validation("username must not be empty")
+	}
+	if !role.IsValid() {
+		return nil, serviceError.Validation("invalid role %q, expected one of %q, %q or %q", role,
+			dbapi.CentralRoleViewer, dbapi.CentralRoleEditor, dbapi.CentralRoleOwner)
+	}
+
+	var count int64
+	if err := s.connectionFactory.New().Model(&dbapi.CentralGrant{}).
+		Where("central_id = ? AND username = ?", centralID, username).
+		Count(&count).Error; err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to check grants of central %q", centralID)
+	}
+	if count > 0 {
+		return nil, serviceError.Conflict("user %q already has a grant for central %q", username, centralID)
+	}
+
+	grant := &dbapi.CentralGrant{
+		Meta:      api.Meta{ID: api.NewID()},
+		CentralID: centralID,
+		Username:  username,
+		Role:      role,
+	}
+	if err := s.connectionFactory.New().Create(grant).Error; err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to create grant")
+	}
+	glog.Infof("User %q granted role %q for central %s", username, role, centralID)
+	return grant, nil
+}
+
+// List ...
+func (s *centralGrantService) List(centralID string) ([]*dbapi.CentralGrant, *serviceError.ServiceError) {
+	var grants []*dbapi.CentralGrant
+	if err := s.connectionFactory.New().
+		Where("central_id = ?", centralID).
+		Order("username").
+		Find(&grants).Error; err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to list grants of central %q", centralID)
+	}
+	return grants, nil
+}
+
+// Delete ...
+func (s *centralGrantService) Delete(centralID, id string) *serviceError.ServiceError {
+	var grant dbapi.CentralGrant
+	if err := s.connectionFactory.New().
+		Where("central_id = ? AND id = ?", centralID, id).
+		First(&grant).Error; err != nil {
+		return services.HandleGetError("CentralGrant", "id", id, err)
+	}
+	// deleted grants are removed permanently, so that the user can be granted a role again
+	if err := s.connectionFactory.New().Unscoped().Delete(&grant).Error; err != nil {
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to delete grant %s", id)
+	}
+	glog.Infof("Grant of role %q for central %s revoked from user %q", grant.Role, centralID, grant.Username)
+	return nil
+}
@@ -0,0 +1,182 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that CentralGrantServiceMock does implement CentralGrantService.
+// If this is not the case, regenerate this file with moq.
+var _ CentralGrantService = &CentralGrantServiceMock{}
+
+// CentralGrantServiceMock is a mock implementation of CentralGrantService.
+//
+//	func TestSomethingThatUsesCentralGrantService(t *testing.T) {
+//
+//		// make and configure a mocked CentralGrantService
+//		mockedCentralGrantService := &CentralGrantServiceMock{
+//			CreateFunc: func(centralID string, username string, role dbapi.CentralRole) (*dbapi.CentralGrant, *serviceError.ServiceError) {
+//				panic("mock out the Create method")
+//			},
+//			DeleteFunc: func(centralID string, id string) *serviceError.ServiceError {
+//				panic("mock out the Delete method")
+//			},
+//			ListFunc: func(centralID string) ([]*dbapi.CentralGrant, *serviceError.ServiceError) {
+//				panic("mock out the List method")
+//			},
+//		}
+//
+//		// use mockedCentralGrantService in code that requires CentralGrantService
+//		// and then make assertions.
+//
+//	}
+type CentralGrantServiceMock struct {
+	// CreateFunc mocks the Create method.
+	CreateFunc func(centralID string, username string, role dbapi.CentralRole) (*dbapi.CentralGrant, *serviceError.ServiceError)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(centralID string, id string) *serviceError.ServiceError
+
+	// ListFunc mocks the List method.
+	ListFunc func(centralID string) ([]*dbapi.CentralGrant, *serviceError.ServiceError)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// CentralID is the centralID argument value.
+			CentralID string
+			// Username is the username argument value.
+			Username string
+			// Role is the role argument value.
+			Role dbapi.CentralRole
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// CentralID is the centralID argument value.
+			CentralID string
+			// ID is the id argument value.
+			ID string
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// CentralID is the centralID argument value.
+			CentralID string
+		}
+	}
+	lockCreate sync.RWMutex
+	lockDelete sync.RWMutex
+	lockList   sync.RWMutex
+}
+
+// Create calls CreateFunc.
+func (mock *CentralGrantServiceMock) Create(centralID string, username string, role dbapi.CentralRole) (*dbapi.CentralGrant, *serviceError.ServiceError) {
+	if mock.CreateFunc == nil {
+		panic("CentralGrantServiceMock.CreateFunc: method is nil but CentralGrantService.Create was just called")
+	}
+	callInfo := struct {
+		CentralID string
+		Username  string
+		Role      dbapi.CentralRole
+	}{
+		CentralID: centralID,
+		Username:  username,
+		Role:      role,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(centralID, username, role)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedCentralGrantService.CreateCalls())
+func (mock *CentralGrantServiceMock) CreateCalls() []struct {
+	CentralID string
+	Username  string
+	Role      dbapi.CentralRole
+} {
+	var calls []struct {
+		CentralID string
+		Username  string
+		Role      dbapi.CentralRole
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// Delete calls DeleteFunc.
+func (mock *CentralGrantServiceMock) Delete(centralID string, id string) *serviceError.ServiceError {
+	if mock.DeleteFunc == nil {
+		panic("CentralGrantServiceMock.DeleteFunc: method is nil but CentralGrantService.Delete was just called")
+	}
+	callInfo := struct {
+		CentralID string
+		ID        string
+	}{
+		CentralID: centralID,
+		ID:        id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(centralID, id)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedCentralGrantService.DeleteCalls())
+func (mock *CentralGrantServiceMock) DeleteCalls() []struct {
+	CentralID string
+	ID        string
+} {
+	var calls []struct {
+		CentralID string
+		ID        string
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// List calls ListFunc.
+func (mock *CentralGrantServiceMock) List(centralID string) ([]*dbapi.CentralGrant, *serviceError.ServiceError) {
+	if mock.ListFunc == nil {
+		panic("CentralGrantServiceMock.ListFunc: method is nil but CentralGrantService.List was just called")
+	}
+	callInfo := struct {
+		CentralID string
+	}{
+		CentralID: centralID,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(centralID)
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedCentralGrantService.ListCalls())
+func (mock *CentralGrantServiceMock) ListCalls() []struct {
+	CentralID string
+} {
+	var calls []struct {
+		CentralID string
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
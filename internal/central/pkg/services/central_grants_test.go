@@ -0,0 +1,31 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCentralGrantServiceCreateValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		username string
+		role     dbapi.CentralRole
+	}{
+		{name: "empty username", username: "", role: dbapi.CentralRoleEditor},
+		{name: "no role", username: "alice", role: dbapi.CentralRoleNone},
+		{name: "unknown role", username: "alice", role: "admin"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// validation fails before the database is accessed
+			s := NewCentralGrantService(nil)
+			_, svcErr := s.Create("central-1", tt.username, tt.role)
+			require.NotNil(t, svcErr)
+			assert.Equal(t, serviceError.ErrorValidation, svcErr.Code)
+		})
+	}
+}
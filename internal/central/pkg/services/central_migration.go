@@ -0,0 +1,297 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/internal/central/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// migrationPhaseClusters are the roles of the clusters which have to complete their part of a phase. Phases without
+// roles are carried out by the central migration worker.
+var migrationPhaseClusters = map[dbapi.CentralMigrationPhase][]dbapi.CentralMigrationRole{
+	dbapi.CentralMigrationPhasePrepareTarget: {dbapi.CentralMigrationRoleTarget},
+	dbapi.CentralMigrationPhaseQuiesceSource: {dbapi.CentralMigrationRoleSource},
+	dbapi.CentralMigrationPhaseVerifyTarget:  {dbapi.CentralMigrationRoleTarget},
+	dbapi.CentralMigrationPhaseCleanupSource: {dbapi.CentralMigrationRoleSource},
+	dbapi.CentralMigrationPhaseRollingBack:   {dbapi.CentralMigrationRoleTarget},
+}
+
+// CentralMigrationService persists migrations of Central tenants between dataplane clusters. The phases are carried
+// out by fleetshard-sync on the source and target clusters and by the central migration worker.
+//
+//go:generate moq -out central_migration_moq.go . CentralMigrationService
+type CentralMigrationService interface {
+	// Start creates a migration of the ready central to the target cluster
+	Start(ctx context.Context, centralID string, targetClusterID string) (*dbapi.CentralMigration, *serviceError.ServiceError)
+	// List returns the migrations of the central, most recent first
+	List(centralID string) ([]*dbapi.CentralMigration, *serviceError.ServiceError)
+	// ListActive returns all migrations which have not reached a final phase
+	ListActive() ([]*dbapi.CentralMigration, *serviceError.ServiceError)
+	// ListActiveByClusterID returns the active migrations from or to the cluster
+	ListActiveByClusterID(clusterID string) ([]*dbapi.CentralMigration, *serviceError.ServiceError)
+	// Rollback starts rolling back a failed migration of the central
+	Rollback(centralID string, id string) (*dbapi.CentralMigration, *serviceError.ServiceError)
+	// CompletePhase records that the cluster completed its part of the phase of the central's active migration.
+	// The routes reported by the target cluster are kept to point the DNS records to it.
+	CompletePhase(centralID string, clusterID string, phase dbapi.CentralMigrationPhase, routes []dbapi.DataPlaneCentralRoute) *serviceError.ServiceError
+	// Transition moves the migration to the next phase, if it is still in its current phase
+	Transition(migration *dbapi.CentralMigration, to dbapi.CentralMigrationPhase, reason string) *serviceError.ServiceError
+	// SetDNSChangeID records the pending change of the tenant's DNS records
+	SetDNSChangeID(migration *dbapi.CentralMigration, changeID string) *serviceError.ServiceError
+}
+
+type centralMigrationService struct {
+	connectionFactory *db.ConnectionFactory
+	centralService    CentralService
+	clusterService    ClusterService
+	now               func() time.Time
+}
+
+// NewCentralMigrationService ...
+func NewCentralMigrationService(connectionFactory *db.ConnectionFactory, centralService CentralService, clusterService ClusterService) CentralMigrationService {
+	return &centralMigrationService{
+		connectionFactory: connectionFactory,
+		centralService:    centralService,
+		clusterService:    clusterService,
+		now:               time.Now,
+	}
+}
+
+// IsMigrationPhaseCompleted returns true if all clusters taking part in the phase completed it
+func IsMigrationPhaseCompleted(migration *dbapi.CentralMigration) bool {
+	for _, role := range migrationPhaseClusters[migration.Phase] {
+		if role == dbapi.CentralMigrationRoleSource && !migration.SourceCompleted ||
+			role == dbapi.CentralMigrationRoleTarget && !migration.TargetCompleted {
+			return false
+		}
+	}
+	return true
+}
+
+// Start ...
+func (s *centralMigrationService) Start(ctx context.Context, centralID string, targetClusterID string) (*dbapi.CentralMigration, *serviceError.ServiceError) {
+	central, svcErr := s.centralService.GetByID(centralID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if central.Status != constants.CentralRequestStatusReady.String() {
+		return nil, serviceError.BadRequest("central %q in status %q cannot be migrated, status %q is required",
+			centralID, central.Status, constants.CentralRequestStatusReady)
+	}
+	// The target cluster restores the secrets from the backup to reattach the managed DB
+	if central.SecretDataSha256Sum == "" {
+		return nil, serviceError.BadRequest("secrets of central %q are not backed up yet", centralID)
+	}
+	if central.ClusterID == targetClusterID {
+		return nil, serviceError.BadRequest("central %q already runs on cluster %q", centralID, targetClusterID)
+	}
+	if central.MigrationPhase != "" && central.MigrationPhase.IsActive() {
+		return nil, serviceError.Conflict("central %q is already being migrated", centralID)
+	}
+
+	clusters, err := AllMatchingClustersForCentral(central, s.clusterService)
+	if err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to find matching clusters for central %q", centralID)
+	}
+	matching := false
+	for _, cluster := range clusters {
+		matching = matching || cluster.ClusterID == targetClusterID
+	}
+	if !matching {
+		return nil, serviceError.BadRequest("cluster %q is not a matching cluster for central %q", targetClusterID, centralID)
+	}
+
+	migration := &dbapi.CentralMigration{
+		CentralID:       centralID,
+		SourceClusterID: central.ClusterID,
+		TargetClusterID: targetClusterID,
+		Phase:           dbapi.CentralMigrationPhasePrepareTarget,
+		PhaseStartedAt:  s.now(),
+		SourceRoutes:    central.Routes,
+	}
+	migration.ID = api.NewID()
+	if claims, err := auth.GetClaimsFromContext(ctx); err == nil {
+		migration.Owner, _ = claims.GetUsername()
+	}
+	err = s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		// The condition on the central makes sure that concurrent requests do not start two migrations
+		result := tx.Model(&dbapi.CentralRequest{}).
+			Where("id = ? AND (migration_phase IS NULL OR migration_phase NOT IN ?)", centralID, dbapi.ActiveCentralMigrationPhases).
+			Update("migration_phase", migration.Phase)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return serviceError.Conflict("central %q is already being migrated", centralID)
+		}
+		return tx.Create(migration).Error
+	})
+	if err != nil {
+		if svcErr, ok := err.(*serviceError.ServiceError); ok {
+			return nil, svcErr
+		}
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to create migration of central %s", centralID)
+	}
+	glog.Infof("Started migration %s of central %s from cluster %s to %s", migration.ID, centralID, migration.SourceClusterID, targetClusterID)
+	return migration, nil
+}
+
+// List ...
+func (s *centralMigrationService) List(centralID string) ([]*dbapi.CentralMigration, *serviceError.ServiceError) {
+	var migrations []*dbapi.CentralMigration
+	if err := s.connectionFactory.New().Where("central_id = ?", centralID).Order("created_at DESC").Find(&migrations).Error; err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to list migrations of central %s", centralID)
+	}
+	return migrations, nil
+}
+
+// ListActive ...
+func (s *centralMigrationService) ListActive() ([]*dbapi.CentralMigration, *serviceError.ServiceError) {
+	var migrations []*dbapi.CentralMigration
+	if err := s.connectionFactory.New().Where("phase IN ?", dbapi.ActiveCentralMigrationPhases).Order("created_at").Find(&migrations).Error; err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to list active central migrations")
+	}
+	return migrations, nil
+}
+
+// ListActiveByClusterID ...
+func (s *centralMigrationService) ListActiveByClusterID(clusterID string) ([]*dbapi.CentralMigration, *serviceError.ServiceError) {
+	var migrations []*dbapi.CentralMigration
+	if err := s.connectionFactory.New().
+		Where("phase IN ?", dbapi.ActiveCentralMigrationPhases).
+		Where("source_cluster_id = ? OR target_cluster_id = ?", clusterID, clusterID).
+		Find(&migrations).Error; err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to list central migrations of cluster %s", clusterID)
+	}
+	return migrations, nil
+}
+
+// Rollback ...
+func (s *centralMigrationService) Rollback(centralID string, id string) (*dbapi.CentralMigration, *serviceError.ServiceError) {
+	var migration dbapi.CentralMigration
+	if err := s.connectionFactory.New().Where("id = ? AND central_id = ?", id, centralID).First(&migration).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, serviceError.NotFound("migration %q of central %q not found", id, centralID)
+		}
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to get migration %s", id)
+	}
+	if migration.Phase != dbapi.CentralMigrationPhaseFailed {
+		return nil, serviceError.BadRequest("migration %q in phase %q cannot be rolled back, phase %q is required",
+			id, migration.Phase, dbapi.CentralMigrationPhaseFailed)
+	}
+	if migration.FailedPhase == dbapi.CentralMigrationPhaseCleanupSource {
+		return nil, serviceError.BadRequest("migration %q failed while cleaning up the source cluster and cannot be rolled back", id)
+	}
+	if svcErr := s.Transition(&migration, dbapi.CentralMigrationPhaseRollingBack, ""); svcErr != nil {
+		return nil, svcErr
+	}
+	return &migration, nil
+}
+
+// CompletePhase ...
+func (s *centralMigrationService) CompletePhase(centralID string, clusterID string, phase dbapi.CentralMigrationPhase, routes []dbapi.DataPlaneCentralRoute) *serviceError.ServiceError {
+	var migration dbapi.CentralMigration
+	err := s.connectionFactory.New().
+		Where("central_id = ? AND phase = ?", centralID, phase).
+		First(&migration).Error
+	if err == gorm.ErrRecordNotFound {
+		// The phase was completed before or the migration moved on
+		return nil
+	}
+	if err != nil {
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to get migration of central %s", centralID)
+	}
+
+	role, ok := migration.RoleOf(clusterID)
+	if !ok {
+		return serviceError.BadRequest("cluster %q takes no part in migration %q", clusterID, migration.ID)
+	}
+	values := map[string]interface{}{}
+	if role == dbapi.CentralMigrationRoleSource {
+		values["source_completed"] = true
+	} else {
+		values["target_completed"] = true
+		if phase == dbapi.CentralMigrationPhasePrepareTarget && len(routes) > 0 {
+			if err := migration.SetTargetRoutes(routes); err != nil {
+				return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to set routes of migration %s", migration.ID)
+			}
+			values["target_routes"] = migration.TargetRoutes
+		}
+	}
+	if err := s.connectionFactory.New().Model(&dbapi.CentralMigration{}).
+		Where("id = ? AND phase = ?", migration.ID, phase).
+		Updates(values).Error; err != nil {
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to update migration %s", migration.ID)
+	}
+	glog.Infof("Cluster %s completed phase %s of migration %s of central %s", clusterID, phase, migration.ID, centralID)
+	return nil
+}
+
+// Transition ...
+func (s *centralMigrationService) Transition(migration *dbapi.CentralMigration, to dbapi.CentralMigrationPhase, reason string) *serviceError.ServiceError {
+	from := migration.Phase
+	values := map[string]interface{}{
+		"phase":            to,
+		"phase_started_at": s.now(),
+		"source_completed": false,
+		"target_completed": false,
+		"dns_change_id":    "",
+	}
+	if to == dbapi.CentralMigrationPhaseFailed {
+		values["failed_phase"] = from
+		values["failed_reason"] = reason
+	}
+	if !to.IsActive() {
+		values["completed_at"] = s.now()
+	}
+
+	err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&dbapi.CentralMigration{}).
+			Where("id = ? AND phase = ?", migration.ID, from).
+			Updates(values)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return serviceError.Conflict("migration %s cannot change from phase %q to %q", migration.ID, from, to)
+		}
+		return tx.Model(&dbapi.CentralRequest{}).
+			Where("id = ?", migration.CentralID).
+			Update("migration_phase", to).Error
+	})
+	if err != nil {
+		if svcErr, ok := err.(*serviceError.ServiceError); ok {
+			return svcErr
+		}
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to update phase of migration %s", migration.ID)
+	}
+
+	migration.Phase = to
+	migration.PhaseStartedAt = values["phase_started_at"].(time.Time)
+	migration.SourceCompleted, migration.TargetCompleted = false, false
+	migration.DNSChangeID = ""
+	if to == dbapi.CentralMigrationPhaseFailed {
+		migration.FailedPhase, migration.FailedReason = from, reason
+	}
+	glog.Infof("Migration %s of central %s moved from phase %s to %s", migration.ID, migration.CentralID, from, to)
+	return nil
+}
+
+// SetDNSChangeID ...
+func (s *centralMigrationService) SetDNSChangeID(migration *dbapi.CentralMigration, changeID string) *serviceError.ServiceError {
+	if err := s.connectionFactory.New().Model(&dbapi.CentralMigration{}).
+		Where("id = ?", migration.ID).
+		Update("dns_change_id", changeID).Error; err != nil {
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to update migration %s", migration.ID)
+	}
+	migration.DNSChangeID = changeID
+	return nil
+}
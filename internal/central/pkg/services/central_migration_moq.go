@@ -0,0 +1,432 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"context"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that CentralMigrationServiceMock does implement CentralMigrationService.
+// If this is not the case, regenerate this file with moq.
+var _ CentralMigrationService = &CentralMigrationServiceMock{}
+
+// CentralMigrationServiceMock is a mock implementation of CentralMigrationService.
+//
+//	func TestSomethingThatUsesCentralMigrationService(t *testing.T) {
+//
+//		// make and configure a mocked CentralMigrationService
+//		mockedCentralMigrationService := &CentralMigrationServiceMock{
+//			CompletePhaseFunc: func(centralID string, clusterID string, phase dbapi.CentralMigrationPhase, routes []dbapi.DataPlaneCentralRoute) *serviceError.ServiceError {
+//				panic("mock out the CompletePhase method")
+//			},
+//			ListFunc: func(centralID string) ([]*dbapi.CentralMigration, *serviceError.ServiceError) {
+//				panic("mock out the List method")
+//			},
+//			ListActiveFunc: func() ([]*dbapi.CentralMigration, *serviceError.ServiceError) {
+//				panic("mock out the ListActive method")
+//			},
+//			ListActiveByClusterIDFunc: func(clusterID string) ([]*dbapi.CentralMigration, *serviceError.ServiceError) {
+//				panic("mock out the ListActiveByClusterID method")
+//			},
+//			RollbackFunc: func(centralID string, id string) (*dbapi.CentralMigration, *serviceError.ServiceError) {
+//				panic("mock out the Rollback method")
+//			},
+//			SetDNSChangeIDFunc: func(migration *dbapi.CentralMigration, changeID string) *serviceError.ServiceError {
+//				panic("mock out the SetDNSChangeID method")
+//			},
+//			StartFunc: func(ctx context.Context, centralID string, targetClusterID string) (*dbapi.CentralMigration, *serviceError.ServiceError) {
+//				panic("mock out the Start method")
+//			},
+//			TransitionFunc: func(migration *dbapi.CentralMigration, to dbapi.CentralMigrationPhase, reason string) *serviceError.ServiceError {
+//				panic("mock out the Transition method")
+//			},
+//		}
+//
+//		// use mockedCentralMigrationService in code that requires CentralMigrationService
+//		// and then make assertions.
+//
+//	}
+type CentralMigrationServiceMock struct {
+	// CompletePhaseFunc mocks the CompletePhase method.
+	CompletePhaseFunc func(centralID string, clusterID string, phase dbapi.CentralMigrationPhase, routes []dbapi.DataPlaneCentralRoute) *serviceError.ServiceError
+
+	// ListFunc mocks the List method.
+	ListFunc func(centralID string) ([]*dbapi.CentralMigration, *serviceError.ServiceError)
+
+	// ListActiveFunc mocks the ListActive method.
+	ListActiveFunc func() ([]*dbapi.CentralMigration, *serviceError.ServiceError)
+
+	// ListActiveByClusterIDFunc mocks the ListActiveByClusterID method.
+	ListActiveByClusterIDFunc func(clusterID string) ([]*dbapi.CentralMigration, *serviceError.ServiceError)
+
+	// RollbackFunc mocks the Rollback method.
+	RollbackFunc func(centralID string, id string) (*dbapi.CentralMigration, *serviceError.ServiceError)
+
+	// SetDNSChangeIDFunc mocks the SetDNSChangeID method.
+	SetDNSChangeIDFunc func(migration *dbapi.CentralMigration, changeID string) *serviceError.ServiceError
+
+	// StartFunc mocks the Start method.
+	StartFunc func(ctx context.Context, centralID string, targetClusterID string) (*dbapi.CentralMigration, *serviceError.ServiceError)
+
+	// TransitionFunc mocks the Transition method.
+	TransitionFunc func(migration *dbapi.CentralMigration, to dbapi.CentralMigrationPhase, reason string) *serviceError.ServiceError
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CompletePhase holds details about calls to the CompletePhase method.
+		CompletePhase []struct {
+			// CentralID is the centralID argument value.
+			CentralID string
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// Phase is the phase argument value.
+			Phase dbapi.CentralMigrationPhase
+			// Routes is the routes argument value.
+			Routes []dbapi.DataPlaneCentralRoute
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// CentralID is the centralID argument value.
+			CentralID string
+		}
+		// ListActive holds details about calls to the ListActive method.
+		ListActive []struct {
+		}
+		// ListActiveByClusterID holds details about calls to the ListActiveByClusterID method.
+		ListActiveByClusterID []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
+		// Rollback holds details about calls to the Rollback method.
+		Rollback []struct {
+			// CentralID is the centralID argument value.
+			CentralID string
+			// ID is the id argument value.
+			ID string
+		}
+		// SetDNSChangeID holds details about calls to the SetDNSChangeID method.
+		SetDNSChangeID []struct {
+			// Migration is the migration argument value.
+			Migration *dbapi.CentralMigration
+			// ChangeID is the changeID argument value.
+			ChangeID string
+		}
+		// Start holds details about calls to the Start method.
+		Start []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CentralID is the centralID argument value.
+			CentralID string
+			// TargetClusterID is the targetClusterID argument value.
+			TargetClusterID string
+		}
+		// Transition holds details about calls to the Transition method.
+		Transition []struct {
+			// Migration is the migration argument value.
+			Migration *dbapi.CentralMigration
+			// To is the to argument value.
+			To dbapi.CentralMigrationPhase
+			// Reason is the reason argument value.
+			Reason string
+		}
+	}
+	lockCompletePhase         sync.RWMutex
+	lockList                  sync.RWMutex
+	lockListActive            sync.RWMutex
+	lockListActiveByClusterID sync.RWMutex
+	lockRollback              sync.RWMutex
+	lockSetDNSChangeID        sync.RWMutex
+	lockStart                 sync.RWMutex
+	lockTransition            sync.RWMutex
+}
+
+// CompletePhase calls CompletePhaseFunc.
+func (mock *CentralMigrationServiceMock) CompletePhase(centralID string, clusterID string, phase dbapi.CentralMigrationPhase, routes []dbapi.DataPlaneCentralRoute) *serviceError.ServiceError {
+	if mock.CompletePhaseFunc == nil {
+		panic("CentralMigrationServiceMock.CompletePhaseFunc: method is nil but CentralMigrationService.CompletePhase was just called")
+	}
+	callInfo := struct {
+		CentralID string
+		ClusterID string
+		Phase     dbapi.CentralMigrationPhase
+		Routes    []dbapi.DataPlaneCentralRoute
+	}{
+		CentralID: centralID,
+		ClusterID: clusterID,
+		Phase:     phase,
+		Routes:    routes,
+	}
+	mock.lockCompletePhase.Lock()
+	mock.calls.CompletePhase = append(mock.calls.CompletePhase, callInfo)
+	mock.lockCompletePhase.Unlock()
+	return mock.CompletePhaseFunc(centralID, clusterID, phase, routes)
+}
+
+// CompletePhaseCalls gets all the calls that were made to CompletePhase.
+// Check the length with:
+//
+//	len(mockedCentralMigrationService.CompletePhaseCalls())
+func (mock *CentralMigrationServiceMock) CompletePhaseCalls() []struct {
+	CentralID string
+	ClusterID string
+	Phase     dbapi.CentralMigrationPhase
+	Routes    []dbapi.DataPlaneCentralRoute
+} {
+	var calls []struct {
+		CentralID string
+		ClusterID string
+		Phase     dbapi.CentralMigrationPhase
+		Routes    []dbapi.DataPlaneCentralRoute
+	}
+	mock.lockCompletePhase.RLock()
+	calls = mock.calls.CompletePhase
+	mock.lockCompletePhase.RUnlock()
+	return calls
+}
+
+// List calls ListFunc.
+func (mock *CentralMigrationServiceMock) List(centralID string) ([]*dbapi.CentralMigration, *serviceError.ServiceError) {
+	if mock.ListFunc == nil {
+		panic("CentralMigrationServiceMock.ListFunc: method is nil but CentralMigrationService.List was just called")
+	}
+	callInfo := struct {
+		CentralID string
+	}{
+		CentralID: centralID,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(centralID)
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedCentralMigrationService.ListCalls())
+func (mock *CentralMigrationServiceMock) ListCalls() []struct {
+	CentralID string
+} {
+	var calls []struct {
+		CentralID string
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListActive calls ListActiveFunc.
+func (mock *CentralMigrationServiceMock) ListActive() ([]*dbapi.CentralMigration, *serviceError.ServiceError) {
+	if mock.ListActiveFunc == nil {
+		panic("CentralMigrationServiceMock.ListActiveFunc: method is nil but CentralMigrationService.ListActive was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListActive.Lock()
+	mock.calls.ListActive = append(mock.calls.ListActive, callInfo)
+	mock.lockListActive.Unlock()
+	return mock.ListActiveFunc()
+}
+
+// ListActiveCalls gets all the calls that were made to ListActive.
+// Check the length with:
+//
+//	len(mockedCentralMigrationService.ListActiveCalls())
+func (mock *CentralMigrationServiceMock) ListActiveCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListActive.RLock()
+	calls = mock.calls.ListActive
+	mock.lockListActive.RUnlock()
+	return calls
+}
+
+// ListActiveByClusterID calls ListActiveByClusterIDFunc.
+func (mock *CentralMigrationServiceMock) ListActiveByClusterID(clusterID string) ([]*dbapi.CentralMigration, *serviceError.ServiceError) {
+	if mock.ListActiveByClusterIDFunc == nil {
+		panic("CentralMigrationServiceMock.ListActiveByClusterIDFunc: method is nil but CentralMigrationService.ListActiveByClusterID was just called")
+	}
+	callInfo := struct {
+		ClusterID string
+	}{
+		ClusterID: clusterID,
+	}
+	mock.lockListActiveByClusterID.Lock()
+	mock.calls.ListActiveByClusterID = append(mock.calls.ListActiveByClusterID, callInfo)
+	mock.lockListActiveByClusterID.Unlock()
+	return mock.ListActiveByClusterIDFunc(clusterID)
+}
+
+// ListActiveByClusterIDCalls gets all the calls that were made to ListActiveByClusterID.
+// Check the length with:
+//
+//	len(mockedCentralMigrationService.ListActiveByClusterIDCalls())
+func (mock *CentralMigrationServiceMock) ListActiveByClusterIDCalls() []struct {
+	ClusterID string
+} {
+	var calls []struct {
+		ClusterID string
+	}
+	mock.lockListActiveByClusterID.RLock()
+	calls = mock.calls.ListActiveByClusterID
+	mock.lockListActiveByClusterID.RUnlock()
+	return calls
+}
+
+// Rollback calls RollbackFunc.
+func (mock *CentralMigrationServiceMock) Rollback(centralID string, id string) (*dbapi.CentralMigration, *serviceError.ServiceError) {
+	if mock.RollbackFunc == nil {
+		panic("CentralMigrationServiceMock.RollbackFunc: method is nil but CentralMigrationService.Rollback was just called")
+	}
+	callInfo := struct {
+		CentralID string
+		ID        string
+	}{
+		CentralID: centralID,
+		ID:        id,
+	}
+	mock.lockRollback.Lock()
+	mock.calls.Rollback = append(mock.calls.Rollback, callInfo)
+	mock.lockRollback.Unlock()
+	return mock.RollbackFunc(centralID, id)
+}
+
+// RollbackCalls gets all the calls that were made to Rollback.
+// Check the length with:
+//
+//	len(mockedCentralMigrationService.RollbackCalls())
+func (mock *CentralMigrationServiceMock) RollbackCalls() []struct {
+	CentralID string
+	ID        string
+} {
+	var calls []struct {
+		CentralID string
+		ID        string
+	}
+	mock.lockRollback.RLock()
+	calls = mock.calls.Rollback
+	mock.lockRollback.RUnlock()
+	return calls
+}
+
+// SetDNSChangeID calls SetDNSChangeIDFunc.
+func (mock *CentralMigrationServiceMock) SetDNSChangeID(migration *dbapi.CentralMigration, changeID string) *serviceError.ServiceError {
+	if mock.SetDNSChangeIDFunc == nil {
+		panic("CentralMigrationServiceMock.SetDNSChangeIDFunc: method is nil but CentralMigrationService.SetDNSChangeID was just called")
+	}
+	callInfo := struct {
+		Migration *dbapi.CentralMigration
+		ChangeID  string
+	}{
+		Migration: migration,
+		ChangeID:  changeID,
+	}
+	mock.lockSetDNSChangeID.Lock()
+	mock.calls.SetDNSChangeID = append(mock.calls.SetDNSChangeID, callInfo)
+	mock.lockSetDNSChangeID.Unlock()
+	return mock.SetDNSChangeIDFunc(migration, changeID)
+}
+
+// SetDNSChangeIDCalls gets all the calls that were made to SetDNSChangeID.
+// Check the length with:
+//
+//	len(mockedCentralMigrationService.SetDNSChangeIDCalls())
+func (mock *CentralMigrationServiceMock) SetDNSChangeIDCalls() []struct {
+	Migration *dbapi.CentralMigration
+	ChangeID  string
+} {
+	var calls []struct {
+		Migration *dbapi.CentralMigration
+		ChangeID  string
+	}
+	mock.lockSetDNSChangeID.RLock()
+	calls = mock.calls.SetDNSChangeID
+	mock.lockSetDNSChangeID.RUnlock()
+	return calls
+}
+
+// Start calls StartFunc.
+func (mock *CentralMigrationServiceMock) Start(ctx context.Context, centralID string, targetClusterID string) (*dbapi.CentralMigration, *serviceError.ServiceError) {
+	if mock.StartFunc == nil {
+		panic("CentralMigrationServiceMock.StartFunc: method is nil but CentralMigrationService.Start was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		CentralID       string
+		TargetClusterID string
+	}{
+		Ctx:             ctx,
+		CentralID:       centralID,
+		TargetClusterID: targetClusterID,
+	}
+	mock.lockStart.Lock()
+	mock.calls.Start = append(mock.calls.Start, callInfo)
+	mock.lockStart.Unlock()
+	return mock.StartFunc(ctx, centralID, targetClusterID)
+}
+
+// StartCalls gets all the calls that were made to Start.
+// Check the length with:
+//
+//	len(mockedCentralMigrationService.StartCalls())
+func (mock *CentralMigrationServiceMock) StartCalls() []struct {
+	Ctx             context.Context
+	CentralID       string
+	TargetClusterID string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		CentralID       string
+		TargetClusterID string
+	}
+	mock.lockStart.RLock()
+	calls = mock.calls.Start
+	mock.lockStart.RUnlock()
+	return calls
+}
+
+// Transition calls TransitionFunc.
+func (mock *CentralMigrationServiceMock) Transition(migration *dbapi.CentralMigration, to dbapi.CentralMigrationPhase, reason string) *serviceError.ServiceError {
+	if mock.TransitionFunc == nil {
+		panic("CentralMigrationServiceMock.TransitionFunc: method is nil but CentralMigrationService.Transition was just called")
+	}
+	callInfo := struct {
+		Migration *dbapi.CentralMigration
+		To        dbapi.CentralMigrationPhase
+		Reason    string
+	}{
+		Migration: migration,
+		To:        to,
+		Reason:    reason,
+	}
+	mock.lockTransition.Lock()
+	mock.calls.Transition = append(mock.calls.Transition, callInfo)
+	mock.lockTransition.Unlock()
+	return mock.TransitionFunc(migration, to, reason)
+}
+
+// TransitionCalls gets all the calls that were made to Transition.
+// Check the length with:
+//
+//	len(mockedCentralMigrationService.TransitionCalls())
+func (mock *CentralMigrationServiceMock) TransitionCalls() []struct {
+	Migration *dbapi.CentralMigration
+	To        dbapi.CentralMigrationPhase
+	Reason    string
+} {
+	var calls []struct {
+		Migration *dbapi.CentralMigration
+		To        dbapi.CentralMigrationPhase
+		Reason    string
+	}
+	mock.lockTransition.RLock()
+	calls = mock.calls.Transition
+	mock.lockTransition.RUnlock()
+	return calls
+}
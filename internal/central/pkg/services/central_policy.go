@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// centralRoleOf derives the role of the user described by claims for the given Central instance.
+//
+// The creator of an instance and the admins of its organisation are owners. When the request is filtered by
+// organisation, every other member of the organisation is a viewer, unless one of the grants gives the member a
+// higher role. Grants never give access to users outside of the instance's organisation.
+func centralRoleOf(claims auth.ACSClaims, filterByOrganisation bool, central *dbapi.CentralRequest, grants []*dbapi.CentralGrant) dbapi.CentralRole {
+	user, _ := claims.GetUsername()
+	orgID, _ := claims.GetOrgID()
+	sameOrg := orgID != "" && central.OrganisationID == orgID
+
+	if sameOrg && claims.IsOrgAdmin() {
+		return dbapi.CentralRoleOwner
+	}
+	if user != "" && central.Owner == user {
+		return dbapi.CentralRoleOwner
+	}
+	if !filterByOrganisation || !sameOrg || user == "" {
+		return dbapi.CentralRoleNone
+	}
+
+	role := dbapi.CentralRoleViewer
+	for _, grant := range grants {
+		if grant.CentralID != central.ID || grant.Username != user || !grant.Role.IsValid() {
+			continue
+		}
+		if !role.Includes(grant.Role) {
+			role = grant.Role
+		}
+	}
+	return role
+}
+
+// scopeToVisibleCentrals restricts the query to the Central instances the user can at least view
+func (k *centralService) scopeToVisibleCentrals(ctx context.Context, dbConn *gorm.DB) (*gorm.DB, string, *errors.ServiceError) {
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, "", errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
+	}
+	if auth.GetIsAdminFromContext(ctx) {
+		return dbConn, "", nil
+	}
+
+	user, _ := claims.GetUsername()
+	if user == "" {
+		return nil, "", errors.Unauthenticated("user not authenticated")
+	}
+
+	// filter by organisationId if a user is part of an organisation and is not allowed as a service account
+	if auth.GetFilterByOrganisationFromContext(ctx) {
+		orgID, _ := claims.GetOrgID()
+		return dbConn.Where("organisation_id = ?", orgID), user, nil
+	}
+	// filter by owner as we are dealing with service accounts which may not have an org id
+	return dbConn.Where("owner = ?", user), user, nil
+}
+
+// HasCentralRole ...
+func (k *centralService) HasCentralRole(ctx context.Context, centralRequest *dbapi.CentralRequest, required dbapi.CentralRole) (bool, *errors.ServiceError) {
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return false, errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
+	}
+	if auth.GetIsAdminFromContext(ctx) {
+		return true, nil
+	}
+
+	filterByOrganisation := auth.GetFilterByOrganisationFromContext(ctx)
+	role := centralRoleOf(claims, filterByOrganisation, centralRequest, nil)
+	if role.Includes(required) || role == dbapi.CentralRoleNone {
+		return role.Includes(required), nil
+	}
+
+	// only members of the instance's organisation can hold grants, so they are only looked up when needed
+	user, _ := claims.GetUsername()
+	var grants []*dbapi.CentralGrant
+	if err := k.connectionFactory.New().
+		Where("central_id = ? AND username = ?", centralRequest.ID, user).
+		Find(&grants).Error; err != nil {
+		return false, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list grants for central %q", centralRequest.ID)
+	}
+	return centralRoleOf(claims, filterByOrganisation, centralRequest, grants).Includes(required), nil
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_centralRoleOf(t *testing.T) {
+	const (
+		orgID      = "org-1"
+		otherOrgID = "org-2"
+		member     = "member"
+	)
+	central := buildCentralRequest(func(centralRequest *dbapi.CentralRequest) {
+		centralRequest.OrganisationID = orgID
+	})
+	grant := func(username string, role dbapi.CentralRole) *dbapi.CentralGrant {
+		return &dbapi.CentralGrant{CentralID: central.ID, Username: username, Role: role}
+	}
+
+	tests := []struct {
+		name                 string
+		claims               auth.ACSClaims
+		filterByOrganisation bool
+		grants               []*dbapi.CentralGrant
+		want                 dbapi.CentralRole
+	}{
+		{
+			name:                 "creator is owner",
+			claims:               auth.ACSClaims{"username": testUser, "org_id": orgID},
+			filterByOrganisation: true,
+			want:                 dbapi.CentralRoleOwner,
+		},
+		{
+			name:   "creator is owner when filtering by owner",
+			claims: auth.ACSClaims{"username": testUser},
+			want:   dbapi.CentralRoleOwner,
+		},
+		{
+			name:                 "organisation admin is owner",
+			claims:               auth.ACSClaims{"username": member, "org_id": orgID, "is_org_admin": true},
+			filterByOrganisation: true,
+			want:                 dbapi.CentralRoleOwner,
+		},
+		{
+			name:                 "admin of another organisation has no role",
+			claims:               auth.ACSClaims{"username": member, "org_id": otherOrgID, "is_org_admin": true},
+			filterByOrganisation: true,
+			want:                 dbapi.CentralRoleNone,
+		},
+		{
+			name:                 "organisation member is viewer",
+			claims:               auth.ACSClaims{"username": member, "org_id": orgID},
+			filterByOrganisation: true,
+			want:                 dbapi.CentralRoleViewer,
+		},
+		{
+			name:   "organisation member has no role when filtering by owner",
+			claims: auth.ACSClaims{"username": member, "org_id": orgID},
+			grants: []*dbapi.CentralGrant{grant(member, dbapi.CentralRoleEditor)},
+			want:   dbapi.CentralRoleNone,
+		},
+		{
+			name:                 "member of another organisation has no role",
+			claims:               auth.ACSClaims{"username": member, "org_id": otherOrgID},
+			filterByOrganisation: true,
+			grants:               []*dbapi.CentralGrant{grant(member, dbapi.CentralRoleOwner)},
+			want:                 dbapi.CentralRoleNone,
+		},
+		{
+			name:                 "grant raises the role of an organisation member",
+			claims:               auth.ACSClaims{"username": member, "org_id": orgID},
+			filterByOrganisation: true,
+			grants:               []*dbapi.CentralGrant{grant(member, dbapi.CentralRoleEditor)},
+			want:                 dbapi.CentralRoleEditor,
+		},
+		{
+			name:                 "highest grant wins",
+			claims:               auth.ACSClaims{"username": member, "org_id": orgID},
+			filterByOrganisation: true,
+			grants: []*dbapi.CentralGrant{
+				grant(member, dbapi.CentralRoleOwner),
+				grant(member, dbapi.CentralRoleEditor),
+			},
+			want: dbapi.CentralRoleOwner,
+		},
+		{
+			name:                 "grants of other users are ignored",
+			claims:               auth.ACSClaims{"username": member, "org_id": orgID},
+			filterByOrganisation: true,
+			grants:               []*dbapi.CentralGrant{grant("someone-else", dbapi.CentralRoleOwner)},
+			want:                 dbapi.CentralRoleViewer,
+		},
+		{
+			name:                 "invalid grant roles are ignored",
+			claims:               auth.ACSClaims{"username": member, "org_id": orgID},
+			filterByOrganisation: true,
+			grants:               []*dbapi.CentralGrant{grant(member, "superuser")},
+			want:                 dbapi.CentralRoleViewer,
+		},
+		{
+			name:                 "user without username has no role",
+			claims:               auth.ACSClaims{"org_id": orgID},
+			filterByOrganisation: true,
+			want:                 dbapi.CentralRoleNone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, centralRoleOf(tt.claims, tt.filterByOrganisation, central, tt.grants))
+		})
+	}
+}
+
+func TestCentralRole_Includes(t *testing.T) {
+	assert.True(t, dbapi.CentralRoleOwner.Includes(dbapi.CentralRoleEditor))
+	assert.True(t, dbapi.CentralRoleEditor.Includes(dbapi.CentralRoleEditor))
+	assert.False(t, dbapi.CentralRoleViewer.Includes(dbapi.CentralRoleEditor))
+	assert.False(t, dbapi.CentralRoleNone.Includes(dbapi.CentralRoleViewer))
+}
@@ -3,7 +3,9 @@ package services
 import (
 	"context"
 	"database/sql/driver"
+	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -209,6 +211,26 @@ func Test_centralService_DeprovisionExpiredCentralsQuery(t *testing.T) {
 	assert.True(t, m.Triggered)
 }
 
+func Test_centralService_DeleteRemovesGrantsAndAuthProviders(t *testing.T) {
+	k := &centralService{
+		connectionFactory: db.NewMockConnectionFactory(nil),
+		centralConfig:     &config.CentralConfig{},
+	}
+
+	catcher := mocket.Catcher.Reset()
+	deleteGrants := catcher.NewMock().WithQuery(`DELETE FROM "central_grants" WHERE central_id = $1`).
+		WithArgs("test-id").OneTime()
+	deleteAuthProviders := catcher.NewMock().WithQuery(`DELETE FROM "central_auth_providers" WHERE central_id = $1`).
+		WithArgs("test-id").OneTime()
+	deleteCentral := catcher.NewMock().WithQuery(`UPDATE "central_requests" SET "deleted_at"=$1`).OneTime()
+
+	svcErr := k.Delete(context.Background(), &dbapi.CentralRequest{Meta: api.Meta{ID: "test-id"}}, false)
+	assert.Nil(t, svcErr)
+	assert.True(t, deleteGrants.Triggered)
+	assert.True(t, deleteAuthProviders.Triggered)
+	assert.True(t, deleteCentral.Triggered)
+}
+
 func Test_centralService_RestoreExpiredCentrals(t *testing.T) {
 	dbConnectionFactory := db.NewMockConnectionFactory(nil)
 
@@ -332,3 +354,89 @@ func Test_centralService_ChangeSubscription(t *testing.T) {
 	assert.True(t, q1.Triggered)
 
 }
+
+func Test_centralService_HasAvailableCapacityInRegion(t *testing.T) {
+	tests := []struct {
+		name           string
+		apiLimits      []int
+		manualClusters config.ClusterList
+		count          int64
+		want           bool
+	}{
+		{
+			name:      "capacity left",
+			apiLimits: []int{2, 3},
+			count:     4,
+			want:      true,
+		},
+		{
+			name:           "capacity of API and manual clusters exhausted",
+			apiLimits:      []int{2},
+			manualClusters: config.ClusterList{{ClusterID: "manual", Region: testCentralRequestRegion, CentralInstanceLimit: 2}},
+			count:          4,
+			want:           false,
+		},
+		{
+			name:      "unlimited API cluster",
+			apiLimits: []int{2, config.UnlimitedCentralInstanceLimit},
+			count:     100,
+			want:      true,
+		},
+		{
+			name:           "unlimited manual cluster",
+			apiLimits:      []int{2},
+			manualClusters: config.ClusterList{{ClusterID: "manual", Region: testCentralRequestRegion, CentralInstanceLimit: config.UnlimitedCentralInstanceLimit}},
+			count:          100,
+			want:           true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dataplaneClusterConfig := config.NewDataplaneClusterConfig()
+			dataplaneClusterConfig.ClusterConfig = config.NewClusterConfig(tc.manualClusters)
+			centralService := &centralService{
+				connectionFactory:      db.NewMockConnectionFactory(nil),
+				dataplaneClusterConfig: dataplaneClusterConfig,
+			}
+			clusters := make([]map[string]interface{}, 0, len(tc.apiLimits))
+			for i, limit := range tc.apiLimits {
+				clusters = append(clusters, map[string]interface{}{"cluster_id": fmt.Sprintf("api-%d", i), "central_instance_limit": limit})
+			}
+			catcher := mocket.Catcher.Reset()
+			catcher.NewMock().WithQuery(`SELECT cluster_id, central_instance_limit FROM "clusters"`).WithReply(clusters).OneTime()
+			catcher.NewMock().WithQuery(`SELECT count(*) FROM "central_requests"`).WithReply([]map[string]interface{}{{"count": tc.count}}).OneTime()
+
+			hasCapacity, svcErr := centralService.HasAvailableCapacityInRegion(buildCentralRequest(nil))
+			require.Nil(t, svcErr)
+			assert.Equal(t, tc.want, hasCapacity)
+		})
+	}
+}
+
+func Test_centralService_ListKnownCentralIDsFiltersByCluster(t *testing.T) {
+	k := &centralService{
+		connectionFactory: db.NewMockConnectionFactory(nil),
+		centralConfig:     &config.CentralConfig{CentralRetentionPeriodDays: 7},
+	}
+
+	var query string
+	var clusterArgs []interface{}
+	mocket.Catcher.Reset().NewMock().WithQuery(`SELECT "id" FROM "central_requests"`).
+		WithCallback(func(q string, args []driver.NamedValue) {
+			query = q
+			for _, arg := range args {
+				if s, ok := arg.Value.(string); ok && strings.HasPrefix(s, "cluster-") {
+					clusterArgs = append(clusterArgs, s)
+				}
+			}
+		}).
+		WithReply([]map[string]interface{}{{"id": "central-1"}, {"id": "central-2"}})
+
+	ids, svcErr := k.ListKnownCentralIDs("cluster-1")
+	require.Nil(t, svcErr)
+	assert.Equal(t, []string{"central-1", "central-2"}, ids)
+	// centrals of other clusters are excluded, unless they are migrated from or to the cluster
+	assert.Contains(t, query, `cluster_id = $1 OR id IN (SELECT "central_id" FROM "central_migrations"`)
+	assert.Contains(t, query, `(source_cluster_id = $9 OR target_cluster_id = $10)`)
+	assert.Equal(t, []interface{}{"cluster-1", "cluster-1", "cluster-1"}, clusterArgs)
+}
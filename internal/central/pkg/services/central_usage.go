@@ -0,0 +1,180 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"gorm.io/gorm/clause"
+)
+
+// CentralUsageGroupBy is the dimension usage is aggregated by
+type CentralUsageGroupBy string
+
+// Supported usage aggregation dimensions
+const (
+	CentralUsageGroupByOrganisation CentralUsageGroupBy = "organisation"
+	CentralUsageGroupByCluster      CentralUsageGroupBy = "cluster"
+	CentralUsageGroupByInstanceType CentralUsageGroupBy = "instance_type"
+)
+
+// CentralUsageGranularity is the length of the periods usage is aggregated over
+type CentralUsageGranularity string
+
+// Supported usage aggregation periods
+const (
+	CentralUsageGranularityHour  CentralUsageGranularity = "hour"
+	CentralUsageGranularityDay   CentralUsageGranularity = "day"
+	CentralUsageGranularityMonth CentralUsageGranularity = "month"
+)
+
+var centralUsageGroupByColumns = map[CentralUsageGroupBy]string{
+	CentralUsageGroupByOrganisation: "organisation_id",
+	CentralUsageGroupByCluster:      "cluster_id",
+	CentralUsageGroupByInstanceType: "instance_type",
+}
+
+// IsValid returns true if usage can be aggregated by the dimension
+func (g CentralUsageGroupBy) IsValid() bool {
+	_, ok := centralUsageGroupByColumns[g]
+	return ok
+}
+
+// IsValid returns true if usage can be aggregated over periods of the granularity
+func (g CentralUsageGranularity) IsValid() bool {
+	switch g {
+	case CentralUsageGranularityHour, CentralUsageGranularityDay, CentralUsageGranularityMonth:
+		return true
+	}
+	return false
+}
+
+// CentralUsageQuery selects the usage to aggregate
+type CentralUsageQuery struct {
+	// From is the inclusive start of the reported time range
+	From time.Time
+	// To is the exclusive end of the reported time range
+	To          time.Time
+	GroupBy     CentralUsageGroupBy
+	Granularity CentralUsageGranularity
+}
+
+// CentralUsageReportEntry is the aggregated usage of a group of tenants over one period. Every tenant contributes
+// its hourly reported values, so the sums are resource hours, e.g. CPU request millicore hours.
+type CentralUsageReportEntry struct {
+	Group                     string    `json:"group"`
+	PeriodStart               time.Time `json:"period_start"`
+	Tenants                   int64     `json:"tenants"`
+	CPURequestsMillicoreHours int64     `json:"cpu_requests_millicore_hours"`
+	CPUUsageMillicoreHours    int64     `json:"cpu_usage_millicore_hours"`
+	MemoryRequestsByteHours   int64     `json:"memory_requests_byte_hours"`
+	MemoryUsageByteHours      int64     `json:"memory_usage_byte_hours"`
+	StorageByteHours          int64     `json:"storage_byte_hours"`
+	DBMinCapacityACUHours     float64   `json:"db_min_capacity_acu_hours"`
+	DBMaxCapacityACUHours     float64   `json:"db_max_capacity_acu_hours"`
+}
+
+// CentralUsageService stores the resource usage reported for Central tenants and aggregates it for cost reporting
+//
+//go:generate moq -out central_usage_moq.go . CentralUsageService
+type CentralUsageService interface {
+	// RecordUsage stores the usage of the central for the current hour, replacing usage reported earlier in the same hour
+	RecordUsage(central *dbapi.CentralRequest, usage *dbapi.DataPlaneCentralUsage) *serviceError.ServiceError
+	// ListUsage aggregates the stored usage according to the query
+	ListUsage(query CentralUsageQuery) ([]CentralUsageReportEntry, *serviceError.ServiceError)
+	// PruneUsage permanently deletes the usage of periods which started before the given time and returns the
+	// number of deleted records
+	PruneUsage(before time.Time) (int64, *serviceError.ServiceError)
+}
+
+type centralUsageService struct {
+	connectionFactory *db.ConnectionFactory
+	now               func() time.Time
+}
+
+// NewCentralUsageService ...
+func NewCentralUsageService(connectionFactory *db.ConnectionFactory) CentralUsageService {
+	return &centralUsageService{
+		connectionFactory: connectionFactory,
+		now:               time.Now,
+	}
+}
+
+// RecordUsage ...
+func (s *centralUsageService) RecordUsage(central *dbapi.CentralRequest, usage *dbapi.DataPlaneCentralUsage) *serviceError.ServiceError {
+	record := &dbapi.CentralUsage{
+		Meta:                  api.Meta{ID: api.NewID()},
+		CentralID:             central.ID,
+		OrganisationID:        central.OrganisationID,
+		ClusterID:             central.ClusterID,
+		InstanceType:          central.InstanceType,
+		PeriodStart:           s.now().UTC().Truncate(time.Hour),
+		CPURequestsMillicores: usage.CPURequestsMillicores,
+		CPUUsageMillicores:    usage.CPUUsageMillicores,
+		MemoryRequestsBytes:   usage.MemoryRequestsBytes,
+		MemoryUsageBytes:      usage.MemoryUsageBytes,
+		StorageBytes:          usage.StorageBytes,
+		DBMinCapacityACU:      usage.DBMinCapacityACU,
+		DBMaxCapacityACU:      usage.DBMaxCapacityACU,
+	}
+
+	dbConn := s.connectionFactory.New().Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "central_id"}, {Name: "period_start"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"updated_at", "organisation_id", "cluster_id", "instance_type",
+			"cpu_requests_millicores", "cpu_usage_millicores", "memory_requests_bytes", "memory_usage_bytes",
+			"storage_bytes", "db_min_capacity_acu", "db_max_capacity_acu",
+		}),
+	})
+	if err := dbConn.Create(record).Error; err != nil {
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to record usage of central %s", central.ID)
+	}
+	return nil
+}
+
+// ListUsage ...
+func (s *centralUsageService) ListUsage(query CentralUsageQuery) ([]CentralUsageReportEntry, *serviceError.ServiceError) {
+	groupColumn, ok := centralUsageGroupByColumns[query.GroupBy]
+	if !ok {
+		return nil, serviceError.Validation("unsupported group by %q", query.GroupBy)
+	}
+	if !query.Granularity.IsValid() {
+		return nil, serviceError.Validation("unsupported granularity %q", query.Granularity)
+	}
+
+	// both the group column and the granularity are validated above and safe to be used in the query
+	periodExpr := fmt.Sprintf("date_trunc('%s', period_start)", query.Granularity)
+	var entries []CentralUsageReportEntry
+	err := s.connectionFactory.New().
+		Model(&dbapi.CentralUsage{}).
+		Select(fmt.Sprintf(`%s AS "group", %s AS period_start, COUNT(DISTINCT central_id) AS tenants,
+			SUM(cpu_requests_millicores) AS cpu_requests_millicore_hours,
+			SUM(cpu_usage_millicores) AS cpu_usage_millicore_hours,
+			SUM(memory_requests_bytes) AS memory_requests_byte_hours,
+			SUM(memory_usage_bytes) AS memory_usage_byte_hours,
+			SUM(storage_bytes) AS storage_byte_hours,
+			SUM(db_min_capacity_acu) AS db_min_capacity_acu_hours,
+			SUM(db_max_capacity_acu) AS db_max_capacity_acu_hours`, groupColumn, periodExpr)).
+		Where("period_start >= ? AND period_start < ?", query.From, query.To).
+		Group(fmt.Sprintf("%s, %s", groupColumn, periodExpr)).
+		Order(fmt.Sprintf("%s, %s", periodExpr, groupColumn)).
+		Scan(&entries).Error
+	if err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to list central usage")
+	}
+	return entries, nil
+}
+
+// PruneUsage ...
+func (s *centralUsageService) PruneUsage(before time.Time) (int64, *serviceError.ServiceError) {
+	result := s.connectionFactory.New().Unscoped().
+		Where("period_start < ?", before).
+		Delete(&dbapi.CentralUsage{})
+	if result.Error != nil {
+		return 0, serviceError.NewWithCause(serviceError.ErrorGeneral, result.Error, "unable to prune central usage before %s", before.Format(time.RFC3339))
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,171 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"sync"
+	"time"
+)
+
+// Ensure, that CentralUsageServiceMock does implement CentralUsageService.
+// If this is not the case, regenerate this file with moq.
+var _ CentralUsageService = &CentralUsageServiceMock{}
+
+// CentralUsageServiceMock is a mock implementation of CentralUsageService.
+//
+//	func TestSomethingThatUsesCentralUsageService(t *testing.T) {
+//
+//		// make and configure a mocked CentralUsageService
+//		mockedCentralUsageService := &CentralUsageServiceMock{
+//			ListUsageFunc: func(query CentralUsageQuery) ([]CentralUsageReportEntry, *serviceError.ServiceError) {
+//				panic("mock out the ListUsage method")
+//			},
+//			PruneUsageFunc: func(before time.Time) (int64, *serviceError.ServiceError) {
+//				panic("mock out the PruneUsage method")
+//			},
+//			RecordUsageFunc: func(central *dbapi.CentralRequest, usage *dbapi.DataPlaneCentralUsage) *serviceError.ServiceError {
+//				panic("mock out the RecordUsage method")
+//			},
+//		}
+//
+//		// use mockedCentralUsageService in code that requires CentralUsageService
+//		// and then make assertions.
+//
+//	}
+type CentralUsageServiceMock struct {
+	// ListUsageFunc mocks the ListUsage method.
+	ListUsageFunc func(query CentralUsageQuery) ([]CentralUsageReportEntry, *serviceError.ServiceError)
+
+	// PruneUsageFunc mocks the PruneUsage method.
+	PruneUsageFunc func(before time.Time) (int64, *serviceError.ServiceError)
+
+	// RecordUsageFunc mocks the RecordUsage method.
+	RecordUsageFunc func(central *dbapi.CentralRequest, usage *dbapi.DataPlaneCentralUsage) *serviceError.ServiceError
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListUsage holds details about calls to the ListUsage method.
+		ListUsage []struct {
+			// Query is the query argument value.
+			Query CentralUsageQuery
+		}
+		// PruneUsage holds details about calls to the PruneUsage method.
+		PruneUsage []struct {
+			// Before is the before argument value.
+			Before time.Time
+		}
+		// RecordUsage holds details about calls to the RecordUsage method.
+		RecordUsage []struct {
+			// Central is the central argument value.
+			Central *dbapi.CentralRequest
+			// Usage is the usage argument value.
+			Usage *dbapi.DataPlaneCentralUsage
+		}
+	}
+	lockListUsage   sync.RWMutex
+	lockPruneUsage  sync.RWMutex
+	lockRecordUsage sync.RWMutex
+}
+
+// ListUsage calls ListUsageFunc.
+func (mock *CentralUsageServiceMock) ListUsage(query CentralUsageQuery) ([]CentralUsageReportEntry, *serviceError.ServiceError) {
+	if mock.ListUsageFunc == nil {
+		panic("CentralUsageServiceMock.ListUsageFunc: method is nil but CentralUsageService.ListUsage was just called")
+	}
+	callInfo := struct {
+		Query CentralUsageQuery
+	}{
+		Query: query,
+	}
+	mock.lockListUsage.Lock()
+	mock.calls.ListUsage = append(mock.calls.ListUsage, callInfo)
+	mock.lockListUsage.Unlock()
+	return mock.ListUsageFunc(query)
+}
+
+// ListUsageCalls gets all the calls that were made to ListUsage.
+// Check the length with:
+//
+//	len(mockedCentralUsageService.ListUsageCalls())
+func (mock *CentralUsageServiceMock) ListUsageCalls() []struct {
+	Query CentralUsageQuery
+} {
+	var calls []struct {
+		Query CentralUsageQuery
+	}
+	mock.lockListUsage.RLock()
+	calls = mock.calls.ListUsage
+	mock.lockListUsage.RUnlock()
+	return calls
+}
+
+// PruneUsage calls PruneUsageFunc.
+func (mock *CentralUsageServiceMock) PruneUsage(before time.Time) (int64, *serviceError.ServiceError) {
+	if mock.PruneUsageFunc == nil {
+		panic("CentralUsageServiceMock.PruneUsageFunc: method is nil but CentralUsageService.PruneUsage was just called")
+	}
+	callInfo := struct {
+		Before time.Time
+	}{
+		Before: before,
+	}
+	mock.lockPruneUsage.Lock()
+	mock.calls.PruneUsage = append(mock.calls.PruneUsage, callInfo)
+	mock.lockPruneUsage.Unlock()
+	return mock.PruneUsageFunc(before)
+}
+
+// PruneUsageCalls gets all the calls that were made to PruneUsage.
+// Check the length with:
+//
+//	len(mockedCentralUsageService.PruneUsageCalls())
+func (mock *CentralUsageServiceMock) PruneUsageCalls() []struct {
+	Before time.Time
+} {
+	var calls []struct {
+		Before time.Time
+	}
+	mock.lockPruneUsage.RLock()
+	calls = mock.calls.PruneUsage
+	mock.lockPruneUsage.RUnlock()
+	return calls
+}
+
+// RecordUsage calls RecordUsageFunc.
+func (mock *CentralUsageServiceMock) RecordUsage(central *dbapi.CentralRequest, usage *dbapi.DataPlaneCentralUsage) *serviceError.ServiceError {
+	if mock.RecordUsageFunc == nil {
+		panic("CentralUsageServiceMock.RecordUsageFunc: method is nil but CentralUsageService.RecordUsage was just called")
+	}
+	callInfo := struct {
+		Central *dbapi.CentralRequest
+		Usage   *dbapi.DataPlaneCentralUsage
+	}{
+		Central: central,
+		Usage:   usage,
+	}
+	mock.lockRecordUsage.Lock()
+	mock.calls.RecordUsage = append(mock.calls.RecordUsage, callInfo)
+	mock.lockRecordUsage.Unlock()
+	return mock.RecordUsageFunc(central, usage)
+}
+
+// RecordUsageCalls gets all the calls that were made to RecordUsage.
+// Check the length with:
+//
+//	len(mockedCentralUsageService.RecordUsageCalls())
+func (mock *CentralUsageServiceMock) RecordUsageCalls() []struct {
+	Central *dbapi.CentralRequest
+	Usage   *dbapi.DataPlaneCentralUsage
+} {
+	var calls []struct {
+		Central *dbapi.CentralRequest
+		Usage   *dbapi.DataPlaneCentralUsage
+	}
+	mock.lockRecordUsage.RLock()
+	calls = mock.calls.RecordUsage
+	mock.lockRecordUsage.RUnlock()
+	return calls
+}
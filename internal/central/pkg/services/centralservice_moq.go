@@ -5,10 +5,10 @@ package services
 
 import (
 	"context"
-	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/stackrox/acs-fleet-manager/internal/central/constants"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/centrals/types"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/dns"
 	"github.com/stackrox/acs-fleet-manager/pkg/api"
 	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
 	"github.com/stackrox/acs-fleet-manager/pkg/services"
@@ -34,19 +34,22 @@ var _ CentralService = &CentralServiceMock{}
 //			ChangeBillingParametersFunc: func(ctx context.Context, centralID string, billingModel string, cloudAccountID string, cloudProvider string, product string) *serviceError.ServiceError {
 //				panic("mock out the ChangeBillingParameters method")
 //			},
-//			ChangeCentralCNAMErecordsFunc: func(centralRequest *dbapi.CentralRequest, action CentralRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *serviceError.ServiceError) {
+//			ChangeCentralCNAMErecordsFunc: func(centralRequest *dbapi.CentralRequest, action CentralRoutesAction) (*dns.Change, *serviceError.ServiceError) {
 //				panic("mock out the ChangeCentralCNAMErecords method")
 //			},
 //			ChangeSubscriptionFunc: func(ctx context.Context, centralID string, cloudAccountID string, cloudProvider string, subscriptionID string) *serviceError.ServiceError {
 //				panic("mock out the ChangeSubscription method")
 //			},
+//			CompleteCentralRHSSOClientRotationFunc: func(ctx context.Context, centralRequest *dbapi.CentralRequest) *serviceError.ServiceError {
+//				panic("mock out the CompleteCentralRHSSOClientRotation method")
+//			},
 //			CountByRegionAndInstanceTypeFunc: func() ([]CentralRegionCount, error) {
 //				panic("mock out the CountByRegionAndInstanceType method")
 //			},
 //			CountByStatusFunc: func(status []constants.CentralStatus) ([]CentralStatusCount, error) {
 //				panic("mock out the CountByStatus method")
 //			},
-//			DeleteFunc: func(centralRequest *dbapi.CentralRequest, force bool) *serviceError.ServiceError {
+//			DeleteFunc: func(ctx context.Context, centralRequest *dbapi.CentralRequest, force bool) *serviceError.ServiceError {
 //				panic("mock out the Delete method")
 //			},
 //			DeprovisionCentralForUsersFunc: func(users []string) *serviceError.ServiceError {
@@ -64,24 +67,36 @@ var _ CentralService = &CentralServiceMock{}
 //			GetByIDFunc: func(id string) (*dbapi.CentralRequest, *serviceError.ServiceError) {
 //				panic("mock out the GetByID method")
 //			},
-//			GetCNAMERecordStatusFunc: func(centralRequest *dbapi.CentralRequest) (*CNameRecordStatus, error) {
-//				panic("mock out the GetCNAMERecordStatus method")
-//			},
 //			HasAvailableCapacityInRegionFunc: func(centralRequest *dbapi.CentralRequest) (bool, *serviceError.ServiceError) {
 //				panic("mock out the HasAvailableCapacityInRegion method")
 //			},
+//			HasCentralRoleFunc: func(ctx context.Context, centralRequest *dbapi.CentralRequest, required dbapi.CentralRole) (bool, *serviceError.ServiceError) {
+//				panic("mock out the HasCentralRole method")
+//			},
 //			ListFunc: func(ctx context.Context, listArgs *services.ListArguments) (dbapi.CentralList, *api.PagingMeta, *serviceError.ServiceError) {
 //				panic("mock out the List method")
 //			},
 //			ListByStatusFunc: func(status ...constants.CentralStatus) ([]*dbapi.CentralRequest, *serviceError.ServiceError) {
 //				panic("mock out the ListByStatus method")
 //			},
+//			ListCentralsByHostFunc: func(host string) ([]*dbapi.CentralRequest, *serviceError.ServiceError) {
+//				panic("mock out the ListCentralsByHost method")
+//			},
+//			ListCentralsWithDynamicClientsFunc: func() ([]*dbapi.CentralRequest, *serviceError.ServiceError) {
+//				panic("mock out the ListCentralsWithDynamicClients method")
+//			},
 //			ListCentralsWithRoutesNotCreatedFunc: func() ([]*dbapi.CentralRequest, *serviceError.ServiceError) {
 //				panic("mock out the ListCentralsWithRoutesNotCreated method")
 //			},
 //			ListCentralsWithoutAuthConfigFunc: func() ([]*dbapi.CentralRequest, *serviceError.ServiceError) {
 //				panic("mock out the ListCentralsWithoutAuthConfig method")
 //			},
+//			ListDynamicClientIDsFunc: func() ([]string, *serviceError.ServiceError) {
+//				panic("mock out the ListDynamicClientIDs method")
+//			},
+//			ListKnownCentralIDsFunc: func(clusterID string) ([]string, *serviceError.ServiceError) {
+//				panic("mock out the ListKnownCentralIDs method")
+//			},
 //			PrepareCentralRequestFunc: func(centralRequest *dbapi.CentralRequest) *serviceError.ServiceError {
 //				panic("mock out the PrepareCentralRequest method")
 //			},
@@ -100,13 +115,16 @@ var _ CentralService = &CentralServiceMock{}
 //			RotateCentralRHSSOClientFunc: func(ctx context.Context, centralRequest *dbapi.CentralRequest) *serviceError.ServiceError {
 //				panic("mock out the RotateCentralRHSSOClient method")
 //			},
-//			UpdateIgnoreNilsFunc: func(centralRequest *dbapi.CentralRequest) *serviceError.ServiceError {
+//			StartCentralRHSSOClientRotationFunc: func(ctx context.Context, centralRequest *dbapi.CentralRequest) *serviceError.ServiceError {
+//				panic("mock out the StartCentralRHSSOClientRotation method")
+//			},
+//			UpdateIgnoreNilsFunc: func(ctx context.Context, centralRequest *dbapi.CentralRequest) *serviceError.ServiceError {
 //				panic("mock out the UpdateIgnoreNils method")
 //			},
-//			UpdateStatusFunc: func(id string, status constants.CentralStatus) (bool, *serviceError.ServiceError) {
+//			UpdateStatusFunc: func(ctx context.Context, id string, status constants.CentralStatus) (bool, *serviceError.ServiceError) {
 //				panic("mock out the UpdateStatus method")
 //			},
-//			UpdatesFunc: func(centralRequest *dbapi.CentralRequest, values map[string]interface{}) *serviceError.ServiceError {
+//			UpdatesFunc: func(ctx context.Context, centralRequest *dbapi.CentralRequest, values map[string]interface{}) *serviceError.ServiceError {
 //				panic("mock out the Updates method")
 //			},
 //			VerifyAndUpdateCentralAdminFunc: func(ctx context.Context, centralRequest *dbapi.CentralRequest) *serviceError.ServiceError {
@@ -129,11 +147,14 @@ type CentralServiceMock struct {
 	ChangeBillingParametersFunc func(ctx context.Context, centralID string, billingModel string, cloudAccountID string, cloudProvider string, product string) *serviceError.ServiceError
 
 	// ChangeCentralCNAMErecordsFunc mocks the ChangeCentralCNAMErecords method.
-	ChangeCentralCNAMErecordsFunc func(centralRequest *dbapi.CentralRequest, action CentralRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *serviceError.ServiceError)
+	ChangeCentralCNAMErecordsFunc func(centralRequest *dbapi.CentralRequest, action CentralRoutesAction) (*dns.Change, *serviceError.ServiceError)
 
 	// ChangeSubscriptionFunc mocks the ChangeSubscription method.
 	ChangeSubscriptionFunc func(ctx context.Context, centralID string, cloudAccountID string, cloudProvider string, subscriptionID string) *serviceError.ServiceError
 
+	// CompleteCentralRHSSOClientRotationFunc mocks the CompleteCentralRHSSOClientRotation method.
+	CompleteCentralRHSSOClientRotationFunc func(ctx context.Context, centralRequest *dbapi.CentralRequest) *serviceError.ServiceError
+
 	// CountByRegionAndInstanceTypeFunc mocks the CountByRegionAndInstanceType method.
 	CountByRegionAndInstanceTypeFunc func() ([]CentralRegionCount, error)
 
@@ -141,7 +162,7 @@ type CentralServiceMock struct {
 	CountByStatusFunc func(status []constants.CentralStatus) ([]CentralStatusCount, error)
 
 	// DeleteFunc mocks the Delete method.
-	DeleteFunc func(centralRequest *dbapi.CentralRequest, force bool) *serviceError.ServiceError
+	DeleteFunc func(ctx context.Context, centralRequest *dbapi.CentralRequest, force bool) *serviceError.ServiceError
 
 	// DeprovisionCentralForUsersFunc mocks the DeprovisionCentralForUsers method.
 	DeprovisionCentralForUsersFunc func(users []string) *serviceError.ServiceError
@@ -158,24 +179,36 @@ type CentralServiceMock struct {
 	// GetByIDFunc mocks the GetByID method.
 	GetByIDFunc func(id string) (*dbapi.CentralRequest, *serviceError.ServiceError)
 
-	// GetCNAMERecordStatusFunc mocks the GetCNAMERecordStatus method.
-	GetCNAMERecordStatusFunc func(centralRequest *dbapi.CentralRequest) (*CNameRecordStatus, error)
-
 	// HasAvailableCapacityInRegionFunc mocks the HasAvailableCapacityInRegion method.
 	HasAvailableCapacityInRegionFunc func(centralRequest *dbapi.CentralRequest) (bool, *serviceError.ServiceError)
 
+	// HasCentralRoleFunc mocks the HasCentralRole method.
+	HasCentralRoleFunc func(ctx context.Context, centralRequest *dbapi.CentralRequest, required dbapi.CentralRole) (bool, *serviceError.ServiceError)
+
 	// ListFunc mocks the List method.
 	ListFunc func(ctx context.Context, listArgs *services.ListArguments) (dbapi.CentralList, *api.PagingMeta, *serviceError.ServiceError)
 
 	// ListByStatusFunc mocks the ListByStatus method.
 	ListByStatusFunc func(status ...constants.CentralStatus) ([]*dbapi.CentralRequest, *serviceError.ServiceError)
 
+	// ListCentralsByHostFunc mocks the ListCentralsByHost method.
+	ListCentralsByHostFunc func(host string) ([]*dbapi.CentralRequest, *serviceError.ServiceError)
+
+	// ListCentralsWithDynamicClientsFunc mocks the ListCentralsWithDynamicClients method.
+	ListCentralsWithDynamicClientsFunc func() ([]*dbapi.CentralRequest, *serviceError.ServiceError)
+
 	// ListCentralsWithRoutesNotCreatedFunc mocks the ListCentralsWithRoutesNotCreated method.
 	ListCentralsWithRoutesNotCreatedFunc func() ([]*dbapi.CentralRequest, *serviceError.ServiceError)
 
 	// ListCentralsWithoutAuthConfigFunc mocks the ListCentralsWithoutAuthConfig method.
 	ListCentralsWithoutAuthConfigFunc func() ([]*dbapi.CentralRequest, *serviceError.ServiceError)
 
+	// ListDynamicClientIDsFunc mocks the ListDynamicClientIDs method.
+	ListDynamicClientIDsFunc func() ([]string, *serviceError.ServiceError)
+
+	// ListKnownCentralIDsFunc mocks the ListKnownCentralIDs method.
+	ListKnownCentralIDsFunc func(clusterID string) ([]string, *serviceError.ServiceError)
+
 	// PrepareCentralRequestFunc mocks the PrepareCentralRequest method.
 	PrepareCentralRequestFunc func(centralRequest *dbapi.CentralRequest) *serviceError.ServiceError
 
@@ -194,14 +227,17 @@ type CentralServiceMock struct {
 	// RotateCentralRHSSOClientFunc mocks the RotateCentralRHSSOClient method.
 	RotateCentralRHSSOClientFunc func(ctx context.Context, centralRequest *dbapi.CentralRequest) *serviceError.ServiceError
 
+	// StartCentralRHSSOClientRotationFunc mocks the StartCentralRHSSOClientRotation method.
+	StartCentralRHSSOClientRotationFunc func(ctx context.Context, centralRequest *dbapi.CentralRequest) *serviceError.ServiceError
+
 	// UpdateIgnoreNilsFunc mocks the UpdateIgnoreNils method.
-	UpdateIgnoreNilsFunc func(centralRequest *dbapi.CentralRequest) *serviceError.ServiceError
+	UpdateIgnoreNilsFunc func(ctx context.Context, centralRequest *dbapi.CentralRequest) *serviceError.ServiceError
 
 	// UpdateStatusFunc mocks the UpdateStatus method.
-	UpdateStatusFunc func(id string, status constants.CentralStatus) (bool, *serviceError.ServiceError)
+	UpdateStatusFunc func(ctx context.Context, id string, status constants.CentralStatus) (bool, *serviceError.ServiceError)
 
 	// UpdatesFunc mocks the Updates method.
-	UpdatesFunc func(centralRequest *dbapi.CentralRequest, values map[string]interface{}) *serviceError.ServiceError
+	UpdatesFunc func(ctx context.Context, centralRequest *dbapi.CentralRequest, values map[string]interface{}) *serviceError.ServiceError
 
 	// VerifyAndUpdateCentralAdminFunc mocks the VerifyAndUpdateCentralAdmin method.
 	VerifyAndUpdateCentralAdminFunc func(ctx context.Context, centralRequest *dbapi.CentralRequest) *serviceError.ServiceError
@@ -257,6 +293,13 @@ type CentralServiceMock struct {
 			// SubscriptionID is the subscriptionID argument value.
 			SubscriptionID string
 		}
+		// CompleteCentralRHSSOClientRotation holds details about calls to the CompleteCentralRHSSOClientRotation method.
+		CompleteCentralRHSSOClientRotation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CentralRequest is the centralRequest argument value.
+			CentralRequest *dbapi.CentralRequest
+		}
 		// CountByRegionAndInstanceType holds details about calls to the CountByRegionAndInstanceType method.
 		CountByRegionAndInstanceType []struct {
 		}
@@ -267,6 +310,8 @@ type CentralServiceMock struct {
 		}
 		// Delete holds details about calls to the Delete method.
 		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// CentralRequest is the centralRequest argument value.
 			CentralRequest *dbapi.CentralRequest
 			// Force is the force argument value.
@@ -297,15 +342,19 @@ type CentralServiceMock struct {
 			// ID is the id argument value.
 			ID string
 		}
-		// GetCNAMERecordStatus holds details about calls to the GetCNAMERecordStatus method.
-		GetCNAMERecordStatus []struct {
+		// HasAvailableCapacityInRegion holds details about calls to the HasAvailableCapacityInRegion method.
+		HasAvailableCapacityInRegion []struct {
 			// CentralRequest is the centralRequest argument value.
 			CentralRequest *dbapi.CentralRequest
 		}
-		// HasAvailableCapacityInRegion holds details about calls to the HasAvailableCapacityInRegion method.
-		HasAvailableCapacityInRegion []struct {
+		// HasCentralRole holds details about calls to the HasCentralRole method.
+		HasCentralRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// CentralRequest is the centralRequest argument value.
 			CentralRequest *dbapi.CentralRequest
+			// Required is the required argument value.
+			Required dbapi.CentralRole
 		}
 		// List holds details about calls to the List method.
 		List []struct {
@@ -319,12 +368,28 @@ type CentralServiceMock struct {
 			// Status is the status argument value.
 			Status []constants.CentralStatus
 		}
+		// ListCentralsByHost holds details about calls to the ListCentralsByHost method.
+		ListCentralsByHost []struct {
+			// Host is the host argument value.
+			Host string
+		}
+		// ListCentralsWithDynamicClients holds details about calls to the ListCentralsWithDynamicClients method.
+		ListCentralsWithDynamicClients []struct {
+		}
 		// ListCentralsWithRoutesNotCreated holds details about calls to the ListCentralsWithRoutesNotCreated method.
 		ListCentralsWithRoutesNotCreated []struct {
 		}
 		// ListCentralsWithoutAuthConfig holds details about calls to the ListCentralsWithoutAuthConfig method.
 		ListCentralsWithoutAuthConfig []struct {
 		}
+		// ListDynamicClientIDs holds details about calls to the ListDynamicClientIDs method.
+		ListDynamicClientIDs []struct {
+		}
+		// ListKnownCentralIDs holds details about calls to the ListKnownCentralIDs method.
+		ListKnownCentralIDs []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
 		// PrepareCentralRequest holds details about calls to the PrepareCentralRequest method.
 		PrepareCentralRequest []struct {
 			// CentralRequest is the centralRequest argument value.
@@ -365,13 +430,24 @@ type CentralServiceMock struct {
 			// CentralRequest is the centralRequest argument value.
 			CentralRequest *dbapi.CentralRequest
 		}
+		// StartCentralRHSSOClientRotation holds details about calls to the StartCentralRHSSOClientRotation method.
+		StartCentralRHSSOClientRotation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CentralRequest is the centralRequest argument value.
+			CentralRequest *dbapi.CentralRequest
+		}
 		// UpdateIgnoreNils holds details about calls to the UpdateIgnoreNils method.
 		UpdateIgnoreNils []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// CentralRequest is the centralRequest argument value.
 			CentralRequest *dbapi.CentralRequest
 		}
 		// UpdateStatus holds details about calls to the UpdateStatus method.
 		UpdateStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// ID is the id argument value.
 			ID string
 			// Status is the status argument value.
@@ -379,6 +455,8 @@ type CentralServiceMock struct {
 		}
 		// Updates holds details about calls to the Updates method.
 		Updates []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// CentralRequest is the centralRequest argument value.
 			CentralRequest *dbapi.CentralRequest
 			// Values is the values argument value.
@@ -392,35 +470,41 @@ type CentralServiceMock struct {
 			CentralRequest *dbapi.CentralRequest
 		}
 	}
-	lockAcceptCentralRequest             sync.RWMutex
-	lockAssignCluster                    sync.RWMutex
-	lockChangeBillingParameters          sync.RWMutex
-	lockChangeCentralCNAMErecords        sync.RWMutex
-	lockChangeSubscription               sync.RWMutex
-	lockCountByRegionAndInstanceType     sync.RWMutex
-	lockCountByStatus                    sync.RWMutex
-	lockDelete                           sync.RWMutex
-	lockDeprovisionCentralForUsers       sync.RWMutex
-	lockDeprovisionExpiredCentrals       sync.RWMutex
-	lockDetectInstanceType               sync.RWMutex
-	lockGet                              sync.RWMutex
-	lockGetByID                          sync.RWMutex
-	lockGetCNAMERecordStatus             sync.RWMutex
-	lockHasAvailableCapacityInRegion     sync.RWMutex
-	lockList                             sync.RWMutex
-	lockListByStatus                     sync.RWMutex
-	lockListCentralsWithRoutesNotCreated sync.RWMutex
-	lockListCentralsWithoutAuthConfig    sync.RWMutex
-	lockPrepareCentralRequest            sync.RWMutex
-	lockRegisterCentralDeprovisionJob    sync.RWMutex
-	lockRegisterCentralJob               sync.RWMutex
-	lockResetCentralSecretBackup         sync.RWMutex
-	lockRestore                          sync.RWMutex
-	lockRotateCentralRHSSOClient         sync.RWMutex
-	lockUpdateIgnoreNils                 sync.RWMutex
-	lockUpdateStatus                     sync.RWMutex
-	lockUpdates                          sync.RWMutex
-	lockVerifyAndUpdateCentralAdmin      sync.RWMutex
+	lockAcceptCentralRequest               sync.RWMutex
+	lockAssignCluster                      sync.RWMutex
+	lockChangeBillingParameters            sync.RWMutex
+	lockChangeCentralCNAMErecords          sync.RWMutex
+	lockChangeSubscription                 sync.RWMutex
+	lockCompleteCentralRHSSOClientRotation sync.RWMutex
+	lockCountByRegionAndInstanceType       sync.RWMutex
+	lockCountByStatus                      sync.RWMutex
+	lockDelete                             sync.RWMutex
+	lockDeprovisionCentralForUsers         sync.RWMutex
+	lockDeprovisionExpiredCentrals         sync.RWMutex
+	lockDetectInstanceType                 sync.RWMutex
+	lockGet                                sync.RWMutex
+	lockGetByID                            sync.RWMutex
+	lockHasAvailableCapacityInRegion       sync.RWMutex
+	lockHasCentralRole                     sync.RWMutex
+	lockList                               sync.RWMutex
+	lockListByStatus                       sync.RWMutex
+	lockListCentralsByHost                 sync.RWMutex
+	lockListCentralsWithDynamicClients     sync.RWMutex
+	lockListCentralsWithRoutesNotCreated   sync.RWMutex
+	lockListCentralsWithoutAuthConfig      sync.RWMutex
+	lockListDynamicClientIDs               sync.RWMutex
+	lockListKnownCentralIDs                sync.RWMutex
+	lockPrepareCentralRequest              sync.RWMutex
+	lockRegisterCentralDeprovisionJob      sync.RWMutex
+	lockRegisterCentralJob                 sync.RWMutex
+	lockResetCentralSecretBackup           sync.RWMutex
+	lockRestore                            sync.RWMutex
+	lockRotateCentralRHSSOClient           sync.RWMutex
+	lockStartCentralRHSSOClientRotation    sync.RWMutex
+	lockUpdateIgnoreNils                   sync.RWMutex
+	lockUpdateStatus                       sync.RWMutex
+	lockUpdates                            sync.RWMutex
+	lockVerifyAndUpdateCentralAdmin        sync.RWMutex
 }
 
 // AcceptCentralRequest calls AcceptCentralRequestFunc.
@@ -548,7 +632,7 @@ func (mock *CentralServiceMock) ChangeBillingParametersCalls() []struct {
 }
 
 // ChangeCentralCNAMErecords calls ChangeCentralCNAMErecordsFunc.
-func (mock *CentralServiceMock) ChangeCentralCNAMErecords(centralRequest *dbapi.CentralRequest, action CentralRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *serviceError.ServiceError) {
+func (mock *CentralServiceMock) ChangeCentralCNAMErecords(centralRequest *dbapi.CentralRequest, action CentralRoutesAction) (*dns.Change, *serviceError.ServiceError) {
 	if mock.ChangeCentralCNAMErecordsFunc == nil {
 		panic("CentralServiceMock.ChangeCentralCNAMErecordsFunc: method is nil but CentralService.ChangeCentralCNAMErecords was just called")
 	}
@@ -631,6 +715,42 @@ func (mock *CentralServiceMock) ChangeSubscriptionCalls() []struct {
 	return calls
 }
 
+// CompleteCentralRHSSOClientRotation calls CompleteCentralRHSSOClientRotationFunc.
+func (mock *CentralServiceMock) CompleteCentralRHSSOClientRotation(ctx context.Context, centralRequest *dbapi.CentralRequest) *serviceError.ServiceError {
+	if mock.CompleteCentralRHSSOClientRotationFunc == nil {
+		panic("CentralServiceMock.CompleteCentralRHSSOClientRotationFunc: method is nil but CentralService.CompleteCentralRHSSOClientRotation was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		CentralRequest *dbapi.CentralRequest
+	}{
+		Ctx:            ctx,
+		CentralRequest: centralRequest,
+	}
+	mock.lockCompleteCentralRHSSOClientRotation.Lock()
+	mock.calls.CompleteCentralRHSSOClientRotation = append(mock.calls.CompleteCentralRHSSOClientRotation, callInfo)
+	mock.lockCompleteCentralRHSSOClientRotation.Unlock()
+	return mock.CompleteCentralRHSSOClientRotationFunc(ctx, centralRequest)
+}
+
+// CompleteCentralRHSSOClientRotationCalls gets all the calls that were made to CompleteCentralRHSSOClientRotation.
+// Check the length with:
+//
+//	len(mockedCentralService.CompleteCentralRHSSOClientRotationCalls())
+func (mock *CentralServiceMock) CompleteCentralRHSSOClientRotationCalls() []struct {
+	Ctx            context.Context
+	CentralRequest *dbapi.CentralRequest
+} {
+	var calls []struct {
+		Ctx            context.Context
+		CentralRequest *dbapi.CentralRequest
+	}
+	mock.lockCompleteCentralRHSSOClientRotation.RLock()
+	calls = mock.calls.CompleteCentralRHSSOClientRotation
+	mock.lockCompleteCentralRHSSOClientRotation.RUnlock()
+	return calls
+}
+
 // CountByRegionAndInstanceType calls CountByRegionAndInstanceTypeFunc.
 func (mock *CentralServiceMock) CountByRegionAndInstanceType() ([]CentralRegionCount, error) {
 	if mock.CountByRegionAndInstanceTypeFunc == nil {
@@ -691,21 +811,23 @@ func (mock *CentralServiceMock) CountByStatusCalls() []struct {
 }
 
 // Delete calls DeleteFunc.
-func (mock *CentralServiceMock) Delete(centralRequest *dbapi.CentralRequest, force bool) *serviceError.ServiceError {
+func (mock *CentralServiceMock) Delete(ctx context.Context, centralRequest *dbapi.CentralRequest, force bool) *serviceError.ServiceError {
 	if mock.DeleteFunc == nil {
 		panic("CentralServiceMock.DeleteFunc: method is nil but CentralService.Delete was just called")
 	}
 	callInfo := struct {
+		Ctx            context.Context
 		CentralRequest *dbapi.CentralRequest
 		Force          bool
 	}{
+		Ctx:            ctx,
 		CentralRequest: centralRequest,
 		Force:          force,
 	}
 	mock.lockDelete.Lock()
 	mock.calls.Delete = append(mock.calls.Delete, callInfo)
 	mock.lockDelete.Unlock()
-	return mock.DeleteFunc(centralRequest, force)
+	return mock.DeleteFunc(ctx, centralRequest, force)
 }
 
 // DeleteCalls gets all the calls that were made to Delete.
@@ -713,10 +835,12 @@ func (mock *CentralServiceMock) Delete(centralRequest *dbapi.CentralRequest, for
 //
 //	len(mockedCentralService.DeleteCalls())
 func (mock *CentralServiceMock) DeleteCalls() []struct {
+	Ctx            context.Context
 	CentralRequest *dbapi.CentralRequest
 	Force          bool
 } {
 	var calls []struct {
+		Ctx            context.Context
 		CentralRequest *dbapi.CentralRequest
 		Force          bool
 	}
@@ -885,67 +1009,75 @@ func (mock *CentralServiceMock) GetByIDCalls() []struct {
 	return calls
 }
 
-// GetCNAMERecordStatus calls GetCNAMERecordStatusFunc.
-func (mock *CentralServiceMock) GetCNAMERecordStatus(centralRequest *dbapi.CentralRequest) (*CNameRecordStatus, error) {
-	if mock.GetCNAMERecordStatusFunc == nil {
-		panic("CentralServiceMock.GetCNAMERecordStatusFunc: method is nil but CentralService.GetCNAMERecordStatus was just called")
+// HasAvailableCapacityInRegion calls HasAvailableCapacityInRegionFunc.
+func (mock *CentralServiceMock) HasAvailableCapacityInRegion(centralRequest *dbapi.CentralRequest) (bool, *serviceError.ServiceError) {
+	if mock.HasAvailableCapacityInRegionFunc == nil {
+		panic("CentralServiceMock.HasAvailableCapacityInRegionFunc: method is nil but CentralService.HasAvailableCapacityInRegion was just called")
 	}
 	callInfo := struct {
 		CentralRequest *dbapi.CentralRequest
 	}{
 		CentralRequest: centralRequest,
 	}
-	mock.lockGetCNAMERecordStatus.Lock()
-	mock.calls.GetCNAMERecordStatus = append(mock.calls.GetCNAMERecordStatus, callInfo)
-	mock.lockGetCNAMERecordStatus.Unlock()
-	return mock.GetCNAMERecordStatusFunc(centralRequest)
+	mock.lockHasAvailableCapacityInRegion.Lock()
+	mock.calls.HasAvailableCapacityInRegion = append(mock.calls.HasAvailableCapacityInRegion, callInfo)
+	mock.lockHasAvailableCapacityInRegion.Unlock()
+	return mock.HasAvailableCapacityInRegionFunc(centralRequest)
 }
 
-// GetCNAMERecordStatusCalls gets all the calls that were made to GetCNAMERecordStatus.
+// HasAvailableCapacityInRegionCalls gets all the calls that were made to HasAvailableCapacityInRegion.
 // Check the length with:
 //
-//	len(mockedCentralService.GetCNAMERecordStatusCalls())
-func (mock *CentralServiceMock) GetCNAMERecordStatusCalls() []struct {
+//	len(mockedCentralService.HasAvailableCapacityInRegionCalls())
+func (mock *CentralServiceMock) HasAvailableCapacityInRegionCalls() []struct {
 	CentralRequest *dbapi.CentralRequest
 } {
 	var calls []struct {
 		CentralRequest *dbapi.CentralRequest
 	}
-	mock.lockGetCNAMERecordStatus.RLock()
-	calls = mock.calls.GetCNAMERecordStatus
-	mock.lockGetCNAMERecordStatus.RUnlock()
+	mock.lockHasAvailableCapacityInRegion.RLock()
+	calls = mock.calls.HasAvailableCapacityInRegion
+	mock.lockHasAvailableCapacityInRegion.RUnlock()
 	return calls
 }
 
-// HasAvailableCapacityInRegion calls HasAvailableCapacityInRegionFunc.
-func (mock *CentralServiceMock) HasAvailableCapacityInRegion(centralRequest *dbapi.CentralRequest) (bool, *serviceError.ServiceError) {
-	if mock.HasAvailableCapacityInRegionFunc == nil {
-		panic("CentralServiceMock.HasAvailableCapacityInRegionFunc: method is nil but CentralService.HasAvailableCapacityInRegion was just called")
+// HasCentralRole calls HasCentralRoleFunc.
+func (mock *CentralServiceMock) HasCentralRole(ctx context.Context, centralRequest *dbapi.CentralRequest, required dbapi.CentralRole) (bool, *serviceError.ServiceError) {
+	if mock.HasCentralRoleFunc == nil {
+		panic("CentralServiceMock.HasCentralRoleFunc: method is nil but CentralService.HasCentralRole was just called")
 	}
 	callInfo := struct {
+		Ctx            context.Context
 		CentralRequest *dbapi.CentralRequest
+		Required       dbapi.CentralRole
 	}{
+		Ctx:            ctx,
 		CentralRequest: centralRequest,
+		Required:       required,
 	}
-	mock.lockHasAvailableCapacityInRegion.Lock()
-	mock.calls.HasAvailableCapacityInRegion = append(mock.calls.HasAvailableCapacityInRegion, callInfo)
-	mock.lockHasAvailableCapacityInRegion.Unlock()
-	return mock.HasAvailableCapacityInRegionFunc(centralRequest)
+	mock.lockHasCentralRole.Lock()
+	mock.calls.HasCentralRole = append(mock.calls.HasCentralRole, callInfo)
+	mock.lockHasCentralRole.Unlock()
+	return mock.HasCentralRoleFunc(ctx, centralRequest, required)
 }
 
-// HasAvailableCapacityInRegionCalls gets all the calls that were made to HasAvailableCapacityInRegion.
+// HasCentralRoleCalls gets all the calls that were made to HasCentralRole.
 // Check the length with:
 //
-//	len(mockedCentralService.HasAvailableCapacityInRegionCalls())
-func (mock *CentralServiceMock) HasAvailableCapacityInRegionCalls() []struct {
+//	len(mockedCentralService.HasCentralRoleCalls())
+func (mock *CentralServiceMock) HasCentralRoleCalls() []struct {
+	Ctx            context.Context
 	CentralRequest *dbapi.CentralRequest
+	Required       dbapi.CentralRole
 } {
 	var calls []struct {
+		Ctx            context.Context
 		CentralRequest *dbapi.CentralRequest
+		Required       dbapi.CentralRole
 	}
-	mock.lockHasAvailableCapacityInRegion.RLock()
-	calls = mock.calls.HasAvailableCapacityInRegion
-	mock.lockHasAvailableCapacityInRegion.RUnlock()
+	mock.lockHasCentralRole.RLock()
+	calls = mock.calls.HasCentralRole
+	mock.lockHasCentralRole.RUnlock()
 	return calls
 }
 
@@ -1017,6 +1149,65 @@ func (mock *CentralServiceMock) ListByStatusCalls() []struct {
 	return calls
 }
 
+// ListCentralsByHost calls ListCentralsByHostFunc.
+func (mock *CentralServiceMock) ListCentralsByHost(host string) ([]*dbapi.CentralRequest, *serviceError.ServiceError) {
+	if mock.ListCentralsByHostFunc == nil {
+		panic("CentralServiceMock.ListCentralsByHostFunc: method is nil but CentralService.ListCentralsByHost was just called")
+	}
+	callInfo := struct {
+		Host string
+	}{
+		Host: host,
+	}
+	mock.lockListCentralsByHost.Lock()
+	mock.calls.ListCentralsByHost = append(mock.calls.ListCentralsByHost, callInfo)
+	mock.lockListCentralsByHost.Unlock()
+	return mock.ListCentralsByHostFunc(host)
+}
+
+// ListCentralsByHostCalls gets all the calls that were made to ListCentralsByHost.
+// Check the length with:
+//
+//	len(mockedCentralService.ListCentralsByHostCalls())
+func (mock *CentralServiceMock) ListCentralsByHostCalls() []struct {
+	Host string
+} {
+	var calls []struct {
+		Host string
+	}
+	mock.lockListCentralsByHost.RLock()
+	calls = mock.calls.ListCentralsByHost
+	mock.lockListCentralsByHost.RUnlock()
+	return calls
+}
+
+// ListCentralsWithDynamicClients calls ListCentralsWithDynamicClientsFunc.
+func (mock *CentralServiceMock) ListCentralsWithDynamicClients() ([]*dbapi.CentralRequest, *serviceError.ServiceError) {
+	if mock.ListCentralsWithDynamicClientsFunc == nil {
+		panic("CentralServiceMock.ListCentralsWithDynamicClientsFunc: method is nil but CentralService.ListCentralsWithDynamicClients was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListCentralsWithDynamicClients.Lock()
+	mock.calls.ListCentralsWithDynamicClients = append(mock.calls.ListCentralsWithDynamicClients, callInfo)
+	mock.lockListCentralsWithDynamicClients.Unlock()
+	return mock.ListCentralsWithDynamicClientsFunc()
+}
+
+// ListCentralsWithDynamicClientsCalls gets all the calls that were made to ListCentralsWithDynamicClients.
+// Check the length with:
+//
+//	len(mockedCentralService.ListCentralsWithDynamicClientsCalls())
+func (mock *CentralServiceMock) ListCentralsWithDynamicClientsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListCentralsWithDynamicClients.RLock()
+	calls = mock.calls.ListCentralsWithDynamicClients
+	mock.lockListCentralsWithDynamicClients.RUnlock()
+	return calls
+}
+
 // ListCentralsWithRoutesNotCreated calls ListCentralsWithRoutesNotCreatedFunc.
 func (mock *CentralServiceMock) ListCentralsWithRoutesNotCreated() ([]*dbapi.CentralRequest, *serviceError.ServiceError) {
 	if mock.ListCentralsWithRoutesNotCreatedFunc == nil {
@@ -1071,6 +1262,65 @@ func (mock *CentralServiceMock) ListCentralsWithoutAuthConfigCalls() []struct {
 	return calls
 }
 
+// ListDynamicClientIDs calls ListDynamicClientIDsFunc.
+func (mock *CentralServiceMock) ListDynamicClientIDs() ([]string, *serviceError.ServiceError) {
+	if mock.ListDynamicClientIDsFunc == nil {
+		panic("CentralServiceMock.ListDynamicClientIDsFunc: method is nil but CentralService.ListDynamicClientIDs was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListDynamicClientIDs.Lock()
+	mock.calls.ListDynamicClientIDs = append(mock.calls.ListDynamicClientIDs, callInfo)
+	mock.lockListDynamicClientIDs.Unlock()
+	return mock.ListDynamicClientIDsFunc()
+}
+
+// ListDynamicClientIDsCalls gets all the calls that were made to ListDynamicClientIDs.
+// Check the length with:
+//
+//	len(mockedCentralService.ListDynamicClientIDsCalls())
+func (mock *CentralServiceMock) ListDynamicClientIDsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListDynamicClientIDs.RLock()
+	calls = mock.calls.ListDynamicClientIDs
+	mock.lockListDynamicClientIDs.RUnlock()
+	return calls
+}
+
+// ListKnownCentralIDs calls ListKnownCentralIDsFunc.
+func (mock *CentralServiceMock) ListKnownCentralIDs(clusterID string) ([]string, *serviceError.ServiceError) {
+	if mock.ListKnownCentralIDsFunc == nil {
+		panic("CentralServiceMock.ListKnownCentralIDsFunc: method is nil but CentralService.ListKnownCentralIDs was just called")
+	}
+	callInfo := struct {
+		ClusterID string
+	}{
+		ClusterID: clusterID,
+	}
+	mock.lockListKnownCentralIDs.Lock()
+	mock.calls.ListKnownCentralIDs = append(mock.calls.ListKnownCentralIDs, callInfo)
+	mock.lockListKnownCentralIDs.Unlock()
+	return mock.ListKnownCentralIDsFunc(clusterID)
+}
+
+// ListKnownCentralIDsCalls gets all the calls that were made to ListKnownCentralIDs.
+// Check the length with:
+//
+//	len(mockedCentralService.ListKnownCentralIDsCalls())
+func (mock *CentralServiceMock) ListKnownCentralIDsCalls() []struct {
+	ClusterID string
+} {
+	var calls []struct {
+		ClusterID string
+	}
+	mock.lockListKnownCentralIDs.RLock()
+	calls = mock.calls.ListKnownCentralIDs
+	mock.lockListKnownCentralIDs.RUnlock()
+	return calls
+}
+
 // PrepareCentralRequest calls PrepareCentralRequestFunc.
 func (mock *CentralServiceMock) PrepareCentralRequest(centralRequest *dbapi.CentralRequest) *serviceError.ServiceError {
 	if mock.PrepareCentralRequestFunc == nil {
@@ -1283,20 +1533,58 @@ func (mock *CentralServiceMock) RotateCentralRHSSOClientCalls() []struct {
 	return calls
 }
 
+// StartCentralRHSSOClientRotation calls StartCentralRHSSOClientRotationFunc.
+func (mock *CentralServiceMock) StartCentralRHSSOClientRotation(ctx context.Context, centralRequest *dbapi.CentralRequest) *serviceError.ServiceError {
+	if mock.StartCentralRHSSOClientRotationFunc == nil {
+		panic("CentralServiceMock.StartCentralRHSSOClientRotationFunc: method is nil but CentralService.StartCentralRHSSOClientRotation was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		CentralRequest *dbapi.CentralRequest
+	}{
+		Ctx:            ctx,
+		CentralRequest: centralRequest,
+	}
+	mock.lockStartCentralRHSSOClientRotation.Lock()
+	mock.calls.StartCentralRHSSOClientRotation = append(mock.calls.StartCentralRHSSOClientRotation, callInfo)
+	mock.lockStartCentralRHSSOClientRotation.Unlock()
+	return mock.StartCentralRHSSOClientRotationFunc(ctx, centralRequest)
+}
+
+// StartCentralRHSSOClientRotationCalls gets all the calls that were made to StartCentralRHSSOClientRotation.
+// Check the length with:
+//
+//	len(mockedCentralService.StartCentralRHSSOClientRotationCalls())
+func (mock *CentralServiceMock) StartCentralRHSSOClientRotationCalls() []struct {
+	Ctx            context.Context
+	CentralRequest *dbapi.CentralRequest
+} {
+	var calls []struct {
+		Ctx            context.Context
+		CentralRequest *dbapi.CentralRequest
+	}
+	mock.lockStartCentralRHSSOClientRotation.RLock()
+	calls = mock.calls.StartCentralRHSSOClientRotation
+	mock.lockStartCentralRHSSOClientRotation.RUnlock()
+	return calls
+}
+
 // UpdateIgnoreNils calls UpdateIgnoreNilsFunc.
-func (mock *CentralServiceMock) UpdateIgnoreNils(centralRequest *dbapi.CentralRequest) *serviceError.ServiceError {
+func (mock *CentralServiceMock) UpdateIgnoreNils(ctx context.Context, centralRequest *dbapi.CentralRequest) *serviceError.ServiceError {
 	if mock.UpdateIgnoreNilsFunc == nil {
 		panic("CentralServiceMock.UpdateIgnoreNilsFunc: method is nil but CentralService.UpdateIgnoreNils was just called")
 	}
 	callInfo := struct {
+		Ctx            context.Context
 		CentralRequest *dbapi.CentralRequest
 	}{
+		Ctx:            ctx,
 		CentralRequest: centralRequest,
 	}
 	mock.lockUpdateIgnoreNils.Lock()
 	mock.calls.UpdateIgnoreNils = append(mock.calls.UpdateIgnoreNils, callInfo)
 	mock.lockUpdateIgnoreNils.Unlock()
-	return mock.UpdateIgnoreNilsFunc(centralRequest)
+	return mock.UpdateIgnoreNilsFunc(ctx, centralRequest)
 }
 
 // UpdateIgnoreNilsCalls gets all the calls that were made to UpdateIgnoreNils.
@@ -1304,9 +1592,11 @@ func (mock *CentralServiceMock) UpdateIgnoreNils(centralRequest *dbapi.CentralRe
 //
 //	len(mockedCentralService.UpdateIgnoreNilsCalls())
 func (mock *CentralServiceMock) UpdateIgnoreNilsCalls() []struct {
+	Ctx            context.Context
 	CentralRequest *dbapi.CentralRequest
 } {
 	var calls []struct {
+		Ctx            context.Context
 		CentralRequest *dbapi.CentralRequest
 	}
 	mock.lockUpdateIgnoreNils.RLock()
@@ -1316,21 +1606,23 @@ func (mock *CentralServiceMock) UpdateIgnoreNilsCalls() []struct {
 }
 
 // UpdateStatus calls UpdateStatusFunc.
-func (mock *CentralServiceMock) UpdateStatus(id string, status constants.CentralStatus) (bool, *serviceError.ServiceError) {
+func (mock *CentralServiceMock) UpdateStatus(ctx context.Context, id string, status constants.CentralStatus) (bool, *serviceError.ServiceError) {
 	if mock.UpdateStatusFunc == nil {
 		panic("CentralServiceMock.UpdateStatusFunc: method is nil but CentralService.UpdateStatus was just called")
 	}
 	callInfo := struct {
+		Ctx    context.Context
 		ID     string
 		Status constants.CentralStatus
 	}{
+		Ctx:    ctx,
 		ID:     id,
 		Status: status,
 	}
 	mock.lockUpdateStatus.Lock()
 	mock.calls.UpdateStatus = append(mock.calls.UpdateStatus, callInfo)
 	mock.lockUpdateStatus.Unlock()
-	return mock.UpdateStatusFunc(id, status)
+	return mock.UpdateStatusFunc(ctx, id, status)
 }
 
 // UpdateStatusCalls gets all the calls that were made to UpdateStatus.
@@ -1338,10 +1630,12 @@ func (mock *CentralServiceMock) UpdateStatus(id string, status constants.Central
 //
 //	len(mockedCentralService.UpdateStatusCalls())
 func (mock *CentralServiceMock) UpdateStatusCalls() []struct {
+	Ctx    context.Context
 	ID     string
 	Status constants.CentralStatus
 } {
 	var calls []struct {
+		Ctx    context.Context
 		ID     string
 		Status constants.CentralStatus
 	}
@@ -1352,21 +1646,23 @@ func (mock *CentralServiceMock) UpdateStatusCalls() []struct {
 }
 
 // Updates calls UpdatesFunc.
-func (mock *CentralServiceMock) Updates(centralRequest *dbapi.CentralRequest, values map[string]interface{}) *serviceError.ServiceError {
+func (mock *CentralServiceMock) Updates(ctx context.Context, centralRequest *dbapi.CentralRequest, values map[string]interface{}) *serviceError.ServiceError {
 	if mock.UpdatesFunc == nil {
 		panic("CentralServiceMock.UpdatesFunc: method is nil but CentralService.Updates was just called")
 	}
 	callInfo := struct {
+		Ctx            context.Context
 		CentralRequest *dbapi.CentralRequest
 		Values         map[string]interface{}
 	}{
+		Ctx:            ctx,
 		CentralRequest: centralRequest,
 		Values:         values,
 	}
 	mock.lockUpdates.Lock()
 	mock.calls.Updates = append(mock.calls.Updates, callInfo)
 	mock.lockUpdates.Unlock()
-	return mock.UpdatesFunc(centralRequest, values)
+	return mock.UpdatesFunc(ctx, centralRequest, values)
 }
 
 // UpdatesCalls gets all the calls that were made to Updates.
@@ -1374,10 +1670,12 @@ func (mock *CentralServiceMock) Updates(centralRequest *dbapi.CentralRequest, va
 //
 //	len(mockedCentralService.UpdatesCalls())
 func (mock *CentralServiceMock) UpdatesCalls() []struct {
+	Ctx            context.Context
 	CentralRequest *dbapi.CentralRequest
 	Values         map[string]interface{}
 } {
 	var calls []struct {
+		Ctx            context.Context
 		CentralRequest *dbapi.CentralRequest
 		Values         map[string]interface{}
 	}
@@ -0,0 +1,237 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/internal/central/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/services"
+	"gorm.io/gorm"
+)
+
+// DefaultClusterDrainConcurrency is the number of tenants moved at the same time if the drain request does not specify it
+const DefaultClusterDrainConcurrency = 3
+
+// drainedCentralStatuses are the states of tenants a drain waits for. Tenants which are being deleted leave the
+// cluster on their own and failed tenants cannot be reassigned.
+var drainedCentralStatuses = []string{
+	constants.CentralRequestStatusAccepted.String(),
+	constants.CentralRequestStatusPreparing.String(),
+	constants.CentralRequestStatusProvisioning.String(),
+	constants.CentralRequestStatusReady.String(),
+}
+
+// ClusterDrainService persists dataplane cluster drains. The tenants are moved by the cluster drain worker.
+//
+//go:generate moq -out cluster_drain_moq.go . ClusterDrainService
+type ClusterDrainService interface {
+	// Start cordons the cluster and creates a running drain for it
+	Start(ctx context.Context, clusterID string, concurrency int) (*dbapi.ClusterDrain, *serviceError.ServiceError)
+	// Get returns the drain including the tenants it moved
+	Get(id string) (*dbapi.ClusterDrain, *serviceError.ServiceError)
+	// List returns the drains of the cluster, most recent first
+	List(clusterID string) ([]*dbapi.ClusterDrain, *serviceError.ServiceError)
+	// ListActive returns all running and paused drains including the tenants they moved
+	ListActive() ([]*dbapi.ClusterDrain, *serviceError.ServiceError)
+	// Pause stops the drain from moving further tenants. Tenants already being moved are still tracked.
+	Pause(id string) (*dbapi.ClusterDrain, *serviceError.ServiceError)
+	// Resume continues a paused drain
+	Resume(id string) (*dbapi.ClusterDrain, *serviceError.ServiceError)
+	// Cancel stops the drain for good. The cluster stays cordoned until an admin makes it schedulable again.
+	Cancel(id string) (*dbapi.ClusterDrain, *serviceError.ServiceError)
+	// Finish sets the final status of a drain which has no tenants left to move
+	Finish(drain *dbapi.ClusterDrain, status dbapi.ClusterDrainStatus) *serviceError.ServiceError
+	// SaveCentral creates or updates the progress of a tenant moved by a drain
+	SaveCentral(drainCentral *dbapi.ClusterDrainCentral) *serviceError.ServiceError
+	// ListCentralsToDrain returns the tenants on the cluster which have to be moved before the drain is complete
+	ListCentralsToDrain(clusterID string) ([]*dbapi.CentralRequest, *serviceError.ServiceError)
+}
+
+type clusterDrainService struct {
+	connectionFactory *db.ConnectionFactory
+	clusterService    ClusterService
+	now               func() time.Time
+}
+
+// NewClusterDrainService ...
+func NewClusterDrainService(connectionFactory *db.ConnectionFactory, clusterService ClusterService) ClusterDrainService {
+	return &clusterDrainService{
+		connectionFactory: connectionFactory,
+		clusterService:    clusterService,
+		now:               time.Now,
+	}
+}
+
+// Start ...
+func (s *clusterDrainService) Start(ctx context.Context, clusterID string, concurrency int) (*dbapi.ClusterDrain, *serviceError.ServiceError) {
+	if concurrency < 0 {
+		return nil, serviceError.Validation("concurrency must not be negative")
+	}
+	if concurrency == 0 {
+		concurrency = DefaultClusterDrainConcurrency
+	}
+
+	cluster, svcErr := s.clusterService.FindClusterByID(clusterID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if cluster == nil {
+		return nil, serviceError.NotFound("cluster %q not found", clusterID)
+	}
+
+	var active int64
+	if err := s.connectionFactory.New().Model(&dbapi.ClusterDrain{}).
+		Where("cluster_id = ? AND status IN ?", clusterID, dbapi.ActiveClusterDrainStatuses).
+		Count(&active).Error; err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to look up drains of cluster %s", clusterID)
+	}
+	if active > 0 {
+		return nil, serviceError.Conflict("cluster %q is already being drained", clusterID)
+	}
+
+	// Gorm does not update fields set to their zero value when passing a struct
+	if svcErr := s.clusterService.Updates(*cluster, map[string]interface{}{"schedulable": false, "cordoned": true}); svcErr != nil {
+		return nil, svcErr
+	}
+
+	drain := &dbapi.ClusterDrain{
+		ClusterID:   clusterID,
+		Status:      dbapi.ClusterDrainStatusRunning,
+		Concurrency: concurrency,
+	}
+	drain.ID = api.NewID()
+	if claims, err := auth.GetClaimsFromContext(ctx); err == nil {
+		drain.Owner, _ = claims.GetUsername()
+	}
+	if err := s.connectionFactory.New().Create(drain).Error; err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to create drain of cluster %s", clusterID)
+	}
+	glog.Infof("Started drain %s of cluster %s with concurrency %d", drain.ID, clusterID, concurrency)
+	return drain, nil
+}
+
+// Get ...
+func (s *clusterDrainService) Get(id string) (*dbapi.ClusterDrain, *serviceError.ServiceError) {
+	if id == "" {
+		return nil, serviceError.Validation("id is undefined")
+	}
+	var drain dbapi.ClusterDrain
+	if err := s.withCentrals().Where("id = ?", id).First(&drain).Error; err != nil {
+		return nil, services.HandleGetError("ClusterDrain", "id", id, err)
+	}
+	return &drain, nil
+}
+
+// List ...
+func (s *clusterDrainService) List(clusterID string) ([]*dbapi.ClusterDrain, *serviceError.ServiceError) {
+	var drains []*dbapi.ClusterDrain
+	if err := s.withCentrals().Where("cluster_id = ?", clusterID).Order("created_at DESC").Find(&drains).Error; err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to list drains of cluster %s", clusterID)
+	}
+	return drains, nil
+}
+
+// ListActive ...
+func (s *clusterDrainService) ListActive() ([]*dbapi.ClusterDrain, *serviceError.ServiceError) {
+	var drains []*dbapi.ClusterDrain
+	if err := s.withCentrals().Where("status IN ?", dbapi.ActiveClusterDrainStatuses).Order("created_at").Find(&drains).Error; err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to list active cluster drains")
+	}
+	return drains, nil
+}
+
+// Pause ...
+func (s *clusterDrainService) Pause(id string) (*dbapi.ClusterDrain, *serviceError.ServiceError) {
+	return s.transition(id, dbapi.ClusterDrainStatusPaused, dbapi.ClusterDrainStatusRunning)
+}
+
+// Resume ...
+func (s *clusterDrainService) Resume(id string) (*dbapi.ClusterDrain, *serviceError.ServiceError) {
+	return s.transition(id, dbapi.ClusterDrainStatusRunning, dbapi.ClusterDrainStatusPaused)
+}
+
+// Cancel ...
+func (s *clusterDrainService) Cancel(id string) (*dbapi.ClusterDrain, *serviceError.ServiceError) {
+	return s.transition(id, dbapi.ClusterDrainStatusCancelled, dbapi.ActiveClusterDrainStatuses...)
+}
+
+// Finish ...
+func (s *clusterDrainService) Finish(drain *dbapi.ClusterDrain, status dbapi.ClusterDrainStatus) *serviceError.ServiceError {
+	if status.IsActive() {
+		return serviceError.Validation("%q is not a final drain status", status)
+	}
+	if err := s.updateStatus(drain, status, dbapi.ActiveClusterDrainStatuses...); err != nil {
+		return err
+	}
+	glog.Infof("Drain %s of cluster %s finished with status %s", drain.ID, drain.ClusterID, status)
+	return nil
+}
+
+// SaveCentral ...
+func (s *clusterDrainService) SaveCentral(drainCentral *dbapi.ClusterDrainCentral) *serviceError.ServiceError {
+	if drainCentral.ID == "" {
+		drainCentral.ID = api.NewID()
+	}
+	if err := s.connectionFactory.New().Save(drainCentral).Error; err != nil {
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, err,
+			"unable to save progress of central %s in drain %s", drainCentral.CentralID, drainCentral.DrainID)
+	}
+	return nil
+}
+
+// ListCentralsToDrain ...
+func (s *clusterDrainService) ListCentralsToDrain(clusterID string) ([]*dbapi.CentralRequest, *serviceError.ServiceError) {
+	var centrals []*dbapi.CentralRequest
+	if err := s.connectionFactory.New().
+		Where("cluster_id = ? AND status IN ?", clusterID, drainedCentralStatuses).
+		Order("created_at").
+		Find(&centrals).Error; err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to list centrals of cluster %s", clusterID)
+	}
+	return centrals, nil
+}
+
+func (s *clusterDrainService) withCentrals() *gorm.DB {
+	return s.connectionFactory.New().Preload("Centrals", func(tx *gorm.DB) *gorm.DB {
+		return tx.Order("created_at")
+	})
+}
+
+func (s *clusterDrainService) transition(id string, to dbapi.ClusterDrainStatus, from ...dbapi.ClusterDrainStatus) (*dbapi.ClusterDrain, *serviceError.ServiceError) {
+	drain, svcErr := s.Get(id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if err := s.updateStatus(drain, to, from...); err != nil {
+		return nil, err
+	}
+	glog.Infof("Drain %s of cluster %s is now %s", drain.ID, drain.ClusterID, to)
+	return drain, nil
+}
+
+// updateStatus sets the status of the drain if its current status is one of from. The condition is part of the
+// update so concurrent requests and the drain worker cannot override each other.
+func (s *clusterDrainService) updateStatus(drain *dbapi.ClusterDrain, to dbapi.ClusterDrainStatus, from ...dbapi.ClusterDrainStatus) *serviceError.ServiceError {
+	values := map[string]interface{}{"status": to}
+	if !to.IsActive() {
+		drain.CompletedAt.Time, drain.CompletedAt.Valid = s.now(), true
+		values["completed_at"] = drain.CompletedAt
+	}
+	result := s.connectionFactory.New().Model(&dbapi.ClusterDrain{}).
+		Where("id = ? AND status IN ?", drain.ID, from).
+		Updates(values)
+	if result.Error != nil {
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, result.Error, "unable to update status of drain %s", drain.ID)
+	}
+	if result.RowsAffected == 0 {
+		return serviceError.Conflict("drain %s cannot change from status %q to %q", drain.ID, drain.Status, to)
+	}
+	drain.Status = to
+	return nil
+}
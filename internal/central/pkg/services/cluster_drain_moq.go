@@ -0,0 +1,484 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"context"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that ClusterDrainServiceMock does implement ClusterDrainService.
+// If this is not the case, regenerate this file with moq.
+var _ ClusterDrainService = &ClusterDrainServiceMock{}
+
+// ClusterDrainServiceMock is a mock implementation of ClusterDrainService.
+//
+//	func TestSomethingThatUsesClusterDrainService(t *testing.T) {
+//
+//		// make and configure a mocked ClusterDrainService
+//		mockedClusterDrainService := &ClusterDrainServiceMock{
+//			CancelFunc: func(id string) (*dbapi.ClusterDrain, *serviceError.ServiceError) {
+//				panic("mock out the Cancel method")
+//			},
+//			FinishFunc: func(drain *dbapi.ClusterDrain, status dbapi.ClusterDrainStatus) *serviceError.ServiceError {
+//				panic("mock out the Finish method")
+//			},
+//			GetFunc: func(id string) (*dbapi.ClusterDrain, *serviceError.ServiceError) {
+//				panic("mock out the Get method")
+//			},
+//			ListFunc: func(clusterID string) ([]*dbapi.ClusterDrain, *serviceError.ServiceError) {
+//				panic("mock out the List method")
+//			},
+//			ListActiveFunc: func() ([]*dbapi.ClusterDrain, *serviceError.ServiceError) {
+//				panic("mock out the ListActive method")
+//			},
+//			ListCentralsToDrainFunc: func(clusterID string) ([]*dbapi.CentralRequest, *serviceError.ServiceError) {
+//				panic("mock out the ListCentralsToDrain method")
+//			},
+//			PauseFunc: func(id string) (*dbapi.ClusterDrain, *serviceError.ServiceError) {
+//				panic("mock out the Pause method")
+//			},
+//			ResumeFunc: func(id string) (*dbapi.ClusterDrain, *serviceError.ServiceError) {
+//				panic("mock out the Resume method")
+//			},
+//			SaveCentralFunc: func(drainCentral *dbapi.ClusterDrainCentral) *serviceError.ServiceError {
+//				panic("mock out the SaveCentral method")
+//			},
+//			StartFunc: func(ctx context.Context, clusterID string, concurrency int) (*dbapi.ClusterDrain, *serviceError.ServiceError) {
+//				panic("mock out the Start method")
+//			},
+//		}
+//
+//		// use mockedClusterDrainService in code that requires ClusterDrainService
+//		// and then make assertions.
+//
+//	}
+type ClusterDrainServiceMock struct {
+	// CancelFunc mocks the Cancel method.
+	CancelFunc func(id string) (*dbapi.ClusterDrain, *serviceError.ServiceError)
+
+	// FinishFunc mocks the Finish method.
+	FinishFunc func(drain *dbapi.ClusterDrain, status dbapi.ClusterDrainStatus) *serviceError.ServiceError
+
+	// GetFunc mocks the Get method.
+	GetFunc func(id string) (*dbapi.ClusterDrain, *serviceError.ServiceError)
+
+	// ListFunc mocks the List method.
+	ListFunc func(clusterID string) ([]*dbapi.ClusterDrain, *serviceError.ServiceError)
+
+	// ListActiveFunc mocks the ListActive method.
+	ListActiveFunc func() ([]*dbapi.ClusterDrain, *serviceError.ServiceError)
+
+	// ListCentralsToDrainFunc mocks the ListCentralsToDrain method.
+	ListCentralsToDrainFunc func(clusterID string) ([]*dbapi.CentralRequest, *serviceError.ServiceError)
+
+	// PauseFunc mocks the Pause method.
+	PauseFunc func(id string) (*dbapi.ClusterDrain, *serviceError.ServiceError)
+
+	// ResumeFunc mocks the Resume method.
+	ResumeFunc func(id string) (*dbapi.ClusterDrain, *serviceError.ServiceError)
+
+	// SaveCentralFunc mocks the SaveCentral method.
+	SaveCentralFunc func(drainCentral *dbapi.ClusterDrainCentral) *serviceError.ServiceError
+
+	// StartFunc mocks the Start method.
+	StartFunc func(ctx context.Context, clusterID string, concurrency int) (*dbapi.ClusterDrain, *serviceError.ServiceError)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Cancel holds details about calls to the Cancel method.
+		Cancel []struct {
+			// ID is the id argument value.
+			ID string
+		}
+		// Finish holds details about calls to the Finish method.
+		Finish []struct {
+			// Drain is the drain argument value.
+			Drain *dbapi.ClusterDrain
+			// Status is the status argument value.
+			Status dbapi.ClusterDrainStatus
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// ID is the id argument value.
+			ID string
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
+		// ListActive holds details about calls to the ListActive method.
+		ListActive []struct {
+		}
+		// ListCentralsToDrain holds details about calls to the ListCentralsToDrain method.
+		ListCentralsToDrain []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
+		// Pause holds details about calls to the Pause method.
+		Pause []struct {
+			// ID is the id argument value.
+			ID string
+		}
+		// Resume holds details about calls to the Resume method.
+		Resume []struct {
+			// ID is the id argument value.
+			ID string
+		}
+		// SaveCentral holds details about calls to the SaveCentral method.
+		SaveCentral []struct {
+			// DrainCentral is the drainCentral argument value.
+			DrainCentral *dbapi.ClusterDrainCentral
+		}
+		// Start holds details about calls to the Start method.
+		Start []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// Concurrency is the concurrency argument value.
+			Concurrency int
+		}
+	}
+	lockCancel              sync.RWMutex
+	lockFinish              sync.RWMutex
+	lockGet                 sync.RWMutex
+	lockList                sync.RWMutex
+	lockListActive          sync.RWMutex
+	lockListCentralsToDrain sync.RWMutex
+	lockPause               sync.RWMutex
+	lockResume              sync.RWMutex
+	lockSaveCentral         sync.RWMutex
+	lockStart               sync.RWMutex
+}
+
+// Cancel calls CancelFunc.
+func (mock *ClusterDrainServiceMock) Cancel(id string) (*dbapi.ClusterDrain, *serviceError.ServiceError) {
+	if mock.CancelFunc == nil {
+		panic("ClusterDrainServiceMock.CancelFunc: method is nil but ClusterDrainService.Cancel was just called")
+	}
+	callInfo := struct {
+		ID string
+	}{
+		ID: id,
+	}
+	mock.lockCancel.Lock()
+	mock.calls.Cancel = append(mock.calls.Cancel, callInfo)
+	mock.lockCancel.Unlock()
+	return mock.CancelFunc(id)
+}
+
+// CancelCalls gets all the calls that were made to Cancel.
+// Check the length with:
+//
+//	len(mockedClusterDrainService.CancelCalls())
+func (mock *ClusterDrainServiceMock) CancelCalls() []struct {
+	ID string
+} {
+	var calls []struct {
+		ID string
+	}
+	mock.lockCancel.RLock()
+	calls = mock.calls.Cancel
+	mock.lockCancel.RUnlock()
+	return calls
+}
+
+// Finish calls FinishFunc.
+func (mock *ClusterDrainServiceMock) Finish(drain *dbapi.ClusterDrain, status dbapi.ClusterDrainStatus) *serviceError.ServiceError {
+	if mock.FinishFunc == nil {
+		panic("ClusterDrainServiceMock.FinishFunc: method is nil but ClusterDrainService.Finish was just called")
+	}
+	callInfo := struct {
+		Drain  *dbapi.ClusterDrain
+		Status dbapi.ClusterDrainStatus
+	}{
+		Drain:  drain,
+		Status: status,
+	}
+	mock.lockFinish.Lock()
+	mock.calls.Finish = append(mock.calls.Finish, callInfo)
+	mock.lockFinish.Unlock()
+	return mock.FinishFunc(drain, status)
+}
+
+// FinishCalls gets all the calls that were made to Finish.
+// Check the length with:
+//
+//	len(mockedClusterDrainService.FinishCalls())
+func (mock *ClusterDrainServiceMock) FinishCalls() []struct {
+	Drain  *dbapi.ClusterDrain
+	Status dbapi.ClusterDrainStatus
+} {
+	var calls []struct {
+		Drain  *dbapi.ClusterDrain
+		Status dbapi.ClusterDrainStatus
+	}
+	mock.lockFinish.RLock()
+	calls = mock.calls.Finish
+	mock.lockFinish.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *ClusterDrainServiceMock) Get(id string) (*dbapi.ClusterDrain, *serviceError.ServiceError) {
+	if mock.GetFunc == nil {
+		panic("ClusterDrainServiceMock.GetFunc: method is nil but ClusterDrainService.Get was just called")
+	}
+	callInfo := struct {
+		ID string
+	}{
+		ID: id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(id)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedClusterDrainService.GetCalls())
+func (mock *ClusterDrainServiceMock) GetCalls() []struct {
+	ID string
+} {
+	var calls []struct {
+		ID string
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// List calls ListFunc.
+func (mock *ClusterDrainServiceMock) List(clusterID string) ([]*dbapi.ClusterDrain, *serviceError.ServiceError) {
+	if mock.ListFunc == nil {
+		panic("ClusterDrainServiceMock.ListFunc: method is nil but ClusterDrainService.List was just called")
+	}
+	callInfo := struct {
+		ClusterID string
+	}{
+		ClusterID: clusterID,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(clusterID)
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedClusterDrainService.ListCalls())
+func (mock *ClusterDrainServiceMock) ListCalls() []struct {
+	ClusterID string
+} {
+	var calls []struct {
+		ClusterID string
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListActive calls ListActiveFunc.
+func (mock *ClusterDrainServiceMock) ListActive() ([]*dbapi.ClusterDrain, *serviceError.ServiceError) {
+	if mock.ListActiveFunc == nil {
+		panic("ClusterDrainServiceMock.ListActiveFunc: method is nil but ClusterDrainService.ListActive was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListActive.Lock()
+	mock.calls.ListActive = append(mock.calls.ListActive, callInfo)
+	mock.lockListActive.Unlock()
+	return mock.ListActiveFunc()
+}
+
+// ListActiveCalls gets all the calls that were made to ListActive.
+// Check the length with:
+//
+//	len(mockedClusterDrainService.ListActiveCalls())
+func (mock *ClusterDrainServiceMock) ListActiveCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListActive.RLock()
+	calls = mock.calls.ListActive
+	mock.lockListActive.RUnlock()
+	return calls
+}
+
+// ListCentralsToDrain calls ListCentralsToDrainFunc.
+func (mock *ClusterDrainServiceMock) ListCentralsToDrain(clusterID string) ([]*dbapi.CentralRequest, *serviceError.ServiceError) {
+	if mock.ListCentralsToDrainFunc == nil {
+		panic("ClusterDrainServiceMock.ListCentralsToDrainFunc: method is nil but ClusterDrainService.ListCentralsToDrain was just called")
+	}
+	callInfo := struct {
+		ClusterID string
+	}{
+		ClusterID: clusterID,
+	}
+	mock.lockListCentralsToDrain.Lock()
+	mock.calls.ListCentralsToDrain = append(mock.calls.ListCentralsToDrain, callInfo)
+	mock.lockListCentralsToDrain.Unlock()
+	return mock.ListCentralsToDrainFunc(clusterID)
+}
+
+// ListCentralsToDrainCalls gets all the calls that were made to ListCentralsToDrain.
+// Check the length with:
+//
+//	len(mockedClusterDrainService.ListCentralsToDrainCalls())
+func (mock *ClusterDrainServiceMock) ListCentralsToDrainCalls() []struct {
+	ClusterID string
+} {
+	var calls []struct {
+		ClusterID string
+	}
+	mock.lockListCentralsToDrain.RLock()
+	calls = mock.calls.ListCentralsToDrain
+	mock.lockListCentralsToDrain.RUnlock()
+	return calls
+}
+
+// Pause calls PauseFunc.
+func (mock *ClusterDrainServiceMock) Pause(id string) (*dbapi.ClusterDrain, *serviceError.ServiceError) {
+	if mock.PauseFunc == nil {
+		panic("ClusterDrainServiceMock.PauseFunc: method is nil but ClusterDrainService.Pause was just called")
+	}
+	callInfo := struct {
+		ID string
+	}{
+		ID: id,
+	}
+	mock.lockPause.Lock()
+	mock.calls.Pause = append(mock.calls.Pause, callInfo)
+	mock.lockPause.Unlock()
+	return mock.PauseFunc(id)
+}
+
+// PauseCalls gets all the calls that were made to Pause.
+// Check the length with:
+//
+//	len(mockedClusterDrainService.PauseCalls())
+func (mock *ClusterDrainServiceMock) PauseCalls() []struct {
+	ID string
+} {
+	var calls []struct {
+		ID string
+	}
+	mock.lockPause.RLock()
+	calls = mock.calls.Pause
+	mock.lockPause.RUnlock()
+	return calls
+}
+
+// Resume calls ResumeFunc.
+func (mock *ClusterDrainServiceMock) Resume(id string) (*dbapi.ClusterDrain, *serviceError.ServiceError) {
+	if mock.ResumeFunc == nil {
+		panic("ClusterDrainServiceMock.ResumeFunc: method is nil but ClusterDrainService.Resume was just called")
+	}
+	callInfo := struct {
+		ID string
+	}{
+		ID: id,
+	}
+	mock.lockResume.Lock()
+	mock.calls.Resume = append(mock.calls.Resume, callInfo)
+	mock.lockResume.Unlock()
+	return mock.ResumeFunc(id)
+}
+
+// ResumeCalls gets all the calls that were made to Resume.
+// Check the length with:
+//
+//	len(mockedClusterDrainService.ResumeCalls())
+func (mock *ClusterDrainServiceMock) ResumeCalls() []struct {
+	ID string
+} {
+	var calls []struct {
+		ID string
+	}
+	mock.lockResume.RLock()
+	calls = mock.calls.Resume
+	mock.lockResume.RUnlock()
+	return calls
+}
+
+// SaveCentral calls SaveCentralFunc.
+func (mock *ClusterDrainServiceMock) SaveCentral(drainCentral *dbapi.ClusterDrainCentral) *serviceError.ServiceError {
+	if mock.SaveCentralFunc == nil {
+		panic("ClusterDrainServiceMock.SaveCentralFunc: method is nil but ClusterDrainService.SaveCentral was just called")
+	}
+	callInfo := struct {
+		DrainCentral *dbapi.ClusterDrainCentral
+	}{
+		DrainCentral: drainCentral,
+	}
+	mock.lockSaveCentral.Lock()
+	mock.calls.SaveCentral = append(mock.calls.SaveCentral, callInfo)
+	mock.lockSaveCentral.Unlock()
+	return mock.SaveCentralFunc(drainCentral)
+}
+
+// SaveCentralCalls gets all the calls that were made to SaveCentral.
+// Check the length with:
+//
+//	len(mockedClusterDrainService.SaveCentralCalls())
+func (mock *ClusterDrainServiceMock) SaveCentralCalls() []struct {
+	DrainCentral *dbapi.ClusterDrainCentral
+} {
+	var calls []struct {
+		DrainCentral *dbapi.ClusterDrainCentral
+	}
+	mock.lockSaveCentral.RLock()
+	calls = mock.calls.SaveCentral
+	mock.lockSaveCentral.RUnlock()
+	return calls
+}
+
+// Start calls StartFunc.
+func (mock *ClusterDrainServiceMock) Start(ctx context.Context, clusterID string, concurrency int) (*dbapi.ClusterDrain, *serviceError.ServiceError) {
+	if mock.StartFunc == nil {
+		panic("ClusterDrainServiceMock.StartFunc: method is nil but ClusterDrainService.Start was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		ClusterID   string
+		Concurrency int
+	}{
+		Ctx:         ctx,
+		ClusterID:   clusterID,
+		Concurrency: concurrency,
+	}
+	mock.lockStart.Lock()
+	mock.calls.Start = append(mock.calls.Start, callInfo)
+	mock.lockStart.Unlock()
+	return mock.StartFunc(ctx, clusterID, concurrency)
+}
+
+// StartCalls gets all the calls that were made to Start.
+// Check the length with:
+//
+//	len(mockedClusterDrainService.StartCalls())
+func (mock *ClusterDrainServiceMock) StartCalls() []struct {
+	Ctx         context.Context
+	ClusterID   string
+	Concurrency int
+} {
+	var calls []struct {
+		Ctx         context.Context
+		ClusterID   string
+		Concurrency int
+	}
+	mock.lockStart.RLock()
+	calls = mock.calls.Start
+	mock.lockStart.RUnlock()
+	return calls
+}
@@ -0,0 +1,126 @@
+package services
+
+import (
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// clusterReconcileErrorPenalty is the health score subtracted for every failed Central reconciliation
+	clusterReconcileErrorPenalty = 10
+	// clusterMaxReconcileErrorPenalty caps the penalty for failed reconciliations, so that a cluster which keeps
+	// sending heartbeats scores higher than a silent one
+	clusterMaxReconcileErrorPenalty = 60
+)
+
+// ClusterHealth is the health of a dataplane cluster derived from the heartbeats of its fleetshard-sync
+type ClusterHealth struct {
+	Heartbeat dbapi.ClusterHeartbeat
+	// Score ranges from 0 for silent clusters to 100 for clusters with a recent heartbeat and no reconcile errors
+	Score int
+	// Silent is set if fleetshard-sync did not send a heartbeat within the configured timeout
+	Silent bool
+	// Healthy is set if the score is high enough to place Centrals on the cluster
+	Healthy bool
+}
+
+// ClusterHealthService records the heartbeats of fleetshard-sync and derives the health of dataplane clusters
+//
+//go:generate moq -out cluster_health_moq.go . ClusterHealthService
+type ClusterHealthService interface {
+	// RecordHeartbeat records a request of fleetshard-sync on the cluster
+	RecordHeartbeat(clusterID string, fleetshardVersion string, reconcileErrors int) *serviceError.ServiceError
+	// ListHealth returns the health of all clusters which sent a heartbeat, keyed by cluster ID.
+	// Clusters without heartbeat are missing, as their fleetshard-sync might not be deployed yet.
+	ListHealth() (map[string]*ClusterHealth, *serviceError.ServiceError)
+	// SetUnscheduledAsSilent records whether the cluster was made unschedulable because it is silent
+	SetUnscheduledAsSilent(clusterID string, unscheduled bool) *serviceError.ServiceError
+}
+
+type clusterHealthService struct {
+	connectionFactory      *db.ConnectionFactory
+	dataplaneClusterConfig *config.DataplaneClusterConfig
+	now                    func() time.Time
+}
+
+// NewClusterHealthService ...
+func NewClusterHealthService(connectionFactory *db.ConnectionFactory, dataplaneClusterConfig *config.DataplaneClusterConfig) ClusterHealthService {
+	return &clusterHealthService{
+		connectionFactory:      connectionFactory,
+		dataplaneClusterConfig: dataplaneClusterConfig,
+		now:                    time.Now,
+	}
+}
+
+// RecordHeartbeat ...
+func (s *clusterHealthService) RecordHeartbeat(clusterID string, fleetshardVersion string, reconcileErrors int) *serviceError.ServiceError {
+	heartbeat := &dbapi.ClusterHeartbeat{
+		Meta:              api.Meta{ID: api.NewID()},
+		ClusterID:         clusterID,
+		FleetshardVersion: fleetshardVersion,
+		ReconcileErrors:   reconcileErrors,
+		LastSeenAt:        s.now(),
+	}
+	dbConn := s.connectionFactory.New().Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "cluster_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"updated_at", "fleetshard_version", "reconcile_errors", "last_seen_at"}),
+	})
+	if err := dbConn.Create(heartbeat).Error; err != nil {
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to record heartbeat of cluster %s", clusterID)
+	}
+	return nil
+}
+
+// ListHealth ...
+func (s *clusterHealthService) ListHealth() (map[string]*ClusterHealth, *serviceError.ServiceError) {
+	var heartbeats []dbapi.ClusterHeartbeat
+	if err := s.connectionFactory.New().Find(&heartbeats).Error; err != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to list cluster heartbeats")
+	}
+
+	now := s.now()
+	result := make(map[string]*ClusterHealth, len(heartbeats))
+	for _, heartbeat := range heartbeats {
+		score, silent := clusterHealthScore(heartbeat, now, s.dataplaneClusterConfig.ClusterHeartbeatTimeout)
+		result[heartbeat.ClusterID] = &ClusterHealth{
+			Heartbeat: heartbeat,
+			Score:     score,
+			Silent:    silent,
+			Healthy:   !silent && score >= s.dataplaneClusterConfig.ClusterMinHealthScore,
+		}
+	}
+	return result, nil
+}
+
+// SetUnscheduledAsSilent ...
+func (s *clusterHealthService) SetUnscheduledAsSilent(clusterID string, unscheduled bool) *serviceError.ServiceError {
+	err := s.connectionFactory.New().Model(&dbapi.ClusterHeartbeat{}).
+		Where("cluster_id = ?", clusterID).
+		Update("unscheduled_as_silent", unscheduled).Error
+	if err != nil {
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "unable to update heartbeat of cluster %s", clusterID)
+	}
+	return nil
+}
+
+// clusterHealthScore scores a cluster by the age of its last heartbeat and the reconcile errors reported with it.
+// The score starts decreasing once the heartbeat is older than half the timeout and reaches 0 at the timeout.
+func clusterHealthScore(heartbeat dbapi.ClusterHeartbeat, now time.Time, timeout time.Duration) (int, bool) {
+	age := now.Sub(heartbeat.LastSeenAt)
+	if age >= timeout {
+		return 0, true
+	}
+
+	score := 100
+	if half := timeout / 2; age > half {
+		score = int(100 * (timeout - age) / (timeout - half))
+	}
+	score -= min(heartbeat.ReconcileErrors*clusterReconcileErrorPenalty, clusterMaxReconcileErrorPenalty)
+	return max(score, 0), false
+}
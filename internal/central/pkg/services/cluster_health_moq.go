@@ -0,0 +1,174 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that ClusterHealthServiceMock does implement ClusterHealthService.
+// If this is not the case, regenerate this file with moq.
+var _ ClusterHealthService = &ClusterHealthServiceMock{}
+
+// ClusterHealthServiceMock is a mock implementation of ClusterHealthService.
+//
+//	func TestSomethingThatUsesClusterHealthService(t *testing.T) {
+//
+//		// make and configure a mocked ClusterHealthService
+//		mockedClusterHealthService := &ClusterHealthServiceMock{
+//			ListHealthFunc: func() (map[string]*ClusterHealth, *serviceError.ServiceError) {
+//				panic("mock out the ListHealth method")
+//			},
+//			RecordHeartbeatFunc: func(clusterID string, fleetshardVersion string, reconcileErrors int) *serviceError.ServiceError {
+//				panic("mock out the RecordHeartbeat method")
+//			},
+//			SetUnscheduledAsSilentFunc: func(clusterID string, unscheduled bool) *serviceError.ServiceError {
+//				panic("mock out the SetUnscheduledAsSilent method")
+//			},
+//		}
+//
+//		// use mockedClusterHealthService in code that requires ClusterHealthService
+//		// and then make assertions.
+//
+//	}
+type ClusterHealthServiceMock struct {
+	// ListHealthFunc mocks the ListHealth method.
+	ListHealthFunc func() (map[string]*ClusterHealth, *serviceError.ServiceError)
+
+	// RecordHeartbeatFunc mocks the RecordHeartbeat method.
+	RecordHeartbeatFunc func(clusterID string, fleetshardVersion string, reconcileErrors int) *serviceError.ServiceError
+
+	// SetUnscheduledAsSilentFunc mocks the SetUnscheduledAsSilent method.
+	SetUnscheduledAsSilentFunc func(clusterID string, unscheduled bool) *serviceError.ServiceError
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListHealth holds details about calls to the ListHealth method.
+		ListHealth []struct {
+		}
+		// RecordHeartbeat holds details about calls to the RecordHeartbeat method.
+		RecordHeartbeat []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// FleetshardVersion is the fleetshardVersion argument value.
+			FleetshardVersion string
+			// ReconcileErrors is the reconcileErrors argument value.
+			ReconcileErrors int
+		}
+		// SetUnscheduledAsSilent holds details about calls to the SetUnscheduledAsSilent method.
+		SetUnscheduledAsSilent []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// Unscheduled is the unscheduled argument value.
+			Unscheduled bool
+		}
+	}
+	lockListHealth             sync.RWMutex
+	lockRecordHeartbeat        sync.RWMutex
+	lockSetUnscheduledAsSilent sync.RWMutex
+}
+
+// ListHealth calls ListHealthFunc.
+func (mock *ClusterHealthServiceMock) ListHealth() (map[string]*ClusterHealth, *serviceError.ServiceError) {
+	if mock.ListHealthFunc == nil {
+		panic("ClusterHealthServiceMock.ListHealthFunc: method is nil but ClusterHealthService.ListHealth was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListHealth.Lock()
+	mock.calls.ListHealth = append(mock.calls.ListHealth, callInfo)
+	mock.lockListHealth.Unlock()
+	return mock.ListHealthFunc()
+}
+
+// ListHealthCalls gets all the calls that were made to ListHealth.
+// Check the length with:
+//
+//	len(mockedClusterHealthService.ListHealthCalls())
+func (mock *ClusterHealthServiceMock) ListHealthCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListHealth.RLock()
+	calls = mock.calls.ListHealth
+	mock.lockListHealth.RUnlock()
+	return calls
+}
+
+// RecordHeartbeat calls RecordHeartbeatFunc.
+func (mock *ClusterHealthServiceMock) RecordHeartbeat(clusterID string, fleetshardVersion string, reconcileErrors int) *serviceError.ServiceError {
+	if mock.RecordHeartbeatFunc == nil {
+		panic("ClusterHealthServiceMock.RecordHeartbeatFunc: method is nil but ClusterHealthService.RecordHeartbeat was just called")
+	}
+	callInfo := struct {
+		ClusterID         string
+		FleetshardVersion string
+		ReconcileErrors   int
+	}{
+		ClusterID:         clusterID,
+		FleetshardVersion: fleetshardVersion,
+		ReconcileErrors:   reconcileErrors,
+	}
+	mock.lockRecordHeartbeat.Lock()
+	mock.calls.RecordHeartbeat = append(mock.calls.RecordHeartbeat, callInfo)
+	mock.lockRecordHeartbeat.Unlock()
+	return mock.RecordHeartbeatFunc(clusterID, fleetshardVersion, reconcileErrors)
+}
+
+// RecordHeartbeatCalls gets all the calls that were made to RecordHeartbeat.
+// Check the length with:
+//
+//	len(mockedClusterHealthService.RecordHeartbeatCalls())
+func (mock *ClusterHealthServiceMock) RecordHeartbeatCalls() []struct {
+	ClusterID         string
+	FleetshardVersion string
+	ReconcileErrors   int
+} {
+	var calls []struct {
+		ClusterID         string
+		FleetshardVersion string
+		ReconcileErrors   int
+	}
+	mock.lockRecordHeartbeat.RLock()
+	calls = mock.calls.RecordHeartbeat
+	mock.lockRecordHeartbeat.RUnlock()
+	return calls
+}
+
+// SetUnscheduledAsSilent calls SetUnscheduledAsSilentFunc.
+func (mock *ClusterHealthServiceMock) SetUnscheduledAsSilent(clusterID string, unscheduled bool) *serviceError.ServiceError {
+	if mock.SetUnscheduledAsSilentFunc == nil {
+		panic("ClusterHealthServiceMock.SetUnscheduledAsSilentFunc: method is nil but ClusterHealthService.SetUnscheduledAsSilent was just called")
+	}
+	callInfo := struct {
+		ClusterID   string
+		Unscheduled bool
+	}{
+		ClusterID:   clusterID,
+		Unscheduled: unscheduled,
+	}
+	mock.lockSetUnscheduledAsSilent.Lock()
+	mock.calls.SetUnscheduledAsSilent = append(mock.calls.SetUnscheduledAsSilent, callInfo)
+	mock.lockSetUnscheduledAsSilent.Unlock()
+	return mock.SetUnscheduledAsSilentFunc(clusterID, unscheduled)
+}
+
+// SetUnscheduledAsSilentCalls gets all the calls that were made to SetUnscheduledAsSilent.
+// Check the length with:
+//
+//	len(mockedClusterHealthService.SetUnscheduledAsSilentCalls())
+func (mock *ClusterHealthServiceMock) SetUnscheduledAsSilentCalls() []struct {
+	ClusterID   string
+	Unscheduled bool
+} {
+	var calls []struct {
+		ClusterID   string
+		Unscheduled bool
+	}
+	mock.lockSetUnscheduledAsSilent.RLock()
+	calls = mock.calls.SetUnscheduledAsSilent
+	mock.lockSetUnscheduledAsSilent.RUnlock()
+	return calls
+}
@@ -0,0 +1,37 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterHealthScore(t *testing.T) {
+	now := time.Date(2026, 3, 25, 12, 0, 0, 0, time.UTC)
+	timeout := 10 * time.Minute
+
+	tests := map[string]struct {
+		age             time.Duration
+		reconcileErrors int
+		expectedScore   int
+		expectedSilent  bool
+	}{
+		"recent heartbeat":                  {age: 5 * time.Second, expectedScore: 100},
+		"heartbeat at half the timeout":     {age: 5 * time.Minute, expectedScore: 100},
+		"ageing heartbeat":                  {age: 8 * time.Minute, expectedScore: 40},
+		"reconcile errors":                  {age: 5 * time.Second, reconcileErrors: 3, expectedScore: 70},
+		"reconcile error penalty is capped": {age: 5 * time.Second, reconcileErrors: 20, expectedScore: 40},
+		"score does not drop below zero":    {age: 9 * time.Minute, reconcileErrors: 5, expectedScore: 0},
+		"silent":                            {age: timeout, expectedScore: 0, expectedSilent: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			heartbeat := dbapi.ClusterHeartbeat{LastSeenAt: now.Add(-tc.age), ReconcileErrors: tc.reconcileErrors}
+			score, silent := clusterHealthScore(heartbeat, now, timeout)
+			assert.Equal(t, tc.expectedScore, score)
+			assert.Equal(t, tc.expectedSilent, silent)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"errors"
+
+	pkgErrors "github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
+	"github.com/stackrox/acs-fleet-manager/pkg/db"
+	"gorm.io/gorm"
+)
+
+type clusterIdentityService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+var _ auth.ClusterIdentitySource = (*clusterIdentityService)(nil)
+
+// NewClusterIdentityService returns the fleetshard identities bound to the registered data plane clusters
+func NewClusterIdentityService(connectionFactory *db.ConnectionFactory) auth.ClusterIdentitySource {
+	return &clusterIdentityService{connectionFactory: connectionFactory}
+}
+
+// GetClusterIdentity ...
+func (s *clusterIdentityService) GetClusterIdentity(clusterID string) (*auth.ClusterIdentity, error) {
+	cluster := &api.Cluster{}
+	if err := s.connectionFactory.New().Where("cluster_id = ?", clusterID).First(cluster).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, pkgErrors.Wrapf(err, "finding cluster %s", clusterID)
+	}
+	if !cluster.HasAuthIdentity() {
+		return nil, nil
+	}
+	return &auth.ClusterIdentity{Issuer: cluster.AuthIssuer, Subject: cluster.AuthSubject}, nil
+}
+
+// FindClusterByIdentity ...
+func (s *clusterIdentityService) FindClusterByIdentity(identity auth.ClusterIdentity) (string, error) {
+	if identity.Issuer == "" || identity.Subject == "" {
+		return "", nil
+	}
+	var clusters []api.Cluster
+	if err := s.connectionFactory.New().
+		Where("auth_issuer = ? AND auth_subject = ?", identity.Issuer, identity.Subject).
+		Limit(1).
+		Find(&clusters).Error; err != nil {
+		return "", pkgErrors.Wrapf(err, "finding cluster of identity %q of issuer %q", identity.Subject, identity.Issuer)
+	}
+	if len(clusters) == 0 {
+		return "", nil
+	}
+	return clusters[0].ClusterID, nil
+}
+
+// IsClusterIssuer ...
+func (s *clusterIdentityService) IsClusterIssuer(issuer string) (bool, error) {
+	if issuer == "" {
+		return false, nil
+	}
+	var count int64
+	if err := s.connectionFactory.New().Model(&api.Cluster{}).
+		Where("auth_issuer = ?", issuer).
+		Count(&count).Error; err != nil {
+		return false, pkgErrors.Wrapf(err, "counting clusters of issuer %q", issuer)
+	}
+	return count > 0, nil
+}
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
@@ -18,15 +19,17 @@ type ClusterPlacementStrategy interface {
 // NewClusterPlacementStrategy return a concrete strategy impl. depends on the
 // placement configuration. An appropriate ClusterPlacementStrategy implementation
 // is returned based on the received parameters content
-func NewClusterPlacementStrategy(clusterService ClusterService) ClusterPlacementStrategy {
-	return &FirstReadyPlacementStrategy{clusterService: clusterService}
+func NewClusterPlacementStrategy(clusterService ClusterService, clusterHealthService ClusterHealthService) ClusterPlacementStrategy {
+	return &FirstReadyPlacementStrategy{clusterService: clusterService, clusterHealthService: clusterHealthService}
 }
 
 var _ ClusterPlacementStrategy = (*FirstReadyPlacementStrategy)(nil)
 
-// FirstReadyPlacementStrategy ...
+// FirstReadyPlacementStrategy places Centrals on the healthiest matching cluster. Clusters whose health score is too
+// low are skipped, clusters without heartbeat are not. Among equally healthy clusters the first one is picked.
 type FirstReadyPlacementStrategy struct {
-	clusterService ClusterService
+	clusterService       ClusterService
+	clusterHealthService ClusterHealthService
 }
 
 // FindCluster ...
@@ -35,14 +38,37 @@ func (d FirstReadyPlacementStrategy) FindCluster(central *dbapi.CentralRequest)
 	if err != nil {
 		return nil, err
 	}
+	health, err := d.clusterHealthService.ListHealth()
+	if err != nil {
+		return nil, err
+	}
 
+	candidates := []*api.Cluster{}
 	for _, c := range clusters {
-		if c.Schedulable && supportsInstanceType(c, central.InstanceType) {
-			return c, nil
+		if !c.Schedulable || !supportsInstanceType(c, central.InstanceType) {
+			continue
+		}
+		if h, ok := health[c.ClusterID]; ok && !h.Healthy {
+			continue
 		}
+		candidates = append(candidates, c)
 	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return healthScore(health, candidates[i].ClusterID) > healthScore(health, candidates[j].ClusterID)
+	})
+	return candidates[0], nil
+}
 
-	return nil, nil
+// healthScore returns the health score of the cluster. Clusters without heartbeat are not penalised.
+func healthScore(health map[string]*ClusterHealth, clusterID string) int {
+	if h, ok := health[clusterID]; ok {
+		return h.Score
+	}
+	return 100
 }
 
 // AllMatchingClustersForCentral returns all cluster that fit the criteria to run a central
@@ -31,7 +31,7 @@ func TestPlacementStrategyType(t *testing.T) {
 
 	for _, tc := range tt {
 		t.Run(tc.description, func(t *testing.T) {
-			strategy := NewClusterPlacementStrategy(tc.createClusterService())
+			strategy := NewClusterPlacementStrategy(tc.createClusterService(), &ClusterHealthServiceMock{})
 
 			require.IsType(t, tc.expectedType, strategy)
 		})
@@ -68,6 +68,7 @@ func TestFirstClusterPlacementStrategy(t *testing.T) {
 		description           string
 		newClusterServiceMock func() ClusterService
 		central               *dbapi.CentralRequest
+		health                map[string]*ClusterHealth
 		expectedError         error
 		expectedCluster       *api.Cluster
 	}{
@@ -136,11 +137,49 @@ func TestFirstClusterPlacementStrategy(t *testing.T) {
 			expectedError:   nil,
 			expectedCluster: goodCluster1,
 		},
+		{
+			description: "should skip unhealthy clusters",
+			newClusterServiceMock: func() ClusterService {
+				return &ClusterServiceMock{
+					FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, *serviceErrors.ServiceError) {
+						return []*api.Cluster{goodCluster1, goodCluster2}, nil
+					},
+				}
+			},
+			central: centralRequest,
+			health: map[string]*ClusterHealth{
+				"good1": {Score: 0, Silent: true},
+			},
+			expectedError:   nil,
+			expectedCluster: goodCluster2,
+		},
+		{
+			description: "should return the healthiest cluster",
+			newClusterServiceMock: func() ClusterService {
+				return &ClusterServiceMock{
+					FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, *serviceErrors.ServiceError) {
+						return []*api.Cluster{goodCluster1, goodCluster2}, nil
+					},
+				}
+			},
+			central: centralRequest,
+			health: map[string]*ClusterHealth{
+				"good1": {Score: 70, Healthy: true},
+				"good2": {Score: 90, Healthy: true},
+			},
+			expectedError:   nil,
+			expectedCluster: goodCluster2,
+		},
 	}
 
 	for _, tc := range tt {
 		t.Run(tc.description, func(t *testing.T) {
-			strategy := FirstReadyPlacementStrategy{clusterService: tc.newClusterServiceMock()}
+			healthService := &ClusterHealthServiceMock{
+				ListHealthFunc: func() (map[string]*ClusterHealth, *serviceErrors.ServiceError) {
+					return tc.health, nil
+				},
+			}
+			strategy := FirstReadyPlacementStrategy{clusterService: tc.newClusterServiceMock(), clusterHealthService: healthService}
 			cluster, err := strategy.FindCluster(tc.central)
 			require.Equal(t, err, tc.expectedError)
 			if tc.expectedError != nil {
@@ -3,6 +3,7 @@ package services
 import (
 	"encoding/json"
 	"errors"
+	"time"
 
 	"github.com/stackrox/acs-fleet-manager/internal/central/constants"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
@@ -18,6 +19,8 @@ import (
 	"github.com/stackrox/acs-fleet-manager/pkg/api"
 	"github.com/stackrox/acs-fleet-manager/pkg/db"
 	apiErrors "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/services"
+	queryparser "github.com/stackrox/acs-fleet-manager/pkg/services/queryparser"
 )
 
 // ClusterService ...
@@ -48,12 +51,16 @@ type ClusterService interface {
 	// FindNonEmptyClusterByID returns a cluster if it present and it is not empty.
 	// Cluster emptiness is determined by checking whether the cluster contains Centrals that have been provisioned, are being provisioned on it, or are being deprovisioned from it i.e central that are not in failure state.
 	FindNonEmptyClusterByID(clusterID string) (*api.Cluster, *apiErrors.ServiceError)
-	// ListAllClusterIds returns all the valid cluster ids in array
+	// ListAllClusterIds returns the cluster ids and registration sources of all clusters
 	ListAllClusterIds() ([]api.Cluster, *apiErrors.ServiceError)
 	// FindAllClusters return all the valid clusters in array
 	FindAllClusters(criteria FindClusterCriteria) ([]*api.Cluster, *apiErrors.ServiceError)
+	// List returns a page of the clusters matching the search of the list arguments
+	List(listArgs *services.ListArguments) ([]*api.Cluster, *api.PagingMeta, *apiErrors.ServiceError)
 	// FindCentralInstanceCount returns the central instance counts associated with the list of clusters. If the list is empty, it will list all clusterIds that have Central instances assigned.
 	FindCentralInstanceCount(clusterIDs []string) ([]ResCentralInstanceCount, *apiErrors.ServiceError)
+	// FindCentralGrowth counts the active Centrals per ready and schedulable cluster, along with the Centrals created since the given time
+	FindCentralGrowth(since time.Time) ([]ResCentralGrowth, *apiErrors.ServiceError)
 	// UpdateMultiClusterStatus updates a list of clusters' status to a status
 	UpdateMultiClusterStatus(clusterIds []string, status api.ClusterStatus) *apiErrors.ServiceError
 	// CountByStatus returns the count of clusters for each given status in the database
@@ -92,6 +99,7 @@ func (c clusterService) RegisterClusterJob(clusterRequest *api.Cluster) *apiErro
 func (c clusterService) Create(cluster *api.Cluster) (*api.Cluster, *apiErrors.ServiceError) {
 	dbConn := c.connectionFactory.New()
 	r := &types.ClusterRequest{
+		ClusterID:      cluster.ClusterID,
 		CloudProvider:  cluster.CloudProvider,
 		Region:         cluster.Region,
 		MultiAZ:        cluster.MultiAZ,
@@ -375,7 +383,7 @@ func (c clusterService) ListAllClusterIds() ([]api.Cluster, *apiErrors.ServiceEr
 	// However, it only down to the level of seconds. This means that if a few records are created at almost the same time,
 	// the order is not guaranteed. So use the `created_at` column will provider better consistency.
 	if err := dbConn.Model(&api.Cluster{}).
-		Select("cluster_id").
+		Select("cluster_id, registration_source").
 		Where("cluster_id != '' ").
 		Order("created_at asc ").
 		Scan(&res).Error; err != nil {
@@ -438,6 +446,34 @@ func (c clusterService) FindCentralInstanceCount(clusterIDs []string) ([]ResCent
 	return res, nil
 }
 
+// ResCentralGrowth ...
+type ResCentralGrowth struct {
+	ClusterID string
+	// Count is the number of Centrals which are not being deleted
+	Count int
+	// Created is the number of these Centrals created since the start of the growth window
+	Created int
+}
+
+// FindCentralGrowth counts the Centrals of the clusters which provide capacity, so that Centrals on drained or
+// otherwise unschedulable clusters do not use up the capacity of the other clusters.
+func (c clusterService) FindCentralGrowth(since time.Time) ([]ResCentralGrowth, *apiErrors.ServiceError) {
+	var res []ResCentralGrowth
+	if err := c.connectionFactory.New().
+		Model(&dbapi.CentralRequest{}).
+		Select("central_requests.cluster_id as cluster_id, "+
+			"count(1) as Count, count(case when central_requests.created_at >= ? then 1 end) as Created", since).
+		Joins("JOIN clusters ON clusters.cluster_id = central_requests.cluster_id AND clusters.deleted_at IS NULL").
+		Where("central_requests.status in (?)", constants.ActiveStatuses).
+		Where("clusters.status = ? AND clusters.schedulable", api.ClusterReady).
+		Group("central_requests.cluster_id").
+		Order("central_requests.cluster_id").
+		Scan(&res).Error; err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to count centrals by cluster")
+	}
+	return res, nil
+}
+
 // FindAllClusters ...
 func (c clusterService) FindAllClusters(criteria FindClusterCriteria) ([]*api.Cluster, *apiErrors.ServiceError) {
 	dbConn := c.connectionFactory.New().
@@ -466,6 +502,55 @@ func (c clusterService) FindAllClusters(criteria FindClusterCriteria) ([]*api.Cl
 	return cluster, nil
 }
 
+// clusterSearchColumns are the columns clusters can be searched by
+var clusterSearchColumns = []string{
+	"cluster_id", "name", "cloud_provider", "region", "status", "provider_type", "schedulable", "multi_az", "registration_source",
+}
+
+// GetAcceptedClusterOrderByParams returns the fields clusters can be ordered by
+func GetAcceptedClusterOrderByParams() []string {
+	return []string{"cluster_id", "name", "cloud_provider", "region", "status", "provider_type", "schedulable", "multi_az",
+		"registration_source", "created_at", "updated_at"}
+}
+
+// List ...
+func (c clusterService) List(listArgs *services.ListArguments) ([]*api.Cluster, *api.PagingMeta, *apiErrors.ServiceError) {
+	var clusters []*api.Cluster
+	dbConn := c.connectionFactory.New().Model(&api.Cluster{})
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	if len(listArgs.Search) > 0 {
+		searchDbQuery, err := queryparser.NewQueryParser(clusterSearchColumns...).Parse(listArgs.Search)
+		if err != nil {
+			return nil, pagingMeta, apiErrors.NewWithCause(apiErrors.ErrorFailedToParseSearch, err, "Unable to list clusters: %s", err.Error())
+		}
+		dbConn = dbConn.Where(searchDbQuery.Query, searchDbQuery.Values...)
+	}
+
+	if len(listArgs.OrderBy) == 0 {
+		dbConn = dbConn.Order("cluster_id")
+	}
+	for _, orderByArg := range listArgs.OrderBy {
+		dbConn = dbConn.Order(orderByArg)
+	}
+
+	total := int64(pagingMeta.Total)
+	dbConn.Count(&total)
+	pagingMeta.Total = int(total)
+	if pagingMeta.Size > pagingMeta.Total {
+		pagingMeta.Size = pagingMeta.Total
+	}
+	dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
+
+	if err := dbConn.Find(&clusters).Error; err != nil {
+		return nil, pagingMeta, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "Unable to list clusters")
+	}
+	return clusters, pagingMeta, nil
+}
+
 // UpdateMultiClusterStatus ...
 func (c clusterService) UpdateMultiClusterStatus(clusterIds []string, status api.ClusterStatus) *apiErrors.ServiceError {
 	if status.String() == "" {
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"database/sql/driver"
 	"fmt"
 	"reflect"
 	"testing"
@@ -982,7 +983,7 @@ func Test_clusterService_ListAllClusterIds(t *testing.T) {
 		connectionFactory *db.ConnectionFactory
 	}
 	var clusters []api.Cluster
-	clusters = append(clusters, api.Cluster{ClusterID: "test01"})
+	clusters = append(clusters, api.Cluster{ClusterID: "test01", RegistrationSource: api.ClusterRegistrationSourceAPI})
 
 	tests := []struct {
 		name    string
@@ -997,7 +998,7 @@ func Test_clusterService_ListAllClusterIds(t *testing.T) {
 				connectionFactory: db.NewMockConnectionFactory(nil),
 			},
 			setupFn: func() {
-				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT "cluster_id" FROM "clusters"`)
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT cluster_id, registration_source FROM "clusters"`)
 				mocket.Catcher.NewMock().WithQueryException().WithExecException()
 			},
 			want:  nil,
@@ -1009,9 +1010,10 @@ func Test_clusterService_ListAllClusterIds(t *testing.T) {
 				connectionFactory: db.NewMockConnectionFactory(nil),
 			},
 			setupFn: func() {
-				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT "cluster_id" FROM "clusters" WHERE cluster_id != ''`).WithReply([]map[string]interface{}{
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT cluster_id, registration_source FROM "clusters" WHERE cluster_id != ''`).WithReply([]map[string]interface{}{
 					{
-						"cluster_id": "test01",
+						"cluster_id":          "test01",
+						"registration_source": "api",
 					},
 				})
 			},
@@ -1120,6 +1122,23 @@ func Test_clusterService_FindCentralInstanceCount(t *testing.T) {
 	}
 }
 
+func Test_clusterService_FindCentralGrowth(t *testing.T) {
+	g := gomega.NewWithT(t)
+	c := clusterService{connectionFactory: db.NewMockConnectionFactory(nil)}
+	// only the Centrals of ready and schedulable clusters are counted
+	mocket.Catcher.Reset().NewMock().
+		WithQuery(`JOIN clusters ON clusters.cluster_id = central_requests.cluster_id AND clusters.deleted_at IS NULL`).
+		WithCallback(func(query string, _ []driver.NamedValue) {
+			g.Expect(query).To(gomega.ContainSubstring(`AND (clusters.status = $`))
+			g.Expect(query).To(gomega.ContainSubstring(`AND clusters.schedulable)`))
+		}).
+		WithReply([]map[string]interface{}{{"cluster_id": "test01", "count": 3, "created": 1}})
+
+	got, err := c.FindCentralGrowth(time.Now())
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(got).To(gomega.Equal([]ResCentralGrowth{{ClusterID: "test01", Count: 3, Created: 1}}))
+}
+
 func Test_clusterService_FindAllClusters(t *testing.T) {
 	type fields struct {
 		connectionFactory *db.ConnectionFactory
@@ -6,7 +6,9 @@ package services
 import (
 	"github.com/stackrox/acs-fleet-manager/pkg/api"
 	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/services"
 	"sync"
+	"time"
 )
 
 // Ensure, that ClusterServiceMock does implement ClusterService.
@@ -37,6 +39,9 @@ var _ ClusterService = &ClusterServiceMock{}
 //			FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, *serviceError.ServiceError) {
 //				panic("mock out the FindAllClusters method")
 //			},
+//			FindCentralGrowthFunc: func(since time.Time) ([]ResCentralGrowth, *serviceError.ServiceError) {
+//				panic("mock out the FindCentralGrowth method")
+//			},
 //			FindCentralInstanceCountFunc: func(clusterIDs []string) ([]ResCentralInstanceCount, *serviceError.ServiceError) {
 //				panic("mock out the FindCentralInstanceCount method")
 //			},
@@ -55,6 +60,9 @@ var _ ClusterService = &ClusterServiceMock{}
 //			GetExternalIDFunc: func(clusterID string) (string, *serviceError.ServiceError) {
 //				panic("mock out the GetExternalID method")
 //			},
+//			ListFunc: func(listArgs *services.ListArguments) ([]*api.Cluster, *api.PagingMeta, *serviceError.ServiceError) {
+//				panic("mock out the List method")
+//			},
 //			ListAllClusterIdsFunc: func() ([]api.Cluster, *serviceError.ServiceError) {
 //				panic("mock out the ListAllClusterIds method")
 //			},
@@ -104,6 +112,9 @@ type ClusterServiceMock struct {
 	// FindAllClustersFunc mocks the FindAllClusters method.
 	FindAllClustersFunc func(criteria FindClusterCriteria) ([]*api.Cluster, *serviceError.ServiceError)
 
+	// FindCentralGrowthFunc mocks the FindCentralGrowth method.
+	FindCentralGrowthFunc func(since time.Time) ([]ResCentralGrowth, *serviceError.ServiceError)
+
 	// FindCentralInstanceCountFunc mocks the FindCentralInstanceCount method.
 	FindCentralInstanceCountFunc func(clusterIDs []string) ([]ResCentralInstanceCount, *serviceError.ServiceError)
 
@@ -122,6 +133,9 @@ type ClusterServiceMock struct {
 	// GetExternalIDFunc mocks the GetExternalID method.
 	GetExternalIDFunc func(clusterID string) (string, *serviceError.ServiceError)
 
+	// ListFunc mocks the List method.
+	ListFunc func(listArgs *services.ListArguments) ([]*api.Cluster, *api.PagingMeta, *serviceError.ServiceError)
+
 	// ListAllClusterIdsFunc mocks the ListAllClusterIds method.
 	ListAllClusterIdsFunc func() ([]api.Cluster, *serviceError.ServiceError)
 
@@ -178,6 +192,11 @@ type ClusterServiceMock struct {
 			// Criteria is the criteria argument value.
 			Criteria FindClusterCriteria
 		}
+		// FindCentralGrowth holds details about calls to the FindCentralGrowth method.
+		FindCentralGrowth []struct {
+			// Since is the since argument value.
+			Since time.Time
+		}
 		// FindCentralInstanceCount holds details about calls to the FindCentralInstanceCount method.
 		FindCentralInstanceCount []struct {
 			// ClusterIDs is the clusterIDs argument value.
@@ -208,6 +227,11 @@ type ClusterServiceMock struct {
 			// ClusterID is the clusterID argument value.
 			ClusterID string
 		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// ListArgs is the listArgs argument value.
+			ListArgs *services.ListArguments
+		}
 		// ListAllClusterIds holds details about calls to the ListAllClusterIds method.
 		ListAllClusterIds []struct {
 		}
@@ -263,12 +287,14 @@ type ClusterServiceMock struct {
 	lockDelete                       sync.RWMutex
 	lockDeleteByClusterID            sync.RWMutex
 	lockFindAllClusters              sync.RWMutex
+	lockFindCentralGrowth            sync.RWMutex
 	lockFindCentralInstanceCount     sync.RWMutex
 	lockFindCluster                  sync.RWMutex
 	lockFindClusterByID              sync.RWMutex
 	lockFindNonEmptyClusterByID      sync.RWMutex
 	lockGetClusterDNS                sync.RWMutex
 	lockGetExternalID                sync.RWMutex
+	lockList                         sync.RWMutex
 	lockListAllClusterIds            sync.RWMutex
 	lockListByStatus                 sync.RWMutex
 	lockListGroupByProviderAndRegion sync.RWMutex
@@ -471,6 +497,38 @@ func (mock *ClusterServiceMock) FindAllClustersCalls() []struct {
 	return calls
 }
 
+// FindCentralGrowth calls FindCentralGrowthFunc.
+func (mock *ClusterServiceMock) FindCentralGrowth(since time.Time) ([]ResCentralGrowth, *serviceError.ServiceError) {
+	if mock.FindCentralGrowthFunc == nil {
+		panic("ClusterServiceMock.FindCentralGrowthFunc: method is nil but ClusterService.FindCentralGrowth was just called")
+	}
+	callInfo := struct {
+		Since time.Time
+	}{
+		Since: since,
+	}
+	mock.lockFindCentralGrowth.Lock()
+	mock.calls.FindCentralGrowth = append(mock.calls.FindCentralGrowth, callInfo)
+	mock.lockFindCentralGrowth.Unlock()
+	return mock.FindCentralGrowthFunc(since)
+}
+
+// FindCentralGrowthCalls gets all the calls that were made to FindCentralGrowth.
+// Check the length with:
+//
+//	len(mockedClusterService.FindCentralGrowthCalls())
+func (mock *ClusterServiceMock) FindCentralGrowthCalls() []struct {
+	Since time.Time
+} {
+	var calls []struct {
+		Since time.Time
+	}
+	mock.lockFindCentralGrowth.RLock()
+	calls = mock.calls.FindCentralGrowth
+	mock.lockFindCentralGrowth.RUnlock()
+	return calls
+}
+
 // FindCentralInstanceCount calls FindCentralInstanceCountFunc.
 func (mock *ClusterServiceMock) FindCentralInstanceCount(clusterIDs []string) ([]ResCentralInstanceCount, *serviceError.ServiceError) {
 	if mock.FindCentralInstanceCountFunc == nil {
@@ -663,6 +721,38 @@ func (mock *ClusterServiceMock) GetExternalIDCalls() []struct {
 	return calls
 }
 
+// List calls ListFunc.
+func (mock *ClusterServiceMock) List(listArgs *services.ListArguments) ([]*api.Cluster, *api.PagingMeta, *serviceError.ServiceError) {
+	if mock.ListFunc == nil {
+		panic("ClusterServiceMock.ListFunc: method is nil but ClusterService.List was just called")
+	}
+	callInfo := struct {
+		ListArgs *services.ListArguments
+	}{
+		ListArgs: listArgs,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(listArgs)
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedClusterService.ListCalls())
+func (mock *ClusterServiceMock) ListCalls() []struct {
+	ListArgs *services.ListArguments
+} {
+	var calls []struct {
+		ListArgs *services.ListArguments
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
 // ListAllClusterIds calls ListAllClusterIdsFunc.
 func (mock *ClusterServiceMock) ListAllClusterIds() ([]api.Cluster, *serviceError.ServiceError) {
 	if mock.ListAllClusterIdsFunc == nil {
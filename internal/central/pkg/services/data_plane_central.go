@@ -40,6 +40,8 @@ type dataPlaneCentralService struct {
 	clusterService         ClusterService
 	connectionFactory      *db.ConnectionFactory
 	dataplaneClusterConfig *config.DataplaneClusterConfig
+	centralUsageService    CentralUsageService
+	migrationService       CentralMigrationService
 }
 
 // NewDataPlaneCentralService ...
@@ -48,12 +50,16 @@ func NewDataPlaneCentralService(
 	clusterSrv ClusterService,
 	connectionFactory *db.ConnectionFactory,
 	dataplaneClusterConfig *config.DataplaneClusterConfig,
+	centralUsageService CentralUsageService,
+	migrationService CentralMigrationService,
 ) DataPlaneCentralService {
 	return &dataPlaneCentralService{
 		centralService:         centralSrv,
 		clusterService:         clusterSrv,
 		connectionFactory:      connectionFactory,
 		dataplaneClusterConfig: dataplaneClusterConfig,
+		centralUsageService:    centralUsageService,
+		migrationService:       migrationService,
 	}
 }
 
@@ -74,10 +80,35 @@ func (s *dataPlaneCentralService) UpdateDataPlaneCentralService(ctx context.Cont
 			glog.Error(errors.Wrapf(getErr, "failed to get central cluster by id %s", ks.CentralClusterID))
 			continue
 		}
+		if central.MigrationPhase != "" && central.MigrationPhase.IsActive() {
+			// Both clusters report the Central while it is migrated. Its status is left as it is until the migration
+			// completes, as the Central is scaled down on purpose.
+			if ks.MigrationPhaseCompleted != "" {
+				if e := s.migrationService.CompletePhase(central.ID, clusterID, ks.MigrationPhaseCompleted, ks.Routes); e != nil {
+					log.Error(errors.Wrapf(e, "Error completing migration phase %s of central %s", ks.MigrationPhaseCompleted, central.ID))
+				}
+			}
+			continue
+		}
 		if central.ClusterID != clusterID {
 			log.Warningf("clusterId for central cluster %s does not match clusterId. central clusterId = %s :: clusterId = %s", central.ID, central.ClusterID, clusterID)
 			continue
 		}
+		if ks.Usage != nil {
+			if e := s.centralUsageService.RecordUsage(central, ks.Usage); e != nil {
+				log.Error(errors.Wrapf(e, "Error recording usage of central %s", ks.CentralClusterID))
+			}
+		}
+		if central.PreviousClientID != "" && ks.AuthClientID == central.ClientID {
+			// the data plane applied the rotated OIDC client, so that the previous one is not used anymore. The
+			// request transaction is not used, as the status of the central is written outside of it below.
+			if e := s.centralService.CompleteCentralRHSSOClientRotation(context.Background(), central); e != nil {
+				log.Error(errors.Wrapf(e, "Error completing OIDC client rotation of central %s", ks.CentralClusterID))
+			}
+		}
+		if e := s.persistDBUpgradeStatus(central, ks); e != nil {
+			log.Error(errors.Wrapf(e, "Error recording database upgrade status of central %s", ks.CentralClusterID))
+		}
 		var e *serviceError.ServiceError
 		switch getStatus(ks) {
 		case statusReady:
@@ -108,10 +139,15 @@ func (s *dataPlaneCentralService) UpdateDataPlaneCentralService(ctx context.Cont
 	return nil
 }
 
-// ListByClusterID returns a list of CentralRequests with specified clusterID
+// ListByClusterID returns a list of CentralRequests with specified clusterID, including the ones which are migrated
+// from or to the cluster
 func (s *dataPlaneCentralService) ListByClusterID(clusterID string) (dbapi.CentralList, *serviceError.ServiceError) {
+	migrated := s.connectionFactory.New().Model(&dbapi.CentralMigration{}).
+		Select("central_id").
+		Where("phase IN ?", dbapi.ActiveCentralMigrationPhases).
+		Where("source_cluster_id = ? OR target_cluster_id = ?", clusterID, clusterID)
 	dbConn := s.connectionFactory.New().
-		Where("cluster_id = ?", clusterID).
+		Where("cluster_id = ? OR id IN (?)", clusterID, migrated).
 		Where("status IN (?)", centralManagedCRStatuses).
 		Where("host != ''")
 
@@ -136,7 +172,7 @@ func (s *dataPlaneCentralService) setCentralClusterReady(centralRequest *dbapi.C
 		return err
 	}
 
-	err = s.centralService.Updates(centralRequest, map[string]interface{}{"failed_reason": "", "status": constants.CentralRequestStatusReady.String()})
+	err = s.centralService.Updates(context.Background(), centralRequest, map[string]interface{}{"failed_reason": "", "status": constants.CentralRequestStatusReady.String()})
 	if err != nil {
 		return serviceError.NewWithCause(err.Code, err, "failed to update status %s for central cluster %s", constants.CentralRequestStatusReady, centralRequest.ID)
 	}
@@ -163,7 +199,7 @@ func (s *dataPlaneCentralService) setCentralClusterFailed(centralRequest *dbapi.
 
 	centralRequest.Status = string(constants.CentralRequestStatusFailed)
 	centralRequest.FailedReason = fmt.Sprintf("Central reported as failed: '%s'", errMessage)
-	err = s.centralService.UpdateIgnoreNils(centralRequest)
+	err = s.centralService.UpdateIgnoreNils(context.Background(), centralRequest)
 	if err != nil {
 		return serviceError.NewWithCause(err.Code, err, "failed to update central cluster to %s status for central cluster %s", constants.CentralRequestStatusFailed, centralRequest.ID)
 	}
@@ -182,7 +218,7 @@ func (s *dataPlaneCentralService) setCentralClusterFailed(centralRequest *dbapi.
 
 func (s *dataPlaneCentralService) setCentralClusterDeleting(centralRequest *dbapi.CentralRequest) *serviceError.ServiceError {
 	// If the Central cluster is deleted from the data plane cluster, we will make it as "deleting" in db and the reconcilier will ensure it is cleaned up properly
-	if ok, updateErr := s.centralService.UpdateStatus(centralRequest.ID, constants.CentralRequestStatusDeleting); ok {
+	if ok, updateErr := s.centralService.UpdateStatus(context.Background(), centralRequest.ID, constants.CentralRequestStatusDeleting); ok {
 		if updateErr != nil {
 			return serviceError.NewWithCause(updateErr.Code, updateErr, "failed to update status %s for central cluster %s", constants.CentralRequestStatusDeleting, centralRequest.ID)
 		}
@@ -197,7 +233,7 @@ func (s *dataPlaneCentralService) reassignCentralCluster(centralRequest *dbapi.C
 		// But now we only have one Data Plane cluster, so we need to change the placementId field so that the fleetshard-sync will try it again
 		// In the future, we may consider adding a new table to track the placement history for central clusters if there are multiple Data Plane clusters and the value here can be the key of that table
 		centralRequest.PlacementID = api.NewID()
-		if err := s.centralService.UpdateIgnoreNils(centralRequest); err != nil {
+		if err := s.centralService.UpdateIgnoreNils(context.Background(), centralRequest); err != nil {
 			return err
 		}
 		metrics.UpdateCentralRequestsStatusSinceCreatedMetric(constants.CentralRequestStatusProvisioning, centralRequest.ID, centralRequest.ClusterID, time.Since(centralRequest.CreatedAt))
@@ -243,6 +279,27 @@ func (s *dataPlaneCentralService) checkCentralRequestCurrentStatus(centralReques
 	return matchStatus, nil
 }
 
+// persistDBUpgradeStatus records the latest engine upgrade of the Central's managed DB, the data plane reports the
+// condition only while the upgrade is in progress or on the reconciliation it was completed.
+func (s *dataPlaneCentralService) persistDBUpgradeStatus(centralRequest *dbapi.CentralRequest, centralStatus *dbapi.DataPlaneCentralStatus) *serviceError.ServiceError {
+	condition, ok := centralStatus.GetDatabaseUpgradeCondition()
+	if !ok {
+		return nil
+	}
+	if centralRequest.DBUpgradeStatus == condition.Reason && centralRequest.DBUpgradeMessage == condition.Message {
+		return nil
+	}
+	if err := s.centralService.Updates(context.Background(), centralRequest, map[string]interface{}{
+		"db_upgrade_status":  condition.Reason,
+		"db_upgrade_message": condition.Message,
+	}); err != nil {
+		return serviceError.NewWithCause(err.Code, err, "failed to update database upgrade status of central %s", centralRequest.ID)
+	}
+	centralRequest.DBUpgradeStatus = condition.Reason
+	centralRequest.DBUpgradeMessage = condition.Message
+	return nil
+}
+
 func (s *dataPlaneCentralService) persistCentralValues(centralRequest *dbapi.CentralRequest, centralStatus *dbapi.DataPlaneCentralStatus, cluster *api.Cluster) *serviceError.ServiceError {
 	if err := s.addRoutesToRequest(centralRequest, centralStatus, cluster); err != nil {
 		return err
@@ -252,7 +309,7 @@ func (s *dataPlaneCentralService) persistCentralValues(centralRequest *dbapi.Cen
 		return err
 	}
 
-	if err := s.centralService.UpdateIgnoreNils(centralRequest); err != nil {
+	if err := s.centralService.UpdateIgnoreNils(context.Background(), centralRequest); err != nil {
 		return serviceError.NewWithCause(err.Code, err, "failed to update routes for central cluster %s", centralRequest.ID)
 	}
 
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	serviceError "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateDataPlaneCentralServicePersistsDBUpgradeStatus(t *testing.T) {
+	central := &dbapi.CentralRequest{
+		Meta:      api.Meta{ID: "central-1"},
+		ClusterID: "cluster-1",
+		Status:    constants.CentralRequestStatusReady.String(),
+	}
+	var updates []map[string]interface{}
+	centralService := &CentralServiceMock{
+		GetByIDFunc: func(id string) (*dbapi.CentralRequest, *serviceError.ServiceError) {
+			return central, nil
+		},
+		UpdatesFunc: func(_ context.Context, centralRequest *dbapi.CentralRequest, values map[string]interface{}) *serviceError.ServiceError {
+			updates = append(updates, values)
+			return nil
+		},
+	}
+	clusterService := &ClusterServiceMock{
+		FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *serviceError.ServiceError) {
+			return &api.Cluster{ClusterID: clusterID}, nil
+		},
+	}
+	s := NewDataPlaneCentralService(centralService, clusterService, nil, nil, nil, nil)
+
+	status := &dbapi.DataPlaneCentralStatus{
+		CentralClusterID: central.ID,
+		Conditions: []dbapi.DataPlaneCentralStatusCondition{
+			{Type: "Ready", Status: "False", Reason: "Installing"},
+			{Type: dbapi.DatabaseUpgradeConditionType, Status: "False", Reason: "upgrading", Message: "upgrading to 15.7"},
+		},
+	}
+	require.Nil(t, s.UpdateDataPlaneCentralService(context.Background(), central.ClusterID, []*dbapi.DataPlaneCentralStatus{status}))
+	require.Len(t, updates, 1)
+	assert.Equal(t, map[string]interface{}{"db_upgrade_status": "upgrading", "db_upgrade_message": "upgrading to 15.7"}, updates[0])
+	assert.Equal(t, "upgrading", central.DBUpgradeStatus)
+
+	// an unchanged condition is not written again
+	require.Nil(t, s.UpdateDataPlaneCentralService(context.Background(), central.ClusterID, []*dbapi.DataPlaneCentralStatus{status}))
+	require.Len(t, updates, 1)
+
+	// the status is kept once the data plane stops reporting the condition
+	status.Conditions = status.Conditions[:1]
+	require.Nil(t, s.UpdateDataPlaneCentralService(context.Background(), central.ClusterID, []*dbapi.DataPlaneCentralStatus{status}))
+	require.Len(t, updates, 1)
+	assert.Equal(t, "upgrading to 15.7", central.DBUpgradeMessage)
+}
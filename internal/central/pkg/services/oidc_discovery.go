@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	oidcDiscoveryPath    = "/.well-known/openid-configuration"
+	oidcDiscoveryTimeout = 10 * time.Second
+	// oidcDiscoveryMaxSize limits the size of discovery documents read from customer issuers
+	oidcDiscoveryMaxSize = 1 << 20
+)
+
+// OIDCDiscoveryDocument contains the fields of an OpenID Connect discovery document which Central needs
+type OIDCDiscoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// OIDCDiscoveryClient fetches the discovery documents of OIDC issuers
+//
+//go:generate moq -out oidc_discovery_moq.go . OIDCDiscoveryClient
+type OIDCDiscoveryClient interface {
+	// Discover fetches the discovery document of the issuer and checks that it belongs to the issuer
+	Discover(ctx context.Context, issuer string) (*OIDCDiscoveryDocument, error)
+}
+
+type oidcDiscoveryClient struct {
+	httpClient *http.Client
+}
+
+var _ OIDCDiscoveryClient = (*oidcDiscoveryClient)(nil)
+
+// NewOIDCDiscoveryClient returns a client which only connects to public addresses, as the issuers are provided by
+// customers
+func NewOIDCDiscoveryClient() OIDCDiscoveryClient {
+	dialer := &net.Dialer{
+		Timeout: oidcDiscoveryTimeout,
+		Control: denyNonPublicAddresses,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &oidcDiscoveryClient{
+		httpClient: &http.Client{
+			Timeout:   oidcDiscoveryTimeout,
+			Transport: transport,
+		},
+	}
+}
+
+// Discover ...
+func (c *oidcDiscoveryClient) Discover(ctx context.Context, issuer string) (*OIDCDiscoveryDocument, error) {
+	if err := validateIssuerURL(issuer); err != nil {
+		return nil, err
+	}
+	discoveryURL := strings.TrimSuffix(issuer, "/") + oidcDiscoveryPath
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating request for %s", discoveryURL)
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "fetching %s", discoveryURL)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("fetching %s: unexpected status %d", discoveryURL, resp.StatusCode)
+	}
+
+	var document OIDCDiscoveryDocument
+	if err := json.NewDecoder(io.LimitReader(resp.Body, oidcDiscoveryMaxSize)).Decode(&document); err != nil {
+		return nil, errors.Wrapf(err, "decoding discovery document %s", discoveryURL)
+	}
+	// the issuer of the document must be identical to the issuer it was fetched from, see
+	// https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderConfigurationValidation
+	if strings.TrimSuffix(document.Issuer, "/") != strings.TrimSuffix(issuer, "/") {
+		return nil, errors.Errorf("discovery document %s belongs to issuer %q", discoveryURL, document.Issuer)
+	}
+	if document.AuthorizationEndpoint == "" {
+		return nil, errors.Errorf("discovery document %s has no authorization endpoint", discoveryURL)
+	}
+	if document.JWKSURI == "" {
+		return nil, errors.Errorf("discovery document %s has no JWKS URI", discoveryURL)
+	}
+	return &document, nil
+}
+
+// validateIssuerURL checks that the issuer is an absolute https URL without query and fragment, as required by
+// https://openid.net/specs/openid-connect-discovery-1_0.html#IssuerDiscovery
+func validateIssuerURL(issuer string) error {
+	u, err := url.Parse(issuer)
+	if err != nil {
+		return errors.Wrapf(err, "parsing issuer %q", issuer)
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return errors.Errorf("issuer %q is not an https URL", issuer)
+	}
+	if u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return errors.Errorf("issuer %q must not contain user info, query or fragment", issuer)
+	}
+	return nil
+}
+
+// denyNonPublicAddresses prevents connections to loopback, private and link-local addresses
+func denyNonPublicAddresses(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return errors.Wrapf(err, "parsing address %q", address)
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return errors.Errorf("address %q is not an IP address", address)
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsUnspecified() || ip.IsMulticast() {
+		return errors.Errorf("connections to non-public address %s are not allowed", ip)
+	}
+	return nil
+}
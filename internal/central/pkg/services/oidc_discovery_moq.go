@@ -0,0 +1,81 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that OIDCDiscoveryClientMock does implement OIDCDiscoveryClient.
+// If this is not the case, regenerate this file with moq.
+var _ OIDCDiscoveryClient = &OIDCDiscoveryClientMock{}
+
+// OIDCDiscoveryClientMock is a mock implementation of OIDCDiscoveryClient.
+//
+//	func TestSomethingThatUsesOIDCDiscoveryClient(t *testing.T) {
+//
+//		// make and configure a mocked OIDCDiscoveryClient
+//		mockedOIDCDiscoveryClient := &OIDCDiscoveryClientMock{
+//			DiscoverFunc: func(ctx context.Context, issuer string) (*OIDCDiscoveryDocument, error) {
+//				panic("mock out the Discover method")
+//			},
+//		}
+//
+//		// use mockedOIDCDiscoveryClient in code that requires OIDCDiscoveryClient
+//		// and then make assertions.
+//
+//	}
+type OIDCDiscoveryClientMock struct {
+	// DiscoverFunc mocks the Discover method.
+	DiscoverFunc func(ctx context.Context, issuer string) (*OIDCDiscoveryDocument, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Discover holds details about calls to the Discover method.
+		Discover []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Issuer is the issuer argument value.
+			Issuer string
+		}
+	}
+	lockDiscover sync.RWMutex
+}
+
+// Discover calls DiscoverFunc.
+func (mock *OIDCDiscoveryClientMock) Discover(ctx context.Context, issuer string) (*OIDCDiscoveryDocument, error) {
+	if mock.DiscoverFunc == nil {
+		panic("OIDCDiscoveryClientMock.DiscoverFunc: method is nil but OIDCDiscoveryClient.Discover was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Issuer string
+	}{
+		Ctx:    ctx,
+		Issuer: issuer,
+	}
+	mock.lockDiscover.Lock()
+	mock.calls.Discover = append(mock.calls.Discover, callInfo)
+	mock.lockDiscover.Unlock()
+	return mock.DiscoverFunc(ctx, issuer)
+}
+
+// DiscoverCalls gets all the calls that were made to Discover.
+// Check the length with:
+//
+//	len(mockedOIDCDiscoveryClient.DiscoverCalls())
+func (mock *OIDCDiscoveryClientMock) DiscoverCalls() []struct {
+	Ctx    context.Context
+	Issuer string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Issuer string
+	}
+	mock.lockDiscover.RLock()
+	calls = mock.calls.Discover
+	mock.lockDiscover.RUnlock()
+	return calls
+}
@@ -47,7 +47,7 @@ func (q amsQuotaService) HasQuotaAllowance(central *dbapi.CentralRequest, instan
 	}
 
 	quotaType := instanceType.GetQuotaType()
-	quotaCosts, err := q.amsClient.GetQuotaCostsForProduct(org.ID(), quotaType.GetResourceName(), quotaType.GetProduct())
+	quotaCosts, err := q.getCachedQuotaCosts(org.ID(), quotaType.GetResourceName(), quotaType.GetProduct())
 	if err != nil {
 		return false, errors.NewWithCause(errors.ErrorGeneral, err,
 			"failed to get assigned quota of type %q for organization with external id %q and id %q",
@@ -74,6 +74,15 @@ func (q amsQuotaService) HasQuotaAllowance(central *dbapi.CentralRequest, instan
 	return true, nil
 }
 
+// getCachedQuotaCosts returns the cached quota costs if the AMS client caches them. They must not be used to reserve
+// quota.
+func (q amsQuotaService) getCachedQuotaCosts(orgID, resourceName, product string) ([]*amsv1.QuotaCost, error) {
+	if quotaCostsCache, ok := q.amsClient.(ocm.QuotaCostsCache); ok {
+		return quotaCostsCache.GetCachedQuotaCostsForProduct(orgID, resourceName, product)
+	}
+	return q.amsClient.GetQuotaCostsForProduct(orgID, resourceName, product)
+}
+
 // selectBillingModel selects the billing model of an instance by looking
 // at the resource name and product, cloudProviderID and cloudAccountID.
 // Only QuotaCosts that have available quota, or that contain a RelatedResource
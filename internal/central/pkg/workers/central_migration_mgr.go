@@ -0,0 +1,200 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/internal/central/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/dns"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/externaldns"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/presenters"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/workers"
+)
+
+const centralMigrationLeaseType = "central_migration_worker"
+
+// nextCentralMigrationPhases are the phases migrations move to once both clusters completed their part of the current one
+var nextCentralMigrationPhases = map[dbapi.CentralMigrationPhase]dbapi.CentralMigrationPhase{
+	dbapi.CentralMigrationPhasePrepareTarget: dbapi.CentralMigrationPhaseQuiesceSource,
+	dbapi.CentralMigrationPhaseQuiesceSource: dbapi.CentralMigrationPhaseSwitchDNS,
+	dbapi.CentralMigrationPhaseSwitchDNS:     dbapi.CentralMigrationPhaseVerifyTarget,
+	dbapi.CentralMigrationPhaseVerifyTarget:  dbapi.CentralMigrationPhaseCleanupSource,
+	dbapi.CentralMigrationPhaseCleanupSource: dbapi.CentralMigrationPhaseCompleted,
+	dbapi.CentralMigrationPhaseRollingBack:   dbapi.CentralMigrationPhaseRolledBack,
+}
+
+// CentralMigrationManager moves Central tenants between dataplane clusters. The clusters carry out their part of each
+// phase and report it back, while the manager assigns the tenant to the target cluster and points its DNS records to
+// it. Phases which do not complete within the configured timeout fail the migration.
+type CentralMigrationManager struct {
+	workers.BaseWorker
+	migrationService        services.CentralMigrationService
+	centralService          services.CentralService
+	dnsProvider             dns.DNSProvider
+	centralConfig           *config.CentralConfig
+	managedCentralPresenter *presenters.ManagedCentralPresenter
+	now                     func() time.Time
+}
+
+// NewCentralMigrationManager creates a new central migration manager
+func NewCentralMigrationManager(migrationService services.CentralMigrationService, centralService services.CentralService,
+	dnsProvider dns.DNSProvider, centralConfig *config.CentralConfig, managedCentralPresenter *presenters.ManagedCentralPresenter) *CentralMigrationManager {
+	return &CentralMigrationManager{
+		BaseWorker: workers.BaseWorker{
+			ID:         uuid.New().String(),
+			WorkerType: centralMigrationLeaseType,
+			Reconciler: workers.Reconciler{},
+		},
+		migrationService:        migrationService,
+		centralService:          centralService,
+		dnsProvider:             dnsProvider,
+		centralConfig:           centralConfig,
+		managedCentralPresenter: managedCentralPresenter,
+		now:                     time.Now,
+	}
+}
+
+// GetRepeatInterval ...
+func (*CentralMigrationManager) GetRepeatInterval() time.Duration {
+	return 30 * time.Second
+}
+
+// Start initializes the central migration manager to reconcile central migrations
+func (m *CentralMigrationManager) Start() {
+	m.StartWorker(m)
+}
+
+// Stop causes the process for reconciling central migrations to stop
+func (m *CentralMigrationManager) Stop() {
+	m.StopWorker(m)
+}
+
+// Reconcile ...
+func (m *CentralMigrationManager) Reconcile() []error {
+	migrations, svcErr := m.migrationService.ListActive()
+	if svcErr != nil {
+		return []error{errors.Wrap(svcErr, "failed to list active central migrations")}
+	}
+
+	var encounteredErrors []error
+	for _, migration := range migrations {
+		if err := m.reconcileMigration(migration); err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to reconcile migration %s of central %s", migration.ID, migration.CentralID))
+		}
+	}
+	return encounteredErrors
+}
+
+func (m *CentralMigrationManager) reconcileMigration(migration *dbapi.CentralMigration) error {
+	// Failed migrations are left as they are until an admin rolls them back
+	if migration.Phase == dbapi.CentralMigrationPhaseFailed {
+		return nil
+	}
+
+	central, svcErr := m.centralService.GetByID(migration.CentralID)
+	if svcErr != nil {
+		if svcErr.Is404() {
+			return m.fail(migration, "central was deleted")
+		}
+		return svcErr
+	}
+	if central.Status == constants.CentralRequestStatusDeprovision.String() || central.Status == constants.CentralRequestStatusDeleting.String() {
+		return m.fail(migration, "central is being deleted")
+	}
+
+	completed := services.IsMigrationPhaseCompleted(migration)
+	switch migration.Phase {
+	case dbapi.CentralMigrationPhaseSwitchDNS:
+		switched, err := m.switchCluster(central, migration, migration.TargetClusterID, migration.TargetRoutes)
+		if err != nil {
+			glog.Errorf("Failed to switch central %s to cluster %s: %v", central.ID, migration.TargetClusterID, err)
+		}
+		completed = switched
+	case dbapi.CentralMigrationPhaseRollingBack:
+		// The DNS records point back to the source cluster before the target cluster removes the tenant
+		switched, err := m.switchCluster(central, migration, migration.SourceClusterID, migration.SourceRoutes)
+		if err != nil {
+			glog.Errorf("Failed to switch central %s back to cluster %s: %v", central.ID, migration.SourceClusterID, err)
+		}
+		completed = completed && switched
+	}
+
+	if completed {
+		if svcErr := m.migrationService.Transition(migration, nextCentralMigrationPhases[migration.Phase], ""); svcErr != nil {
+			return svcErr
+		}
+		return nil
+	}
+
+	timeout := m.centralConfig.CentralMigrationPhaseTimeout
+	if m.now().Sub(migration.PhaseStartedAt) > timeout {
+		return m.fail(migration, fmt.Sprintf("phase %s did not complete within %s", migration.Phase, timeout))
+	}
+	return nil
+}
+
+// switchCluster assigns the central to the cluster and points its DNS records to the routes on it. It returns true once
+// the DNS records are in sync.
+func (m *CentralMigrationManager) switchCluster(central *dbapi.CentralRequest, migration *dbapi.CentralMigration, clusterID string, routes api.JSON) (bool, error) {
+	if central.ClusterID != clusterID {
+		if svcErr := m.centralService.Updates(context.Background(), central, map[string]interface{}{
+			"cluster_id": clusterID,
+			"routes":     routes,
+		}); svcErr != nil {
+			return false, svcErr
+		}
+		central.ClusterID = clusterID
+		central.Routes = routes
+		glog.Infof("Assigned central %s to cluster %s for migration %s", central.ID, clusterID, migration.ID)
+	}
+
+	if !m.centralConfig.EnableCentralExternalDomain {
+		return true, nil
+	}
+	managedCentral, err := m.managedCentralPresenter.PresentManagedCentral(central)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to present managed central for central %s", central.ID)
+	}
+	if externaldns.IsEnabled(managedCentral) {
+		// The routes on the cluster publish their DNS records themselves
+		return true, nil
+	}
+
+	if migration.DNSChangeID == "" {
+		change, svcErr := m.centralService.ChangeCentralCNAMErecords(central, services.CentralRoutesActionUpsert)
+		if svcErr != nil {
+			return false, svcErr
+		}
+		if svcErr := m.migrationService.SetDNSChangeID(migration, change.ID); svcErr != nil {
+			return false, svcErr
+		}
+		if svcErr := m.centralService.Updates(context.Background(), central, map[string]interface{}{"routes_creation_id": migration.DNSChangeID}); svcErr != nil {
+			return false, svcErr
+		}
+		central.RoutesCreationID = migration.DNSChangeID
+		return change.IsInSync(), nil
+	}
+
+	central.RoutesCreationID = migration.DNSChangeID
+	change, err := m.dnsProvider.GetChangeStatus(context.Background(), migration.DNSChangeID)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get status of DNS change %s", migration.DNSChangeID)
+	}
+	return change.IsInSync(), nil
+}
+
+func (m *CentralMigrationManager) fail(migration *dbapi.CentralMigration, reason string) error {
+	glog.Warningf("Migration %s of central %s failed in phase %s: %s", migration.ID, migration.CentralID, migration.Phase, reason)
+	if svcErr := m.migrationService.Transition(migration, dbapi.CentralMigrationPhaseFailed, reason); svcErr != nil {
+		return svcErr
+	}
+	return nil
+}
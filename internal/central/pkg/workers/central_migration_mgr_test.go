@@ -0,0 +1,136 @@
+package workers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/dns"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sourceClusterID = "source-cluster"
+
+func TestCentralMigrationManagerReconcile(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name            string
+		migration       dbapi.CentralMigration
+		central         *dbapi.CentralRequest
+		wantTransitions []dbapi.CentralMigrationPhase
+		wantClusterID   string
+	}{
+		{
+			name:          "should wait for the target cluster to be prepared",
+			migration:     dbapi.CentralMigration{Phase: dbapi.CentralMigrationPhasePrepareTarget, PhaseStartedAt: now},
+			wantClusterID: sourceClusterID,
+		},
+		{
+			name: "should quiesce the source cluster once the target cluster is prepared",
+			migration: dbapi.CentralMigration{Phase: dbapi.CentralMigrationPhasePrepareTarget, PhaseStartedAt: now,
+				TargetCompleted: true},
+			wantTransitions: []dbapi.CentralMigrationPhase{dbapi.CentralMigrationPhaseQuiesceSource},
+			wantClusterID:   sourceClusterID,
+		},
+		{
+			name: "should not complete the phase of the source cluster with the target cluster",
+			migration: dbapi.CentralMigration{Phase: dbapi.CentralMigrationPhaseQuiesceSource, PhaseStartedAt: now,
+				TargetCompleted: true},
+			wantClusterID: sourceClusterID,
+		},
+		{
+			name:            "should assign the central to the target cluster when switching DNS",
+			migration:       dbapi.CentralMigration{Phase: dbapi.CentralMigrationPhaseSwitchDNS, PhaseStartedAt: now},
+			wantTransitions: []dbapi.CentralMigrationPhase{dbapi.CentralMigrationPhaseVerifyTarget},
+			wantClusterID:   targetClusterID,
+		},
+		{
+			name: "should complete the migration once the source cluster is cleaned up",
+			migration: dbapi.CentralMigration{Phase: dbapi.CentralMigrationPhaseCleanupSource, PhaseStartedAt: now,
+				SourceCompleted: true},
+			wantTransitions: []dbapi.CentralMigrationPhase{dbapi.CentralMigrationPhaseCompleted},
+			wantClusterID:   sourceClusterID,
+		},
+		{
+			name:            "should fail a phase which does not complete in time",
+			migration:       dbapi.CentralMigration{Phase: dbapi.CentralMigrationPhaseVerifyTarget, PhaseStartedAt: now.Add(-time.Hour)},
+			wantTransitions: []dbapi.CentralMigrationPhase{dbapi.CentralMigrationPhaseFailed},
+			wantClusterID:   sourceClusterID,
+		},
+		{
+			name:          "should leave failed migrations until they are rolled back",
+			migration:     dbapi.CentralMigration{Phase: dbapi.CentralMigrationPhaseFailed, PhaseStartedAt: now.Add(-time.Hour)},
+			wantClusterID: sourceClusterID,
+		},
+		{
+			name:            "should fail the migration of a deleted central",
+			migration:       dbapi.CentralMigration{Phase: dbapi.CentralMigrationPhasePrepareTarget, PhaseStartedAt: now},
+			central:         &dbapi.CentralRequest{Status: constants.CentralRequestStatusDeprovision.String(), ClusterID: sourceClusterID},
+			wantTransitions: []dbapi.CentralMigrationPhase{dbapi.CentralMigrationPhaseFailed},
+			wantClusterID:   sourceClusterID,
+		},
+		{
+			name: "should assign the central back to the source cluster and wait for the target cluster when rolling back",
+			migration: dbapi.CentralMigration{Phase: dbapi.CentralMigrationPhaseRollingBack, PhaseStartedAt: now,
+				SourceCompleted: true},
+			central:       &dbapi.CentralRequest{Status: constants.CentralRequestStatusReady.String(), ClusterID: targetClusterID},
+			wantClusterID: sourceClusterID,
+		},
+		{
+			name: "should complete the rollback once the target cluster is cleaned up",
+			migration: dbapi.CentralMigration{Phase: dbapi.CentralMigrationPhaseRollingBack, PhaseStartedAt: now,
+				TargetCompleted: true},
+			central:         &dbapi.CentralRequest{Status: constants.CentralRequestStatusReady.String(), ClusterID: targetClusterID},
+			wantTransitions: []dbapi.CentralMigrationPhase{dbapi.CentralMigrationPhaseRolledBack},
+			wantClusterID:   sourceClusterID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migration := tt.migration
+			migration.ID = "migration-1"
+			migration.CentralID = "central-1"
+			migration.SourceClusterID = sourceClusterID
+			migration.TargetClusterID = targetClusterID
+			central := tt.central
+			if central == nil {
+				central = &dbapi.CentralRequest{Status: constants.CentralRequestStatusReady.String(), ClusterID: sourceClusterID}
+			}
+			central.Meta = api.Meta{ID: migration.CentralID}
+
+			var transitions []dbapi.CentralMigrationPhase
+			migrationService := &services.CentralMigrationServiceMock{
+				ListActiveFunc: func() ([]*dbapi.CentralMigration, *errors.ServiceError) {
+					return []*dbapi.CentralMigration{&migration}, nil
+				},
+				TransitionFunc: func(_ *dbapi.CentralMigration, to dbapi.CentralMigrationPhase, _ string) *errors.ServiceError {
+					transitions = append(transitions, to)
+					return nil
+				},
+			}
+			centralService := &services.CentralServiceMock{
+				GetByIDFunc: func(_ string) (*dbapi.CentralRequest, *errors.ServiceError) {
+					return central, nil
+				},
+				UpdatesFunc: func(_ context.Context, centralRequest *dbapi.CentralRequest, values map[string]interface{}) *errors.ServiceError {
+					centralRequest.ClusterID = values["cluster_id"].(string)
+					return nil
+				},
+			}
+			centralConfig := config.NewCentralConfig()
+			centralConfig.EnableCentralExternalDomain = false
+			m := NewCentralMigrationManager(migrationService, centralService, dns.NewMemoryProvider(), centralConfig, nil)
+
+			require.Empty(t, m.Reconcile())
+			assert.Equal(t, tt.wantTransitions, transitions)
+			assert.Equal(t, tt.wantClusterID, central.ClusterID)
+		})
+	}
+}
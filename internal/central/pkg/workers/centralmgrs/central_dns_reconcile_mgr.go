@@ -0,0 +1,258 @@
+package centralmgrs
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/xid"
+	"github.com/stackrox/acs-fleet-manager/internal/central/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/dns"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/externaldns"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/presenters"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+	"github.com/stackrox/acs-fleet-manager/pkg/workers"
+)
+
+const centralDNSReconcileWorkerType = "central_dns_reconcile_worker"
+
+// Kinds of drift between the DNS records and the routes of centrals
+const (
+	dnsDriftMissing  = "missing"
+	dnsDriftWrong    = "wrong"
+	dnsDriftOrphaned = "orphaned"
+	dnsDriftUnowned  = "unowned"
+)
+
+// CentralDNSReconcileManager compares the CNAME and A records of the central hosts in the DNS zone and their owner
+// records with the routes of the centrals. If repair is enabled, missing records and records pointing to the wrong
+// router are upserted and records of centrals which no longer exist are deleted. Records without owner record are
+// never deleted, as they were not created by fleet-manager.
+type CentralDNSReconcileManager struct {
+	workers.BaseWorker
+	centralService          services.CentralService
+	dnsProvider             dns.DNSProvider
+	centralConfig           *config.CentralConfig
+	dnsConfig               *config.DNSConfig
+	managedCentralPresenter *presenters.ManagedCentralPresenter
+}
+
+var _ workers.Worker = &CentralDNSReconcileManager{}
+
+// NewCentralDNSReconcileManager ...
+func NewCentralDNSReconcileManager(centralService services.CentralService, dnsProvider dns.DNSProvider, centralConfig *config.CentralConfig,
+	dnsConfig *config.DNSConfig, managedCentralPresenter *presenters.ManagedCentralPresenter) *CentralDNSReconcileManager {
+	metrics.InitReconcilerMetricsForType(centralDNSReconcileWorkerType)
+	return &CentralDNSReconcileManager{
+		BaseWorker: workers.BaseWorker{
+			ID:         uuid.New().String(),
+			WorkerType: centralDNSReconcileWorkerType,
+			Reconciler: workers.Reconciler{},
+		},
+		centralService:          centralService,
+		dnsProvider:             dnsProvider,
+		centralConfig:           centralConfig,
+		dnsConfig:               dnsConfig,
+		managedCentralPresenter: managedCentralPresenter,
+	}
+}
+
+// GetRepeatInterval ...
+func (k *CentralDNSReconcileManager) GetRepeatInterval() time.Duration {
+	return k.dnsConfig.ReconcileInterval
+}
+
+// Start ...
+func (k *CentralDNSReconcileManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop ...
+func (k *CentralDNSReconcileManager) Stop() {
+	k.StopWorker(k)
+}
+
+// Reconcile ...
+func (k *CentralDNSReconcileManager) Reconcile() []error {
+	if !k.centralConfig.EnableCentralExternalDomain {
+		return nil
+	}
+	zone := k.centralConfig.CentralDomainName
+
+	// The records are listed before the centrals. Records of centrals created in between are not yet in the list,
+	// so that they cannot be mistaken as orphaned.
+	records, err := k.dnsProvider.ListRecords(context.Background(), zone)
+	if err != nil {
+		return []error{errors.Wrapf(err, "failed to list DNS records of zone %s", zone)}
+	}
+	centrals, svcErr := k.centralService.ListCentralsByHost(zone)
+	if svcErr != nil {
+		return []error{errors.Wrap(svcErr, "failed to list centrals")}
+	}
+
+	existing := make(map[string]bool, len(centrals))
+	var expected []dns.Record
+	var errs []error
+	for _, central := range centrals {
+		existing[central.ID] = true
+		centralRecords, err := k.expectedRecords(central)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		expected = append(expected, centralRecords...)
+	}
+
+	drift := findCentralDNSDrift(zone, records, existing, expected)
+	metrics.UpdateCentralDNSRecordDriftMetric(dnsDriftMissing, len(drift.missing))
+	metrics.UpdateCentralDNSRecordDriftMetric(dnsDriftWrong, len(drift.wrong))
+	metrics.UpdateCentralDNSRecordDriftMetric(dnsDriftOrphaned, len(drift.orphaned))
+	metrics.UpdateCentralDNSRecordDriftMetric(dnsDriftUnowned, len(drift.unowned))
+	if drift.isEmpty() {
+		return errs
+	}
+	glog.Infof("DNS records of zone %s drifted from central routes: %d missing, %d wrong, %d orphaned, %d orphaned without owner record",
+		zone, len(drift.missing), len(drift.wrong), len(drift.orphaned), len(drift.unowned))
+	for _, r := range drift.unowned {
+		glog.Warningf("DNS record %s %s of a deleted central has no owner record and is not deleted", r.Name, r.Value)
+	}
+	if !k.dnsConfig.ReconcileRepair {
+		return errs
+	}
+
+	errs = append(errs, k.repair(zone, dnsDriftMissing, drift.missing, k.dnsProvider.UpsertRecords)...)
+	errs = append(errs, k.repair(zone, dnsDriftWrong, drift.wrong, k.dnsProvider.UpsertRecords)...)
+	errs = append(errs, k.repair(zone, dnsDriftOrphaned, drift.orphaned, k.dnsProvider.DeleteRecords)...)
+	return errs
+}
+
+// expectedRecords returns the CNAME or A records of the central hosts and their owner records, if the records are
+// managed by fleet-manager
+func (k *CentralDNSReconcileManager) expectedRecords(central *dbapi.CentralRequest) ([]dns.Record, error) {
+	// Routes which are not yet created are handled by the CentralRoutesCNAMEManager,
+	// deleted centrals by the deletion of the central
+	if !central.RoutesCreated || central.Routes == nil || isCentralDeleted(central) {
+		return nil, nil
+	}
+	managedCentral, err := k.managedCentralPresenter.PresentManagedCentral(central)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to present managed central for central %s", central.ID)
+	}
+	if externaldns.IsEnabled(managedCentral) {
+		return nil, nil
+	}
+	routes, err := central.GetRoutes()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get routes of central %s", central.ID)
+	}
+	hosts := []string{dns.NormalizeName(central.GetUIHost()), dns.NormalizeName(central.GetDataHost())}
+	var records []dns.Record
+	for _, r := range dns.RouteRecords(routes) {
+		if slices.Contains(hosts, dns.NormalizeName(r.Name)) {
+			records = append(records, r)
+		}
+	}
+	return append(records, dns.OwnerRecords(records)...), nil
+}
+
+func (k *CentralDNSReconcileManager) repair(zone string, drift string, records []dns.Record,
+	change func(ctx context.Context, zone string, records []dns.Record) (*dns.Change, error)) []error {
+	var errs []error
+	for _, r := range records {
+		if _, err := change(context.Background(), zone, []dns.Record{r}); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to repair %s DNS record %s", drift, r.Name))
+			continue
+		}
+		glog.Infof("Repaired %s DNS record %s %s", drift, r.Name, r.Value)
+		metrics.IncreaseCentralDNSRecordRepairCountMetric(drift)
+	}
+	return errs
+}
+
+func isCentralDeleted(central *dbapi.CentralRequest) bool {
+	return central.Status == constants.CentralRequestStatusDeprovision.String() ||
+		central.Status == constants.CentralRequestStatusDeleting.String()
+}
+
+// centralDNSDrift contains the records to upsert for missing and wrong records, and the records to delete for
+// orphaned records. Orphaned records without owner record are only reported.
+type centralDNSDrift struct {
+	missing  []dns.Record
+	wrong    []dns.Record
+	orphaned []dns.Record
+	unowned  []dns.Record
+}
+
+func (d centralDNSDrift) isEmpty() bool {
+	return len(d.missing) == 0 && len(d.wrong) == 0 && len(d.orphaned) == 0 && len(d.unowned) == 0
+}
+
+// findCentralDNSDrift compares the CNAME and A records of central hosts in the zone and their owner records with the
+// expected records. Records of central hosts whose central does not exist are orphaned, they are unowned if there is
+// no owner record of the host.
+func findCentralDNSDrift(zone string, records []dns.Record, existing map[string]bool, expected []dns.Record) centralDNSDrift {
+	owned := map[string]bool{}
+	for _, r := range records {
+		if name, ok := dns.OwnedRecordName(r); ok {
+			owned[name] = true
+		}
+	}
+	actual := map[string]dns.Record{}
+	var drift centralDNSDrift
+	for _, r := range records {
+		host := dns.NormalizeName(r.Name)
+		if r.Type != dns.RecordTypeCNAME && r.Type != dns.RecordTypeA {
+			name, ok := dns.OwnedRecordName(r)
+			if !ok {
+				continue
+			}
+			host = name
+		}
+		centralID, ok := centralIDFromHost(zone, host)
+		if !ok {
+			continue
+		}
+		switch {
+		case existing[centralID]:
+			actual[dns.NormalizeName(r.Name)] = r
+		case owned[host]:
+			drift.orphaned = append(drift.orphaned, r)
+		default:
+			drift.unowned = append(drift.unowned, r)
+		}
+	}
+	for _, r := range expected {
+		a, ok := actual[dns.NormalizeName(r.Name)]
+		switch {
+		case !ok:
+			drift.missing = append(drift.missing, r)
+		case dns.NormalizeName(a.Value) != dns.NormalizeName(r.Value):
+			drift.wrong = append(drift.wrong, r)
+		}
+	}
+	return drift
+}
+
+// centralIDFromHost returns the central ID of the UI or data host of a central in the zone
+func centralIDFromHost(zone string, host string) (string, bool) {
+	label, ok := strings.CutSuffix(dns.NormalizeName(host), "."+dns.NormalizeName(zone))
+	if !ok || strings.Contains(label, ".") {
+		return "", false
+	}
+	// The data host prefix has to be checked first, as it also starts with the UI host prefix
+	for _, prefix := range []string{"acs-data-", "acs-"} {
+		if id, ok := strings.CutPrefix(label, prefix); ok {
+			if _, err := xid.FromString(id); err == nil {
+				return id, true
+			}
+		}
+	}
+	return "", false
+}
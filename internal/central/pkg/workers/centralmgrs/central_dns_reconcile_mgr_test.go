@@ -0,0 +1,157 @@
+package centralmgrs
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/dns"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testZone           = "rhacs.test"
+	existingCentralID  = "cq1lnf2u9s3c73a0gvt0"
+	pendingCentralID   = "cq1lnf2u9s3c73a0gvtg"
+	orphanedCentralID  = "cq1lnf2u9s3c73a0gvu0"
+	testClusterRouter  = "router.cluster.test"
+	otherClusterRouter = "router.other-cluster.test"
+)
+
+func cname(name, value string) dns.Record {
+	return dns.Record{Name: name, Type: dns.RecordTypeCNAME, TTL: 300, Value: value}
+}
+
+func TestCentralIDFromHost(t *testing.T) {
+	tests := []struct {
+		host   string
+		wantID string
+		wantOK bool
+	}{
+		{host: "acs-" + existingCentralID + ".rhacs.test", wantID: existingCentralID, wantOK: true},
+		{host: "acs-data-" + existingCentralID + ".rhacs.test.", wantID: existingCentralID, wantOK: true},
+		{host: "acs-" + existingCentralID + ".other.test", wantOK: false},
+		{host: "acs-" + existingCentralID + ".sub.rhacs.test", wantOK: false},
+		{host: "acs-console.rhacs.test", wantOK: false},
+		{host: "rhacs.test", wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			id, ok := centralIDFromHost(testZone, tt.host)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantID, id)
+		})
+	}
+}
+
+// withOwners returns the records followed by their owner records
+func withOwners(records ...dns.Record) []dns.Record {
+	return append(records, dns.OwnerRecords(records)...)
+}
+
+func TestFindCentralDNSDrift(t *testing.T) {
+	uiHost := "acs-" + existingCentralID + "." + testZone
+	dataHost := "acs-data-" + existingCentralID + "." + testZone
+	orphanedHost := "acs-" + orphanedCentralID + "." + testZone
+	expected := withOwners(cname(uiHost, testClusterRouter), cname(dataHost, testClusterRouter))
+	existing := map[string]bool{existingCentralID: true}
+
+	tests := []struct {
+		name    string
+		records []dns.Record
+		want    centralDNSDrift
+	}{
+		{
+			name:    "should not report drift of records matching the routes",
+			records: withOwners(cname(uiHost, testClusterRouter+"."), cname(dataHost, testClusterRouter)),
+		},
+		{
+			name:    "should report missing records",
+			records: withOwners(cname(uiHost, testClusterRouter)),
+			want:    centralDNSDrift{missing: withOwners(cname(dataHost, testClusterRouter))},
+		},
+		{
+			name:    "should report missing owner records",
+			records: []dns.Record{cname(uiHost, testClusterRouter), cname(dataHost, testClusterRouter)},
+			want:    centralDNSDrift{missing: dns.OwnerRecords([]dns.Record{cname(uiHost, testClusterRouter), cname(dataHost, testClusterRouter)})},
+		},
+		{
+			name:    "should report records pointing to the wrong router",
+			records: append(withOwners(cname(uiHost, testClusterRouter)), withOwners(cname(dataHost, otherClusterRouter))...),
+			want:    centralDNSDrift{wrong: []dns.Record{cname(dataHost, testClusterRouter)}},
+		},
+		{
+			name:    "should report A records of hosts whose router is a host name",
+			records: withOwners(cname(uiHost, testClusterRouter), dns.Record{Name: dataHost, Type: dns.RecordTypeA, TTL: 300, Value: "172.18.0.2"}),
+			want:    centralDNSDrift{wrong: []dns.Record{cname(dataHost, testClusterRouter)}},
+		},
+		{
+			name:    "should report records of centrals which do not exist",
+			records: slices.Concat(expected, withOwners(cname(orphanedHost, testClusterRouter))),
+			want:    centralDNSDrift{orphaned: withOwners(cname(orphanedHost, testClusterRouter))},
+		},
+		{
+			name:    "should report records of centrals which do not exist without owner record as unowned",
+			records: slices.Concat(expected, []dns.Record{cname(orphanedHost, testClusterRouter)}),
+			want:    centralDNSDrift{unowned: []dns.Record{cname(orphanedHost, testClusterRouter)}},
+		},
+		{
+			name: "should ignore records which are not CNAME, A or owner records of central hosts",
+			records: slices.Concat(expected, []dns.Record{
+				{Name: orphanedHost, Type: dns.RecordTypeTXT, Value: "heritage=external-dns"},
+				cname("console."+testZone, testClusterRouter),
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, findCentralDNSDrift(testZone, tt.records, existing, expected))
+		})
+	}
+}
+
+func TestCentralDNSReconcileManagerDeletesOwnedOrphanedRecords(t *testing.T) {
+	provider := dns.NewMemoryProvider()
+	pendingRecord := cname("acs-"+pendingCentralID+"."+testZone, testClusterRouter)
+	unownedRecord := cname("acs-data-"+orphanedCentralID+"."+testZone, testClusterRouter)
+	records := append(withOwners(cname("acs-"+orphanedCentralID+"."+testZone, testClusterRouter)), pendingRecord, unownedRecord)
+	_, err := provider.UpsertRecords(context.Background(), testZone, records)
+	require.NoError(t, err)
+
+	dnsConfig := config.NewDNSConfig()
+	dnsConfig.ReconcileRepair = true
+	m := newTestCentralDNSReconcileManager(provider, dnsConfig)
+
+	require.Empty(t, m.Reconcile())
+	assert.Equal(t, []dns.Record{pendingRecord, unownedRecord}, provider.Records())
+}
+
+func TestCentralDNSReconcileManagerOnlyReportsDriftByDefault(t *testing.T) {
+	provider := dns.NewMemoryProvider()
+	_, err := provider.UpsertRecords(context.Background(), testZone, withOwners(cname("acs-"+orphanedCentralID+"."+testZone, testClusterRouter)))
+	require.NoError(t, err)
+	before := provider.Records()
+
+	m := newTestCentralDNSReconcileManager(provider, config.NewDNSConfig())
+
+	require.Empty(t, m.Reconcile())
+	assert.Equal(t, before, provider.Records())
+}
+
+func newTestCentralDNSReconcileManager(provider dns.DNSProvider, dnsConfig *config.DNSConfig) *CentralDNSReconcileManager {
+	centralService := &services.CentralServiceMock{
+		ListCentralsByHostFunc: func(host string) ([]*dbapi.CentralRequest, *errors.ServiceError) {
+			return []*dbapi.CentralRequest{{Meta: api.Meta{ID: pendingCentralID}, Host: host}}, nil
+		},
+	}
+	centralConfig := config.NewCentralConfig()
+	centralConfig.EnableCentralExternalDomain = true
+	centralConfig.CentralDomainName = testZone
+	return NewCentralDNSReconcileManager(centralService, provider, centralConfig, dnsConfig, nil)
+}
@@ -0,0 +1,69 @@
+package centralmgrs
+
+import (
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+	"github.com/stackrox/acs-fleet-manager/pkg/workers"
+)
+
+const centralUsagePruningWorkerType = "central_usage_pruning"
+
+// CentralUsagePruningManager permanently deletes the usage reported for centrals once it exceeds the usage retention.
+// Usage is recorded hourly for every central, so the table grows quickly without pruning.
+type CentralUsagePruningManager struct {
+	workers.BaseWorker
+	usageService  services.CentralUsageService
+	centralConfig *config.CentralConfig
+	now           func() time.Time
+}
+
+var _ workers.Worker = &CentralUsagePruningManager{}
+
+// NewCentralUsagePruningManager creates a new usage pruning manager.
+func NewCentralUsagePruningManager(usageService services.CentralUsageService, centralConfig *config.CentralConfig) *CentralUsagePruningManager {
+	metrics.InitReconcilerMetricsForType(centralUsagePruningWorkerType)
+	return &CentralUsagePruningManager{
+		BaseWorker: workers.BaseWorker{
+			ID:         uuid.New().String(),
+			WorkerType: centralUsagePruningWorkerType,
+			Reconciler: workers.Reconciler{},
+		},
+		usageService:  usageService,
+		centralConfig: centralConfig,
+		now:           time.Now,
+	}
+}
+
+// GetRepeatInterval returns how often the usage pruning worker runs.
+func (*CentralUsagePruningManager) GetRepeatInterval() time.Duration {
+	return 6 * time.Hour
+}
+
+// Start initializes the usage pruning worker.
+func (m *CentralUsagePruningManager) Start() {
+	m.StartWorker(m)
+}
+
+// Stop causes the usage pruning worker to stop.
+func (m *CentralUsagePruningManager) Stop() {
+	m.StopWorker(m)
+}
+
+// Reconcile permanently deletes usage records past the usage retention.
+func (m *CentralUsagePruningManager) Reconcile() []error {
+	glog.Infoln("reconciling central usage pruning")
+	retention := m.centralConfig.CentralUsageRetention
+	pruned, err := m.usageService.PruneUsage(m.now().Add(-retention))
+	if err != nil {
+		return []error{err}
+	}
+	if pruned > 0 {
+		glog.Infof("pruned %d central usage records older than %s", pruned, retention)
+	}
+	return nil
+}
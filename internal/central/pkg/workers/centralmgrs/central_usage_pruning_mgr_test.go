@@ -0,0 +1,34 @@
+package centralmgrs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCentralUsagePruningManager(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	usageService := &services.CentralUsageServiceMock{
+		PruneUsageFunc: func(before time.Time) (int64, *errors.ServiceError) {
+			return 3, nil
+		},
+	}
+	centralConfig := config.NewCentralConfig()
+	centralConfig.CentralUsageRetention = 30 * 24 * time.Hour
+	mgr := NewCentralUsagePruningManager(usageService, centralConfig)
+	mgr.now = func() time.Time { return now }
+
+	assert.Empty(t, mgr.Reconcile())
+	require.Len(t, usageService.PruneUsageCalls(), 1)
+	assert.Equal(t, time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC), usageService.PruneUsageCalls()[0].Before)
+
+	usageService.PruneUsageFunc = func(before time.Time) (int64, *errors.ServiceError) {
+		return 0, errors.GeneralError("database unavailable")
+	}
+	assert.Len(t, mgr.Reconcile(), 1)
+}
@@ -108,7 +108,7 @@ func (k *CentralAuthConfigManager) reconcileCentralRequest(cr *dbapi.CentralRequ
 	if err != nil {
 		return errors.Wrap(err, "failed to augment central request with auth config")
 	}
-	if err := k.centralService.UpdateIgnoreNils(cr); err != nil {
+	if err := k.centralService.UpdateIgnoreNils(context.Background(), cr); err != nil {
 		return errors.Wrapf(err, "failed to update central request %s", cr.ID)
 	}
 
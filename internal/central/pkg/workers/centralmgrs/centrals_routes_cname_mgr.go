@@ -1,10 +1,13 @@
 package centralmgrs
 
 import (
+	"context"
+
 	"github.com/golang/glog"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/dns"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/externaldns"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/presenters"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
@@ -18,6 +21,7 @@ const centralDNSWorkerType = "central_dns"
 type CentralRoutesCNAMEManager struct {
 	workers.BaseWorker
 	centralService          services.CentralService
+	dnsProvider             dns.DNSProvider
 	centralConfig           *config.CentralConfig
 	managedCentralPresenter *presenters.ManagedCentralPresenter
 }
@@ -25,7 +29,7 @@ type CentralRoutesCNAMEManager struct {
 var _ workers.Worker = &CentralRoutesCNAMEManager{}
 
 // NewCentralCNAMEManager ...
-func NewCentralCNAMEManager(centralService services.CentralService, centralConfig *config.CentralConfig, managedCentralPresenter *presenters.ManagedCentralPresenter) *CentralRoutesCNAMEManager {
+func NewCentralCNAMEManager(centralService services.CentralService, dnsProvider dns.DNSProvider, centralConfig *config.CentralConfig, managedCentralPresenter *presenters.ManagedCentralPresenter) *CentralRoutesCNAMEManager {
 	metrics.InitReconcilerMetricsForType(centralDNSWorkerType)
 	return &CentralRoutesCNAMEManager{
 		BaseWorker: workers.BaseWorker{
@@ -34,6 +38,7 @@ func NewCentralCNAMEManager(centralService services.CentralService, centralConfi
 			Reconciler: workers.Reconciler{},
 		},
 		centralService:          centralService,
+		dnsProvider:             dnsProvider,
 		centralConfig:           centralConfig,
 		managedCentralPresenter: managedCentralPresenter,
 	}
@@ -71,38 +76,34 @@ func (k *CentralRoutesCNAMEManager) Reconcile() []error {
 			if central.RoutesCreationID == "" {
 				glog.Infof("creating CNAME records for central %s", central.ID)
 
-				changeOutput, err := k.centralService.ChangeCentralCNAMErecords(central, services.CentralRoutesActionUpsert)
-
+				routes, err := central.GetRoutes()
 				if err != nil {
-					errs = append(errs, err)
+					errs = append(errs, errors.Wrapf(err, "failed to get routes of central %s", central.ID))
 					continue
 				}
-
-				switch {
-				case changeOutput == nil:
-					glog.Infof("creating CNAME records failed with nil result")
-					continue
-				case changeOutput.ChangeInfo == nil || changeOutput.ChangeInfo.Id == nil || changeOutput.ChangeInfo.Status == "":
-					glog.Infof("creating CNAME records failed with nil info")
+				records := dns.RouteRecords(routes)
+				change, err := k.dnsProvider.UpsertRecords(context.Background(), k.centralConfig.CentralDomainName, append(records, dns.OwnerRecords(records)...))
+				if err != nil {
+					errs = append(errs, errors.Wrapf(err, "failed to create CNAME records of central %s", central.ID))
 					continue
 				}
 
-				central.RoutesCreationID = *changeOutput.ChangeInfo.Id
-				central.RoutesCreated = changeOutput.ChangeInfo.Status == "INSYNC"
+				central.RoutesCreationID = change.ID
+				central.RoutesCreated = change.IsInSync()
 			} else {
-				recordStatus, err := k.centralService.GetCNAMERecordStatus(central)
+				change, err := k.dnsProvider.GetChangeStatus(context.Background(), central.RoutesCreationID)
 				if err != nil {
-					errs = append(errs, err)
+					errs = append(errs, errors.Wrapf(err, "failed to get status of CNAME records of central %s", central.ID))
 					continue
 				}
-				central.RoutesCreated = *recordStatus.Status == "INSYNC"
+				central.RoutesCreated = change.IsInSync()
 			}
 		} else {
 			glog.Infof("external certificate is disabled, skip CNAME creation for Central %s", central.ID)
 			central.RoutesCreated = true
 		}
 
-		if err := k.centralService.UpdateIgnoreNils(central); err != nil {
+		if err := k.centralService.UpdateIgnoreNils(context.Background(), central); err != nil {
 			errs = append(errs, err)
 			continue
 		}
@@ -142,7 +142,7 @@ func (k *DeletingCentralManager) reconcileDeletingCentrals(central *dbapi.Centra
 			central.ClientOrigin, central.ID)
 	}
 
-	if err := k.centralService.Delete(central, false); err != nil {
+	if err := k.centralService.Delete(context.Background(), central, false); err != nil {
 		return errors.Wrapf(err, "failed to delete central %s", central.ID)
 	}
 	return nil
@@ -1,6 +1,7 @@
 package centralmgrs
 
 import (
+	"context"
 	"time"
 
 	"github.com/bxcodec/faker/v3/support/slice"
@@ -13,6 +14,7 @@ import (
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
 	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	ocm "github.com/stackrox/acs-fleet-manager/pkg/client/ocm/impl"
 	serviceErr "github.com/stackrox/acs-fleet-manager/pkg/errors"
 	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
 	"github.com/stackrox/acs-fleet-manager/pkg/workers"
@@ -22,15 +24,18 @@ const leaseType = "expiration_date_worker"
 
 // ExpirationDateManager set's the `expired_at` central request property to the
 // current time when the quota allowance returned from AMS equals to 0.
+// The check is skipped while AMS is unavailable, so that outages do not expire Central instances.
 type ExpirationDateManager struct {
 	workers.BaseWorker
 	centralService      services.CentralService
 	quotaServiceFactory services.QuotaServiceFactory
 	centralConfig       *config.CentralConfig
+	amsCircuitBreaker   *ocm.AMSCircuitBreaker
 }
 
 // NewExpirationDateManager creates a new expiration date manager.
-func NewExpirationDateManager(centralService services.CentralService, quotaServiceFactory services.QuotaServiceFactory, centralConfig *config.CentralConfig) *ExpirationDateManager {
+func NewExpirationDateManager(centralService services.CentralService, quotaServiceFactory services.QuotaServiceFactory, centralConfig *config.CentralConfig,
+	amsCircuitBreaker *ocm.AMSCircuitBreaker) *ExpirationDateManager {
 	return &ExpirationDateManager{
 		BaseWorker: workers.BaseWorker{
 			ID:         uuid.New().String(),
@@ -40,6 +45,7 @@ func NewExpirationDateManager(centralService services.CentralService, quotaServi
 		centralService:      centralService,
 		quotaServiceFactory: quotaServiceFactory,
 		centralConfig:       centralConfig,
+		amsCircuitBreaker:   amsCircuitBreaker,
 	}
 }
 
@@ -110,6 +116,11 @@ func (k *ExpirationDateManager) reconcileCentralExpiredAt(centrals dbapi.Central
 		key := quotaCostCacheKey{central.OrganisationID, central.CloudAccountID, central.InstanceType}
 		hasQuota, inCache := quotaCostCache[key]
 		if !inCache {
+			if k.isAMSUnavailable() {
+				glog.Warningf("skipping the expiration check of the remaining central instances, AMS is unavailable")
+				svcErrors = append(svcErrors, errors.Wrap(ocm.ErrAMSUnavailable, "skipped the expiration check"))
+				break
+			}
 			var svcErr *serviceErr.ServiceError
 			hasQuota, svcErr = quotaService.HasQuotaAllowance(central, types.CentralInstanceType(central.InstanceType))
 			if svcErr != nil {
@@ -137,9 +148,15 @@ func (k *ExpirationDateManager) reconcileCentralExpiredAt(centrals dbapi.Central
 	return svcErrors
 }
 
+// isAMSUnavailable returns true if quota is managed by AMS, and AMS is considered unavailable after consecutive
+// failures
+func (k *ExpirationDateManager) isAMSUnavailable() bool {
+	return api.QuotaType(k.centralConfig.Quota.Type) == api.AMSQuotaType && k.amsCircuitBreaker.IsOpen()
+}
+
 func (k *ExpirationDateManager) updateExpiredAtInDB(central *dbapi.CentralRequest) *serviceErr.ServiceError {
 	glog.Infof("updating expired_at of central %q to %q", central.ID, central.ExpiredAt)
-	return k.centralService.Updates(&dbapi.CentralRequest{Meta: api.Meta{ID: central.ID}},
+	return k.centralService.Updates(context.Background(), &dbapi.CentralRequest{Meta: api.Meta{ID: central.ID}},
 		map[string]any{"expired_at": central.ExpiredAt})
 }
 
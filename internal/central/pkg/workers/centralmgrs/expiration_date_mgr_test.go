@@ -1,6 +1,7 @@
 package centralmgrs
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
 	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	ocm "github.com/stackrox/acs-fleet-manager/pkg/client/ocm/impl"
 	"github.com/stackrox/acs-fleet-manager/pkg/errors"
 )
 
@@ -37,7 +39,7 @@ func TestExpirationDateManager(t *testing.T) {
 			ListByStatusFunc: func(status ...constants.CentralStatus) ([]*dbapi.CentralRequest, *errors.ServiceError) {
 				return centrals, nil
 			},
-			UpdatesFunc: func(centralRequest *dbapi.CentralRequest, fields map[string]any) *errors.ServiceError {
+			UpdatesFunc: func(_ context.Context, centralRequest *dbapi.CentralRequest, fields map[string]any) *errors.ServiceError {
 				if _, ok := fields["expired_at"]; !ok {
 					return errors.GeneralError("bad fields")
 				}
@@ -54,7 +56,7 @@ func TestExpirationDateManager(t *testing.T) {
 	t.Run("no centrals, no problem", func(t *testing.T) {
 		centralService := withCentrals()
 		quotaSvc, quotaFactory := withEntitlement(true)
-		mgr := NewExpirationDateManager(centralService, quotaFactory, defaultCfg)
+		mgr := NewExpirationDateManager(centralService, quotaFactory, defaultCfg, ocm.NewAMSCircuitBreaker(ocm.NewOCMConfig()))
 		errs := mgr.Reconcile()
 		require.Empty(t, errs)
 		assert.Len(t, centralService.ListByStatusCalls(), 1)
@@ -68,7 +70,7 @@ func TestExpirationDateManager(t *testing.T) {
 		central := &dbapi.CentralRequest{ExpiredAt: sql.NullTime{Time: now, Valid: true}}
 		centralService := withCentrals(central)
 		quotaSvc, quotaFactory := withEntitlement(true)
-		gpm := NewExpirationDateManager(centralService, quotaFactory, defaultCfg)
+		gpm := NewExpirationDateManager(centralService, quotaFactory, defaultCfg, ocm.NewAMSCircuitBreaker(ocm.NewOCMConfig()))
 		errs := gpm.Reconcile()
 		require.Empty(t, errs)
 		assert.False(t, central.ExpiredAt.Valid)
@@ -83,7 +85,7 @@ func TestExpirationDateManager(t *testing.T) {
 		central := &dbapi.CentralRequest{}
 		centralService := withCentrals(central)
 		quotaSvc, quotaFactory := withEntitlement(false)
-		gpm := NewExpirationDateManager(centralService, quotaFactory, defaultCfg)
+		gpm := NewExpirationDateManager(centralService, quotaFactory, defaultCfg, ocm.NewAMSCircuitBreaker(ocm.NewOCMConfig()))
 		errs := gpm.Reconcile()
 		require.Empty(t, errs)
 		require.True(t, central.ExpiredAt.Valid)
@@ -99,7 +101,7 @@ func TestExpirationDateManager(t *testing.T) {
 		central.ID = internalCentralID
 		centralService := withCentrals(central)
 		quotaSvc, quotaFactory := withEntitlement(true)
-		gpm := NewExpirationDateManager(centralService, quotaFactory, defaultCfg)
+		gpm := NewExpirationDateManager(centralService, quotaFactory, defaultCfg, ocm.NewAMSCircuitBreaker(ocm.NewOCMConfig()))
 		errs := gpm.Reconcile()
 		require.Empty(t, errs)
 		require.False(t, central.ExpiredAt.Valid)
@@ -116,7 +118,7 @@ func TestExpirationDateManager(t *testing.T) {
 		centralE := &dbapi.CentralRequest{ExpiredAt: now, OrganisationID: "another", CloudAccountID: "Zeus"}
 		centralService := withCentrals(centralA, centralB, centralC, centralD, centralE)
 		quotaSvc, quotaFactory := withEntitlement(true)
-		gpm := NewExpirationDateManager(centralService, quotaFactory, defaultCfg)
+		gpm := NewExpirationDateManager(centralService, quotaFactory, defaultCfg, ocm.NewAMSCircuitBreaker(ocm.NewOCMConfig()))
 		errs := gpm.Reconcile()
 		require.Empty(t, errs)
 		assert.False(t, centralA.ExpiredAt.Valid)
@@ -129,4 +131,24 @@ func TestExpirationDateManager(t *testing.T) {
 		assert.Len(t, centralService.UpdatesCalls(), 5)
 		assert.Len(t, quotaFactory.GetQuotaServiceCalls(), 1)
 	})
+
+	t.Run("skip while AMS is unavailable", func(t *testing.T) {
+		central := &dbapi.CentralRequest{}
+		centralService := withCentrals(central)
+		quotaSvc, quotaFactory := withEntitlement(false)
+		amsQuotaConf := config.NewCentralQuotaConfig()
+		amsQuotaConf.Type = api.AMSQuotaType.String()
+		ocmConfig := ocm.NewOCMConfig()
+		breaker := ocm.NewAMSCircuitBreaker(ocmConfig)
+		for i := 0; i < ocmConfig.AMSCircuitBreakerFailureThreshold; i++ {
+			breaker.RecordFailure()
+		}
+		gpm := NewExpirationDateManager(centralService, quotaFactory, &config.CentralConfig{Quota: amsQuotaConf}, breaker)
+		errs := gpm.Reconcile()
+		require.Len(t, errs, 1)
+		assert.ErrorContains(t, errs[0], ocm.ErrAMSUnavailable.Error())
+		assert.False(t, central.ExpiredAt.Valid)
+		assert.Empty(t, quotaSvc.HasQuotaAllowanceCalls())
+		assert.Empty(t, centralService.UpdatesCalls())
+	})
 }
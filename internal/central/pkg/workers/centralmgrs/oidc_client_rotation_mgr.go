@@ -0,0 +1,181 @@
+package centralmgrs
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/iam"
+	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+	"github.com/stackrox/acs-fleet-manager/pkg/workers"
+)
+
+const (
+	oidcClientRotationWorkerType = "oidc_client_rotation_worker"
+
+	// oidcClientRotationAuditActor and oidcClientRotationAuditRouteName identify scheduled rotations in the audit
+	// trail, which are not made by an admin request
+	oidcClientRotationAuditActor     = "fleet-manager"
+	oidcClientRotationAuditRouteName = "scheduled-oidc-client-rotation"
+
+	oidcClientRotationResultSuccess = "success"
+	oidcClientRotationResultFailure = "failure"
+)
+
+// OIDCClientRotationManager rotates the OIDC clients of Centrals which are older than the max age of their origin.
+// A client is rotated during the rotation window of its Central. The previous client is kept until the data plane
+// reports that it applied the new client, or the propagation timeout passed.
+type OIDCClientRotationManager struct {
+	workers.BaseWorker
+	centralService services.CentralService
+	auditService   services.AdminAuditService
+	centralConfig  *config.CentralConfig
+	realmConfig    *iam.IAMRealmConfig
+	now            func() time.Time
+}
+
+var _ workers.Worker = &OIDCClientRotationManager{}
+
+// NewOIDCClientRotationManager ...
+func NewOIDCClientRotationManager(centralService services.CentralService, auditService services.AdminAuditService,
+	iamConfig *iam.IAMConfig, centralConfig *config.CentralConfig) *OIDCClientRotationManager {
+	metrics.InitReconcilerMetricsForType(oidcClientRotationWorkerType)
+	return &OIDCClientRotationManager{
+		BaseWorker: workers.BaseWorker{
+			ID:         uuid.New().String(),
+			WorkerType: oidcClientRotationWorkerType,
+			Reconciler: workers.Reconciler{},
+		},
+		centralService: centralService,
+		auditService:   auditService,
+		centralConfig:  centralConfig,
+		realmConfig:    iamConfig.RedhatSSORealm,
+		now:            time.Now,
+	}
+}
+
+// GetRepeatInterval ...
+func (k *OIDCClientRotationManager) GetRepeatInterval() time.Duration {
+	return k.centralConfig.OIDCClientRotation.Interval
+}
+
+// Start ...
+func (k *OIDCClientRotationManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop ...
+func (k *OIDCClientRotationManager) Stop() {
+	k.StopWorker(k)
+}
+
+// Reconcile ...
+func (k *OIDCClientRotationManager) Reconcile() []error {
+	if k.centralConfig.HasStaticAuth() || !k.realmConfig.IsConfigured() {
+		return nil
+	}
+	ctx := context.Background()
+	rotationConfig := k.centralConfig.OIDCClientRotation
+
+	centrals, svcErr := k.centralService.ListCentralsWithDynamicClients()
+	if svcErr != nil {
+		return []error{errors.Wrap(svcErr, "failed to list centrals with RHSSO dynamic clients")}
+	}
+
+	now := k.now()
+	metrics.ResetCentralOIDCClientAgeMetric()
+	var errs []error
+	rotations := 0
+	for _, central := range centrals {
+		age := now.Sub(oidcClientCreatedAt(central))
+		metrics.UpdateCentralOIDCClientAgeMetric(central.ID, central.ClientOrigin, age)
+
+		if central.PreviousClientID != "" {
+			// the previous client is normally deleted as soon as the data plane reports the new one
+			if age < rotationConfig.PropagationTimeout {
+				continue
+			}
+			glog.Warningf("Data plane did not report the rotated OIDC client %s of central %s within %s, deleting the previous client %s",
+				central.ClientID, central.ID, rotationConfig.PropagationTimeout, central.PreviousClientID)
+			if svcErr := k.centralService.CompleteCentralRHSSOClientRotation(ctx, central); svcErr != nil {
+				errs = append(errs, errors.Wrapf(svcErr, "failed to complete OIDC client rotation of central %s", central.ID))
+			}
+			continue
+		}
+
+		maxAge, ok := rotationConfig.MaxAgeOf(central.ClientOrigin)
+		if !ok || age < maxAge || !k.rotationWindowOf(central).Contains(now) {
+			continue
+		}
+		if rotations >= rotationConfig.BatchSize {
+			glog.Infof("Rotated %d OIDC clients, the remaining clients are rotated in the next interval", rotations)
+			break
+		}
+		rotations++
+		if err := k.rotate(ctx, central, age); err != nil {
+			metrics.IncreaseCentralOIDCClientRotationCountMetric(oidcClientRotationResultFailure)
+			errs = append(errs, err)
+			continue
+		}
+		metrics.IncreaseCentralOIDCClientRotationCountMetric(oidcClientRotationResultSuccess)
+	}
+	return errs
+}
+
+// rotate replaces the client of the central and records the rotation in the audit trail. Like admin mutations, the
+// rotation is not made if it cannot be audited.
+func (k *OIDCClientRotationManager) rotate(ctx context.Context, central *dbapi.CentralRequest, age time.Duration) error {
+	before, svcErr := k.auditService.SnapshotCentral(ctx, central.ID)
+	if svcErr != nil {
+		return errors.Wrapf(svcErr, "failed to snapshot central %s before rotating its OIDC client", central.ID)
+	}
+	glog.Infof("Rotating OIDC client %s of central %s, which is %s old", central.ClientID, central.ID, age.Round(time.Second))
+	if svcErr := k.centralService.StartCentralRHSSOClientRotation(ctx, central); svcErr != nil {
+		return errors.Wrapf(svcErr, "failed to rotate OIDC client of central %s", central.ID)
+	}
+
+	after, svcErr := k.auditService.SnapshotCentral(ctx, central.ID)
+	if svcErr != nil {
+		glog.Errorf("Failed to snapshot central %s after rotating its OIDC client: %v", central.ID, svcErr)
+		return nil
+	}
+	event := &dbapi.AuditEvent{
+		Actor:     oidcClientRotationAuditActor,
+		RouteName: oidcClientRotationAuditRouteName,
+		TargetID:  central.ID,
+		Before:    before,
+		After:     after,
+	}
+	if svcErr := k.auditService.Record(ctx, event); svcErr != nil {
+		glog.Errorf("Failed to record audit event of OIDC client rotation of central %s: %v", central.ID, svcErr)
+	}
+	return nil
+}
+
+// rotationWindowOf returns the window of the central, or the default window if the central has none
+func (k *OIDCClientRotationManager) rotationWindowOf(central *dbapi.CentralRequest) config.DailyWindow {
+	if central.ClientRotationWindow == "" {
+		return k.centralConfig.OIDCClientRotation.DefaultWindow()
+	}
+	window, err := config.ParseDailyWindow(central.ClientRotationWindow)
+	if err != nil {
+		// windows are validated when they are set, this only happens if the database was changed directly
+		glog.Errorf("Invalid OIDC client rotation window of central %s, using the default window: %v", central.ID, err)
+		return k.centralConfig.OIDCClientRotation.DefaultWindow()
+	}
+	return window
+}
+
+// oidcClientCreatedAt returns the creation time of the central's client. Clients without recorded creation time were
+// created with the central.
+func oidcClientCreatedAt(central *dbapi.CentralRequest) time.Time {
+	if central.ClientCreatedAt.Valid {
+		return central.ClientCreatedAt.Time
+	}
+	return central.CreatedAt
+}
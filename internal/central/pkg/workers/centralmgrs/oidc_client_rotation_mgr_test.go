@@ -0,0 +1,137 @@
+package centralmgrs
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/iam"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRotationNow is within the default rotation window
+var testRotationNow = time.Date(2026, 5, 1, 3, 0, 0, 0, time.UTC)
+
+func newTestOIDCClientRotationManager(t *testing.T, centrals []*dbapi.CentralRequest) (*OIDCClientRotationManager, *services.CentralServiceMock, *services.AdminAuditServiceMock) {
+	centralConfig := config.NewCentralConfig()
+	centralConfig.OIDCClientRotation.MaxAge = map[string]string{dbapi.AuthConfigDynamicClientOrigin: "2160h"}
+	centralConfig.OIDCClientRotation.BatchSize = 2
+	require.NoError(t, centralConfig.OIDCClientRotation.Validate())
+
+	centralService := &services.CentralServiceMock{
+		ListCentralsWithDynamicClientsFunc: func() ([]*dbapi.CentralRequest, *errors.ServiceError) {
+			return centrals, nil
+		},
+		StartCentralRHSSOClientRotationFunc: func(ctx context.Context, centralRequest *dbapi.CentralRequest) *errors.ServiceError {
+			centralRequest.PreviousClientID = centralRequest.ClientID
+			centralRequest.ClientID += "-rotated"
+			return nil
+		},
+		CompleteCentralRHSSOClientRotationFunc: func(ctx context.Context, centralRequest *dbapi.CentralRequest) *errors.ServiceError {
+			centralRequest.PreviousClientID = ""
+			return nil
+		},
+	}
+	auditService := &services.AdminAuditServiceMock{
+		SnapshotCentralFunc: func(_ context.Context, centralID string) (api.JSON, *errors.ServiceError) {
+			return api.JSON(`{"id":"` + centralID + `"}`), nil
+		},
+		RecordFunc: func(_ context.Context, event *dbapi.AuditEvent) *errors.ServiceError {
+			return nil
+		},
+	}
+	iamConfig := &iam.IAMConfig{RedhatSSORealm: &iam.IAMRealmConfig{ClientID: "fleet-manager"}}
+	manager := NewOIDCClientRotationManager(centralService, auditService, iamConfig, centralConfig)
+	manager.now = func() time.Time { return testRotationNow }
+	return manager, centralService, auditService
+}
+
+func dynamicClientCentral(id string, clientAge time.Duration) *dbapi.CentralRequest {
+	return &dbapi.CentralRequest{
+		Meta: api.Meta{ID: id, CreatedAt: testRotationNow.Add(-365 * 24 * time.Hour)},
+		AuthConfig: dbapi.AuthConfig{
+			ClientID:     id + "-client",
+			ClientOrigin: dbapi.AuthConfigDynamicClientOrigin,
+		},
+		ClientCreatedAt: sql.NullTime{Time: testRotationNow.Add(-clientAge), Valid: true},
+	}
+}
+
+func TestOIDCClientRotationManager_RotatesExpiredClients(t *testing.T) {
+	expired := dynamicClientCentral("expired", 100*24*time.Hour)
+	recent := dynamicClientCentral("recent", 10*24*time.Hour)
+	legacy := dynamicClientCentral("legacy", 0)
+	legacy.ClientCreatedAt = sql.NullTime{}
+	manager, centralService, auditService := newTestOIDCClientRotationManager(t, []*dbapi.CentralRequest{expired, recent, legacy})
+
+	require.Empty(t, manager.Reconcile())
+
+	calls := centralService.StartCentralRHSSOClientRotationCalls()
+	require.Len(t, calls, 2)
+	assert.Equal(t, "expired", calls[0].CentralRequest.ID)
+	assert.Equal(t, "legacy", calls[1].CentralRequest.ID, "clients without creation time are as old as their central")
+	require.Len(t, auditService.RecordCalls(), 2)
+	event := auditService.RecordCalls()[0].Event
+	assert.Equal(t, oidcClientRotationAuditActor, event.Actor)
+	assert.Equal(t, oidcClientRotationAuditRouteName, event.RouteName)
+	assert.Equal(t, "expired", event.TargetID)
+}
+
+func TestOIDCClientRotationManager_RespectsWindow(t *testing.T) {
+	central := dynamicClientCentral("central", 100*24*time.Hour)
+	central.ClientRotationWindow = "22:00-01:00"
+	manager, centralService, _ := newTestOIDCClientRotationManager(t, []*dbapi.CentralRequest{central})
+
+	require.Empty(t, manager.Reconcile())
+	assert.Empty(t, centralService.StartCentralRHSSOClientRotationCalls())
+
+	manager.now = func() time.Time { return testRotationNow.Add(-4 * time.Hour) }
+	require.Empty(t, manager.Reconcile())
+	assert.Len(t, centralService.StartCentralRHSSOClientRotationCalls(), 1)
+}
+
+func TestOIDCClientRotationManager_LimitsBatch(t *testing.T) {
+	centrals := []*dbapi.CentralRequest{
+		dynamicClientCentral("central-1", 100*24*time.Hour),
+		dynamicClientCentral("central-2", 100*24*time.Hour),
+		dynamicClientCentral("central-3", 100*24*time.Hour),
+	}
+	manager, centralService, _ := newTestOIDCClientRotationManager(t, centrals)
+
+	require.Empty(t, manager.Reconcile())
+
+	assert.Len(t, centralService.StartCentralRHSSOClientRotationCalls(), 2)
+}
+
+func TestOIDCClientRotationManager_CompletesAfterPropagationTimeout(t *testing.T) {
+	pending := dynamicClientCentral("pending", 10*time.Minute)
+	pending.PreviousClientID = "pending-previous"
+	timedOut := dynamicClientCentral("timed-out", 2*time.Hour)
+	timedOut.PreviousClientID = "timed-out-previous"
+	manager, centralService, _ := newTestOIDCClientRotationManager(t, []*dbapi.CentralRequest{pending, timedOut})
+
+	require.Empty(t, manager.Reconcile())
+
+	calls := centralService.CompleteCentralRHSSOClientRotationCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "timed-out", calls[0].CentralRequest.ID)
+	assert.Empty(t, centralService.StartCentralRHSSOClientRotationCalls())
+}
+
+func TestOIDCClientRotationManager_NotAuditedNotRotated(t *testing.T) {
+	manager, centralService, auditService := newTestOIDCClientRotationManager(t,
+		[]*dbapi.CentralRequest{dynamicClientCentral("central", 100*24*time.Hour)})
+	auditService.SnapshotCentralFunc = func(_ context.Context, centralID string) (api.JSON, *errors.ServiceError) {
+		return nil, errors.GeneralError("database unavailable")
+	}
+
+	assert.Len(t, manager.Reconcile(), 1)
+	assert.Empty(t, centralService.StartCentralRHSSOClientRotationCalls())
+}
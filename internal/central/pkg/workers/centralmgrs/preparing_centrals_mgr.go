@@ -1,6 +1,7 @@
 package centralmgrs
 
 import (
+	"context"
 	"time"
 
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
@@ -100,7 +101,7 @@ func (k *PreparingCentralManager) handleCentralRequestCreationError(centralReque
 			metrics.IncreaseCentralTotalOperationsCountMetric(constants2.CentralOperationCreate)
 			centralRequest.Status = string(constants2.CentralRequestStatusFailed)
 			centralRequest.FailedReason = err.Reason
-			updateErr := k.centralService.UpdateIgnoreNils(centralRequest)
+			updateErr := k.centralService.UpdateIgnoreNils(context.Background(), centralRequest)
 			if updateErr != nil {
 				return errors.Wrapf(updateErr, "Failed to update central %s in failed state. Central failed reason %s", centralRequest.ID, centralRequest.FailedReason)
 			}
@@ -111,7 +112,7 @@ func (k *PreparingCentralManager) handleCentralRequestCreationError(centralReque
 		metrics.IncreaseCentralTotalOperationsCountMetric(constants2.CentralOperationCreate)
 		centralRequest.Status = string(constants2.CentralRequestStatusFailed)
 		centralRequest.FailedReason = err.Reason
-		updateErr := k.centralService.UpdateIgnoreNils(centralRequest)
+		updateErr := k.centralService.UpdateIgnoreNils(context.Background(), centralRequest)
 		if updateErr != nil {
 			return errors.Wrapf(err, "Failed to update central %s in failed state", centralRequest.ID)
 		}
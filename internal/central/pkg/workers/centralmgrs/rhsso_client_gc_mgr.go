@@ -0,0 +1,148 @@
+package centralmgrs
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/rhsso"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/iam"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/redhatsso/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/redhatsso/dynamicclients"
+	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+	"github.com/stackrox/acs-fleet-manager/pkg/workers"
+)
+
+const rhSSOClientGCWorkerType = "rhsso_client_gc_worker"
+
+// rhSSOClientsPageSize is the number of dynamic clients listed at once
+const rhSSOClientsPageSize = 100
+
+// RHSSOClientGCManager deletes RHSSO dynamic clients which no longer belong to a Central. Such clients are left behind
+// if deleting the previous client fails while rotating it, or if a Central is deleted from the database directly.
+type RHSSOClientGCManager struct {
+	workers.BaseWorker
+	centralService    services.CentralService
+	centralConfig     *config.CentralConfig
+	realmConfig       *iam.IAMRealmConfig
+	dynamicClientsAPI *api.AcsTenantsApiService
+	now               func() time.Time
+}
+
+var _ workers.Worker = &RHSSOClientGCManager{}
+
+// NewRHSSOClientGCManager ...
+func NewRHSSOClientGCManager(centralService services.CentralService, iamConfig *iam.IAMConfig, centralConfig *config.CentralConfig) *RHSSOClientGCManager {
+	metrics.InitReconcilerMetricsForType(rhSSOClientGCWorkerType)
+	return &RHSSOClientGCManager{
+		BaseWorker: workers.BaseWorker{
+			ID:         uuid.New().String(),
+			WorkerType: rhSSOClientGCWorkerType,
+			Reconciler: workers.Reconciler{},
+		},
+		centralService:    centralService,
+		centralConfig:     centralConfig,
+		realmConfig:       iamConfig.RedhatSSORealm,
+		dynamicClientsAPI: dynamicclients.NewDynamicClientsAPI(iamConfig.RedhatSSORealm),
+		now:               time.Now,
+	}
+}
+
+// GetRepeatInterval ...
+func (k *RHSSOClientGCManager) GetRepeatInterval() time.Duration {
+	return k.centralConfig.RHSSOClientGC.Interval
+}
+
+// Start ...
+func (k *RHSSOClientGCManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop ...
+func (k *RHSSOClientGCManager) Stop() {
+	k.StopWorker(k)
+}
+
+// Reconcile ...
+func (k *RHSSOClientGCManager) Reconcile() []error {
+	if k.centralConfig.HasStaticAuth() || !k.realmConfig.IsConfigured() {
+		return nil
+	}
+	ctx := context.Background()
+	gcConfig := k.centralConfig.RHSSOClientGC
+
+	// The clients are listed before the centrals. Clients of centrals created in between are not yet in the list,
+	// so that they cannot be mistaken as orphaned.
+	clients, err := k.listClients(ctx)
+	if err != nil {
+		return []error{errors.Wrap(err, "failed to list RHSSO dynamic clients")}
+	}
+	clientIDs, svcErr := k.centralService.ListDynamicClientIDs()
+	if svcErr != nil {
+		return []error{errors.Wrap(svcErr, "failed to list RHSSO dynamic client IDs of centrals")}
+	}
+
+	orphans := findOrphanedRHSSOClients(clients, clientIDs, k.now().Add(-gcConfig.GracePeriod))
+	metrics.UpdateCentralRHSSOOrphanedClientsMetric(len(orphans))
+	if len(orphans) == 0 {
+		return nil
+	}
+	if gcConfig.DryRun {
+		for _, client := range orphans {
+			glog.Infof("Dry run: would delete orphaned RHSSO dynamic client %s (%s)", client.ClientId, client.Name)
+		}
+		return nil
+	}
+
+	var errs []error
+	for _, client := range orphans {
+		resp, err := k.dynamicClientsAPI.DeleteAcsClient(ctx, client.ClientId)
+		if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+			errs = append(errs, errors.Wrapf(err, "failed to delete orphaned RHSSO dynamic client %s", client.ClientId))
+			continue
+		}
+		glog.Infof("Deleted orphaned RHSSO dynamic client %s (%s)", client.ClientId, client.Name)
+		metrics.IncreaseCentralRHSSOOrphanedClientDeletionCountMetric()
+	}
+	return errs
+}
+
+func (k *RHSSOClientGCManager) listClients(ctx context.Context) ([]api.AcsClientResponseData, error) {
+	var clients []api.AcsClientResponseData
+	for first := int32(0); ; first += rhSSOClientsPageSize {
+		page, _, err := k.dynamicClientsAPI.GetAcsClients(ctx, first, rhSSOClientsPageSize)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, page...)
+		if len(page) < rhSSOClientsPageSize {
+			return clients, nil
+		}
+	}
+}
+
+// findOrphanedRHSSOClients returns the clients created for Centrals before the cutoff which do not belong to any of
+// the Centrals. Clients without creation time are never orphaned, as their age is unknown.
+func findOrphanedRHSSOClients(clients []api.AcsClientResponseData, clientIDs []string, cutoff time.Time) []api.AcsClientResponseData {
+	live := make(map[string]bool, len(clientIDs))
+	for _, id := range clientIDs {
+		live[id] = true
+	}
+	var orphans []api.AcsClientResponseData
+	for _, client := range clients {
+		if live[client.ClientId] || !strings.HasPrefix(client.Name, rhsso.DynamicClientNamePrefix) {
+			continue
+		}
+		if client.CreatedAt == 0 || !time.UnixMilli(client.CreatedAt).Before(cutoff) {
+			continue
+		}
+		orphans = append(orphans, client)
+	}
+	return orphans
+}
@@ -0,0 +1,102 @@
+package centralmgrs
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/iam"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/redhatsso/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRHSSOClientGCManager(t *testing.T, clientIDs []string, dryRun bool) (*RHSSOClientGCManager, mocks.RedhatSSOMock) {
+	server := mocks.NewMockServer()
+	server.Start()
+	t.Cleanup(server.Stop)
+	clientID, clientSecret := server.GetInitialClientCredentials()
+	iamConfig := &iam.IAMConfig{
+		RedhatSSORealm: &iam.IAMRealmConfig{
+			Realm:            "redhat-external",
+			ClientID:         clientID,
+			ClientSecret:     clientSecret, // pragma: allowlist secret
+			BaseURL:          server.BaseURL(),
+			APIEndpointURI:   "/auth/realms/redhat-external",
+			TokenEndpointURI: fmt.Sprintf("%s/auth/realms/redhat-external/protocol/openid-connect/token", server.BaseURL()),
+		},
+	}
+	centralConfig := config.NewCentralConfig()
+	centralConfig.RHSSOClientGC.DryRun = dryRun
+	centralService := &services.CentralServiceMock{
+		ListDynamicClientIDsFunc: func() ([]string, *errors.ServiceError) {
+			return clientIDs, nil
+		},
+	}
+	return NewRHSSOClientGCManager(centralService, iamConfig, centralConfig), server
+}
+
+func addTestDynamicClients(server mocks.RedhatSSOMock, now time.Time) {
+	old := now.Add(-48 * time.Hour).UnixMilli()
+	server.AddDynamicClient(api.AcsClientResponseData{ClientId: "live", Name: "acscs-live", CreatedAt: old})
+	server.AddDynamicClient(api.AcsClientResponseData{ClientId: "orphaned", Name: "acscs-deleted", CreatedAt: old})
+	server.AddDynamicClient(api.AcsClientResponseData{ClientId: "recent", Name: "acscs-new", CreatedAt: now.Add(-time.Hour).UnixMilli()})
+	server.AddDynamicClient(api.AcsClientResponseData{ClientId: "unknown-age", Name: "acscs-unknown"})
+	server.AddDynamicClient(api.AcsClientResponseData{ClientId: "foreign", Name: "other-client", CreatedAt: old})
+}
+
+func clientIDsOf(clients []api.AcsClientResponseData) []string {
+	ids := make([]string, 0, len(clients))
+	for _, client := range clients {
+		ids = append(ids, client.ClientId)
+	}
+	return ids
+}
+
+func TestRHSSOClientGCManager_DeletesOrphanedClients(t *testing.T) {
+	manager, server := newTestRHSSOClientGCManager(t, []string{"live"}, false)
+	addTestDynamicClients(server, manager.now())
+
+	require.Empty(t, manager.Reconcile())
+
+	assert.ElementsMatch(t, []string{"live", "recent", "unknown-age", "foreign"}, clientIDsOf(server.DynamicClients()))
+}
+
+func TestRHSSOClientGCManager_DryRun(t *testing.T) {
+	manager, server := newTestRHSSOClientGCManager(t, []string{"live"}, true)
+	addTestDynamicClients(server, manager.now())
+
+	require.Empty(t, manager.Reconcile())
+
+	assert.Len(t, server.DynamicClients(), 5)
+}
+
+func TestRHSSOClientGCManager_ListsAllPages(t *testing.T) {
+	manager, server := newTestRHSSOClientGCManager(t, nil, false)
+	old := manager.now().Add(-48 * time.Hour)
+	for i := 0; i < rhSSOClientsPageSize+1; i++ {
+		server.AddDynamicClient(api.AcsClientResponseData{
+			ClientId:  fmt.Sprintf("client-%03d", i),
+			Name:      "acscs-central",
+			CreatedAt: old.Add(time.Duration(i) * time.Second).UnixMilli(),
+		})
+	}
+
+	require.Empty(t, manager.Reconcile())
+
+	assert.Empty(t, server.DynamicClients())
+}
+
+func TestRHSSOClientGCManager_SkipsStaticAuth(t *testing.T) {
+	manager, server := newTestRHSSOClientGCManager(t, nil, false)
+	manager.centralConfig.CentralIDPClientID = "static-client"
+	addTestDynamicClients(server, manager.now())
+
+	require.Empty(t, manager.Reconcile())
+
+	assert.Len(t, server.DynamicClients(), 5)
+}
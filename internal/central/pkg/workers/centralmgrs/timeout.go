@@ -1,6 +1,7 @@
 package centralmgrs
 
 import (
+	"context"
 	"time"
 
 	"github.com/pkg/errors"
@@ -22,7 +23,7 @@ func FailIfTimeoutExceeded(centralService services.CentralService, timeout time.
 		centralRequest.Status = constants2.CentralRequestStatusFailed.String()
 		centralRequest.FailedReason = "Creation time went over the timeout. Interrupting central initialization."
 
-		if err := centralService.UpdateIgnoreNils(centralRequest); err != nil {
+		if err := centralService.UpdateIgnoreNils(context.Background(), centralRequest); err != nil {
 			return errors.Wrapf(err, "failed to update timed out central %s", centralRequest.ID)
 		}
 		metrics.UpdateCentralRequestsStatusSinceCreatedMetric(constants2.CentralRequestStatusFailed, centralRequest.ID, centralRequest.ClusterID, time.Since(centralRequest.CreatedAt))
@@ -0,0 +1,255 @@
+package workers
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+)
+
+// Scale-out decisions of the capacity forecast
+const (
+	scaleOutDecisionNone      = "none"
+	scaleOutDecisionPending   = "pending"
+	scaleOutDecisionDryRun    = "dry_run"
+	scaleOutDecisionRequested = "requested"
+)
+
+// pendingClusterStatuses are the statuses of clusters which are requested but do not provide capacity yet
+var pendingClusterStatuses = map[api.ClusterStatus]bool{
+	api.ClusterAccepted:     true,
+	api.ClusterProvisioning: true,
+	api.ClusterProvisioned:  true,
+}
+
+type capacityGroup struct {
+	cloudProvider string
+	region        string
+	instanceType  string
+}
+
+type capacityForecast struct {
+	// capacity is the sum of the Central instance limits of the ready and schedulable clusters supporting the
+	// instance type
+	capacity int
+	// unlimited is set if a ready and schedulable cluster supporting the instance type has no Central instance limit
+	unlimited bool
+	// largestLimit is the largest Central instance limit of the ready clusters, which is used for new clusters
+	largestLimit int
+	// centrals and created count the Centrals of all instance types on the clusters the capacity is summed of, as
+	// they share the Central instance limit of the clusters
+	centrals int
+	created  int
+	// pending is set if a cluster was already requested and provides capacity once it is ready
+	pending bool
+}
+
+// projectedCentrals extrapolates the growth of Centrals within the window linearly to the end of the horizon
+func (f *capacityForecast) projectedCentrals(window, horizon time.Duration) int {
+	if window <= 0 {
+		return f.centrals
+	}
+	growth := float64(f.created) * horizon.Hours() / window.Hours()
+	return f.centrals + int(growth+0.5)
+}
+
+// reconcileClusterCapacity forecasts the remaining capacity per cloud provider, region and instance type from the
+// growth of Centrals and requests a new cluster where it falls below the threshold. The Central instance limit of a
+// cluster applies to the Centrals of all instance types it supports, so the forecast of an instance type counts all
+// Centrals on the clusters supporting it. Regions without clusters are covered by reconcileClustersForRegions.
+func (c *ClusterManager) reconcileClusterCapacity() []error {
+	c.remainingCapacity = nil
+	if !c.DataplaneClusterConfig.IsDataPlaneAutoScalingEnabled() {
+		return nil
+	}
+
+	clusters, svcErr := c.ClusterService.FindAllClusters(services.FindClusterCriteria{})
+	if svcErr != nil {
+		return []error{errors.Wrap(svcErr, "failed to list clusters for capacity forecast")}
+	}
+	window := c.DataplaneClusterConfig.CapacityForecastWindow
+	growth, svcErr := c.ClusterService.FindCentralGrowth(time.Now().Add(-window))
+	if svcErr != nil {
+		return []error{errors.Wrap(svcErr, "failed to count centrals for capacity forecast")}
+	}
+
+	forecasts := map[capacityGroup]*capacityForecast{}
+	// capacityGroups are the forecasts the capacity of a cluster is summed up in
+	capacityGroups := map[string][]capacityGroup{}
+	for _, cluster := range clusters {
+		if cluster.Status != api.ClusterReady && !pendingClusterStatuses[cluster.Status] {
+			continue
+		}
+		for _, instanceType := range strings.Split(cluster.SupportedInstanceType, ",") {
+			if instanceType == "" {
+				continue
+			}
+			group := capacityGroup{cloudProvider: cluster.CloudProvider, region: cluster.Region, instanceType: instanceType}
+			forecast, ok := forecasts[group]
+			if !ok {
+				forecast = &capacityForecast{}
+				forecasts[group] = forecast
+			}
+			if pendingClusterStatuses[cluster.Status] {
+				forecast.pending = true
+				continue
+			}
+			if cluster.Schedulable {
+				if cluster.CentralInstanceLimit == config.UnlimitedCentralInstanceLimit {
+					forecast.unlimited = true
+				} else {
+					forecast.capacity += cluster.CentralInstanceLimit
+				}
+				capacityGroups[cluster.ClusterID] = append(capacityGroups[cluster.ClusterID], group)
+			}
+			forecast.largestLimit = max(forecast.largestLimit, cluster.CentralInstanceLimit)
+		}
+	}
+	for _, g := range growth {
+		for _, group := range capacityGroups[g.ClusterID] {
+			forecasts[group].centrals += g.Count
+			forecasts[group].created += g.Created
+		}
+	}
+
+	groups := make([]capacityGroup, 0, len(forecasts))
+	for group := range forecasts {
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		a, b := groups[i], groups[j]
+		if a.cloudProvider != b.cloudProvider {
+			return a.cloudProvider < b.cloudProvider
+		}
+		if a.region != b.region {
+			return a.region < b.region
+		}
+		return a.instanceType < b.instanceType
+	})
+
+	metrics.ResetClusterCapacityForecastMetrics()
+	c.remainingCapacity = map[capacityGroup]int{}
+	var errs []error
+	// The instance types of a region running out of capacity are requested together, so that a single cluster is
+	// requested if the clusters they share fill up.
+	for start := 0; start < len(groups); {
+		end := start
+		for end < len(groups) && groups[end].cloudProvider == groups[start].cloudProvider && groups[end].region == groups[start].region {
+			end++
+		}
+		if err := c.forecastRegion(groups[start:end], forecasts, window); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to request cluster in %s, region: %s",
+				groups[start].cloudProvider, groups[start].region))
+		}
+		start = end
+	}
+	return errs
+}
+
+// forecastRegion forecasts the capacity of the instance types of a region and requests a single cluster for all
+// instance types whose capacity falls below the threshold
+func (c *ClusterManager) forecastRegion(groups []capacityGroup, forecasts map[capacityGroup]*capacityForecast, window time.Duration) error {
+	threshold := c.DataplaneClusterConfig.CapacityScaleOutThreshold
+	type groupForecast struct {
+		group     capacityGroup
+		projected int
+		remaining int
+		decision  string
+	}
+	var results []*groupForecast
+	var shortTypes []string
+	largestLimit := 0
+	for _, group := range groups {
+		forecast := forecasts[group]
+		if forecast.unlimited {
+			// clusters without a limit never run out of capacity
+			glog.V(10).Infof("Skipped capacity forecast in %s, region: %s, instance type: %s, as a cluster has no central instance limit",
+				group.cloudProvider, group.region, group.instanceType)
+			continue
+		}
+		result := &groupForecast{group: group, decision: scaleOutDecisionNone}
+		result.projected = forecast.projectedCentrals(window, c.DataplaneClusterConfig.CapacityForecastHorizon)
+		result.remaining = forecast.capacity - result.projected
+		results = append(results, result)
+		c.remainingCapacity[group] = result.remaining
+		if result.remaining >= threshold {
+			continue
+		}
+		switch {
+		case forecast.pending:
+			glog.V(10).Infof("Forecast capacity in %s, region: %s, instance type: %s is %d, waiting for the requested cluster",
+				group.cloudProvider, group.region, group.instanceType, result.remaining)
+			result.decision = scaleOutDecisionPending
+		case forecast.largestLimit == 0:
+			// the limit of a new cluster is unknown, as the clusters in the region do not accept any Centrals
+		case c.DataplaneClusterConfig.CapacityScaleOutDryRun:
+			glog.Infof("Forecast capacity in %s, region: %s, instance type: %s is %d, below the threshold of %d. Skipped requesting a cluster in dry-run mode",
+				group.cloudProvider, group.region, group.instanceType, result.remaining, threshold)
+			result.decision = scaleOutDecisionDryRun
+		default:
+			result.decision = scaleOutDecisionRequested
+			shortTypes = append(shortTypes, group.instanceType)
+			largestLimit = max(largestLimit, forecast.largestLimit)
+		}
+	}
+
+	var err error
+	if len(shortTypes) > 0 {
+		if err = c.scaleOut(groups[0], shortTypes, largestLimit); err != nil {
+			for _, result := range results {
+				if result.decision == scaleOutDecisionRequested {
+					result.decision = scaleOutDecisionNone
+				}
+			}
+		}
+	}
+	for _, result := range results {
+		metrics.UpdateClusterCapacityForecastMetrics(result.group.cloudProvider, result.group.region, result.group.instanceType,
+			result.projected, result.remaining, result.decision)
+	}
+	return err
+}
+
+// scaleOut requests a cluster in the region of the group supporting the instance types
+func (c *ClusterManager) scaleOut(group capacityGroup, instanceTypes []string, centralInstanceLimit int) error {
+	clusterRequest := api.Cluster{
+		CloudProvider:         group.cloudProvider,
+		Region:                group.region,
+		MultiAZ:               true,
+		Status:                api.ClusterAccepted,
+		ProviderType:          api.ClusterProviderOCM,
+		SupportedInstanceType: strings.Join(instanceTypes, ","),
+		CentralInstanceLimit:  centralInstanceLimit,
+	}
+	if svcErr := c.ClusterService.RegisterClusterJob(&clusterRequest); svcErr != nil {
+		return svcErr
+	}
+	for _, instanceType := range instanceTypes {
+		metrics.IncreaseClusterScaleOutRequestCountMetric(group.cloudProvider, group.region, instanceType)
+	}
+	glog.Infof("Forecast capacity in %s, region: %s, instance types: %s is below the threshold of %d. Requested cluster %s",
+		group.cloudProvider, group.region, clusterRequest.SupportedInstanceType, c.DataplaneClusterConfig.CapacityScaleOutThreshold, clusterRequest.ID)
+	return nil
+}
+
+// isNeededForCapacity returns true if the latest capacity forecast of an instance type supported by the cluster falls
+// below the threshold without the cluster, so that an empty cluster requested by the forecast is not deleted again
+func (c *ClusterManager) isNeededForCapacity(cluster api.Cluster) bool {
+	limit := 0
+	if cluster.Schedulable {
+		limit = cluster.CentralInstanceLimit
+	}
+	for _, instanceType := range strings.Split(cluster.SupportedInstanceType, ",") {
+		remaining, ok := c.remainingCapacity[capacityGroup{cloudProvider: cluster.CloudProvider, region: cluster.Region, instanceType: instanceType}]
+		if ok && remaining-limit < c.DataplaneClusterConfig.CapacityScaleOutThreshold {
+			return true
+		}
+	}
+	return false
+}
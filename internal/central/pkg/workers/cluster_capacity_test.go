@@ -0,0 +1,269 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapacityForecastProjectedCentrals(t *testing.T) {
+	forecast := &capacityForecast{centrals: 20, created: 7}
+	assert.Equal(t, 23, forecast.projectedCentrals(7*24*time.Hour, 3*24*time.Hour))
+	assert.Equal(t, 20, forecast.projectedCentrals(0, 3*24*time.Hour))
+}
+
+func TestClusterManagerReconcileClusterCapacity(t *testing.T) {
+	readyCluster := &api.Cluster{
+		ClusterID:             "ready",
+		CloudProvider:         "aws",
+		Region:                "us-east-1",
+		Status:                api.ClusterReady,
+		Schedulable:           true,
+		SupportedInstanceType: api.AllInstanceTypeSupport.String(),
+		CentralInstanceLimit:  30,
+	}
+
+	tests := []struct {
+		name            string
+		clusters        []*api.Cluster
+		growth          []services.ResCentralGrowth
+		dryRun          bool
+		scalingType     string
+		wantRequests    []api.Cluster
+		wantGrowthQuery bool
+	}{
+		{
+			name:     "should not request a cluster with enough capacity",
+			clusters: []*api.Cluster{readyCluster},
+			growth: []services.ResCentralGrowth{
+				{ClusterID: "ready", Count: 10, Created: 7},
+			},
+			scalingType:     config.AutoScaling,
+			wantGrowthQuery: true,
+		},
+		{
+			name:     "should request a cluster for the region running out of capacity",
+			clusters: []*api.Cluster{readyCluster},
+			growth: []services.ResCentralGrowth{
+				{ClusterID: "ready", Count: 22, Created: 14},
+			},
+			scalingType: config.AutoScaling,
+			wantRequests: []api.Cluster{{
+				CloudProvider:         "aws",
+				Region:                "us-east-1",
+				MultiAZ:               true,
+				Status:                api.ClusterAccepted,
+				ProviderType:          api.ClusterProviderOCM,
+				SupportedInstanceType: "eval,standard",
+				CentralInstanceLimit:  30,
+			}},
+			wantGrowthQuery: true,
+		},
+		{
+			name:     "should count the limit of a cluster once for all its instance types",
+			clusters: []*api.Cluster{readyCluster},
+			growth: []services.ResCentralGrowth{
+				{ClusterID: "ready", Count: 29},
+			},
+			scalingType: config.AutoScaling,
+			wantRequests: []api.Cluster{{
+				CloudProvider:         "aws",
+				Region:                "us-east-1",
+				MultiAZ:               true,
+				Status:                api.ClusterAccepted,
+				ProviderType:          api.ClusterProviderOCM,
+				SupportedInstanceType: "eval,standard",
+				CentralInstanceLimit:  30,
+			}},
+			wantGrowthQuery: true,
+		},
+		{
+			name: "should not request a cluster if a cluster has no central instance limit",
+			clusters: []*api.Cluster{readyCluster, {
+				ClusterID:             "unlimited",
+				CloudProvider:         "aws",
+				Region:                "us-east-1",
+				Status:                api.ClusterReady,
+				Schedulable:           true,
+				SupportedInstanceType: "standard",
+				CentralInstanceLimit:  config.UnlimitedCentralInstanceLimit,
+			}},
+			growth: []services.ResCentralGrowth{
+				{ClusterID: "unlimited", Count: 100, Created: 50},
+			},
+			scalingType:     config.AutoScaling,
+			wantGrowthQuery: true,
+		},
+		{
+			name:     "should not request a cluster in dry-run mode",
+			clusters: []*api.Cluster{readyCluster},
+			growth: []services.ResCentralGrowth{
+				{ClusterID: "ready", Count: 28},
+			},
+			dryRun:          true,
+			scalingType:     config.AutoScaling,
+			wantGrowthQuery: true,
+		},
+		{
+			name: "should not request a cluster while another one is provisioned",
+			clusters: []*api.Cluster{readyCluster, {
+				ClusterID:             "provisioning",
+				CloudProvider:         "aws",
+				Region:                "us-east-1",
+				Status:                api.ClusterProvisioning,
+				SupportedInstanceType: api.AllInstanceTypeSupport.String(),
+			}},
+			growth: []services.ResCentralGrowth{
+				{ClusterID: "ready", Count: 28},
+			},
+			scalingType:     config.AutoScaling,
+			wantGrowthQuery: true,
+		},
+		{
+			name: "should request a cluster for the instance type running out of capacity",
+			clusters: []*api.Cluster{{
+				ClusterID:             "standard",
+				CloudProvider:         "aws",
+				Region:                "us-east-1",
+				Status:                api.ClusterReady,
+				Schedulable:           true,
+				SupportedInstanceType: "standard",
+				CentralInstanceLimit:  30,
+			}, {
+				ClusterID:             "eval",
+				CloudProvider:         "aws",
+				Region:                "us-east-1",
+				Status:                api.ClusterReady,
+				Schedulable:           true,
+				SupportedInstanceType: "eval",
+				CentralInstanceLimit:  10,
+			}},
+			growth: []services.ResCentralGrowth{
+				{ClusterID: "standard", Count: 10},
+				{ClusterID: "eval", Count: 9},
+			},
+			scalingType: config.AutoScaling,
+			wantRequests: []api.Cluster{{
+				CloudProvider:         "aws",
+				Region:                "us-east-1",
+				MultiAZ:               true,
+				Status:                api.ClusterAccepted,
+				ProviderType:          api.ClusterProviderOCM,
+				SupportedInstanceType: "eval",
+				CentralInstanceLimit:  10,
+			}},
+			wantGrowthQuery: true,
+		},
+		{
+			name: "should only count the centrals of the clusters providing capacity",
+			clusters: []*api.Cluster{readyCluster, {
+				ClusterID:             "drained",
+				CloudProvider:         "aws",
+				Region:                "us-east-1",
+				Status:                api.ClusterReady,
+				Schedulable:           false,
+				SupportedInstanceType: api.AllInstanceTypeSupport.String(),
+				CentralInstanceLimit:  30,
+			}},
+			growth: []services.ResCentralGrowth{
+				{ClusterID: "ready", Count: 10},
+				{ClusterID: "drained", Count: 28},
+			},
+			scalingType:     config.AutoScaling,
+			wantGrowthQuery: true,
+		},
+		{
+			name:     "should not forecast capacity with manual scaling",
+			clusters: []*api.Cluster{readyCluster},
+			growth: []services.ResCentralGrowth{
+				{ClusterID: "ready", Count: 28},
+			},
+			scalingType: config.ManualScaling,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []api.Cluster
+			clusterService := &services.ClusterServiceMock{
+				FindAllClustersFunc: func(_ services.FindClusterCriteria) ([]*api.Cluster, *errors.ServiceError) {
+					return tt.clusters, nil
+				},
+				FindCentralGrowthFunc: func(_ time.Time) ([]services.ResCentralGrowth, *errors.ServiceError) {
+					return tt.growth, nil
+				},
+				RegisterClusterJobFunc: func(clusterRequest *api.Cluster) *errors.ServiceError {
+					requests = append(requests, *clusterRequest)
+					return nil
+				},
+			}
+			dataplaneClusterConfig := config.NewDataplaneClusterConfig()
+			dataplaneClusterConfig.DataPlaneClusterScalingType = tt.scalingType
+			dataplaneClusterConfig.CapacityScaleOutDryRun = tt.dryRun
+			c := &ClusterManager{
+				ClusterManagerOptions: ClusterManagerOptions{
+					ClusterService:         clusterService,
+					DataplaneClusterConfig: dataplaneClusterConfig,
+				},
+			}
+
+			require.Empty(t, c.reconcileClusterCapacity())
+			assert.Equal(t, tt.wantRequests, requests)
+			assert.Equal(t, tt.wantGrowthQuery, len(clusterService.FindCentralGrowthCalls()) == 1)
+		})
+	}
+}
+
+func TestClusterManagerReconcileEmptyClusterKeepsClusterNeededForCapacity(t *testing.T) {
+	emptyCluster := api.Cluster{
+		ClusterID:             "requested",
+		CloudProvider:         "aws",
+		Region:                "us-east-1",
+		Status:                api.ClusterReady,
+		Schedulable:           true,
+		SupportedInstanceType: "standard",
+		CentralInstanceLimit:  30,
+	}
+	tests := []struct {
+		name        string
+		remaining   int
+		wantDeleted bool
+	}{
+		{name: "should keep the cluster if the capacity falls below the threshold without it", remaining: 32},
+		{name: "should delete the cluster if the capacity suffices without it", remaining: 40, wantDeleted: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusterService := &services.ClusterServiceMock{
+				FindNonEmptyClusterByIDFunc: func(_ string) (*api.Cluster, *errors.ServiceError) {
+					return nil, nil
+				},
+				ListGroupByProviderAndRegionFunc: func(_ []string, _ []string, _ []string) ([]*services.ResGroupCPRegion, *errors.ServiceError) {
+					return []*services.ResGroupCPRegion{{Provider: "aws", Region: "us-east-1", Count: 2}}, nil
+				},
+				UpdateStatusFunc: func(_ api.Cluster, _ api.ClusterStatus) error {
+					return nil
+				},
+			}
+			c := &ClusterManager{
+				ClusterManagerOptions: ClusterManagerOptions{
+					ClusterService:         clusterService,
+					DataplaneClusterConfig: config.NewDataplaneClusterConfig(),
+				},
+				remainingCapacity: map[capacityGroup]int{
+					{cloudProvider: "aws", region: "us-east-1", instanceType: "standard"}: tt.remaining,
+				},
+			}
+
+			deleted, err := c.reconcileEmptyCluster(emptyCluster)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantDeleted, deleted)
+			assert.Equal(t, tt.wantDeleted, len(clusterService.UpdateStatusCalls()) == 1)
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/internal/central/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/workers"
+)
+
+const clusterDrainLeaseType = "cluster_drain_worker"
+
+// ClusterDrainManager moves the Central tenants of drained dataplane clusters to other clusters. Tenants are moved
+// one batch at a time: at most the drain's concurrency of tenants is reassigned before they report ready on their
+// target cluster.
+type ClusterDrainManager struct {
+	workers.BaseWorker
+	clusterDrainService services.ClusterDrainService
+	centralService      services.CentralService
+	placementStrategy   services.ClusterPlacementStrategy
+}
+
+// NewClusterDrainManager creates a new cluster drain manager
+func NewClusterDrainManager(clusterDrainService services.ClusterDrainService, centralService services.CentralService,
+	placementStrategy services.ClusterPlacementStrategy) *ClusterDrainManager {
+	return &ClusterDrainManager{
+		BaseWorker: workers.BaseWorker{
+			ID:         uuid.New().String(),
+			WorkerType: clusterDrainLeaseType,
+			Reconciler: workers.Reconciler{},
+		},
+		clusterDrainService: clusterDrainService,
+		centralService:      centralService,
+		placementStrategy:   placementStrategy,
+	}
+}
+
+// GetRepeatInterval ...
+func (*ClusterDrainManager) GetRepeatInterval() time.Duration {
+	return 30 * time.Second
+}
+
+// Start initializes the cluster drain manager to reconcile cluster drains
+func (m *ClusterDrainManager) Start() {
+	m.StartWorker(m)
+}
+
+// Stop causes the process for reconciling cluster drains to stop
+func (m *ClusterDrainManager) Stop() {
+	m.StopWorker(m)
+}
+
+// Reconcile ...
+func (m *ClusterDrainManager) Reconcile() []error {
+	drains, svcErr := m.clusterDrainService.ListActive()
+	if svcErr != nil {
+		return []error{errors.Wrap(svcErr, "failed to list active cluster drains")}
+	}
+
+	var encounteredErrors []error
+	for _, drain := range drains {
+		if err := m.reconcileDrain(drain); err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to reconcile drain %s of cluster %s", drain.ID, drain.ClusterID))
+		}
+	}
+	return encounteredErrors
+}
+
+func (m *ClusterDrainManager) reconcileDrain(drain *dbapi.ClusterDrain) error {
+	tracked := make(map[string]bool, len(drain.Centrals))
+	inFlight, failed := 0, 0
+	for i := range drain.Centrals {
+		drainCentral := &drain.Centrals[i]
+		tracked[drainCentral.CentralID] = true
+		if drainCentral.Status == dbapi.ClusterDrainCentralStatusMigrating {
+			if err := m.updateMigratingCentral(drainCentral); err != nil {
+				return err
+			}
+		}
+		switch drainCentral.Status {
+		case dbapi.ClusterDrainCentralStatusMigrating:
+			inFlight++
+		case dbapi.ClusterDrainCentralStatusFailed:
+			failed++
+		}
+	}
+
+	centrals, svcErr := m.clusterDrainService.ListCentralsToDrain(drain.ClusterID)
+	if svcErr != nil {
+		return svcErr
+	}
+	var remaining []*dbapi.CentralRequest
+	for _, central := range centrals {
+		// Failed tenants are left on the cluster for an admin to look into
+		if !tracked[central.ID] {
+			remaining = append(remaining, central)
+		}
+	}
+
+	if inFlight == 0 && len(remaining) == 0 {
+		status := dbapi.ClusterDrainStatusCompleted
+		if failed > 0 {
+			status = dbapi.ClusterDrainStatusFailed
+		}
+		if svcErr := m.clusterDrainService.Finish(drain, status); svcErr != nil {
+			return svcErr
+		}
+		return nil
+	}
+
+	if drain.Status != dbapi.ClusterDrainStatusRunning {
+		return nil
+	}
+
+	for _, central := range remaining {
+		if inFlight >= drain.Concurrency {
+			break
+		}
+		// Tenants which are still being created are moved once they are ready
+		if central.Status != constants.CentralRequestStatusReady.String() {
+			continue
+		}
+		drainCentral, err := m.moveCentral(drain, central)
+		if err != nil {
+			return err
+		}
+		if svcErr := m.clusterDrainService.SaveCentral(drainCentral); svcErr != nil {
+			return svcErr
+		}
+		if drainCentral.Status == dbapi.ClusterDrainCentralStatusMigrating {
+			inFlight++
+		}
+	}
+	return nil
+}
+
+// moveCentral assigns the central to the cluster chosen by the placement strategy. Errors specific to the central
+// are recorded in the returned progress instead of failing the whole drain.
+func (m *ClusterDrainManager) moveCentral(drain *dbapi.ClusterDrain, central *dbapi.CentralRequest) (*dbapi.ClusterDrainCentral, error) {
+	drainCentral := &dbapi.ClusterDrainCentral{
+		DrainID:   drain.ID,
+		CentralID: central.ID,
+		Status:    dbapi.ClusterDrainCentralStatusFailed,
+	}
+
+	target, err := m.placementStrategy.FindCluster(central)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find target cluster for central %s", central.ID)
+	}
+	if target == nil || target.ClusterID == drain.ClusterID {
+		drainCentral.Reason = "no schedulable target cluster found"
+		glog.Warningf("Drain %s cannot move central %s: %s", drain.ID, central.ID, drainCentral.Reason)
+		return drainCentral, nil
+	}
+
+	drainCentral.TargetClusterID = target.ClusterID
+	if svcErr := m.centralService.AssignCluster(context.Background(), central.ID, target.ClusterID); svcErr != nil {
+		drainCentral.Reason = fmt.Sprintf("assigning target cluster: %s", svcErr.Error())
+		glog.Warningf("Drain %s cannot move central %s: %s", drain.ID, central.ID, drainCentral.Reason)
+		return drainCentral, nil
+	}
+
+	glog.Infof("Drain %s moves central %s from cluster %s to %s", drain.ID, central.ID, drain.ClusterID, target.ClusterID)
+	drainCentral.Status = dbapi.ClusterDrainCentralStatusMigrating
+	return drainCentral, nil
+}
+
+// updateMigratingCentral checks whether a central being moved is ready on its target cluster
+func (m *ClusterDrainManager) updateMigratingCentral(drainCentral *dbapi.ClusterDrainCentral) error {
+	central, svcErr := m.centralService.GetByID(drainCentral.CentralID)
+	switch {
+	case svcErr != nil && svcErr.Is404():
+		drainCentral.Status = dbapi.ClusterDrainCentralStatusDeleted
+	case svcErr != nil:
+		return svcErr
+	case central.Status == constants.CentralRequestStatusDeprovision.String() ||
+		central.Status == constants.CentralRequestStatusDeleting.String():
+		drainCentral.Status = dbapi.ClusterDrainCentralStatusDeleted
+	case central.Status == constants.CentralRequestStatusFailed.String():
+		drainCentral.Status = dbapi.ClusterDrainCentralStatusFailed
+		drainCentral.Reason = central.FailedReason
+	case central.ClusterID != drainCentral.TargetClusterID:
+		drainCentral.Status = dbapi.ClusterDrainCentralStatusFailed
+		drainCentral.Reason = fmt.Sprintf("central was reassigned to cluster %s", central.ClusterID)
+	case central.Status == constants.CentralRequestStatusReady.String():
+		drainCentral.Status = dbapi.ClusterDrainCentralStatusMigrated
+	default:
+		return nil
+	}
+
+	if svcErr := m.clusterDrainService.SaveCentral(drainCentral); svcErr != nil {
+		return svcErr
+	}
+	glog.Infof("Central %s moved by drain %s is %s", drainCentral.CentralID, drainCentral.DrainID, drainCentral.Status)
+	return nil
+}
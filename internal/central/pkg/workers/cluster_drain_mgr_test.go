@@ -0,0 +1,188 @@
+package workers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/constants"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	drainedClusterID = "drained-cluster"
+	targetClusterID  = "target-cluster"
+)
+
+type clusterDrainTestFixture struct {
+	drain       *dbapi.ClusterDrain
+	centrals    map[string]*dbapi.CentralRequest
+	saved       []dbapi.ClusterDrainCentral
+	finished    []dbapi.ClusterDrainStatus
+	assigned    []string
+	findCluster func(central *dbapi.CentralRequest) (*api.Cluster, error)
+}
+
+func newClusterDrainTestFixture(status dbapi.ClusterDrainStatus, concurrency int, centrals ...*dbapi.CentralRequest) *clusterDrainTestFixture {
+	f := &clusterDrainTestFixture{
+		drain: &dbapi.ClusterDrain{
+			Meta:        api.Meta{ID: "drain-1"},
+			ClusterID:   drainedClusterID,
+			Status:      status,
+			Concurrency: concurrency,
+		},
+		centrals: map[string]*dbapi.CentralRequest{},
+		findCluster: func(_ *dbapi.CentralRequest) (*api.Cluster, error) {
+			return &api.Cluster{ClusterID: targetClusterID}, nil
+		},
+	}
+	for _, central := range centrals {
+		f.centrals[central.ID] = central
+	}
+	return f
+}
+
+func (f *clusterDrainTestFixture) manager() *ClusterDrainManager {
+	drainService := &services.ClusterDrainServiceMock{
+		ListActiveFunc: func() ([]*dbapi.ClusterDrain, *errors.ServiceError) {
+			return []*dbapi.ClusterDrain{f.drain}, nil
+		},
+		ListCentralsToDrainFunc: func(clusterID string) ([]*dbapi.CentralRequest, *errors.ServiceError) {
+			var result []*dbapi.CentralRequest
+			for _, central := range f.centrals {
+				if central.ClusterID == clusterID {
+					result = append(result, central)
+				}
+			}
+			return result, nil
+		},
+		SaveCentralFunc: func(drainCentral *dbapi.ClusterDrainCentral) *errors.ServiceError {
+			f.saved = append(f.saved, *drainCentral)
+			return nil
+		},
+		FinishFunc: func(_ *dbapi.ClusterDrain, status dbapi.ClusterDrainStatus) *errors.ServiceError {
+			f.finished = append(f.finished, status)
+			return nil
+		},
+	}
+	centralService := &services.CentralServiceMock{
+		GetByIDFunc: func(id string) (*dbapi.CentralRequest, *errors.ServiceError) {
+			central, ok := f.centrals[id]
+			if !ok {
+				return nil, errors.NotFound("not found")
+			}
+			return central, nil
+		},
+		AssignClusterFunc: func(_ context.Context, centralID string, clusterID string) *errors.ServiceError {
+			f.assigned = append(f.assigned, centralID)
+			f.centrals[centralID].ClusterID = clusterID
+			f.centrals[centralID].Status = constants.CentralRequestStatusProvisioning.String()
+			return nil
+		},
+	}
+	placementStrategy := &services.ClusterPlacementStrategyMock{
+		FindClusterFunc: func(central *dbapi.CentralRequest) (*api.Cluster, error) {
+			return f.findCluster(central)
+		},
+	}
+	return NewClusterDrainManager(drainService, centralService, placementStrategy)
+}
+
+func drainTestCentral(id string, status constants.CentralStatus) *dbapi.CentralRequest {
+	return &dbapi.CentralRequest{Meta: api.Meta{ID: id}, ClusterID: drainedClusterID, Status: status.String()}
+}
+
+func TestClusterDrainManagerMovesReadyCentralsUpToConcurrency(t *testing.T) {
+	f := newClusterDrainTestFixture(dbapi.ClusterDrainStatusRunning, 2,
+		drainTestCentral("central-1", constants.CentralRequestStatusReady),
+		drainTestCentral("central-2", constants.CentralRequestStatusProvisioning),
+		drainTestCentral("central-3", constants.CentralRequestStatusReady),
+		drainTestCentral("central-4", constants.CentralRequestStatusReady),
+	)
+	f.drain.Centrals = []dbapi.ClusterDrainCentral{{
+		CentralID:       "central-0",
+		TargetClusterID: targetClusterID,
+		Status:          dbapi.ClusterDrainCentralStatusMigrating,
+	}}
+	f.centrals["central-0"] = &dbapi.CentralRequest{
+		Meta:      api.Meta{ID: "central-0"},
+		ClusterID: targetClusterID,
+		Status:    constants.CentralRequestStatusProvisioning.String(),
+	}
+
+	errs := f.manager().Reconcile()
+	require.Empty(t, errs)
+
+	assert.Len(t, f.assigned, 1, "one slot is taken by the central still being moved")
+	assert.NotEqual(t, "central-2", f.assigned[0], "centrals are moved once they are ready")
+	require.Len(t, f.saved, 1)
+	assert.Equal(t, dbapi.ClusterDrainCentralStatusMigrating, f.saved[0].Status)
+	assert.Equal(t, targetClusterID, f.saved[0].TargetClusterID)
+	assert.Empty(t, f.finished)
+}
+
+func TestClusterDrainManagerTracksMigratingCentrals(t *testing.T) {
+	f := newClusterDrainTestFixture(dbapi.ClusterDrainStatusPaused, 3)
+	f.centrals["ready"] = &dbapi.CentralRequest{Meta: api.Meta{ID: "ready"}, ClusterID: targetClusterID,
+		Status: constants.CentralRequestStatusReady.String()}
+	f.centrals["failed"] = &dbapi.CentralRequest{Meta: api.Meta{ID: "failed"}, ClusterID: targetClusterID,
+		Status: constants.CentralRequestStatusFailed.String(), FailedReason: "provisioning timed out"}
+	f.drain.Centrals = []dbapi.ClusterDrainCentral{
+		{CentralID: "ready", TargetClusterID: targetClusterID, Status: dbapi.ClusterDrainCentralStatusMigrating},
+		{CentralID: "failed", TargetClusterID: targetClusterID, Status: dbapi.ClusterDrainCentralStatusMigrating},
+		{CentralID: "gone", TargetClusterID: targetClusterID, Status: dbapi.ClusterDrainCentralStatusMigrating},
+	}
+
+	errs := f.manager().Reconcile()
+	require.Empty(t, errs)
+
+	require.Len(t, f.saved, 3)
+	assert.Equal(t, dbapi.ClusterDrainCentralStatusMigrated, f.saved[0].Status)
+	assert.Equal(t, dbapi.ClusterDrainCentralStatusFailed, f.saved[1].Status)
+	assert.Equal(t, "provisioning timed out", f.saved[1].Reason)
+	assert.Equal(t, dbapi.ClusterDrainCentralStatusDeleted, f.saved[2].Status)
+	assert.Equal(t, []dbapi.ClusterDrainStatus{dbapi.ClusterDrainStatusFailed}, f.finished)
+}
+
+func TestClusterDrainManagerPausedDrainDoesNotMoveCentrals(t *testing.T) {
+	f := newClusterDrainTestFixture(dbapi.ClusterDrainStatusPaused, 3,
+		drainTestCentral("central-1", constants.CentralRequestStatusReady))
+
+	errs := f.manager().Reconcile()
+	require.Empty(t, errs)
+
+	assert.Empty(t, f.assigned)
+	assert.Empty(t, f.finished)
+}
+
+func TestClusterDrainManagerRecordsCentralsWithoutTarget(t *testing.T) {
+	f := newClusterDrainTestFixture(dbapi.ClusterDrainStatusRunning, 3,
+		drainTestCentral("central-1", constants.CentralRequestStatusReady))
+	f.findCluster = func(_ *dbapi.CentralRequest) (*api.Cluster, error) {
+		return nil, nil
+	}
+
+	errs := f.manager().Reconcile()
+	require.Empty(t, errs)
+
+	assert.Empty(t, f.assigned)
+	require.Len(t, f.saved, 1)
+	assert.Equal(t, dbapi.ClusterDrainCentralStatusFailed, f.saved[0].Status)
+	assert.Equal(t, "no schedulable target cluster found", f.saved[0].Reason)
+}
+
+func TestClusterDrainManagerCompletesEmptyCluster(t *testing.T) {
+	f := newClusterDrainTestFixture(dbapi.ClusterDrainStatusRunning, 3)
+	f.drain.Centrals = []dbapi.ClusterDrainCentral{
+		{CentralID: "central-1", TargetClusterID: targetClusterID, Status: dbapi.ClusterDrainCentralStatusMigrated},
+	}
+
+	errs := f.manager().Reconcile()
+	require.Empty(t, errs)
+
+	assert.Equal(t, []dbapi.ClusterDrainStatus{dbapi.ClusterDrainStatusCompleted}, f.finished)
+}
@@ -0,0 +1,123 @@
+package workers
+
+import (
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+	"github.com/stackrox/acs-fleet-manager/pkg/workers"
+)
+
+const clusterHealthLeaseType = "cluster_health_worker"
+
+// ClusterHealthManager exposes the health of ready dataplane clusters as metrics, which silent clusters are alerted
+// on. Silent clusters are made unschedulable until their fleetshard-sync sends heartbeats again.
+type ClusterHealthManager struct {
+	workers.BaseWorker
+	clusterService         services.ClusterService
+	clusterHealthService   services.ClusterHealthService
+	dataplaneClusterConfig *config.DataplaneClusterConfig
+	now                    func() time.Time
+}
+
+// NewClusterHealthManager creates a new cluster health manager
+func NewClusterHealthManager(clusterService services.ClusterService, clusterHealthService services.ClusterHealthService,
+	dataplaneClusterConfig *config.DataplaneClusterConfig) *ClusterHealthManager {
+	return &ClusterHealthManager{
+		BaseWorker: workers.BaseWorker{
+			ID:         uuid.New().String(),
+			WorkerType: clusterHealthLeaseType,
+			Reconciler: workers.Reconciler{},
+		},
+		clusterService:         clusterService,
+		clusterHealthService:   clusterHealthService,
+		dataplaneClusterConfig: dataplaneClusterConfig,
+		now:                    time.Now,
+	}
+}
+
+// GetRepeatInterval ...
+func (*ClusterHealthManager) GetRepeatInterval() time.Duration {
+	return 1 * time.Minute
+}
+
+// Start initializes the cluster health manager to reconcile the health of clusters
+func (m *ClusterHealthManager) Start() {
+	m.StartWorker(m)
+}
+
+// Stop causes the process for reconciling the health of clusters to stop
+func (m *ClusterHealthManager) Stop() {
+	m.StopWorker(m)
+}
+
+// Reconcile ...
+func (m *ClusterHealthManager) Reconcile() []error {
+	health, svcErr := m.clusterHealthService.ListHealth()
+	if svcErr != nil {
+		return []error{errors.Wrap(svcErr, "failed to list cluster health")}
+	}
+	clusters, svcErr := m.clusterService.ListByStatus(api.ClusterReady)
+	if svcErr != nil {
+		return []error{errors.Wrap(svcErr, "failed to list ready clusters")}
+	}
+
+	metrics.ResetClusterHealthMetrics()
+	var encounteredErrors []error
+	for i := range clusters {
+		cluster := &clusters[i]
+		clusterHealth, ok := health[cluster.ClusterID]
+		if !ok {
+			continue
+		}
+		heartbeatAge := m.now().Sub(clusterHealth.Heartbeat.LastSeenAt)
+		metrics.UpdateClusterHealthMetrics(cluster.ClusterID, clusterHealth.Score, heartbeatAge, clusterHealth.Silent)
+
+		var err error
+		if clusterHealth.Silent {
+			glog.Warningf("fleetshard-sync on cluster %s has not sent a heartbeat for %s", cluster.ClusterID, heartbeatAge.Round(time.Second))
+			err = m.unscheduleSilentCluster(cluster)
+		} else if clusterHealth.Heartbeat.UnscheduledAsSilent {
+			err = m.rescheduleCluster(cluster)
+		}
+		if err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to reconcile health of cluster %s", cluster.ClusterID))
+		}
+	}
+	return encounteredErrors
+}
+
+func (m *ClusterHealthManager) unscheduleSilentCluster(cluster *api.Cluster) error {
+	if !m.dataplaneClusterConfig.UnscheduleSilentClusters || !cluster.Schedulable {
+		return nil
+	}
+	// The mark is set first, so that the cluster is not left unschedulable for good if it cannot be set
+	if svcErr := m.clusterHealthService.SetUnscheduledAsSilent(cluster.ClusterID, true); svcErr != nil {
+		return svcErr
+	}
+	if svcErr := m.clusterService.Updates(*cluster, map[string]interface{}{"schedulable": false}); svcErr != nil {
+		return svcErr
+	}
+	glog.Errorf("Made silent cluster %s unschedulable", cluster.ClusterID)
+	return nil
+}
+
+// rescheduleCluster makes a cluster schedulable again once its heartbeat returned, unless it is cordoned. Clusters
+// from the configuration file become schedulable with the next configuration sync once the mark is removed.
+func (m *ClusterHealthManager) rescheduleCluster(cluster *api.Cluster) error {
+	if cluster.IsRegisteredThroughAPI() && !cluster.Schedulable && !cluster.Cordoned {
+		if svcErr := m.clusterService.Updates(*cluster, map[string]interface{}{"schedulable": true}); svcErr != nil {
+			return svcErr
+		}
+	}
+	if svcErr := m.clusterHealthService.SetUnscheduledAsSilent(cluster.ClusterID, false); svcErr != nil {
+		return svcErr
+	}
+	glog.Infof("fleetshard-sync on cluster %s sends heartbeats again, the cluster is no longer unscheduled as silent", cluster.ClusterID)
+	return nil
+}
@@ -0,0 +1,96 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/api/dbapi"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services"
+	"github.com/stackrox/acs-fleet-manager/pkg/api"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClusterHealthManager(cluster api.Cluster, health *services.ClusterHealth) (*ClusterHealthManager, *services.ClusterServiceMock, *services.ClusterHealthServiceMock) {
+	clusterService := &services.ClusterServiceMock{
+		ListByStatusFunc: func(_ api.ClusterStatus) ([]api.Cluster, *errors.ServiceError) {
+			return []api.Cluster{cluster}, nil
+		},
+		UpdatesFunc: func(_ api.Cluster, _ map[string]interface{}) *errors.ServiceError {
+			return nil
+		},
+	}
+	healthService := &services.ClusterHealthServiceMock{
+		ListHealthFunc: func() (map[string]*services.ClusterHealth, *errors.ServiceError) {
+			return map[string]*services.ClusterHealth{cluster.ClusterID: health}, nil
+		},
+		SetUnscheduledAsSilentFunc: func(_ string, _ bool) *errors.ServiceError {
+			return nil
+		},
+	}
+	m := NewClusterHealthManager(clusterService, healthService, config.NewDataplaneClusterConfig())
+	return m, clusterService, healthService
+}
+
+func TestClusterHealthManagerUnschedulesSilentCluster(t *testing.T) {
+	cluster := api.Cluster{ClusterID: "silent", Schedulable: true}
+	health := &services.ClusterHealth{
+		Heartbeat: dbapi.ClusterHeartbeat{ClusterID: "silent", LastSeenAt: time.Now().Add(-time.Hour)},
+		Silent:    true,
+	}
+	m, clusterService, healthService := newTestClusterHealthManager(cluster, health)
+
+	require.Empty(t, m.Reconcile())
+
+	require.Len(t, healthService.SetUnscheduledAsSilentCalls(), 1)
+	assert.True(t, healthService.SetUnscheduledAsSilentCalls()[0].Unscheduled)
+	require.Len(t, clusterService.UpdatesCalls(), 1)
+	assert.Equal(t, map[string]interface{}{"schedulable": false}, clusterService.UpdatesCalls()[0].Values)
+}
+
+func TestClusterHealthManagerIgnoresUnschedulableSilentCluster(t *testing.T) {
+	cluster := api.Cluster{ClusterID: "silent", Schedulable: false}
+	health := &services.ClusterHealth{Heartbeat: dbapi.ClusterHeartbeat{ClusterID: "silent"}, Silent: true}
+	m, clusterService, healthService := newTestClusterHealthManager(cluster, health)
+
+	require.Empty(t, m.Reconcile())
+
+	assert.Empty(t, healthService.SetUnscheduledAsSilentCalls())
+	assert.Empty(t, clusterService.UpdatesCalls())
+}
+
+func TestClusterHealthManagerReschedulesRecoveredCluster(t *testing.T) {
+	tests := map[string]struct {
+		registrationSource  api.ClusterRegistrationSource
+		cordoned            bool
+		expectedSchedulable bool
+	}{
+		"cluster registered through the admin API": {registrationSource: api.ClusterRegistrationSourceAPI, expectedSchedulable: true},
+		"cluster from the configuration file":      {registrationSource: api.ClusterRegistrationSourceConfig},
+		"cordoned cluster":                         {registrationSource: api.ClusterRegistrationSourceAPI, cordoned: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cluster := api.Cluster{ClusterID: "recovered", RegistrationSource: tc.registrationSource, Cordoned: tc.cordoned}
+			health := &services.ClusterHealth{
+				Heartbeat: dbapi.ClusterHeartbeat{ClusterID: "recovered", LastSeenAt: time.Now(), UnscheduledAsSilent: true},
+				Score:     100,
+				Healthy:   true,
+			}
+			m, clusterService, healthService := newTestClusterHealthManager(cluster, health)
+
+			require.Empty(t, m.Reconcile())
+
+			require.Len(t, healthService.SetUnscheduledAsSilentCalls(), 1)
+			assert.False(t, healthService.SetUnscheduledAsSilentCalls()[0].Unscheduled)
+			if tc.expectedSchedulable {
+				require.Len(t, clusterService.UpdatesCalls(), 1)
+				assert.Equal(t, map[string]interface{}{"schedulable": true}, clusterService.UpdatesCalls()[0].Values)
+			} else {
+				assert.Empty(t, clusterService.UpdatesCalls())
+			}
+		})
+	}
+}
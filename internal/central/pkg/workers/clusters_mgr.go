@@ -54,6 +54,8 @@ type ClusterManager struct {
 	isRunning    bool
 	imStop       chan struct{} // a chan used only for cancellation
 	syncTeardown sync.WaitGroup
+	// remainingCapacity is the remaining capacity per region and instance type forecast by the latest reconciliation
+	remainingCapacity map[capacityGroup]int
 	ClusterManagerOptions
 }
 
@@ -65,6 +67,7 @@ type ClusterManagerOptions struct {
 	DataplaneClusterConfig *config.DataplaneClusterConfig
 	SupportedProviders     *config.ProviderConfig
 	ClusterService         services.ClusterService
+	ClusterHealthService   services.ClusterHealthService
 	CloudProvidersService  services.CloudProvidersService
 	GitOpsConfigProvider   gitops.ConfigProvider
 }
@@ -136,6 +139,7 @@ func (c *ClusterManager) Reconcile() []error {
 		c.processMetrics,
 		c.reconcileClusterWithManualConfig,
 		c.reconcileClustersForRegions,
+		c.reconcileClusterCapacity,
 		c.processDeprovisioningClusters,
 		c.processCleanupClusters,
 		c.processAcceptedClusters,
@@ -447,6 +451,11 @@ func (c *ClusterManager) reconcileEmptyCluster(cluster api.Cluster) (bool, error
 		glog.V(10).Infof("cluster is not empty, ClusterID = %s (%s)", cluster.ClusterID, clusterName)
 		return false, nil
 	}
+	// The cluster may just have been requested by the capacity forecast and is about to be used
+	if c.isNeededForCapacity(cluster) {
+		glog.V(10).Infof("keeping empty cluster ClusterID = %s (%s), as the capacity forecast of its region falls below the threshold without it", cluster.ClusterID, clusterName)
+		return false, nil
+	}
 
 	clustersByRegionAndCloudProvider, findSiblingClusterErr := c.ClusterService.ListGroupByProviderAndRegion(
 		[]string{cluster.CloudProvider},
@@ -529,12 +538,21 @@ func (c *ClusterManager) reconcileClusterWithManualConfig() []error {
 		return []error{errors.Wrapf(err, "failed to retrieve cluster ids from clusters")}
 	}
 	clusterIdsMap := make(map[string]api.Cluster)
+	// Clusters registered through the admin API are neither updated nor deprovisioned based on the configuration file
+	configClusterIdsMap := make(map[string]api.Cluster)
 	for _, v := range allClusterIds {
 		clusterIdsMap[v.ClusterID] = v
+		if !v.IsRegisteredThroughAPI() {
+			configClusterIdsMap[v.ClusterID] = v
+		}
 	}
 
 	// Create all missing clusters
 	for _, p := range c.DataplaneClusterConfig.ClusterConfig.MissingClusters(clusterIdsMap) {
+		providerSpec, err := p.ProviderSpecJSON()
+		if err != nil {
+			return []error{err}
+		}
 		clusterRequest := api.Cluster{
 			CloudProvider:         p.CloudProvider,
 			Region:                p.Region,
@@ -545,6 +563,12 @@ func (c *ClusterManager) reconcileClusterWithManualConfig() []error {
 			ClusterDNS:            p.ClusterDNS,
 			SupportedInstanceType: p.SupportedInstanceType,
 			Schedulable:           p.Schedulable,
+			ProviderSpec:          providerSpec,
+			Name:                  p.Name,
+			CentralInstanceLimit:  p.CentralInstanceLimit,
+			RegistrationSource:    api.ClusterRegistrationSourceConfig,
+			AuthIssuer:            p.AuthIssuer,
+			AuthSubject:           p.AuthSubject,
 		}
 
 		if err := c.ClusterService.RegisterClusterJob(&clusterRequest); err != nil {
@@ -554,23 +578,49 @@ func (c *ClusterManager) reconcileClusterWithManualConfig() []error {
 		glog.Infof("Registered a new cluster with config file: %s (%s)", p.ClusterID, clusterName)
 	}
 
+	// Cordoned clusters and clusters unscheduled as silent stay unschedulable regardless of their configuration
+	clusterHealth, healthErr := c.ClusterHealthService.ListHealth()
+	if healthErr != nil {
+		return []error{errors.Wrapf(healthErr, "failed to retrieve cluster health")}
+	}
+	unschedulableClusterIDs := make(map[string]bool, len(clusterHealth))
+	for clusterID, health := range clusterHealth {
+		if health.Heartbeat.UnscheduledAsSilent {
+			unschedulableClusterIDs[clusterID] = true
+		}
+	}
+
 	// Update existing clusters.
-	for _, manualCluster := range c.DataplaneClusterConfig.ClusterConfig.ExistingClusters(clusterIdsMap) {
+	for _, manualCluster := range c.DataplaneClusterConfig.ClusterConfig.ExistingClusters(configClusterIdsMap) {
 		cluster, err := c.ClusterService.FindClusterByID(manualCluster.ClusterID)
 		if err != nil {
 			glog.Warningf("Failed to lookup cluster %s in cluster service: %v", manualCluster.ClusterID, err)
 			continue
 		}
 
+		providerSpec, specErr := manualCluster.ProviderSpecJSON()
+		if specErr != nil {
+			glog.Warningf("Failed to read provider spec of cluster %s: %v", manualCluster.ClusterID, specErr)
+			continue
+		}
+
 		newCluster := *cluster
 		newCluster.CloudProvider = manualCluster.CloudProvider
 		newCluster.Region = manualCluster.Region
 		newCluster.MultiAZ = manualCluster.MultiAZ
-		newCluster.Status = manualCluster.Status
+		// The status of EKS clusters is managed by the EKS provider once they are registered
+		if manualCluster.ProviderType != api.ClusterProviderAwsEKS {
+			newCluster.Status = manualCluster.Status
+		}
+		newCluster.ProviderSpec = providerSpec
 		newCluster.ProviderType = manualCluster.ProviderType
 		newCluster.ClusterDNS = manualCluster.ClusterDNS
 		newCluster.SupportedInstanceType = manualCluster.SupportedInstanceType
-		newCluster.Schedulable = manualCluster.Schedulable
+		newCluster.Schedulable = manualCluster.Schedulable && !cluster.Cordoned && !unschedulableClusterIDs[manualCluster.ClusterID]
+		newCluster.Name = manualCluster.Name
+		newCluster.CentralInstanceLimit = manualCluster.CentralInstanceLimit
+		newCluster.AuthIssuer = manualCluster.AuthIssuer
+		newCluster.AuthSubject = manualCluster.AuthSubject
 
 		if cmp.Equal(*cluster, newCluster) {
 			continue
@@ -589,10 +639,15 @@ func (c *ClusterManager) reconcileClusterWithManualConfig() []error {
 			"region":                  newCluster.Region,
 			"multi_az":                newCluster.MultiAZ,
 			"status":                  newCluster.Status,
+			"provider_spec":           newCluster.ProviderSpec,
 			"provider_type":           newCluster.ProviderType,
 			"cluster_dns":             newCluster.ClusterDNS,
 			"supported_instance_type": newCluster.SupportedInstanceType,
 			"schedulable":             newCluster.Schedulable,
+			"name":                    newCluster.Name,
+			"central_instance_limit":  newCluster.CentralInstanceLimit,
+			"auth_issuer":             newCluster.AuthIssuer,
+			"auth_subject":            newCluster.AuthSubject,
 		}
 
 		if err := c.ClusterService.Updates(newCluster, values); err != nil {
@@ -601,7 +656,7 @@ func (c *ClusterManager) reconcileClusterWithManualConfig() []error {
 	}
 
 	// Remove all clusters that are not in the config file
-	excessClusterIds := c.DataplaneClusterConfig.ClusterConfig.ExcessClusters(clusterIdsMap)
+	excessClusterIds := c.DataplaneClusterConfig.ClusterConfig.ExcessClusters(configClusterIdsMap)
 	if len(excessClusterIds) == 0 {
 		return nil
 	}
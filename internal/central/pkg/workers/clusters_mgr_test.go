@@ -9,6 +9,7 @@ import (
 	"github.com/stackrox/acs-fleet-manager/pkg/api"
 	"github.com/stackrox/acs-fleet-manager/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestClusterManager_processReadyClusters_emptyConfig(t *testing.T) {
@@ -46,3 +47,122 @@ type mockProvider struct {
 func (m mockProvider) Get() (gitops.Config, error) {
 	return m.config, nil
 }
+
+func TestClusterManager_reconcileClusterWithManualConfig_updatesProviderSpec(t *testing.T) {
+	cluster := api.Cluster{
+		ClusterID:             "1234567890abcdef1234567890abcdef", // pragma: allowlist secret
+		Name:                  "eks-cluster",
+		CloudProvider:         "aws",
+		Region:                "us-east-1",
+		Status:                api.ClusterReady,
+		ProviderType:          api.ClusterProviderStandalone,
+		SupportedInstanceType: api.AllInstanceTypeSupport.String(),
+		Schedulable:           true,
+		CentralInstanceLimit:  10,
+		ProviderSpec:          api.JSON(`{"node_group":"small"}`),
+	}
+	manualCluster := config.ManualCluster{
+		Name:                  cluster.Name,
+		ClusterID:             cluster.ClusterID,
+		CloudProvider:         cluster.CloudProvider,
+		Region:                cluster.Region,
+		Status:                cluster.Status,
+		ProviderType:          cluster.ProviderType,
+		SupportedInstanceType: cluster.SupportedInstanceType,
+		Schedulable:           cluster.Schedulable,
+		CentralInstanceLimit:  cluster.CentralInstanceLimit,
+		ProviderSpec:          map[string]interface{}{"node_group": "large"},
+	}
+
+	var updates []map[string]interface{}
+	clusterService := &services.ClusterServiceMock{
+		ListAllClusterIdsFunc: func() ([]api.Cluster, *errors.ServiceError) {
+			return []api.Cluster{cluster}, nil
+		},
+		FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+			c := cluster
+			return &c, nil
+		},
+		UpdatesFunc: func(cluster api.Cluster, values map[string]interface{}) *errors.ServiceError {
+			updates = append(updates, values)
+			return nil
+		},
+	}
+	c := &ClusterManager{
+		ClusterManagerOptions: ClusterManagerOptions{
+			ClusterService: clusterService,
+			ClusterHealthService: &services.ClusterHealthServiceMock{
+				ListHealthFunc: func() (map[string]*services.ClusterHealth, *errors.ServiceError) {
+					return nil, nil
+				},
+			},
+			DataplaneClusterConfig: &config.DataplaneClusterConfig{
+				DataPlaneClusterScalingType: config.ManualScaling,
+				ClusterConfig:               config.NewClusterConfig(config.ClusterList{manualCluster}),
+			},
+		},
+	}
+
+	errs := c.reconcileClusterWithManualConfig()
+	assert.Empty(t, errs)
+	require.Len(t, updates, 1)
+	assert.JSONEq(t, `{"node_group":"large"}`, string(updates[0]["provider_spec"].(api.JSON)))
+}
+
+func TestClusterManager_reconcileClusterWithManualConfig_keepsCordonedClusterUnschedulable(t *testing.T) {
+	// the drain of the cluster completed, so that only the cordon keeps it unschedulable
+	cluster := api.Cluster{
+		ClusterID:             "1234567890abcdef1234567890abcdef", // pragma: allowlist secret
+		Name:                  "drained-cluster",
+		CloudProvider:         "aws",
+		Region:                "us-east-1",
+		Status:                api.ClusterReady,
+		ProviderType:          api.ClusterProviderStandalone,
+		SupportedInstanceType: api.AllInstanceTypeSupport.String(),
+		Schedulable:           false,
+		Cordoned:              true,
+		CentralInstanceLimit:  10,
+	}
+	manualCluster := config.ManualCluster{
+		Name:                  cluster.Name,
+		ClusterID:             cluster.ClusterID,
+		CloudProvider:         cluster.CloudProvider,
+		Region:                cluster.Region,
+		Status:                cluster.Status,
+		ProviderType:          cluster.ProviderType,
+		SupportedInstanceType: cluster.SupportedInstanceType,
+		Schedulable:           true,
+		CentralInstanceLimit:  cluster.CentralInstanceLimit,
+	}
+
+	clusterService := &services.ClusterServiceMock{
+		ListAllClusterIdsFunc: func() ([]api.Cluster, *errors.ServiceError) {
+			return []api.Cluster{cluster}, nil
+		},
+		FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+			c := cluster
+			return &c, nil
+		},
+		UpdatesFunc: func(cluster api.Cluster, values map[string]interface{}) *errors.ServiceError {
+			return nil
+		},
+	}
+	c := &ClusterManager{
+		ClusterManagerOptions: ClusterManagerOptions{
+			ClusterService: clusterService,
+			ClusterHealthService: &services.ClusterHealthServiceMock{
+				ListHealthFunc: func() (map[string]*services.ClusterHealth, *errors.ServiceError) {
+					return nil, nil
+				},
+			},
+			DataplaneClusterConfig: &config.DataplaneClusterConfig{
+				DataPlaneClusterScalingType: config.ManualScaling,
+				ClusterConfig:               config.NewClusterConfig(config.ClusterList{manualCluster}),
+			},
+		},
+	}
+
+	errs := c.reconcileClusterWithManualConfig()
+	assert.Empty(t, errs)
+	assert.Empty(t, clusterService.UpdatesCalls())
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/goava/di"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/clusters"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/config"
+	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/dns"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/environments"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/gitops"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/migrations"
@@ -14,6 +15,8 @@ import (
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/services/quota"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/workers"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/workers/centralmgrs"
+	"github.com/stackrox/acs-fleet-manager/pkg/acl"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
 	environments2 "github.com/stackrox/acs-fleet-manager/pkg/environments"
 	"github.com/stackrox/acs-fleet-manager/pkg/providers"
 )
@@ -40,6 +43,7 @@ func ConfigProviders() di.Option {
 		di.Provide(config.NewAWSConfig, di.As(new(environments2.ConfigModule))),
 		di.Provide(config.NewSupportedProvidersConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
 		di.Provide(config.NewCentralConfig, di.As(new(environments2.ConfigModule))),
+		di.Provide(config.NewDNSConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
 		di.Provide(config.NewDataplaneClusterConfig, di.As(new(environments2.ConfigModule))),
 		di.Provide(config.NewCentralRequestConfig, di.As(new(environments2.ConfigModule))),
 
@@ -52,10 +56,22 @@ func ConfigProviders() di.Option {
 func ServiceProviders() di.Option {
 	return di.Options(
 		di.Provide(services.NewClusterService),
+		di.Provide(dns.NewDNSProvider),
 		di.Provide(services.NewCentralService),
 		di.Provide(services.NewCloudProvidersService),
 		di.Provide(services.NewClusterPlacementStrategy),
 		di.Provide(services.NewDataPlaneCentralService),
+		di.Provide(services.NewCentralUsageService),
+		di.Provide(services.NewClusterDrainService),
+		di.Provide(services.NewClusterHealthService),
+		di.Provide(services.NewClusterIdentityService),
+		di.Provide(services.NewCentralMigrationService),
+		di.Provide(services.NewAdminAuditService),
+		di.Provide(services.NewAccessRuleService, di.As(new(acl.AccessRuleSource))),
+		di.Provide(services.NewOIDCDiscoveryClient),
+		di.Provide(services.NewCentralAuthProviderService),
+		di.Provide(services.NewCentralGrantService),
+		di.Provide(services.NewAPIKeyService, di.As(new(auth.APIKeyAuthenticator))),
 		di.Provide(clusters.NewDefaultProviderFactory, di.As(new(clusters.ProviderFactory))),
 		di.Provide(routes.NewRouteLoader),
 		di.Provide(quota.NewDefaultQuotaServiceFactory),
@@ -70,9 +86,16 @@ func ServiceProviders() di.Option {
 		di.Provide(centralmgrs.NewProvisioningCentralManager, di.As(new(workers.Worker))),
 		di.Provide(centralmgrs.NewReadyCentralManager, di.As(new(workers.Worker))),
 		di.Provide(centralmgrs.NewCentralCNAMEManager, di.As(new(workers.Worker))),
+		di.Provide(centralmgrs.NewCentralDNSReconcileManager, di.As(new(workers.Worker))),
 		di.Provide(centralmgrs.NewCentralAuthConfigManager, di.As(new(workers.Worker))),
+		di.Provide(centralmgrs.NewRHSSOClientGCManager, di.As(new(workers.Worker))),
+		di.Provide(centralmgrs.NewOIDCClientRotationManager, di.As(new(workers.Worker))),
 		di.Provide(centralmgrs.NewExpirationDateManager, di.As(new(workers.Worker))),
 		di.Provide(centralmgrs.NewCentralRequestPruningManager, di.As(new(workers.Worker))),
+		di.Provide(centralmgrs.NewCentralUsagePruningManager, di.As(new(workers.Worker))),
+		di.Provide(workers.NewClusterDrainManager, di.As(new(workers.Worker))),
+		di.Provide(workers.NewCentralMigrationManager, di.As(new(workers.Worker))),
+		di.Provide(workers.NewClusterHealthManager, di.As(new(workers.Worker))),
 		di.Provide(gitops.NewEmptyReader),
 		di.Provide(gitops.NewProvider),
 		di.Provide(presenters.NewManagedCentralPresenter),
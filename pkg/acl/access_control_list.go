@@ -3,6 +3,7 @@ package acl
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/pflag"
 	"github.com/stackrox/acs-fleet-manager/pkg/shared"
@@ -25,20 +26,24 @@ type AccessControlListConfig struct {
 	DenyList           DeniedUsers
 	DenyListConfigFile string
 	EnableDenyList     bool
+	// AccessRulesRefreshInterval is the maximum age of the cached access rules managed via the admin API
+	AccessRulesRefreshInterval time.Duration
 }
 
 // NewAccessControlListConfig ...
 func NewAccessControlListConfig() *AccessControlListConfig {
 	return &AccessControlListConfig{
-		DenyListConfigFile: "config/deny-list-configuration.yaml",
-		EnableDenyList:     false,
+		DenyListConfigFile:         "config/deny-list-configuration.yaml",
+		EnableDenyList:             false,
+		AccessRulesRefreshInterval: 30 * time.Second,
 	}
 }
 
 // AddFlags ...
 func (c *AccessControlListConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.DenyListConfigFile, "deny-list-config-file", c.DenyListConfigFile, "DenyList configuration file")
-	fs.BoolVar(&c.EnableDenyList, "enable-deny-list", c.EnableDenyList, "Enable access control via the denied list of users")
+	fs.BoolVar(&c.EnableDenyList, "enable-deny-list", c.EnableDenyList, "Enable access control via the denied list of users of the deny list configuration file. The access rules managed via the admin API are always enforced")
+	fs.DurationVar(&c.AccessRulesRefreshInterval, "access-rules-refresh-interval", c.AccessRulesRefreshInterval, "Interval after which the access rules managed via the admin API are reloaded from the database")
 }
 
 // ReadFiles ...
@@ -3,6 +3,7 @@ package acl
 import (
 	"net/http"
 
+	"github.com/golang/glog"
 	"github.com/stackrox/acs-fleet-manager/pkg/auth"
 	"github.com/stackrox/acs-fleet-manager/pkg/errors"
 	"github.com/stackrox/acs-fleet-manager/pkg/shared"
@@ -11,12 +12,14 @@ import (
 // AccessControlListMiddleware ...
 type AccessControlListMiddleware struct {
 	accessControlListConfig *AccessControlListConfig
+	accessRules             *AccessRuleCache
 }
 
 // NewAccessControlListMiddleware ...
-func NewAccessControlListMiddleware(accessControlListConfig *AccessControlListConfig) *AccessControlListMiddleware {
+func NewAccessControlListMiddleware(accessControlListConfig *AccessControlListConfig, accessRules *AccessRuleCache) *AccessControlListMiddleware {
 	middleware := AccessControlListMiddleware{
 		accessControlListConfig: accessControlListConfig,
+		accessRules:             accessRules,
 	}
 	return &middleware
 }
@@ -32,17 +35,13 @@ func (middleware *AccessControlListMiddleware) Authorize(next http.Handler) http
 		}
 
 		username, _ := claims.GetUsername()
+		orgID, _ := claims.GetOrgID()
 
-		if middleware.accessControlListConfig.EnableDenyList {
-			userIsDenied := middleware.accessControlListConfig.DenyList.IsUserDenied(username)
-			if userIsDenied {
-				shared.HandleError(r, w, errors.New(errors.ErrorForbidden, "User %q is not authorized to access the service.", username))
-				return
-			}
+		if middleware.isDenied(claims, username, orgID) {
+			shared.HandleError(r, w, errors.New(errors.ErrorForbidden, "User %q is not authorized to access the service.", username))
+			return
 		}
 
-		orgID, _ := claims.GetOrgID()
-
 		// If the users claim has an orgId, resources should be filtered by their organisation. Otherwise, filter them by owner.
 		context = auth.SetFilterByOrganisationContext(context, orgID != "")
 		*r = *r.WithContext(context)
@@ -50,3 +49,22 @@ func (middleware *AccessControlListMiddleware) Authorize(next http.Handler) http
 		next.ServeHTTP(w, r)
 	})
 }
+
+// isDenied evaluates the access rules managed via the admin API and, if it is enabled, the deny list file and logs
+// which rule matched. The access rules are always enforced, a matching allow rule exempts the user from the deny list
+// file as well.
+func (middleware *AccessControlListMiddleware) isDenied(claims auth.ACSClaims, username string, orgID string) bool {
+	if middleware.accessRules != nil {
+		email, _ := claims.GetEmail()
+		subject := AccessSubject{Username: username, OrgID: orgID, Email: email}
+		if rule := middleware.accessRules.Match(subject); rule != nil {
+			glog.Infof("Access rule %s matched user %q of organisation %q", rule, username, orgID)
+			return rule.Action == AccessRuleActionDeny
+		}
+	}
+	if middleware.accessControlListConfig.EnableDenyList && middleware.accessControlListConfig.DenyList.IsUserDenied(username) {
+		glog.Infof("Deny list configuration file matched user %q", username)
+		return true
+	}
+	return false
+}
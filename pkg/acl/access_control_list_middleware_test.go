@@ -47,6 +47,7 @@ func Test_AccessControlListMiddleware_UserHasNoAccess(t *testing.T) {
 	tests := []struct {
 		name           string
 		arg            *acl.AccessControlListConfig
+		rules          acl.AccessRules
 		wantErr        bool
 		wantHTTPStatus int
 	}{
@@ -75,6 +76,49 @@ func Test_AccessControlListMiddleware_UserHasNoAccess(t *testing.T) {
 			wantErr:        false,
 			wantHTTPStatus: http.StatusOK,
 		},
+		{
+			name: "returns 403 Forbidden response when a deny rule matches the user's organisation",
+			arg: &acl.AccessControlListConfig{
+				EnableDenyList: true,
+			},
+			rules: acl.AccessRules{
+				{ID: "1", Action: acl.AccessRuleActionDeny, Type: acl.AccessRuleTypeOrganisationID, Value: "org-id-0"},
+			},
+			wantErr:        true,
+			wantHTTPStatus: http.StatusForbidden,
+		},
+		{
+			name: "returns 403 Forbidden response when a deny rule matches and denyList is disabled",
+			arg: &acl.AccessControlListConfig{
+				EnableDenyList: false,
+			},
+			rules: acl.AccessRules{
+				{ID: "1", Action: acl.AccessRuleActionDeny, Type: acl.AccessRuleTypeOrganisationID, Value: "org-id-0"},
+			},
+			wantErr:        true,
+			wantHTTPStatus: http.StatusForbidden,
+		},
+		{
+			name: "returns 200 status if denyList is disabled and the user is in the deny list",
+			arg: &acl.AccessControlListConfig{
+				EnableDenyList: false,
+				DenyList:       acl.DeniedUsers{"username"},
+			},
+			wantErr:        false,
+			wantHTTPStatus: http.StatusOK,
+		},
+		{
+			name: "returns 200 status if an allow rule exempts a user of the deny list",
+			arg: &acl.AccessControlListConfig{
+				EnableDenyList: true,
+				DenyList:       acl.DeniedUsers{"username"},
+			},
+			rules: acl.AccessRules{
+				{ID: "1", Action: acl.AccessRuleActionAllow, Type: acl.AccessRuleTypePattern, Value: "user*"},
+			},
+			wantErr:        false,
+			wantHTTPStatus: http.StatusOK,
+		},
 	}
 
 	for _, tt := range tests {
@@ -84,7 +128,16 @@ func Test_AccessControlListMiddleware_UserHasNoAccess(t *testing.T) {
 
 			rr := httptest.NewRecorder()
 
-			middleware := acl.NewAccessControlListMiddleware(tt.arg)
+			var accessRules *acl.AccessRuleCache
+			if tt.rules != nil {
+				source := &acl.AccessRuleSourceMock{
+					ListActiveAccessRulesFunc: func() (acl.AccessRules, error) {
+						return tt.rules, nil
+					},
+				}
+				accessRules = acl.NewAccessRuleCache(source, tt.arg)
+			}
+			middleware := acl.NewAccessControlListMiddleware(tt.arg, accessRules)
 			handler := middleware.Authorize(http.HandlerFunc(NextHandler))
 
 			// create a jwt and set it in the context
@@ -0,0 +1,67 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package acl
+
+import (
+	"sync"
+)
+
+// Ensure, that AccessRuleSourceMock does implement AccessRuleSource.
+// If this is not the case, regenerate this file with moq.
+var _ AccessRuleSource = &AccessRuleSourceMock{}
+
+// AccessRuleSourceMock is a mock implementation of AccessRuleSource.
+//
+//	func TestSomethingThatUsesAccessRuleSource(t *testing.T) {
+//
+//		// make and configure a mocked AccessRuleSource
+//		mockedAccessRuleSource := &AccessRuleSourceMock{
+//			ListActiveAccessRulesFunc: func() (AccessRules, error) {
+//				panic("mock out the ListActiveAccessRules method")
+//			},
+//		}
+//
+//		// use mockedAccessRuleSource in code that requires AccessRuleSource
+//		// and then make assertions.
+//
+//	}
+type AccessRuleSourceMock struct {
+	// ListActiveAccessRulesFunc mocks the ListActiveAccessRules method.
+	ListActiveAccessRulesFunc func() (AccessRules, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListActiveAccessRules holds details about calls to the ListActiveAccessRules method.
+		ListActiveAccessRules []struct {
+		}
+	}
+	lockListActiveAccessRules sync.RWMutex
+}
+
+// ListActiveAccessRules calls ListActiveAccessRulesFunc.
+func (mock *AccessRuleSourceMock) ListActiveAccessRules() (AccessRules, error) {
+	if mock.ListActiveAccessRulesFunc == nil {
+		panic("AccessRuleSourceMock.ListActiveAccessRulesFunc: method is nil but AccessRuleSource.ListActiveAccessRules was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListActiveAccessRules.Lock()
+	mock.calls.ListActiveAccessRules = append(mock.calls.ListActiveAccessRules, callInfo)
+	mock.lockListActiveAccessRules.Unlock()
+	return mock.ListActiveAccessRulesFunc()
+}
+
+// ListActiveAccessRulesCalls gets all the calls that were made to ListActiveAccessRules.
+// Check the length with:
+//
+//	len(mockedAccessRuleSource.ListActiveAccessRulesCalls())
+func (mock *AccessRuleSourceMock) ListActiveAccessRulesCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListActiveAccessRules.RLock()
+	calls = mock.calls.ListActiveAccessRules
+	mock.lockListActiveAccessRules.RUnlock()
+	return calls
+}
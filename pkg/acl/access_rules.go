@@ -0,0 +1,179 @@
+package acl
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+// AccessRuleAction is the decision taken when an access rule matches a user
+type AccessRuleAction string
+
+// AccessRuleType is the attribute of the user an access rule is matched against
+type AccessRuleType string
+
+const (
+	// AccessRuleActionDeny denies the user access to the service
+	AccessRuleActionDeny AccessRuleAction = "deny"
+	// AccessRuleActionAllow grants the user access to the service even if a deny rule matches as well
+	AccessRuleActionAllow AccessRuleAction = "allow"
+
+	// AccessRuleTypeUsername matches the username exactly
+	AccessRuleTypeUsername AccessRuleType = "username"
+	// AccessRuleTypeOrganisationID matches the organisation ID exactly
+	AccessRuleTypeOrganisationID AccessRuleType = "organisation_id"
+	// AccessRuleTypeEmailDomain matches the domain of the email address, case-insensitively
+	AccessRuleTypeEmailDomain AccessRuleType = "email_domain"
+	// AccessRuleTypePattern matches the username against a glob pattern, e.g. "spam-*"
+	AccessRuleTypePattern AccessRuleType = "pattern"
+)
+
+// AccessRule is a deny or allow rule for users of the service
+type AccessRule struct {
+	ID        string
+	Action    AccessRuleAction
+	Type      AccessRuleType
+	Value     string
+	ExpiresAt *time.Time
+}
+
+// String describes the rule for log messages
+func (r *AccessRule) String() string {
+	return fmt.Sprintf("%s %s=%q (id %s)", r.Action, r.Type, r.Value, r.ID)
+}
+
+// Validate returns an error if the rule can never match or has an unknown action
+func (r *AccessRule) Validate() error {
+	switch r.Action {
+	case AccessRuleActionDeny, AccessRuleActionAllow:
+	default:
+		return fmt.Errorf("unknown action %q, must be one of %q or %q", r.Action, AccessRuleActionDeny, AccessRuleActionAllow)
+	}
+	if r.Value == "" {
+		return fmt.Errorf("value must not be empty")
+	}
+	switch r.Type {
+	case AccessRuleTypeUsername, AccessRuleTypeOrganisationID, AccessRuleTypeEmailDomain:
+	case AccessRuleTypePattern:
+		if _, err := path.Match(r.Value, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", r.Value, err)
+		}
+	default:
+		return fmt.Errorf("unknown type %q", r.Type)
+	}
+	return nil
+}
+
+// AccessSubject holds the attributes of a user access rules are matched against
+type AccessSubject struct {
+	Username string
+	OrgID    string
+	Email    string
+}
+
+// Matches returns true if the rule has not expired and matches the subject
+func (r *AccessRule) Matches(subject AccessSubject, now time.Time) bool {
+	if r.ExpiresAt != nil && !now.Before(*r.ExpiresAt) {
+		return false
+	}
+	switch r.Type {
+	case AccessRuleTypeUsername:
+		return subject.Username != "" && subject.Username == r.Value
+	case AccessRuleTypeOrganisationID:
+		return subject.OrgID != "" && subject.OrgID == r.Value
+	case AccessRuleTypeEmailDomain:
+		at := strings.LastIndex(subject.Email, "@")
+		return at != -1 && strings.EqualFold(subject.Email[at+1:], strings.TrimPrefix(r.Value, "@"))
+	case AccessRuleTypePattern:
+		matched, _ := path.Match(r.Value, subject.Username)
+		return subject.Username != "" && matched
+	}
+	return false
+}
+
+// AccessRules is a list of access rules
+type AccessRules []*AccessRule
+
+// Match returns the rule deciding about the subject's access or nil if no rule matches.
+// Allow rules take precedence over deny rules, so that single users can be exempted from a rule for their organisation.
+func (rules AccessRules) Match(subject AccessSubject, now time.Time) *AccessRule {
+	var denied *AccessRule
+	for _, rule := range rules {
+		if !rule.Matches(subject, now) {
+			continue
+		}
+		if rule.Action == AccessRuleActionAllow {
+			return rule
+		}
+		if denied == nil {
+			denied = rule
+		}
+	}
+	return denied
+}
+
+// AccessRuleSource provides the access rules managed at runtime
+//
+//go:generate moq -out access_rule_source_moq.go . AccessRuleSource
+type AccessRuleSource interface {
+	// ListActiveAccessRules returns all rules which have not expired yet
+	ListActiveAccessRules() (AccessRules, error)
+}
+
+// AccessRuleCache caches the rules of an AccessRuleSource and reloads them once they are older than the refresh
+// interval. The last loaded rules are kept if reloading fails.
+type AccessRuleCache struct {
+	source          AccessRuleSource
+	refreshInterval time.Duration
+	now             func() time.Time
+
+	mutex    sync.Mutex
+	rules    AccessRules
+	loadedAt time.Time
+}
+
+// NewAccessRuleCache ...
+func NewAccessRuleCache(source AccessRuleSource, accessControlListConfig *AccessControlListConfig) *AccessRuleCache {
+	return &AccessRuleCache{
+		source:          source,
+		refreshInterval: accessControlListConfig.AccessRulesRefreshInterval,
+		now:             time.Now,
+	}
+}
+
+// Match returns the rule deciding about the subject's access or nil if no rule matches
+func (c *AccessRuleCache) Match(subject AccessSubject) *AccessRule {
+	return c.Rules().Match(subject, c.now())
+}
+
+// Rules returns the cached rules, reloading them from the source if they are stale
+func (c *AccessRuleCache) Rules() AccessRules {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := c.now()
+	if !c.loadedAt.IsZero() && now.Sub(c.loadedAt) < c.refreshInterval {
+		return c.rules
+	}
+	rules, err := c.source.ListActiveAccessRules()
+	if err != nil {
+		glog.Errorf("Failed to reload access rules, keeping %d cached rules: %v", len(c.rules), err)
+		// retry with the next refresh instead of hitting the source on every request
+		c.loadedAt = now
+		return c.rules
+	}
+	c.rules = rules
+	c.loadedAt = now
+	return c.rules
+}
+
+// Invalidate makes the next lookup reload the rules from the source
+func (c *AccessRuleCache) Invalidate() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.loadedAt = time.Time{}
+}
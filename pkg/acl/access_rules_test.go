@@ -0,0 +1,121 @@
+package acl
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_AccessRules_Match(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	expired := now.Add(-time.Minute)
+	notExpired := now.Add(time.Minute)
+	subject := AccessSubject{Username: "spam-bot-1", OrgID: "12345", Email: "bot@Example.com"}
+
+	tests := []struct {
+		name   string
+		rules  AccessRules
+		wantID string
+	}{
+		{
+			name:   "no rules match",
+			rules:  AccessRules{{ID: "1", Action: AccessRuleActionDeny, Type: AccessRuleTypeUsername, Value: "other"}},
+			wantID: "",
+		},
+		{
+			name:   "username rule matches",
+			rules:  AccessRules{{ID: "1", Action: AccessRuleActionDeny, Type: AccessRuleTypeUsername, Value: "spam-bot-1"}},
+			wantID: "1",
+		},
+		{
+			name:   "organisation rule matches",
+			rules:  AccessRules{{ID: "1", Action: AccessRuleActionDeny, Type: AccessRuleTypeOrganisationID, Value: "12345"}},
+			wantID: "1",
+		},
+		{
+			name:   "email domain rule matches case-insensitively",
+			rules:  AccessRules{{ID: "1", Action: AccessRuleActionDeny, Type: AccessRuleTypeEmailDomain, Value: "@example.com"}},
+			wantID: "1",
+		},
+		{
+			name:   "email domain rule does not match sub domains",
+			rules:  AccessRules{{ID: "1", Action: AccessRuleActionDeny, Type: AccessRuleTypeEmailDomain, Value: "ample.com"}},
+			wantID: "",
+		},
+		{
+			name:   "pattern rule matches",
+			rules:  AccessRules{{ID: "1", Action: AccessRuleActionDeny, Type: AccessRuleTypePattern, Value: "spam-*"}},
+			wantID: "1",
+		},
+		{
+			name: "expired rule is ignored",
+			rules: AccessRules{
+				{ID: "1", Action: AccessRuleActionDeny, Type: AccessRuleTypeOrganisationID, Value: "12345", ExpiresAt: &expired},
+				{ID: "2", Action: AccessRuleActionDeny, Type: AccessRuleTypeUsername, Value: "spam-bot-1", ExpiresAt: &notExpired},
+			},
+			wantID: "2",
+		},
+		{
+			name: "allow rule takes precedence over deny rules",
+			rules: AccessRules{
+				{ID: "1", Action: AccessRuleActionDeny, Type: AccessRuleTypeOrganisationID, Value: "12345"},
+				{ID: "2", Action: AccessRuleActionAllow, Type: AccessRuleTypeUsername, Value: "spam-bot-1"},
+			},
+			wantID: "2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			rule := tt.rules.Match(subject, now)
+			if tt.wantID == "" {
+				Expect(rule).To(BeNil())
+				return
+			}
+			Expect(rule).NotTo(BeNil())
+			Expect(rule.ID).To(Equal(tt.wantID))
+		})
+	}
+}
+
+func Test_AccessRule_Validate(t *testing.T) {
+	RegisterTestingT(t)
+	Expect((&AccessRule{Action: AccessRuleActionDeny, Type: AccessRuleTypePattern, Value: "spam-*"}).Validate()).To(Succeed())
+	Expect((&AccessRule{Action: "block", Type: AccessRuleTypeUsername, Value: "user"}).Validate()).NotTo(Succeed())
+	Expect((&AccessRule{Action: AccessRuleActionDeny, Type: "ip", Value: "127.0.0.1"}).Validate()).NotTo(Succeed())
+	Expect((&AccessRule{Action: AccessRuleActionDeny, Type: AccessRuleTypeUsername}).Validate()).NotTo(Succeed())
+	Expect((&AccessRule{Action: AccessRuleActionDeny, Type: AccessRuleTypePattern, Value: "spam-["}).Validate()).NotTo(Succeed())
+}
+
+func Test_AccessRuleCache(t *testing.T) {
+	RegisterTestingT(t)
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	rules := AccessRules{{ID: "1", Action: AccessRuleActionDeny, Type: AccessRuleTypeUsername, Value: "user"}}
+	var sourceErr error
+	source := &AccessRuleSourceMock{
+		ListActiveAccessRulesFunc: func() (AccessRules, error) {
+			return rules, sourceErr
+		},
+	}
+	cache := NewAccessRuleCache(source, &AccessControlListConfig{AccessRulesRefreshInterval: time.Minute})
+	cache.now = func() time.Time { return now }
+
+	Expect(cache.Match(AccessSubject{Username: "user"})).NotTo(BeNil())
+	Expect(cache.Match(AccessSubject{Username: "user"})).NotTo(BeNil())
+	Expect(source.ListActiveAccessRulesCalls()).To(HaveLen(1), "rules should be cached")
+
+	rules = nil
+	cache.Invalidate()
+	Expect(cache.Match(AccessSubject{Username: "user"})).To(BeNil(), "rules should be reloaded after invalidation")
+	Expect(source.ListActiveAccessRulesCalls()).To(HaveLen(2))
+
+	rules = AccessRules{{ID: "2", Action: AccessRuleActionDeny, Type: AccessRuleTypeUsername, Value: "user"}}
+	now = now.Add(time.Minute)
+	Expect(cache.Match(AccessSubject{Username: "user"})).NotTo(BeNil(), "rules should be reloaded once stale")
+
+	sourceErr = errors.New("database unavailable")
+	now = now.Add(time.Minute)
+	Expect(cache.Match(AccessSubject{Username: "user"})).NotTo(BeNil(), "cached rules should be kept if reloading fails")
+}
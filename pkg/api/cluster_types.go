@@ -14,6 +14,9 @@ type ClusterProviderType string
 // ClusterInstanceTypeSupport ...
 type ClusterInstanceTypeSupport string
 
+// ClusterRegistrationSource tells what manages the registration of a cluster
+type ClusterRegistrationSource string
+
 // String ...
 func (k ClusterStatus) String() string {
 	return string(k)
@@ -110,6 +113,12 @@ const (
 	ClusterProviderStandalone ClusterProviderType = "standalone"
 
 	AllInstanceTypeSupport ClusterInstanceTypeSupport = "standard,eval"
+
+	// ClusterRegistrationSourceConfig clusters are registered and updated from the data plane cluster configuration file.
+	// Clusters registered before the registration source was introduced have an empty source and are treated the same.
+	ClusterRegistrationSourceConfig ClusterRegistrationSource = "config"
+	// ClusterRegistrationSourceAPI clusters are registered and updated through the admin API only
+	ClusterRegistrationSourceAPI ClusterRegistrationSource = "api"
 )
 
 // ordinals - Used to decide if a status comes after or before a given state
@@ -154,6 +163,29 @@ type Cluster struct {
 	SupportedInstanceType string `json:"supported_instance_type"`
 	// The cluster is "schedulable" if tenants can be placed there.
 	Schedulable bool `json:"schedulable"`
+	// Cordoned keeps the cluster unschedulable regardless of its configuration. It is set once the cluster is
+	// drained and only cleared by an admin making the cluster schedulable again.
+	Cordoned bool `json:"cordoned"`
+	// Name is the human readable name of the cluster
+	Name string `json:"name"`
+	// CentralInstanceLimit is the maximum number of Central tenants on the cluster
+	CentralInstanceLimit int `json:"central_instance_limit"`
+	// RegistrationSource tells whether the cluster is managed by the configuration file or the admin API
+	RegistrationSource ClusterRegistrationSource `json:"registration_source"`
+	// AuthIssuer and AuthSubject identify the fleetshard of the cluster in its access tokens. Once they are set, only
+	// tokens with this identity are accepted for the cluster, and the identity is not accepted for other clusters.
+	AuthIssuer  string `json:"auth_issuer"`
+	AuthSubject string `json:"auth_subject"`
+}
+
+// HasAuthIdentity returns true if a fleetshard identity is bound to the cluster
+func (cluster *Cluster) HasAuthIdentity() bool {
+	return cluster.AuthIssuer != "" && cluster.AuthSubject != ""
+}
+
+// IsRegisteredThroughAPI returns true if the cluster is managed through the admin API instead of the configuration file
+func (cluster *Cluster) IsRegisteredThroughAPI() bool {
+	return cluster.RegistrationSource == ClusterRegistrationSourceAPI
 }
 
 // BeforeCreate ...
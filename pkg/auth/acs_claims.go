@@ -39,6 +39,14 @@ func (c *ACSClaims) GetUsername() (string, error) {
 		tenantUsernameClaim, alternateTenantUsernameClaim)
 }
 
+// GetEmail returns the email claim of the token or error if the claim can't be found.
+func (c *ACSClaims) GetEmail() (string, error) {
+	if email, ok := (*c)[tenantEmailClaim].(string); ok {
+		return email, nil
+	}
+	return "", fmt.Errorf("can't find %q attribute in claims", tenantEmailClaim)
+}
+
 // GetAccountID returns the account ID claim of the access token.
 func (c *ACSClaims) GetAccountID() (string, error) {
 	if accountID, ok := (*c)[tenantAccountIDClaim].(string); ok {
@@ -90,6 +98,34 @@ func (c *ACSClaims) GetSubject() (string, error) {
 	return "", fmt.Errorf("can't find %q attribute in claims", tenantSubClaim)
 }
 
+// GetIssuer returns the issuer claim of the token. It identifies the principal that issued the token.
+func (c *ACSClaims) GetIssuer() (string, error) {
+	if iss, ok := (*c)[issuerClaim].(string); ok {
+		return iss, nil
+	}
+
+	return "", fmt.Errorf("can't find %q attribute in claims", issuerClaim)
+}
+
+// GetAPIKeyID returns the ID of the API key the request is authenticated with. The second value is false for
+// requests authenticated with a JWT.
+func (c *ACSClaims) GetAPIKeyID() (string, bool) {
+	if iss, _ := (*c)[issuerClaim].(string); iss != APIKeyIssuer {
+		return "", false
+	}
+	id, ok := (*c)[apiKeyIDClaim].(string)
+	return id, ok && id != ""
+}
+
+// HasAPIKeyScope returns true if the request is authenticated with an API key with the given scope
+func (c *ACSClaims) HasAPIKeyScope(scope string) bool {
+	if _, isAPIKey := c.GetAPIKeyID(); !isAPIKey {
+		return false
+	}
+	scopes, _ := (*c)[scopeClaim].(string)
+	return arrays.Contains(strings.Fields(scopes), scope)
+}
+
 // GetAudience returns the audience claim of the token. It identifies the token consumer.
 func (c *ACSClaims) GetAudience() ([]string, error) {
 	aud := make([]string, 0)
@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/shared"
+)
+
+const (
+	// APIKeyPrefix starts every customer API key, which tells them apart from JWTs
+	APIKeyPrefix = "rhacs_"
+	// APIKeyIssuer is the issuer of the claims of requests authenticated with API keys
+	APIKeyIssuer = "fleet-manager-api-keys"
+
+	apiKeyIDClaim = "api_key_id"
+	// scopeClaim lists the scopes of the API key separated by spaces, like the OAuth 2.0 scope claim
+	scopeClaim = "scope"
+)
+
+// The operations API keys can be scoped to
+const (
+	APIKeyScopeCentralsRead       = "centrals:read"
+	APIKeyScopeCentralsWrite      = "centrals:write"
+	APIKeyScopeCloudProvidersRead = "cloud_providers:read"
+	APIKeyScopeCloudAccountsRead  = "cloud_accounts:read"
+)
+
+// APIKeyScopes are all scopes of API keys
+var APIKeyScopes = []string{APIKeyScopeCentralsRead, APIKeyScopeCentralsWrite, APIKeyScopeCloudProvidersRead, APIKeyScopeCloudAccountsRead}
+
+// APIKeyIdentity is the identity requests authenticated with an API key act with. Keys act on behalf of the user who
+// created them, restricted to the organisation and the scopes of the key.
+type APIKeyIdentity struct {
+	ID       string
+	Username string
+	// UserID, AlternateUserID and AccountID are the sub, deprecated_sub and account_id claims of the owner
+	UserID          string
+	AlternateUserID string
+	AccountID       string
+	OrgID           string
+	Scopes          []string
+	ExpiresAt       time.Time
+}
+
+// APIKeyAuthenticator verifies customer API keys
+//
+//go:generate moq -out api_key_authenticator_moq.go . APIKeyAuthenticator
+type APIKeyAuthenticator interface {
+	// Authenticate returns the identity of the key, or nil if the key is unknown, revoked or expired
+	Authenticate(key string) (*APIKeyIdentity, error)
+}
+
+// IsAPIKey returns true if the bearer token is an API key instead of a JWT
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
+
+// NewAPIKeyAuthenticationHandler authenticates requests with customer API keys. The identity of the key is stored in
+// the context as token claims, so that the context helpers work for both keys and JWTs. Requests without API key are
+// passed to the fallback handler, which authenticates JWTs.
+func NewAPIKeyAuthenticationHandler(keys APIKeyAuthenticator, fallback http.Handler, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		key, found := bearerToken(request)
+		if !found || !IsAPIKey(key) {
+			fallback.ServeHTTP(writer, request)
+			return
+		}
+		identity, err := keys.Authenticate(key)
+		if err != nil {
+			shared.HandleError(request, writer, errors.GeneralError("unable to authenticate API key: %v", err))
+			return
+		}
+		if identity == nil {
+			shared.HandleError(request, writer, errors.Unauthenticated("invalid API key"))
+			return
+		}
+		token := &jwt.Token{
+			Claims: jwt.MapClaims{
+				issuerClaim:          APIKeyIssuer,
+				tenantSubClaim:       identity.UserID,
+				alternateSubClaim:    identity.AlternateUserID,
+				tenantAccountIDClaim: identity.AccountID,
+				tenantUsernameClaim:  identity.Username,
+				tenantIDClaim:        identity.OrgID,
+				apiKeyIDClaim:        identity.ID,
+				scopeClaim:           strings.Join(identity.Scopes, " "),
+				"exp":                identity.ExpiresAt.Unix(),
+			},
+			Valid: true,
+		}
+		next.ServeHTTP(writer, request.WithContext(SetTokenInContext(request.Context(), token)))
+	})
+}
@@ -0,0 +1,74 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package auth
+
+import (
+	"sync"
+)
+
+// Ensure, that APIKeyAuthenticatorMock does implement APIKeyAuthenticator.
+// If this is not the case, regenerate this file with moq.
+var _ APIKeyAuthenticator = &APIKeyAuthenticatorMock{}
+
+// APIKeyAuthenticatorMock is a mock implementation of APIKeyAuthenticator.
+//
+//	func TestSomethingThatUsesAPIKeyAuthenticator(t *testing.T) {
+//
+//		// make and configure a mocked APIKeyAuthenticator
+//		mockedAPIKeyAuthenticator := &APIKeyAuthenticatorMock{
+//			AuthenticateFunc: func(key string) (*APIKeyIdentity, error) {
+//				panic("mock out the Authenticate method")
+//			},
+//		}
+//
+//		// use mockedAPIKeyAuthenticator in code that requires APIKeyAuthenticator
+//		// and then make assertions.
+//
+//	}
+type APIKeyAuthenticatorMock struct {
+	// AuthenticateFunc mocks the Authenticate method.
+	AuthenticateFunc func(key string) (*APIKeyIdentity, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Authenticate holds details about calls to the Authenticate method.
+		Authenticate []struct {
+			// Key is the key argument value.
+			Key string
+		}
+	}
+	lockAuthenticate sync.RWMutex
+}
+
+// Authenticate calls AuthenticateFunc.
+func (mock *APIKeyAuthenticatorMock) Authenticate(key string) (*APIKeyIdentity, error) {
+	if mock.AuthenticateFunc == nil {
+		panic("APIKeyAuthenticatorMock.AuthenticateFunc: method is nil but APIKeyAuthenticator.Authenticate was just called")
+	}
+	callInfo := struct {
+		Key string
+	}{
+		Key: key,
+	}
+	mock.lockAuthenticate.Lock()
+	mock.calls.Authenticate = append(mock.calls.Authenticate, callInfo)
+	mock.lockAuthenticate.Unlock()
+	return mock.AuthenticateFunc(key)
+}
+
+// AuthenticateCalls gets all the calls that were made to Authenticate.
+// Check the length with:
+//
+//	len(mockedAPIKeyAuthenticator.AuthenticateCalls())
+func (mock *APIKeyAuthenticatorMock) AuthenticateCalls() []struct {
+	Key string
+} {
+	var calls []struct {
+		Key string
+	}
+	mock.lockAuthenticate.RLock()
+	calls = mock.calls.Authenticate
+	mock.lockAuthenticate.RUnlock()
+	return calls
+}
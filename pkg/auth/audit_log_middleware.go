@@ -1,8 +1,8 @@
 package auth
 
 import (
+	"context"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/stackrox/acs-fleet-manager/pkg/errors"
@@ -16,13 +16,36 @@ type AuditLogMiddleware interface {
 }
 
 type auditInfo struct {
-	Type               string        `json:"type"`
-	Username           string        `json:"username"`
-	Method             string        `json:"request_method,omitempty"`
-	RequestURI         string        `json:"request_url,omitempty"`
-	Body               io.ReadCloser `json:"request_body,omitempty"`
-	RemoteAddr         string        `json:"request_remote_ip,omitempty"`
-	ResponseStatusCode int           `json:"response_status_code,omitempty"`
+	Type               string `json:"type"`
+	Username           string `json:"username"`
+	Method             string `json:"request_method,omitempty"`
+	RequestURI         string `json:"request_url,omitempty"`
+	RemoteAddr         string `json:"request_remote_ip,omitempty"`
+	ResponseStatusCode int    `json:"response_status_code,omitempty"`
+	Permission         string `json:"permission,omitempty"`
+	Denied             bool   `json:"authz_denied,omitempty"`
+}
+
+type auditAuthZKey struct{}
+
+// auditAuthZ is the authorization decision of a request, recorded by the authorization middleware
+// running after the audit log middleware
+type auditAuthZ struct {
+	permission string
+	denied     bool
+}
+
+func recordAuthZPermission(ctx context.Context, permission string) {
+	if authZ, ok := ctx.Value(auditAuthZKey{}).(*auditAuthZ); ok {
+		authZ.permission = permission
+	}
+}
+
+func recordAuthZDenial(ctx context.Context, permission string) {
+	if authZ, ok := ctx.Value(auditAuthZKey{}).(*auditAuthZ); ok {
+		authZ.permission = permission
+		authZ.denied = true
+	}
 }
 
 type auditLogMiddleware struct {
@@ -52,7 +75,6 @@ func (a *auditLogMiddleware) AuditLog(code errors.ServiceErrorCode) func(handler
 				Username:   username,
 				Method:     request.Method,
 				RequestURI: request.RequestURI,
-				Body:       request.Body,
 				RemoteAddr: request.RemoteAddr,
 			}
 			logWriter := logging.NewLoggingWriter(writer, request, logging.NewJSONLogFormatter())
@@ -61,11 +83,15 @@ func (a *auditLogMiddleware) AuditLog(code errors.ServiceErrorCode) func(handler
 				shared.HandleError(request, writer, serviceErr)
 				return
 			}
+			authZ := &auditAuthZ{}
+			request = request.WithContext(context.WithValue(ctx, auditAuthZKey{}, authZ))
 			next.ServeHTTP(logWriter, request)
 			statusCode := logWriter.GetResponseStatusCode()
 			info = auditInfo{
 				Type:               "audit",
 				ResponseStatusCode: statusCode,
+				Permission:         authZ.permission,
+				Denied:             authZ.denied,
 			}
 			// the logger will also add the operationId prefix to each of the log message, which can then be used to associated the two log messages together
 			err = logWriter.LogObject(info, nil)
@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/shared"
+)
+
+// ClusterIdentity is the identity of the fleetshard of a data plane cluster in its access tokens
+type ClusterIdentity struct {
+	Issuer  string
+	Subject string
+}
+
+// ClusterIdentitySource provides the fleetshard identities bound to data plane clusters
+//
+//go:generate moq -out cluster_identity_source_moq.go . ClusterIdentitySource
+type ClusterIdentitySource interface {
+	// GetClusterIdentity returns the identity bound to the cluster, or nil if the cluster accepts the fleetshard
+	// identities of the static configuration
+	GetClusterIdentity(clusterID string) (*ClusterIdentity, error)
+	// FindClusterByIdentity returns the ID of the cluster the identity is bound to, or an empty string
+	FindClusterByIdentity(identity ClusterIdentity) (string, error)
+	// IsClusterIssuer returns true if an identity of the issuer is bound to a cluster
+	IsClusterIssuer(issuer string) (bool, error)
+}
+
+// ClusterIDFunc returns the ID of the data plane cluster a request of the private API is for
+type ClusterIDFunc func(request *http.Request) (string, error)
+
+// ClusterIDFromPath returns the cluster ID from the path of requests to /agent-clusters/{id}
+func ClusterIDFromPath(request *http.Request) (string, error) {
+	return mux.Vars(request)["id"], nil
+}
+
+// checkClusterIdentity only lets the fleetshard bound to a cluster access the cluster. Clusters without bound identity
+// accept the static fleetshard identities checked by the fallback, but not the identities bound to other clusters.
+func checkClusterIdentity(identities ClusterIdentitySource, clusterIDOf ClusterIDFunc, fallback ...mux.MiddlewareFunc) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		staticIdentityHandler := next
+		for i := len(fallback) - 1; i >= 0; i-- {
+			staticIdentityHandler = fallback[i](staticIdentityHandler)
+		}
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			// Requests are rejected with 404 like in the other fleetshard checks, so that cluster IDs are not leaked
+			claims, err := GetClaimsFromContext(request.Context())
+			if err != nil {
+				shared.HandleError(request, writer, errors.NotFound(""))
+				return
+			}
+			issuer, _ := claims.GetIssuer()
+			subject, _ := claims.GetSubject()
+			identity := ClusterIdentity{Issuer: issuer, Subject: subject}
+
+			clusterID, err := clusterIDOf(request)
+			if err != nil {
+				glog.Infof("unable to determine the data plane cluster of request %s: %v", request.URL.Path, err)
+				shared.HandleError(request, writer, errors.NotFound(""))
+				return
+			}
+			clusterIdentity, err := identities.GetClusterIdentity(clusterID)
+			if err != nil {
+				shared.HandleError(request, writer, errors.GeneralError("unable to get the fleetshard identity of cluster %q: %v", clusterID, err))
+				return
+			}
+			if clusterIdentity != nil {
+				if *clusterIdentity == identity {
+					next.ServeHTTP(writer, request)
+					return
+				}
+				glog.Infof("identity %q of issuer %q is not bound to cluster %q", identity.Subject, identity.Issuer, clusterID)
+				shared.HandleError(request, writer, errors.NotFound(""))
+				return
+			}
+
+			boundClusterID, err := identities.FindClusterByIdentity(identity)
+			if err != nil {
+				shared.HandleError(request, writer, errors.GeneralError("unable to find the cluster of identity %q of issuer %q: %v", identity.Subject, identity.Issuer, err))
+				return
+			}
+			if boundClusterID != "" {
+				glog.Infof("identity %q of issuer %q is bound to cluster %q and cannot access cluster %q", identity.Subject, identity.Issuer, boundClusterID, clusterID)
+				shared.HandleError(request, writer, errors.NotFound(""))
+				return
+			}
+			staticIdentityHandler.ServeHTTP(writer, request)
+		})
+	}
+}
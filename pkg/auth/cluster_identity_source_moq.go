@@ -0,0 +1,162 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package auth
+
+import (
+	"sync"
+)
+
+// Ensure, that ClusterIdentitySourceMock does implement ClusterIdentitySource.
+// If this is not the case, regenerate this file with moq.
+var _ ClusterIdentitySource = &ClusterIdentitySourceMock{}
+
+// ClusterIdentitySourceMock is a mock implementation of ClusterIdentitySource.
+//
+//	func TestSomethingThatUsesClusterIdentitySource(t *testing.T) {
+//
+//		// make and configure a mocked ClusterIdentitySource
+//		mockedClusterIdentitySource := &ClusterIdentitySourceMock{
+//			FindClusterByIdentityFunc: func(identity ClusterIdentity) (string, error) {
+//				panic("mock out the FindClusterByIdentity method")
+//			},
+//			GetClusterIdentityFunc: func(clusterID string) (*ClusterIdentity, error) {
+//				panic("mock out the GetClusterIdentity method")
+//			},
+//			IsClusterIssuerFunc: func(issuer string) (bool, error) {
+//				panic("mock out the IsClusterIssuer method")
+//			},
+//		}
+//
+//		// use mockedClusterIdentitySource in code that requires ClusterIdentitySource
+//		// and then make assertions.
+//
+//	}
+type ClusterIdentitySourceMock struct {
+	// FindClusterByIdentityFunc mocks the FindClusterByIdentity method.
+	FindClusterByIdentityFunc func(identity ClusterIdentity) (string, error)
+
+	// GetClusterIdentityFunc mocks the GetClusterIdentity method.
+	GetClusterIdentityFunc func(clusterID string) (*ClusterIdentity, error)
+
+	// IsClusterIssuerFunc mocks the IsClusterIssuer method.
+	IsClusterIssuerFunc func(issuer string) (bool, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// FindClusterByIdentity holds details about calls to the FindClusterByIdentity method.
+		FindClusterByIdentity []struct {
+			// Identity is the identity argument value.
+			Identity ClusterIdentity
+		}
+		// GetClusterIdentity holds details about calls to the GetClusterIdentity method.
+		GetClusterIdentity []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
+		// IsClusterIssuer holds details about calls to the IsClusterIssuer method.
+		IsClusterIssuer []struct {
+			// Issuer is the issuer argument value.
+			Issuer string
+		}
+	}
+	lockFindClusterByIdentity sync.RWMutex
+	lockGetClusterIdentity    sync.RWMutex
+	lockIsClusterIssuer       sync.RWMutex
+}
+
+// FindClusterByIdentity calls FindClusterByIdentityFunc.
+func (mock *ClusterIdentitySourceMock) FindClusterByIdentity(identity ClusterIdentity) (string, error) {
+	if mock.FindClusterByIdentityFunc == nil {
+		panic("ClusterIdentitySourceMock.FindClusterByIdentityFunc: method is nil but ClusterIdentitySource.FindClusterByIdentity was just called")
+	}
+	callInfo := struct {
+		Identity ClusterIdentity
+	}{
+		Identity: identity,
+	}
+	mock.lockFindClusterByIdentity.Lock()
+	mock.calls.FindClusterByIdentity = append(mock.calls.FindClusterByIdentity, callInfo)
+	mock.lockFindClusterByIdentity.Unlock()
+	return mock.FindClusterByIdentityFunc(identity)
+}
+
+// FindClusterByIdentityCalls gets all the calls that were made to FindClusterByIdentity.
+// Check the length with:
+//
+//	len(mockedClusterIdentitySource.FindClusterByIdentityCalls())
+func (mock *ClusterIdentitySourceMock) FindClusterByIdentityCalls() []struct {
+	Identity ClusterIdentity
+} {
+	var calls []struct {
+		Identity ClusterIdentity
+	}
+	mock.lockFindClusterByIdentity.RLock()
+	calls = mock.calls.FindClusterByIdentity
+	mock.lockFindClusterByIdentity.RUnlock()
+	return calls
+}
+
+// GetClusterIdentity calls GetClusterIdentityFunc.
+func (mock *ClusterIdentitySourceMock) GetClusterIdentity(clusterID string) (*ClusterIdentity, error) {
+	if mock.GetClusterIdentityFunc == nil {
+		panic("ClusterIdentitySourceMock.GetClusterIdentityFunc: method is nil but ClusterIdentitySource.GetClusterIdentity was just called")
+	}
+	callInfo := struct {
+		ClusterID string
+	}{
+		ClusterID: clusterID,
+	}
+	mock.lockGetClusterIdentity.Lock()
+	mock.calls.GetClusterIdentity = append(mock.calls.GetClusterIdentity, callInfo)
+	mock.lockGetClusterIdentity.Unlock()
+	return mock.GetClusterIdentityFunc(clusterID)
+}
+
+// GetClusterIdentityCalls gets all the calls that were made to GetClusterIdentity.
+// Check the length with:
+//
+//	len(mockedClusterIdentitySource.GetClusterIdentityCalls())
+func (mock *ClusterIdentitySourceMock) GetClusterIdentityCalls() []struct {
+	ClusterID string
+} {
+	var calls []struct {
+		ClusterID string
+	}
+	mock.lockGetClusterIdentity.RLock()
+	calls = mock.calls.GetClusterIdentity
+	mock.lockGetClusterIdentity.RUnlock()
+	return calls
+}
+
+// IsClusterIssuer calls IsClusterIssuerFunc.
+func (mock *ClusterIdentitySourceMock) IsClusterIssuer(issuer string) (bool, error) {
+	if mock.IsClusterIssuerFunc == nil {
+		panic("ClusterIdentitySourceMock.IsClusterIssuerFunc: method is nil but ClusterIdentitySource.IsClusterIssuer was just called")
+	}
+	callInfo := struct {
+		Issuer string
+	}{
+		Issuer: issuer,
+	}
+	mock.lockIsClusterIssuer.Lock()
+	mock.calls.IsClusterIssuer = append(mock.calls.IsClusterIssuer, callInfo)
+	mock.lockIsClusterIssuer.Unlock()
+	return mock.IsClusterIssuerFunc(issuer)
+}
+
+// IsClusterIssuerCalls gets all the calls that were made to IsClusterIssuer.
+// Check the length with:
+//
+//	len(mockedClusterIdentitySource.IsClusterIssuerCalls())
+func (mock *ClusterIdentitySourceMock) IsClusterIssuerCalls() []struct {
+	Issuer string
+} {
+	var calls []struct {
+		Issuer string
+	}
+	mock.lockIsClusterIssuer.RLock()
+	calls = mock.calls.IsClusterIssuer
+	mock.lockIsClusterIssuer.RUnlock()
+	return calls
+}
@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/iam"
+	"github.com/stackrox/acs-fleet-manager/pkg/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUseFleetShardAuthorizationMiddleware_ClusterIdentities(t *testing.T) {
+	const staticIssuer = "https://sso.example.com"
+	const clusterIssuer = "https://oidc.example.com/cluster-a"
+	audience := []string{"acs-fleet-manager-private-api"}
+	clusterIdentities := map[string]ClusterIdentity{
+		"cluster-a": {Issuer: clusterIssuer, Subject: "system:serviceaccount:rhacs:fleetshard-sync"},
+		"cluster-c": {Issuer: clusterIssuer, Subject: "system:serviceaccount:rhacs-c:fleetshard-sync"},
+	}
+	identities := &ClusterIdentitySourceMock{
+		GetClusterIdentityFunc: func(clusterID string) (*ClusterIdentity, error) {
+			if identity, found := clusterIdentities[clusterID]; found {
+				return &identity, nil
+			}
+			return nil, nil
+		},
+		FindClusterByIdentityFunc: func(identity ClusterIdentity) (string, error) {
+			for clusterID, clusterIdentity := range clusterIdentities {
+				if clusterIdentity == identity {
+					return clusterID, nil
+				}
+			}
+			return "", nil
+		},
+	}
+
+	tests := map[string]struct {
+		issuer             string
+		subject            string
+		clusterID          string
+		expectedStatusCode int
+	}{
+		"should succeed for the identity bound to the cluster": {
+			issuer:             clusterIssuer,
+			subject:            "system:serviceaccount:rhacs:fleetshard-sync",
+			clusterID:          "cluster-a",
+			expectedStatusCode: http.StatusOK,
+		},
+		"should fail for the identity bound to another cluster": {
+			issuer:             clusterIssuer,
+			subject:            "system:serviceaccount:rhacs-c:fleetshard-sync",
+			clusterID:          "cluster-a",
+			expectedStatusCode: http.StatusNotFound,
+		},
+		"should fail for the static identity on a cluster with bound identity": {
+			issuer:             staticIssuer,
+			subject:            "fleetshard-sync",
+			clusterID:          "cluster-a",
+			expectedStatusCode: http.StatusNotFound,
+		},
+		"should succeed for the static identity on a cluster without bound identity": {
+			issuer:             staticIssuer,
+			subject:            "fleetshard-sync",
+			clusterID:          "cluster-b",
+			expectedStatusCode: http.StatusOK,
+		},
+		"should fail for a bound identity on a cluster without bound identity": {
+			issuer:             clusterIssuer,
+			subject:            "system:serviceaccount:rhacs:fleetshard-sync",
+			clusterID:          "cluster-b",
+			expectedStatusCode: http.StatusNotFound,
+		},
+		"should fail for an unknown subject of the static issuer": {
+			issuer:             staticIssuer,
+			subject:            "third-party-service",
+			clusterID:          "cluster-b",
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			route := mux.NewRouter().PathPrefix("/agent-clusters/{id}").Subrouter()
+			route.HandleFunc("", func(writer http.ResponseWriter, request *http.Request) {
+				shared.WriteJSONResponse(writer, http.StatusOK, "")
+			}).Methods(http.MethodGet)
+			route.Use(func(handler http.Handler) http.Handler {
+				return setContextToken(handler, &jwt.Token{
+					Claims: jwt.MapClaims{"iss": tt.issuer, "sub": tt.subject, "aud": audience},
+				})
+			})
+			UseFleetShardAuthorizationMiddleware(route,
+				&iam.IAMConfig{
+					DataPlaneOIDCIssuers: &iam.OIDCIssuers{URIs: []string{staticIssuer}},
+					KubernetesIssuer:     &iam.KubernetesIssuer{},
+				},
+				&FleetShardAuthZConfig{
+					AllowedSubjects:  []string{"fleetshard-sync"},
+					AllowedAudiences: audience,
+				},
+				identities,
+				ClusterIDFromPath)
+
+			req := httptest.NewRequest("GET", "http://example.com/agent-clusters/"+tt.clusterID, nil)
+			recorder := httptest.NewRecorder()
+			route.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tt.expectedStatusCode, recorder.Result().StatusCode)
+		})
+	}
+}
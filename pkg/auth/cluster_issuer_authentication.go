@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/golang/glog"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/shared"
+)
+
+// clusterIssuerSigningMethods are the signing methods accepted for tokens of the issuers bound to data plane clusters
+var clusterIssuerSigningMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// NewClusterIssuerAuthenticationHandler authenticates the tokens of the issuers bound to data plane clusters with the
+// keys of the JWKS cache. Requests with tokens of other issuers are passed to the fallback handler, which
+// authenticates the statically configured issuers.
+func NewClusterIssuerAuthenticationHandler(identities ClusterIdentitySource, keys *JWKSCache, fallback http.Handler, next http.Handler) http.Handler {
+	parser := jwt.NewParser(jwt.WithValidMethods(clusterIssuerSigningMethods))
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		tokenString, found := bearerToken(request)
+		if !found {
+			fallback.ServeHTTP(writer, request)
+			return
+		}
+		unverified, _, err := parser.ParseUnverified(tokenString, jwt.MapClaims{})
+		if err != nil {
+			fallback.ServeHTTP(writer, request)
+			return
+		}
+		issuer, _ := unverified.Claims.(jwt.MapClaims)[issuerClaim].(string)
+		isClusterIssuer, err := identities.IsClusterIssuer(issuer)
+		if err != nil {
+			shared.HandleError(request, writer, errors.GeneralError("unable to look up issuer %q: %v", issuer, err))
+			return
+		}
+		if !isClusterIssuer {
+			fallback.ServeHTTP(writer, request)
+			return
+		}
+
+		// Like the authentication of the other data plane issuers, failures are reported as 404
+		token, err := parser.Parse(tokenString, keys.Keyfunc)
+		if err != nil {
+			glog.V(1).Infof("token of data plane cluster issuer %q is invalid: %v", issuer, err)
+			shared.HandleError(request, writer, errors.NotFound(""))
+			return
+		}
+		// golang-jwt only verifies the expiry of tokens which have one
+		if !token.Claims.(jwt.MapClaims).VerifyExpiresAt(jwt.TimeFunc().Unix(), true) {
+			glog.V(1).Infof("token of data plane cluster issuer %q has no expiry", issuer)
+			shared.HandleError(request, writer, errors.NotFound(""))
+			return
+		}
+		next.ServeHTTP(writer, request.WithContext(SetTokenInContext(request.Context(), token)))
+	})
+}
+
+func bearerToken(request *http.Request) (string, bool) {
+	header := request.Header.Get("Authorization")
+	prefix := "Bearer "
+	if len(header) <= len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return "", false
+	}
+	return strings.TrimSpace(header[len(prefix):]), true
+}
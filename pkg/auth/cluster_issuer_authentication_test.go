@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterIssuerAuthenticationHandler(t *testing.T) {
+	issuer := newTestIssuer(t, "key-1")
+	identities := &ClusterIdentitySourceMock{
+		IsClusterIssuerFunc: func(iss string) (bool, error) {
+			return iss == issuer.server.URL, nil
+		},
+	}
+	fallback := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, err := GetClaimsFromContext(r.Context())
+		require.NoError(t, err)
+		subject, _ := claims.GetSubject()
+		assert.Equal(t, "fleetshard-sync", subject)
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := NewClusterIssuerAuthenticationHandler(identities, NewJWKSCache(), fallback, next)
+	expiry := time.Now().Add(time.Minute).Unix()
+
+	tests := map[string]struct {
+		token              string
+		expectedStatusCode int
+	}{
+		"should authenticate tokens of cluster issuers": {
+			token:              issuer.sign(t, "key-1", jwt.MapClaims{"sub": "fleetshard-sync", "exp": expiry}),
+			expectedStatusCode: http.StatusOK,
+		},
+		"should reject tokens without expiry": {
+			token:              issuer.sign(t, "key-1", jwt.MapClaims{"sub": "fleetshard-sync"}),
+			expectedStatusCode: http.StatusNotFound,
+		},
+		"should reject expired tokens": {
+			token:              issuer.sign(t, "key-1", jwt.MapClaims{"sub": "fleetshard-sync", "exp": time.Now().Add(-time.Minute).Unix()}),
+			expectedStatusCode: http.StatusNotFound,
+		},
+		"should reject tokens signed with unknown keys": {
+			token:              signWithUnknownKey(t, issuer.server.URL),
+			expectedStatusCode: http.StatusNotFound,
+		},
+		"should pass tokens of other issuers to the fallback": {
+			token:              issuer.sign(t, "key-1", jwt.MapClaims{"iss": "https://sso.example.com", "exp": expiry}),
+			expectedStatusCode: http.StatusTeapot,
+		},
+		"should pass requests without token to the fallback": {
+			expectedStatusCode: http.StatusTeapot,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "http://example.com/agent-clusters/1234", nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, tt.expectedStatusCode, recorder.Result().StatusCode)
+		})
+	}
+}
@@ -9,6 +9,7 @@ var (
 	tenantUsernameClaim = "username"
 	tenantIDClaim       = "org_id"
 	tenantOrgAdminClaim = "is_org_admin"
+	tenantEmailClaim    = "email"
 
 	// sso.redhat.com token claim keys.
 	alternateTenantUsernameClaim = "preferred_username"
@@ -17,6 +18,7 @@ var (
 	// This is the Red Hat user id.
 	tenantUserIDClaim = "user_id"
 	tenantSubClaim    = "sub"
+	issuerClaim       = "iss"
 	// Only service accounts that have been created via the service_accounts API have these claims set.
 	// The claims relate to the Red Hat organisation and user that created the service account.
 	alternateTenantIDClaim     = "rh-org-id"
@@ -11,13 +11,16 @@ import (
 	"github.com/stackrox/acs-fleet-manager/pkg/shared"
 )
 
-// UseFleetShardAuthorizationMiddleware ...
+// UseFleetShardAuthorizationMiddleware only lets the fleetshard bound to a data plane cluster access the cluster. The
+// static data plane issuers and allowed subjects are accepted for clusters without bound fleetshard identity.
 func UseFleetShardAuthorizationMiddleware(router *mux.Router, iamConfig *iam.IAMConfig,
-	fleetShardAuthZConfig *FleetShardAuthZConfig) {
+	fleetShardAuthZConfig *FleetShardAuthZConfig, identities ClusterIdentitySource, clusterIDOf ClusterIDFunc) {
 	router.Use(
-		NewRequireIssuerMiddleware().RequireIssuer(iamConfig.GetDataPlaneIssuerURIs(), errors.ErrorNotFound),
 		CheckAudience(fleetShardAuthZConfig.AllowedAudiences),
-		checkSubject(fleetShardAuthZConfig.AllowedSubjects),
+		checkClusterIdentity(identities, clusterIDOf,
+			NewRequireIssuerMiddleware().RequireIssuer(iamConfig.GetDataPlaneIssuerURIs(), errors.ErrorNotFound),
+			checkSubject(fleetShardAuthZConfig.AllowedSubjects),
+		),
 	)
 }
 
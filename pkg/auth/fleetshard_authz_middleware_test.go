@@ -187,7 +187,9 @@ func TestUseFleetShardAuthorizationMiddleware_DataPlaneOIDCIssuers(t *testing.T)
 				&FleetShardAuthZConfig{
 					AllowedSubjects:  []string{"fleetshard-sync"},
 					AllowedAudiences: validAudience,
-				})
+				},
+				noClusterIdentities(),
+				ClusterIDFromPath)
 
 			req := httptest.NewRequest("GET", "http://example.com/agent-clusters/1234", nil)
 			recorder := httptest.NewRecorder()
@@ -198,3 +200,14 @@ func TestUseFleetShardAuthorizationMiddleware_DataPlaneOIDCIssuers(t *testing.T)
 		})
 	}
 }
+
+func noClusterIdentities() *ClusterIdentitySourceMock {
+	return &ClusterIdentitySourceMock{
+		GetClusterIdentityFunc: func(clusterID string) (*ClusterIdentity, error) {
+			return nil, nil
+		},
+		FindClusterByIdentityFunc: func(identity ClusterIdentity) (string, error) {
+			return "", nil
+		},
+	}
+}
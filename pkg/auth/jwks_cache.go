@@ -0,0 +1,170 @@
+package auth
+
+import (
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/golang/glog"
+	"github.com/mendsley/gojwk"
+	"github.com/pkg/errors"
+)
+
+const (
+	jwksMaxAge             = time.Hour
+	jwksMinRefreshInterval = time.Minute
+	jwksFetchTimeout       = 10 * time.Second
+	// jwksMaxSize limits the size of the discovery documents and key sets read from issuers
+	jwksMaxSize = 1 << 20
+)
+
+// JWKSCache caches the signing keys of OIDC issuers. The keys are fetched through the discovery document of the
+// issuer and refreshed once they are older than the maximum age. Tokens signed with an unknown key trigger a refresh,
+// so that rotated keys are picked up right away. Refreshes are limited to one per minimum refresh interval and issuer,
+// so that tokens with made-up key IDs cannot flood the issuers with requests.
+type JWKSCache struct {
+	httpClient         *http.Client
+	maxAge             time.Duration
+	minRefreshInterval time.Duration
+	now                func() time.Time
+
+	mutex   sync.Mutex
+	issuers map[string]*issuerKeys
+}
+
+type issuerKeys struct {
+	mutex       sync.Mutex
+	keys        map[string]crypto.PublicKey
+	fetchedAt   time.Time
+	attemptedAt time.Time
+}
+
+// NewJWKSCache ...
+func NewJWKSCache() *JWKSCache {
+	return &JWKSCache{
+		httpClient:         &http.Client{Timeout: jwksFetchTimeout},
+		maxAge:             jwksMaxAge,
+		minRefreshInterval: jwksMinRefreshInterval,
+		now:                time.Now,
+		issuers:            map[string]*issuerKeys{},
+	}
+}
+
+// Keyfunc returns the public key of the issuer of the token which the token is signed with
+func (c *JWKSCache) Keyfunc(token *jwt.Token) (interface{}, error) {
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, errors.New("unexpected claims type")
+	}
+	issuer, _ := claims[issuerClaim].(string)
+	if issuer == "" {
+		return nil, errors.New("token has no issuer")
+	}
+	keyID, _ := token.Header["kid"].(string)
+	return c.key(issuer, keyID)
+}
+
+func (c *JWKSCache) key(issuer, keyID string) (crypto.PublicKey, error) {
+	c.mutex.Lock()
+	entry, exists := c.issuers[issuer]
+	if !exists {
+		entry = &issuerKeys{}
+		c.issuers[issuer] = entry
+	}
+	c.mutex.Unlock()
+
+	entry.mutex.Lock()
+	defer entry.mutex.Unlock()
+
+	now := c.now()
+	key, found := entry.lookup(keyID)
+	if found && now.Sub(entry.fetchedAt) < c.maxAge {
+		return key, nil
+	}
+	if now.Sub(entry.attemptedAt) >= c.minRefreshInterval {
+		entry.attemptedAt = now
+		keys, err := c.fetchKeys(issuer)
+		if err != nil {
+			// the previous keys stay in use, so that tokens can be verified while the issuer is unavailable
+			glog.Warningf("Failed to refresh the signing keys of issuer %q: %v", issuer, err)
+		} else {
+			entry.keys = keys
+			entry.fetchedAt = now
+		}
+	}
+	key, found = entry.lookup(keyID)
+	if !found {
+		return nil, errors.Errorf("issuer %q has no signing key %q", issuer, keyID)
+	}
+	return key, nil
+}
+
+// lookup returns the key with the ID. Tokens without key ID are accepted if the issuer has a single key.
+func (k *issuerKeys) lookup(keyID string) (crypto.PublicKey, bool) {
+	if keyID == "" && len(k.keys) == 1 {
+		for _, key := range k.keys {
+			return key, true
+		}
+	}
+	key, found := k.keys[keyID]
+	return key, found
+}
+
+func (c *JWKSCache) fetchKeys(issuer string) (map[string]crypto.PublicKey, error) {
+	var discovery struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	discoveryURL := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	if err := c.getJSON(discoveryURL, &discovery); err != nil {
+		return nil, err
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != strings.TrimSuffix(issuer, "/") {
+		return nil, errors.Errorf("discovery document %s belongs to issuer %q", discoveryURL, discovery.Issuer)
+	}
+	if discovery.JWKSURI == "" {
+		return nil, errors.Errorf("discovery document %s has no JWKS URI", discoveryURL)
+	}
+
+	var keySet gojwk.Key
+	if err := c.getJSON(discovery.JWKSURI, &keySet); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]crypto.PublicKey, len(keySet.Keys))
+	for _, jwk := range keySet.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.DecodePublicKey()
+		if err != nil {
+			glog.Warningf("Skipping signing key %q of issuer %q: %v", jwk.Kid, issuer, err)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, errors.Errorf("key set %s has no signing keys", discovery.JWKSURI)
+	}
+	return keys, nil
+}
+
+func (c *JWKSCache) getJSON(url string, into interface{}) error {
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return errors.Wrapf(err, "fetching %s", url)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetching %s: unexpected status %d", url, resp.StatusCode)
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, jwksMaxSize)).Decode(into); err != nil {
+		return errors.Wrapf(err, "decoding %s", url)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/mendsley/gojwk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testIssuer serves the discovery document and the signing keys of an OIDC issuer
+type testIssuer struct {
+	server     *httptest.Server
+	keys       atomic.Value // map[string]*rsa.PrivateKey
+	keyFetches atomic.Int32
+}
+
+func newTestIssuer(t *testing.T, keyIDs ...string) *testIssuer {
+	issuer := &testIssuer{}
+	issuer.rotate(t, keyIDs...)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   issuer.server.URL,
+			"jwks_uri": issuer.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		issuer.keyFetches.Add(1)
+		keySet := &gojwk.Key{}
+		for keyID, key := range issuer.keys.Load().(map[string]*rsa.PrivateKey) {
+			jwk, err := gojwk.PublicKey(&key.PublicKey)
+			require.NoError(t, err)
+			jwk.Kid = keyID
+			jwk.Use = "sig"
+			keySet.Keys = append(keySet.Keys, jwk)
+		}
+		_ = json.NewEncoder(w).Encode(keySet)
+	})
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+	return issuer
+}
+
+// rotate replaces the signing keys of the issuer
+func (i *testIssuer) rotate(t *testing.T, keyIDs ...string) {
+	keys := make(map[string]*rsa.PrivateKey, len(keyIDs))
+	for _, keyID := range keyIDs {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		keys[keyID] = key
+	}
+	i.keys.Store(keys)
+}
+
+func (i *testIssuer) sign(t *testing.T, keyID string, claims jwt.MapClaims) string {
+	if _, found := claims["iss"]; !found {
+		claims["iss"] = i.server.URL
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = keyID
+	signed, err := token.SignedString(i.keys.Load().(map[string]*rsa.PrivateKey)[keyID])
+	require.NoError(t, err)
+	return signed
+}
+
+func (i *testIssuer) parse(cache *JWKSCache, token string) error {
+	_, err := jwt.Parse(token, cache.Keyfunc)
+	return err
+}
+
+func TestJWKSCache_CachesKeys(t *testing.T) {
+	issuer := newTestIssuer(t, "key-1")
+	cache := NewJWKSCache()
+	token := issuer.sign(t, "key-1", jwt.MapClaims{})
+
+	require.NoError(t, issuer.parse(cache, token))
+	require.NoError(t, issuer.parse(cache, token))
+	assert.Equal(t, int32(1), issuer.keyFetches.Load())
+}
+
+func TestJWKSCache_RefreshesOnUnknownKey(t *testing.T) {
+	issuer := newTestIssuer(t, "key-1")
+	cache := NewJWKSCache()
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+	require.NoError(t, issuer.parse(cache, issuer.sign(t, "key-1", jwt.MapClaims{})))
+
+	issuer.rotate(t, "key-2")
+	rotated := issuer.sign(t, "key-2", jwt.MapClaims{})
+	// refreshes are limited to one per minimum refresh interval
+	assert.Error(t, issuer.parse(cache, rotated))
+	assert.Equal(t, int32(1), issuer.keyFetches.Load())
+
+	now = now.Add(jwksMinRefreshInterval)
+	require.NoError(t, issuer.parse(cache, rotated))
+	assert.Equal(t, int32(2), issuer.keyFetches.Load())
+
+	// tokens with unknown keys do not trigger further refreshes within the interval
+	assert.Error(t, issuer.parse(cache, signWithUnknownKey(t, issuer.server.URL)))
+	assert.Equal(t, int32(2), issuer.keyFetches.Load())
+}
+
+func TestJWKSCache_RefreshesAfterMaxAge(t *testing.T) {
+	issuer := newTestIssuer(t, "key-1")
+	cache := NewJWKSCache()
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+	token := issuer.sign(t, "key-1", jwt.MapClaims{})
+	require.NoError(t, issuer.parse(cache, token))
+
+	now = now.Add(jwksMaxAge)
+	require.NoError(t, issuer.parse(cache, token))
+	assert.Equal(t, int32(2), issuer.keyFetches.Load())
+}
+
+func TestJWKSCache_KeepsKeysWhenIssuerIsUnavailable(t *testing.T) {
+	issuer := newTestIssuer(t, "key-1")
+	cache := NewJWKSCache()
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+	token := issuer.sign(t, "key-1", jwt.MapClaims{})
+	require.NoError(t, issuer.parse(cache, token))
+
+	issuer.server.Close()
+	now = now.Add(jwksMaxAge)
+	assert.NoError(t, issuer.parse(cache, token))
+}
+
+func signWithUnknownKey(t *testing.T, issuer string) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{"iss": issuer})
+	token.Header["kid"] = "unknown"
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
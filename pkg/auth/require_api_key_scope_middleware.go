@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/shared"
+)
+
+// RequireAPIKeyScope restricts requests authenticated with API keys to keys with the read scope for GET requests and
+// the write scope for all other requests, which are denied if the write scope is empty. Requests authenticated with
+// JWTs are not restricted.
+func RequireAPIKeyScope(readScope, writeScope string) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			claims, err := GetClaimsFromContext(request.Context())
+			if err != nil {
+				shared.HandleError(request, writer, errors.NewWithCause(errors.ErrorUnauthenticated, err, ""))
+				return
+			}
+			apiKeyID, isAPIKey := claims.GetAPIKeyID()
+			if !isAPIKey {
+				next.ServeHTTP(writer, request)
+				return
+			}
+
+			scope := writeScope
+			if request.Method == http.MethodGet {
+				scope = readScope
+			}
+			if scope == "" {
+				shared.HandleError(request, writer, errors.Unauthorized("API key %s cannot be used to modify this resource", apiKeyID))
+				return
+			}
+			if !claims.HasAPIKeyScope(scope) {
+				shared.HandleError(request, writer, errors.Unauthorized("API key %s does not have the scope %q", apiKeyID, scope))
+				return
+			}
+			next.ServeHTTP(writer, request)
+		})
+	}
+}
+
+// RequireAPIKeyReadScope restricts requests authenticated with API keys to GET requests of keys with the read scope,
+// for resources which have no write scope. Requests authenticated with JWTs are not restricted.
+func RequireAPIKeyReadScope(readScope string) mux.MiddlewareFunc {
+	return RequireAPIKeyScope(readScope, "")
+}
+
+// DenyAPIKeys rejects requests authenticated with API keys, e.g. for the management of the keys themselves
+func DenyAPIKeys(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		claims, err := GetClaimsFromContext(request.Context())
+		if err != nil {
+			shared.HandleError(request, writer, errors.NewWithCause(errors.ErrorUnauthenticated, err, ""))
+			return
+		}
+		if apiKeyID, isAPIKey := claims.GetAPIKeyID(); isAPIKey {
+			shared.HandleError(request, writer, errors.Unauthorized("API key %s cannot be used for this operation", apiKeyID))
+			return
+		}
+		next.ServeHTTP(writer, request)
+	})
+}
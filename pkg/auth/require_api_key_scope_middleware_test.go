@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireAPIKeyScope(t *testing.T) {
+	apiKeyClaims := jwt.MapClaims{
+		issuerClaim:   APIKeyIssuer,
+		apiKeyIDClaim: "key-1",
+		scopeClaim:    APIKeyScopeCentralsRead,
+	}
+	// a JWT with a scope claim is not mistaken for an API key
+	jwtClaims := jwt.MapClaims{
+		issuerClaim: "https://sso.redhat.com/auth/realms/redhat-external",
+		scopeClaim:  "openid",
+	}
+
+	tests := map[string]struct {
+		claims             jwt.MapClaims
+		method             string
+		expectedStatusCode int
+	}{
+		"should allow reads with the read scope": {
+			claims:             apiKeyClaims,
+			method:             http.MethodGet,
+			expectedStatusCode: http.StatusOK,
+		},
+		"should deny writes without the write scope": {
+			claims:             apiKeyClaims,
+			method:             http.MethodPost,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		"should not restrict JWTs": {
+			claims:             jwtClaims,
+			method:             http.MethodPost,
+			expectedStatusCode: http.StatusOK,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			handler := setContextToken(
+				RequireAPIKeyScope(APIKeyScopeCentralsRead, APIKeyScopeCentralsWrite)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})),
+				&jwt.Token{Claims: tt.claims})
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(tt.method, "/api/rhacs/v1/centrals", nil))
+
+			assert.Equal(t, tt.expectedStatusCode, recorder.Code)
+		})
+	}
+}
+
+func TestRequireAPIKeyReadScope(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	apiKeyToken := &jwt.Token{Claims: jwt.MapClaims{
+		issuerClaim:   APIKeyIssuer,
+		apiKeyIDClaim: "key-1",
+		scopeClaim:    APIKeyScopeCloudProvidersRead,
+	}}
+
+	recorder := httptest.NewRecorder()
+	setContextToken(RequireAPIKeyReadScope(APIKeyScopeCloudProvidersRead)(next), apiKeyToken).
+		ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/rhacs/v1/cloud_providers", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	setContextToken(RequireAPIKeyReadScope(APIKeyScopeCloudProvidersRead)(next), apiKeyToken).
+		ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/rhacs/v1/cloud_providers", nil))
+	assert.Equal(t, http.StatusForbidden, recorder.Code, "API keys cannot modify read-only resources")
+
+	recorder = httptest.NewRecorder()
+	setContextToken(RequireAPIKeyReadScope(APIKeyScopeCloudAccountsRead)(next), apiKeyToken).
+		ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/rhacs/v1/cloud_accounts", nil))
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+}
+
+func TestDenyAPIKeys(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	recorder := httptest.NewRecorder()
+	setContextToken(DenyAPIKeys(next), &jwt.Token{Claims: jwt.MapClaims{issuerClaim: APIKeyIssuer, apiKeyIDClaim: "key-1"}}).
+		ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/rhacs/v1/api_keys", nil))
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	setContextToken(DenyAPIKeys(next), &jwt.Token{Claims: jwt.MapClaims{issuerClaim: "https://sso.redhat.com/auth/realms/redhat-external"}}).
+		ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/rhacs/v1/api_keys", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
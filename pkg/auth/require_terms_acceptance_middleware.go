@@ -2,12 +2,9 @@ package auth
 
 import (
 	"net/http"
-	"time"
 
 	ocm "github.com/stackrox/acs-fleet-manager/pkg/client/ocm/impl"
 
-	"github.com/patrickmn/go-cache"
-
 	"github.com/stackrox/acs-fleet-manager/pkg/errors"
 	"github.com/stackrox/acs-fleet-manager/pkg/shared"
 )
@@ -19,18 +16,14 @@ type RequireTermsAcceptanceMiddleware interface {
 	RequireTermsAcceptance(enabled bool, amsClient ocm.AMSClient, code errors.ServiceErrorCode) func(handler http.Handler) http.Handler
 }
 
-type requireTermsAcceptanceMiddleware struct {
-	cache *cache.Cache
-}
+type requireTermsAcceptanceMiddleware struct{}
 
 var _ RequireTermsAcceptanceMiddleware = &requireTermsAcceptanceMiddleware{}
 
-// NewRequireTermsAcceptanceMiddleware ...
+// NewRequireTermsAcceptanceMiddleware creates the middleware. The terms requirements of users are cached by the AMS
+// client, see ocm.NewCachedAMSClient.
 func NewRequireTermsAcceptanceMiddleware() RequireTermsAcceptanceMiddleware {
-	return &requireTermsAcceptanceMiddleware{
-		// entries will expire in 5 minutes and will be evicted every 10 minutes
-		cache: cache.New(5*time.Minute, 10*time.Minute),
-	}
+	return &requireTermsAcceptanceMiddleware{}
 }
 
 // RequireTermsAcceptance ...
@@ -45,18 +38,13 @@ func (m *requireTermsAcceptanceMiddleware) RequireTermsAcceptance(enabled bool,
 					return
 				}
 				username, _ := claims.GetUsername()
-				termsRequired, cached := m.cache.Get(username)
-				if !cached {
-					termsRequired, _, err = amsClient.GetRequiresTermsAcceptance(username)
-					if err != nil {
-						shared.HandleError(request, writer, errors.NewWithCause(code, err, ""))
-						return
-					}
-
-					m.cache.Set(username, termsRequired, cache.DefaultExpiration)
+				termsRequired, _, err := amsClient.GetRequiresTermsAcceptance(username)
+				if err != nil {
+					shared.HandleError(request, writer, errors.NewWithCause(code, err, ""))
+					return
 				}
 
-				if termsRequired.(bool) {
+				if termsRequired {
 					shared.HandleError(request, writer, errors.New(code, "required terms have not been accepted"))
 					return
 				}
@@ -3,6 +3,7 @@ package auth
 import (
 	"fmt"
 	"net/http"
+	"path"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -23,11 +24,27 @@ type RolesConfiguration struct {
 // RoleConfig represents the role configuration.
 type RoleConfig []RolesConfiguration
 
+// RolePermissionsConfiguration is the configuration of the permissions granted to a role for the admin API.
+// Permissions are patterns matched against the permission of an endpoint, where `*` matches any sequence of
+// characters, e.g. `centrals.*` or `*.read`.
+type RolePermissionsConfiguration struct {
+	RoleName    string   `yaml:"name"`
+	Permissions []string `yaml:"permissions"`
+}
+
+// adminAuthZFile is the format of the configuration file granting permissions to roles.
+// Method based role mappings can be configured alongside within `methods`.
+type adminAuthZFile struct {
+	Methods RoleConfig                     `yaml:"methods"`
+	Roles   []RolePermissionsConfiguration `yaml:"roles"`
+}
+
 // AdminRoleAuthZConfig is the configuration of the role authZ middleware.
 type AdminRoleAuthZConfig struct {
 	Enabled         bool
 	RolesConfigFile string
 	RolesConfig     RoleConfig
+	RolePermissions []RolePermissionsConfiguration
 }
 
 // NewAdminAuthZConfig creates a default AdminRoleAuthZConfig which is enabled and uses the production configuration.
@@ -41,17 +58,20 @@ func NewAdminAuthZConfig() *AdminRoleAuthZConfig {
 // AddFlags adds required flags for the role authZ configuration.
 func (c *AdminRoleAuthZConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.RolesConfigFile, "admin-authz-config-file", c.RolesConfigFile,
-		"Admin API authZ configuration file containing list of required role per API method")
+		"Admin API authZ configuration file containing the permissions granted to roles, or the list of required roles per API method")
 	fs.BoolVar(&c.Enabled, "enable-admin-authz", c.Enabled, "Enable admin API authZ via roles")
 }
 
 // ReadFiles will read and validate the contents of the configuration file.
 func (c *AdminRoleAuthZConfig) ReadFiles() error {
 	if c.Enabled {
-		if err := readRoleAuthZConfigFile(c.RolesConfigFile, &c.RolesConfig); err != nil {
+		if err := readRoleAuthZConfigFile(c.RolesConfigFile, c); err != nil {
+			return err
+		}
+		if err := validateRolesConfiguration(c.RolesConfig); err != nil {
 			return err
 		}
-		return validateRolesConfiguration(c.RolesConfig)
+		return validateRolePermissionsConfiguration(c.RolePermissions)
 	}
 	return nil
 }
@@ -68,16 +88,43 @@ func (c *AdminRoleAuthZConfig) GetRoleMapping() map[string][]string {
 	return roleMapping
 }
 
-func readRoleAuthZConfigFile(file string, val *RoleConfig) error {
+// GetPermissionMapping will create a map of the granted permissions. The key will be the role name in lower case and
+// value will be a list of permission patterns granted to that role.
+func (c *AdminRoleAuthZConfig) GetPermissionMapping() map[string][]string {
+	permissionMapping := make(map[string][]string, len(c.RolePermissions))
+
+	for _, config := range c.RolePermissions {
+		role := strings.ToLower(config.RoleName)
+		permissionMapping[role] = append(permissionMapping[role], config.Permissions...)
+	}
+
+	return permissionMapping
+}
+
+func readRoleAuthZConfigFile(file string, config *AdminRoleAuthZConfig) error {
 	fileContents, err := shared.ReadFile(file)
 	if err != nil {
 		return errors.Wrap(err, "reading role authz config")
 	}
 
-	if err := yaml.UnmarshalStrict([]byte(fileContents), val); err != nil {
+	// A list at the top level is the method based role mapping, which was the only format before role permissions
+	var contents interface{}
+	if err := yaml.Unmarshal([]byte(fileContents), &contents); err != nil {
 		return errors.Wrap(err, "unmarshalling role authz config")
 	}
+	if _, ok := contents.([]interface{}); ok {
+		if err := yaml.UnmarshalStrict([]byte(fileContents), &config.RolesConfig); err != nil {
+			return errors.Wrap(err, "unmarshalling role authz config")
+		}
+		return nil
+	}
 
+	var authZFile adminAuthZFile
+	if err := yaml.UnmarshalStrict([]byte(fileContents), &authZFile); err != nil {
+		return errors.Wrap(err, "unmarshalling role authz config")
+	}
+	config.RolesConfig = authZFile.Methods
+	config.RolePermissions = authZFile.Roles
 	return nil
 }
 
@@ -92,3 +139,17 @@ func validateRolesConfiguration(configs []RolesConfiguration) error {
 	}
 	return nil
 }
+
+func validateRolePermissionsConfiguration(configs []RolePermissionsConfiguration) error {
+	for _, config := range configs {
+		if config.RoleName == "" {
+			return errors.New("role name must not be empty")
+		}
+		for _, permission := range config.Permissions {
+			if _, err := path.Match(permission, ""); err != nil || permission == "" {
+				return fmt.Errorf("invalid permission %q for role %q", permission, config.RoleName)
+			}
+		}
+	}
+	return nil
+}
@@ -2,8 +2,10 @@ package auth
 
 import (
 	"net/http"
+	"path"
 	"strings"
 
+	"github.com/gorilla/mux"
 	"github.com/stackrox/acs-fleet-manager/pkg/shared/utils/arrays"
 
 	"github.com/golang/glog"
@@ -18,10 +20,24 @@ type RolesAuthorizationMiddleware interface {
 	RequireRealmRole(roleName string, code errors.ServiceErrorCode) func(handler http.Handler) http.Handler
 	// RequireRolesForMethods will check that at least one of the realm roles exists in the request token based on the http method in the request
 	RequireRolesForMethods(code errors.ServiceErrorCode) func(handler http.Handler) http.Handler
+	// RequirePermissions will check that at least one of the realm roles in the request token is granted the permission
+	// declared for the matched route, or is allowed by the roles configured for the http method in the request
+	RequirePermissions(permissions RoutePermissions, code errors.ServiceErrorCode) func(handler http.Handler) http.Handler
+}
+
+// RoutePermissions maps mux route names to the permission required to call the route
+type RoutePermissions map[string]string
+
+// Declare records the permission required for the route name and returns the route name,
+// so that it can be used when naming the route, e.g. `route.Name(permissions.Declare(name, "centrals.read"))`
+func (p RoutePermissions) Declare(routeName string, permission string) string {
+	p[routeName] = permission
+	return routeName
 }
 
 type rolesAuthMiddleware struct {
-	roleMapping map[string][]string
+	roleMapping       map[string][]string
+	permissionMapping map[string][]string
 }
 
 var _ RolesAuthorizationMiddleware = &rolesAuthMiddleware{}
@@ -29,7 +45,8 @@ var _ RolesAuthorizationMiddleware = &rolesAuthMiddleware{}
 // NewRolesAuhzMiddleware ...
 func NewRolesAuhzMiddleware(config *AdminRoleAuthZConfig) RolesAuthorizationMiddleware {
 	return &rolesAuthMiddleware{
-		roleMapping: config.GetRoleMapping(),
+		roleMapping:       config.GetRoleMapping(),
+		permissionMapping: config.GetPermissionMapping(),
 	}
 }
 
@@ -91,6 +108,60 @@ func (m *rolesAuthMiddleware) RequireRolesForMethods(code errors.ServiceErrorCod
 	}
 }
 
+// RequirePermissions ...
+func (m *rolesAuthMiddleware) RequirePermissions(permissions RoutePermissions, code errors.ServiceErrorCode) func(handler http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			serviceErr := errors.New(code, "")
+			var permission string
+			if route := mux.CurrentRoute(request); route != nil {
+				permission = permissions[route.GetName()]
+			}
+
+			ctx := request.Context()
+			claims, err := GetClaimsFromContext(ctx)
+			if err != nil {
+				recordAuthZDenial(ctx, permission)
+				shared.HandleError(request, writer, serviceErr)
+				return
+			}
+			realmRoles := getRealmRolesClaim(claims)
+			if (permission != "" && m.isPermissionGranted(realmRoles, permission)) || m.isMethodAllowed(realmRoles, request.Method) {
+				recordAuthZPermission(ctx, permission)
+				ctx = SetIsAdminContext(ctx, true)
+				request = request.WithContext(ctx)
+				next.ServeHTTP(writer, request)
+				return
+			}
+			// no role is granted the permission nor allowed for the method, deny the request
+			glog.Infof("no role granting permission %q or allowed for method %s, deny the request for url %s",
+				permission, request.Method, request.URL)
+			recordAuthZDenial(ctx, permission)
+			shared.HandleError(request, writer, serviceErr)
+		})
+	}
+}
+
+func (m *rolesAuthMiddleware) isPermissionGranted(roles []string, permission string) bool {
+	for _, role := range roles {
+		for _, pattern := range m.permissionMapping[strings.ToLower(role)] {
+			if matched, _ := path.Match(pattern, permission); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (m *rolesAuthMiddleware) isMethodAllowed(roles []string, method string) bool {
+	for _, r := range m.roleMapping[method] {
+		if hasRole(roles, r) {
+			return true
+		}
+	}
+	return false
+}
+
 func getRealmRolesClaim(claims ACSClaims) []string {
 	if realmRoles, ok := claims["realm_access"]; ok {
 		if roles, ok := realmRoles.(map[string]interface{}); ok {
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/mux"
 	"github.com/openshift-online/ocm-sdk-go/authentication"
 	"github.com/stackrox/acs-fleet-manager/pkg/errors"
 	"github.com/stackrox/acs-fleet-manager/pkg/shared"
@@ -173,3 +174,86 @@ func TestRolesAuthMiddleware_RequireRolesForMethods(t *testing.T) {
 		})
 	}
 }
+
+func TestRolesAuthMiddleware_RequirePermissions(t *testing.T) {
+	const (
+		billingRoute = "admin-billing"
+		dbRoute      = "admin-db-delete-central"
+	)
+	rolePermissions := []RolePermissionsConfiguration{
+		{RoleName: "read", Permissions: []string{"*.read"}},
+		{RoleName: "billing", Permissions: []string{"centrals.billing.write"}},
+		{RoleName: "full", Permissions: []string{"centrals.*"}},
+	}
+	tests := []struct {
+		name        string
+		roles       []interface{}
+		rolesConfig []RolesConfiguration
+		route       string
+		method      string
+		want        int
+	}{
+		{
+			name:   "should allow access when the permission is granted to the role",
+			roles:  []interface{}{"billing"},
+			route:  billingRoute,
+			method: http.MethodPatch,
+			want:   http.StatusOK,
+		},
+		{
+			name:   "should allow access when the permission matches a granted pattern",
+			roles:  []interface{}{"full"},
+			route:  dbRoute,
+			method: http.MethodDelete,
+			want:   http.StatusOK,
+		},
+		{
+			name:   "should not allow access when the permission is not granted to the role",
+			roles:  []interface{}{"billing", "read"},
+			route:  dbRoute,
+			method: http.MethodDelete,
+			want:   http.StatusNotFound,
+		},
+		{
+			name:   "should not allow access when the route declares no permission",
+			roles:  []interface{}{"full"},
+			route:  "undeclared",
+			method: http.MethodGet,
+			want:   http.StatusNotFound,
+		},
+		{
+			name:        "should allow access when the role is allowed for the method",
+			roles:       []interface{}{"legacy"},
+			rolesConfig: []RolesConfiguration{{HTTPMethod: http.MethodDelete, RoleNames: []string{"legacy"}}},
+			route:       dbRoute,
+			method:      http.MethodDelete,
+			want:        http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			permissions := RoutePermissions{}
+			rolesHandler := NewRolesAuhzMiddleware(&AdminRoleAuthZConfig{RolesConfig: tt.rolesConfig, RolePermissions: rolePermissions})
+			router := mux.NewRouter()
+			router.HandleFunc("/billing", func(writer http.ResponseWriter, request *http.Request) {
+				shared.WriteJSONResponse(writer, http.StatusOK, "")
+			}).Name(permissions.Declare(billingRoute, "centrals.billing.write"))
+			router.HandleFunc("/db", func(writer http.ResponseWriter, request *http.Request) {
+				shared.WriteJSONResponse(writer, http.StatusOK, "")
+			}).Name(permissions.Declare(dbRoute, "centrals.db.delete"))
+			router.HandleFunc("/undeclared", func(writer http.ResponseWriter, request *http.Request) {
+				shared.WriteJSONResponse(writer, http.StatusOK, "")
+			}).Name("undeclared")
+			router.Use(rolesHandler.RequirePermissions(permissions, errors.ErrorNotFound))
+			token := &jwt.Token{Claims: jwt.MapClaims{"realm_access": map[string]interface{}{"roles": tt.roles}}}
+			paths := map[string]string{billingRoute: "/billing", dbRoute: "/db", "undeclared": "/undeclared"}
+
+			recorder := httptest.NewRecorder()
+			setContextToken(router, token).ServeHTTP(recorder, httptest.NewRequest(tt.method, "http://example.com"+paths[tt.route], nil))
+			if recorder.Result().StatusCode != tt.want {
+				t.Errorf("expected status code %d but got %d", tt.want, recorder.Result().StatusCode)
+			}
+		})
+	}
+}
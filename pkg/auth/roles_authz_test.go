@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"net/http"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestAdminRoleAuthZConfig_ReadFiles(t *testing.T) {
+	RegisterTestingT(t)
+	c := &AdminRoleAuthZConfig{
+		Enabled:         true,
+		RolesConfigFile: "config/admin-authz-roles-prod.yaml",
+	}
+	err := c.ReadFiles()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(c.RolesConfig).To(BeEmpty())
+	Expect(c.GetPermissionMapping()).To(HaveKeyWithValue("acs-fleet-manager-admin-read", []string{"*.read"}))
+}
+
+func TestAdminRoleAuthZConfig_ReadFilesMethods(t *testing.T) {
+	RegisterTestingT(t)
+	c := &AdminRoleAuthZConfig{
+		Enabled:         true,
+		RolesConfigFile: "pkg/auth/testdata/admin-authz-roles-methods.yaml",
+	}
+	err := c.ReadFiles()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(c.RolePermissions).To(BeEmpty())
+	Expect(c.GetRoleMapping()).To(Equal(map[string][]string{
+		http.MethodGet:   {"test-read"},
+		http.MethodPatch: {"test-write"},
+	}))
+}
+
+func TestValidateRolePermissionsConfiguration(t *testing.T) {
+	RegisterTestingT(t)
+	Expect(validateRolePermissionsConfiguration([]RolePermissionsConfiguration{
+		{RoleName: "test", Permissions: []string{"*", "centrals.*", "*.read", "centrals.db.delete"}},
+	})).To(Succeed())
+	Expect(validateRolePermissionsConfiguration([]RolePermissionsConfiguration{
+		{Permissions: []string{"*"}},
+	})).ToNot(Succeed())
+	Expect(validateRolePermissionsConfiguration([]RolePermissionsConfiguration{
+		{RoleName: "test", Permissions: []string{"centrals.["}},
+	})).ToNot(Succeed())
+}
@@ -56,6 +56,9 @@ func (a AES256Cipher) Encrypt(plaintext []byte) ([]byte, error) {
 // a nonce has been apended to ciphertext at encryption
 func (a AES256Cipher) Decrypt(ciphertext []byte) ([]byte, error) {
 	nonceIndex := len(ciphertext) - a.aesgcm.NonceSize()
+	if nonceIndex < 0 {
+		return nil, fmt.Errorf("decrypting ciphertext: ciphertext is shorter than the nonce")
+	}
 	cipher, nonce := ciphertext[:nonceIndex], ciphertext[nonceIndex:]
 
 	plaintext, err := a.aesgcm.Open(nil, nonce, cipher, nil)
@@ -40,6 +40,17 @@ func TestAES256EncryptDecryptMatch(t *testing.T) {
 	require.Equal(t, string(plaintext), string(decrypted), "decrypted string does not match plaintext")
 }
 
+func TestAES256DecryptTruncatedCiphertext(t *testing.T) {
+	key, err := generateAESKey(32)
+	require.NoError(t, err, "generating key")
+
+	aes, err := NewAES256Cipher(key)
+	require.NoError(t, err, "creating cipher")
+
+	_, err = aes.Decrypt([]byte("short"))
+	require.Error(t, err, "decrypting truncated ciphertext")
+}
+
 func TestAES256Generator(t *testing.T) {
 	generator := AES256KeyGenerator{}
 
@@ -1,11 +1,10 @@
-// Package cipher defines encryption and decryption methods used by fleetshard-sync
+// Package cipher defines encryption and decryption methods used by fleetshard-sync and fleet-manager
 package cipher
 
 import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/kms/types"
-	"github.com/stackrox/acs-fleet-manager/fleetshard/config"
 )
 
 //go:generate moq -out cipher_moq.go . Cipher
@@ -16,31 +15,27 @@ type Cipher interface {
 	Decrypt(ciphertext []byte) ([]byte, error)
 }
 
-// NewCipher returns a new object implementing cipher, based on the Type defined in config
-func NewCipher(config *config.Config) (Cipher, error) {
-	encryptionType := config.SecretEncryption.Type
-
+// NewCipher returns a new object implementing cipher, based on the given encryption type
+func NewCipher(encryptionType string, keyID string) (Cipher, error) {
 	if encryptionType == "local" {
 		return NewLocalBase64Cipher()
 	}
 
 	if encryptionType == "kms" {
-		return NewKMSCipher(config.SecretEncryption.KeyID)
+		return NewKMSCipher(keyID)
 	}
 
 	return nil, fmt.Errorf("no Cipher implementation for SecretEncryption.Type: %s", encryptionType)
 }
 
-// NewKeyGenerator return a new object implementing KeyGenerator based on the type defined in config
-func NewKeyGenerator(config *config.Config) (KeyGenerator, error) {
-	encryptionType := config.SecretEncryption.Type
-
+// NewKeyGenerator return a new object implementing KeyGenerator based on the given encryption type
+func NewKeyGenerator(encryptionType string, keyID string) (KeyGenerator, error) {
 	if encryptionType == "local" {
 		return AES256KeyGenerator{}, nil
 	}
 
 	if encryptionType == "kms" {
-		return NewKMSDataKeyGenerator(config.SecretEncryption.KeyID, types.DataKeySpecAes256)
+		return NewKMSDataKeyGenerator(keyID, types.DataKeySpecAes256)
 	}
 
 	return nil, fmt.Errorf("no KeyGenerator implementation for SecretEncryption.Type: %s", encryptionType)
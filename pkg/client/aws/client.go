@@ -22,6 +22,7 @@ type Client interface {
 	ListHostedZonesByNameInput(dnsName string) (*route53.ListHostedZonesByNameOutput, error)
 	ChangeResourceRecordSets(dnsName string, recordChangeBatch *types.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error)
 	GetChange(changeID string) (*route53.GetChangeOutput, error)
+	ListResourceRecordSets(dnsName string) ([]types.ResourceRecordSet, error)
 }
 
 // ClientFactory ...
@@ -109,15 +110,10 @@ func (client *awsClient) ListHostedZonesByNameInput(dnsName string) (*route53.Li
 
 // ChangeResourceRecordSets ...
 func (client *awsClient) ChangeResourceRecordSets(dnsName string, recordChangeBatch *types.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
-	zones, err := client.ListHostedZonesByNameInput(dnsName)
+	hostedZoneID, err := client.getHostedZoneID(dnsName)
 	if err != nil {
 		return nil, err
 	}
-	if len(zones.HostedZones) == 0 {
-		return nil, fmt.Errorf("No Hosted Zones found")
-	}
-
-	hostedZoneID := zones.HostedZones[0].Id
 
 	recordChanges := &route53.ChangeResourceRecordSetsInput{
 		HostedZoneId: hostedZoneID,
@@ -131,3 +127,35 @@ func (client *awsClient) ChangeResourceRecordSets(dnsName string, recordChangeBa
 	}
 	return recordSetsOutput, nil
 }
+
+// ListResourceRecordSets returns all record sets of the hosted zone of dnsName
+func (client *awsClient) ListResourceRecordSets(dnsName string) ([]types.ResourceRecordSet, error) {
+	hostedZoneID, err := client.getHostedZoneID(dnsName)
+	if err != nil {
+		return nil, err
+	}
+
+	var recordSets []types.ResourceRecordSet
+	paginator := route53.NewListResourceRecordSetsPaginator(client.route53Client, &route53.ListResourceRecordSetsInput{
+		HostedZoneId: hostedZoneID,
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list resource record sets")
+		}
+		recordSets = append(recordSets, page.ResourceRecordSets...)
+	}
+	return recordSets, nil
+}
+
+func (client *awsClient) getHostedZoneID(dnsName string) (*string, error) {
+	zones, err := client.ListHostedZonesByNameInput(dnsName)
+	if err != nil {
+		return nil, err
+	}
+	if len(zones.HostedZones) == 0 {
+		return nil, fmt.Errorf("No Hosted Zones found")
+	}
+	return zones.HostedZones[0].Id, nil
+}
@@ -28,6 +28,9 @@ var _ Client = &ClientMock{}
 //			ListHostedZonesByNameInputFunc: func(dnsName string) (*route53.ListHostedZonesByNameOutput, error) {
 //				panic("mock out the ListHostedZonesByNameInput method")
 //			},
+//			ListResourceRecordSetsFunc: func(dnsName string) ([]types.ResourceRecordSet, error) {
+//				panic("mock out the ListResourceRecordSets method")
+//			},
 //		}
 //
 //		// use mockedClient in code that requires Client
@@ -44,6 +47,9 @@ type ClientMock struct {
 	// ListHostedZonesByNameInputFunc mocks the ListHostedZonesByNameInput method.
 	ListHostedZonesByNameInputFunc func(dnsName string) (*route53.ListHostedZonesByNameOutput, error)
 
+	// ListResourceRecordSetsFunc mocks the ListResourceRecordSets method.
+	ListResourceRecordSetsFunc func(dnsName string) ([]types.ResourceRecordSet, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// ChangeResourceRecordSets holds details about calls to the ChangeResourceRecordSets method.
@@ -63,10 +69,16 @@ type ClientMock struct {
 			// DnsName is the dnsName argument value.
 			DnsName string
 		}
+		// ListResourceRecordSets holds details about calls to the ListResourceRecordSets method.
+		ListResourceRecordSets []struct {
+			// DnsName is the dnsName argument value.
+			DnsName string
+		}
 	}
 	lockChangeResourceRecordSets   sync.RWMutex
 	lockGetChange                  sync.RWMutex
 	lockListHostedZonesByNameInput sync.RWMutex
+	lockListResourceRecordSets     sync.RWMutex
 }
 
 // ChangeResourceRecordSets calls ChangeResourceRecordSetsFunc.
@@ -168,3 +180,35 @@ func (mock *ClientMock) ListHostedZonesByNameInputCalls() []struct {
 	mock.lockListHostedZonesByNameInput.RUnlock()
 	return calls
 }
+
+// ListResourceRecordSets calls ListResourceRecordSetsFunc.
+func (mock *ClientMock) ListResourceRecordSets(dnsName string) ([]types.ResourceRecordSet, error) {
+	if mock.ListResourceRecordSetsFunc == nil {
+		panic("ClientMock.ListResourceRecordSetsFunc: method is nil but Client.ListResourceRecordSets was just called")
+	}
+	callInfo := struct {
+		DnsName string
+	}{
+		DnsName: dnsName,
+	}
+	mock.lockListResourceRecordSets.Lock()
+	mock.calls.ListResourceRecordSets = append(mock.calls.ListResourceRecordSets, callInfo)
+	mock.lockListResourceRecordSets.Unlock()
+	return mock.ListResourceRecordSetsFunc(dnsName)
+}
+
+// ListResourceRecordSetsCalls gets all the calls that were made to ListResourceRecordSets.
+// Check the length with:
+//
+//	len(mockedClient.ListResourceRecordSetsCalls())
+func (mock *ClientMock) ListResourceRecordSetsCalls() []struct {
+	DnsName string
+} {
+	var calls []struct {
+		DnsName string
+	}
+	mock.lockListResourceRecordSets.RLock()
+	calls = mock.calls.ListResourceRecordSets
+	mock.lockListResourceRecordSets.RUnlock()
+	return calls
+}
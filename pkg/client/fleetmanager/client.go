@@ -11,6 +11,15 @@ import (
 
 //go:generate moq -rm -out mocks/client_moq.go -pkg mocks . PublicAPI PrivateAPI AdminAPI
 
+// Headers sent by fleetshard-sync along with private API requests. Fleet manager records them as heartbeat of the
+// cluster fleetshard-sync runs on.
+const (
+	// FleetshardVersionHeader is the version of fleetshard-sync
+	FleetshardVersionHeader = "X-Fleetshard-Version"
+	// FleetshardReconcileErrorsHeader is the number of Central reconciliations that failed in the last reconcile round
+	FleetshardReconcileErrorsHeader = "X-Fleetshard-Reconcile-Errors"
+)
+
 // PublicAPI is a wrapper interface for the fleetmanager client public API.
 type PublicAPI interface {
 	CreateCentral(ctx context.Context, async bool, request public.CentralRequestPayload) (public.CentralRequest, *http.Response, error)
@@ -25,6 +34,7 @@ type PublicAPI interface {
 type PrivateAPI interface {
 	GetCentral(ctx context.Context, centralID string) (private.ManagedCentral, *http.Response, error)
 	GetCentrals(ctx context.Context, id string) (private.ManagedCentralList, *http.Response, error)
+	GetCentralIds(ctx context.Context, id string) (private.CentralIdList, *http.Response, error)
 	UpdateCentralClusterStatus(ctx context.Context, id string, requestBody map[string]private.DataPlaneCentralStatus) (*http.Response, error)
 }
 
@@ -37,6 +47,9 @@ type AdminAPI interface {
 	UpdateCentralNameById(ctx context.Context, id string, centralUpdateNameRequest admin.CentralUpdateNameRequest) (admin.Central, *http.Response, error)
 	AssignCentralCluster(ctx context.Context, id string, centralAssignClusterRequest admin.CentralAssignClusterRequest) (*http.Response, error)
 	RestoreCentral(ctx context.Context, id string) (*http.Response, error)
+	GetDataPlaneClusters(ctx context.Context, localVarOptionals *admin.GetDataPlaneClustersOpts) (admin.DataPlaneClusterList, *http.Response, error)
+	GetDataPlaneCluster(ctx context.Context, id string) (admin.DataPlaneCluster, *http.Response, error)
+	GetCentralAuditEvents(ctx context.Context, id string) (admin.AuditEventList, *http.Response, error)
 }
 
 // Client is a helper struct that wraps around the API clients generated from
@@ -13,6 +13,7 @@ import (
 
 var (
 	_ http.RoundTripper       = (*authTransport)(nil)
+	_ http.RoundTripper       = (*headerTransport)(nil)
 	_ fleetmanager.PublicAPI  = (*publicAPIDelegate)(nil)
 	_ fleetmanager.PrivateAPI = (*privateAPIDelegate)(nil)
 	_ fleetmanager.AdminAPI   = (*adminAPIDelegate)(nil)
@@ -42,6 +43,19 @@ func (c *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	return c.transport.RoundTrip(req)
 }
 
+type headerTransport struct {
+	transport http.RoundTripper
+	headers   func() map[string]string
+}
+
+func (c *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for key, value := range c.headers() {
+		req.Header.Set(key, value)
+	}
+	return c.transport.RoundTrip(req)
+}
+
 // newAuthTransport creates a http.RoundTripper that wraps http.DefaultTransport and injects
 // the authorization header from Auth into any request.
 func newAuthTransport(auth Auth) *authTransport {
@@ -62,9 +76,18 @@ func WithUserAgent(userAgent string) ClientOption {
 	}
 }
 
+// WithRequestHeaders allows to add headers to every request. The headers are evaluated per request, so that
+// they can carry state which changes over time.
+func WithRequestHeaders(headers func() map[string]string) ClientOption {
+	return func(o *options) {
+		o.headers = headers
+	}
+}
+
 type options struct {
 	debug     bool
 	userAgent string
+	headers   func() map[string]string
 }
 
 func defaultOptions() *options {
@@ -86,8 +109,12 @@ func NewClient(endpoint string, auth Auth, opts ...ClientOption) (*fleetmanager.
 		opt(o)
 	}
 
+	var transport http.RoundTripper = newAuthTransport(auth)
+	if o.headers != nil {
+		transport = &headerTransport{transport: transport, headers: o.headers}
+	}
 	httpClient := &http.Client{
-		Transport: newAuthTransport(auth),
+		Transport: transport,
 	}
 
 	publicAPI := &publicAPIDelegate{
@@ -366,6 +366,9 @@ var _ fleetmanager.PrivateAPI = &PrivateAPIMock{}
 //			GetCentralFunc: func(ctx context.Context, centralID string) (private.ManagedCentral, *http.Response, error) {
 //				panic("mock out the GetCentral method")
 //			},
+//			GetCentralIdsFunc: func(ctx context.Context, id string) (private.CentralIdList, *http.Response, error) {
+//				panic("mock out the GetCentralIds method")
+//			},
 //			GetCentralsFunc: func(ctx context.Context, id string) (private.ManagedCentralList, *http.Response, error) {
 //				panic("mock out the GetCentrals method")
 //			},
@@ -382,6 +385,9 @@ type PrivateAPIMock struct {
 	// GetCentralFunc mocks the GetCentral method.
 	GetCentralFunc func(ctx context.Context, centralID string) (private.ManagedCentral, *http.Response, error)
 
+	// GetCentralIdsFunc mocks the GetCentralIds method.
+	GetCentralIdsFunc func(ctx context.Context, id string) (private.CentralIdList, *http.Response, error)
+
 	// GetCentralsFunc mocks the GetCentrals method.
 	GetCentralsFunc func(ctx context.Context, id string) (private.ManagedCentralList, *http.Response, error)
 
@@ -397,6 +403,13 @@ type PrivateAPIMock struct {
 			// CentralID is the centralID argument value.
 			CentralID string
 		}
+		// GetCentralIds holds details about calls to the GetCentralIds method.
+		GetCentralIds []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
 		// GetCentrals holds details about calls to the GetCentrals method.
 		GetCentrals []struct {
 			// Ctx is the ctx argument value.
@@ -415,6 +428,7 @@ type PrivateAPIMock struct {
 		}
 	}
 	lockGetCentral                 sync.RWMutex
+	lockGetCentralIds              sync.RWMutex
 	lockGetCentrals                sync.RWMutex
 	lockUpdateCentralClusterStatus sync.RWMutex
 }
@@ -455,6 +469,42 @@ func (mock *PrivateAPIMock) GetCentralCalls() []struct {
 	return calls
 }
 
+// GetCentralIds calls GetCentralIdsFunc.
+func (mock *PrivateAPIMock) GetCentralIds(ctx context.Context, id string) (private.CentralIdList, *http.Response, error) {
+	if mock.GetCentralIdsFunc == nil {
+		panic("PrivateAPIMock.GetCentralIdsFunc: method is nil but PrivateAPI.GetCentralIds was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetCentralIds.Lock()
+	mock.calls.GetCentralIds = append(mock.calls.GetCentralIds, callInfo)
+	mock.lockGetCentralIds.Unlock()
+	return mock.GetCentralIdsFunc(ctx, id)
+}
+
+// GetCentralIdsCalls gets all the calls that were made to GetCentralIds.
+// Check the length with:
+//
+//	len(mockedPrivateAPI.GetCentralIdsCalls())
+func (mock *PrivateAPIMock) GetCentralIdsCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetCentralIds.RLock()
+	calls = mock.calls.GetCentralIds
+	mock.lockGetCentralIds.RUnlock()
+	return calls
+}
+
 // GetCentrals calls GetCentralsFunc.
 func (mock *PrivateAPIMock) GetCentrals(ctx context.Context, id string) (private.ManagedCentralList, *http.Response, error) {
 	if mock.GetCentralsFunc == nil {
@@ -553,9 +603,18 @@ var _ fleetmanager.AdminAPI = &AdminAPIMock{}
 //			DeleteDbCentralByIdFunc: func(ctx context.Context, id string) (*http.Response, error) {
 //				panic("mock out the DeleteDbCentralById method")
 //			},
+//			GetCentralAuditEventsFunc: func(ctx context.Context, id string) (admin.AuditEventList, *http.Response, error) {
+//				panic("mock out the GetCentralAuditEvents method")
+//			},
 //			GetCentralsFunc: func(ctx context.Context, localVarOptionals *admin.GetCentralsOpts) (admin.CentralList, *http.Response, error) {
 //				panic("mock out the GetCentrals method")
 //			},
+//			GetDataPlaneClusterFunc: func(ctx context.Context, id string) (admin.DataPlaneCluster, *http.Response, error) {
+//				panic("mock out the GetDataPlaneCluster method")
+//			},
+//			GetDataPlaneClustersFunc: func(ctx context.Context, localVarOptionals *admin.GetDataPlaneClustersOpts) (admin.DataPlaneClusterList, *http.Response, error) {
+//				panic("mock out the GetDataPlaneClusters method")
+//			},
 //			RestoreCentralFunc: func(ctx context.Context, id string) (*http.Response, error) {
 //				panic("mock out the RestoreCentral method")
 //			},
@@ -581,9 +640,18 @@ type AdminAPIMock struct {
 	// DeleteDbCentralByIdFunc mocks the DeleteDbCentralById method.
 	DeleteDbCentralByIdFunc func(ctx context.Context, id string) (*http.Response, error)
 
+	// GetCentralAuditEventsFunc mocks the GetCentralAuditEvents method.
+	GetCentralAuditEventsFunc func(ctx context.Context, id string) (admin.AuditEventList, *http.Response, error)
+
 	// GetCentralsFunc mocks the GetCentrals method.
 	GetCentralsFunc func(ctx context.Context, localVarOptionals *admin.GetCentralsOpts) (admin.CentralList, *http.Response, error)
 
+	// GetDataPlaneClusterFunc mocks the GetDataPlaneCluster method.
+	GetDataPlaneClusterFunc func(ctx context.Context, id string) (admin.DataPlaneCluster, *http.Response, error)
+
+	// GetDataPlaneClustersFunc mocks the GetDataPlaneClusters method.
+	GetDataPlaneClustersFunc func(ctx context.Context, localVarOptionals *admin.GetDataPlaneClustersOpts) (admin.DataPlaneClusterList, *http.Response, error)
+
 	// RestoreCentralFunc mocks the RestoreCentral method.
 	RestoreCentralFunc func(ctx context.Context, id string) (*http.Response, error)
 
@@ -626,6 +694,13 @@ type AdminAPIMock struct {
 			// ID is the id argument value.
 			ID string
 		}
+		// GetCentralAuditEvents holds details about calls to the GetCentralAuditEvents method.
+		GetCentralAuditEvents []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
 		// GetCentrals holds details about calls to the GetCentrals method.
 		GetCentrals []struct {
 			// Ctx is the ctx argument value.
@@ -633,6 +708,20 @@ type AdminAPIMock struct {
 			// LocalVarOptionals is the localVarOptionals argument value.
 			LocalVarOptionals *admin.GetCentralsOpts
 		}
+		// GetDataPlaneCluster holds details about calls to the GetDataPlaneCluster method.
+		GetDataPlaneCluster []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetDataPlaneClusters holds details about calls to the GetDataPlaneClusters method.
+		GetDataPlaneClusters []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// LocalVarOptionals is the localVarOptionals argument value.
+			LocalVarOptionals *admin.GetDataPlaneClustersOpts
+		}
 		// RestoreCentral holds details about calls to the RestoreCentral method.
 		RestoreCentral []struct {
 			// Ctx is the ctx argument value.
@@ -654,7 +743,10 @@ type AdminAPIMock struct {
 	lockCentralRotateSecrets  sync.RWMutex
 	lockCreateCentral         sync.RWMutex
 	lockDeleteDbCentralById   sync.RWMutex
+	lockGetCentralAuditEvents sync.RWMutex
 	lockGetCentrals           sync.RWMutex
+	lockGetDataPlaneCluster   sync.RWMutex
+	lockGetDataPlaneClusters  sync.RWMutex
 	lockRestoreCentral        sync.RWMutex
 	lockUpdateCentralNameById sync.RWMutex
 }
@@ -815,6 +907,42 @@ func (mock *AdminAPIMock) DeleteDbCentralByIdCalls() []struct {
 	return calls
 }
 
+// GetCentralAuditEvents calls GetCentralAuditEventsFunc.
+func (mock *AdminAPIMock) GetCentralAuditEvents(ctx context.Context, id string) (admin.AuditEventList, *http.Response, error) {
+	if mock.GetCentralAuditEventsFunc == nil {
+		panic("AdminAPIMock.GetCentralAuditEventsFunc: method is nil but AdminAPI.GetCentralAuditEvents was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetCentralAuditEvents.Lock()
+	mock.calls.GetCentralAuditEvents = append(mock.calls.GetCentralAuditEvents, callInfo)
+	mock.lockGetCentralAuditEvents.Unlock()
+	return mock.GetCentralAuditEventsFunc(ctx, id)
+}
+
+// GetCentralAuditEventsCalls gets all the calls that were made to GetCentralAuditEvents.
+// Check the length with:
+//
+//	len(mockedAdminAPI.GetCentralAuditEventsCalls())
+func (mock *AdminAPIMock) GetCentralAuditEventsCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetCentralAuditEvents.RLock()
+	calls = mock.calls.GetCentralAuditEvents
+	mock.lockGetCentralAuditEvents.RUnlock()
+	return calls
+}
+
 // GetCentrals calls GetCentralsFunc.
 func (mock *AdminAPIMock) GetCentrals(ctx context.Context, localVarOptionals *admin.GetCentralsOpts) (admin.CentralList, *http.Response, error) {
 	if mock.GetCentralsFunc == nil {
@@ -851,6 +979,78 @@ func (mock *AdminAPIMock) GetCentralsCalls() []struct {
 	return calls
 }
 
+// GetDataPlaneCluster calls GetDataPlaneClusterFunc.
+func (mock *AdminAPIMock) GetDataPlaneCluster(ctx context.Context, id string) (admin.DataPlaneCluster, *http.Response, error) {
+	if mock.GetDataPlaneClusterFunc == nil {
+		panic("AdminAPIMock.GetDataPlaneClusterFunc: method is nil but AdminAPI.GetDataPlaneCluster was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetDataPlaneCluster.Lock()
+	mock.calls.GetDataPlaneCluster = append(mock.calls.GetDataPlaneCluster, callInfo)
+	mock.lockGetDataPlaneCluster.Unlock()
+	return mock.GetDataPlaneClusterFunc(ctx, id)
+}
+
+// GetDataPlaneClusterCalls gets all the calls that were made to GetDataPlaneCluster.
+// Check the length with:
+//
+//	len(mockedAdminAPI.GetDataPlaneClusterCalls())
+func (mock *AdminAPIMock) GetDataPlaneClusterCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetDataPlaneCluster.RLock()
+	calls = mock.calls.GetDataPlaneCluster
+	mock.lockGetDataPlaneCluster.RUnlock()
+	return calls
+}
+
+// GetDataPlaneClusters calls GetDataPlaneClustersFunc.
+func (mock *AdminAPIMock) GetDataPlaneClusters(ctx context.Context, localVarOptionals *admin.GetDataPlaneClustersOpts) (admin.DataPlaneClusterList, *http.Response, error) {
+	if mock.GetDataPlaneClustersFunc == nil {
+		panic("AdminAPIMock.GetDataPlaneClustersFunc: method is nil but AdminAPI.GetDataPlaneClusters was just called")
+	}
+	callInfo := struct {
+		Ctx               context.Context
+		LocalVarOptionals *admin.GetDataPlaneClustersOpts
+	}{
+		Ctx:               ctx,
+		LocalVarOptionals: localVarOptionals,
+	}
+	mock.lockGetDataPlaneClusters.Lock()
+	mock.calls.GetDataPlaneClusters = append(mock.calls.GetDataPlaneClusters, callInfo)
+	mock.lockGetDataPlaneClusters.Unlock()
+	return mock.GetDataPlaneClustersFunc(ctx, localVarOptionals)
+}
+
+// GetDataPlaneClustersCalls gets all the calls that were made to GetDataPlaneClusters.
+// Check the length with:
+//
+//	len(mockedAdminAPI.GetDataPlaneClustersCalls())
+func (mock *AdminAPIMock) GetDataPlaneClustersCalls() []struct {
+	Ctx               context.Context
+	LocalVarOptionals *admin.GetDataPlaneClustersOpts
+} {
+	var calls []struct {
+		Ctx               context.Context
+		LocalVarOptionals *admin.GetDataPlaneClustersOpts
+	}
+	mock.lockGetDataPlaneClusters.RLock()
+	calls = mock.calls.GetDataPlaneClusters
+	mock.lockGetDataPlaneClusters.RUnlock()
+	return calls
+}
+
 // RestoreCentral calls RestoreCentralFunc.
 func (mock *AdminAPIMock) RestoreCentral(ctx context.Context, id string) (*http.Response, error) {
 	if mock.RestoreCentralFunc == nil {
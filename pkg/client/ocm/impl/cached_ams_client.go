@@ -0,0 +1,233 @@
+package impl
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	amsv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	ocmerrors "github.com/openshift-online/ocm-sdk-go/errors"
+	"github.com/patrickmn/go-cache"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/ocm"
+	serviceErrors "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+)
+
+// The operations of the AMS client which are cached
+const (
+	amsOperationGetOrganisation     = "get_organisation"
+	amsOperationGetQuotaCosts       = "get_quota_costs"
+	amsOperationGetCloudAccounts    = "get_cloud_accounts"
+	amsOperationGetTermsRequirement = "get_terms_requirement"
+)
+
+// The results of cached AMS calls reported in metrics
+const (
+	amsCacheResultHit         = "hit"
+	amsCacheResultNegativeHit = "negative_hit"
+	amsCacheResultMiss        = "miss"
+	amsCacheResultStale       = "stale"
+	amsCacheResultError       = "error"
+)
+
+var _ ocm.Client = &cachedAMSClient{}
+var _ QuotaCostsCache = &cachedAMSClient{}
+
+// QuotaCostsCache is implemented by AMS clients which cache quota costs. Cached quota costs may be outdated, so that
+// they must only be used to check whether an organisation has quota, but not to reserve quota.
+type QuotaCostsCache interface {
+	GetCachedQuotaCostsForProduct(organizationID, resourceName, product string) ([]*amsv1.QuotaCost, error)
+}
+
+// cachedAMSClient caches the AMS calls used for quota allowance, terms and cloud account checks, so that AMS latency
+// and outages do not fail every request. GetQuotaCostsForProduct is not cached, as it is used to reserve quota. Negative results, e.g. unknown organisations or missing quota, are cached for a
+// shorter time, as they are usually resolved by the customer. If AMS fails, results are served stale up to the stale
+// TTL. All other calls are passed to AMS unchanged.
+type cachedAMSClient struct {
+	ocm.Client
+	breaker     *AMSCircuitBreaker
+	cache       *cache.Cache
+	ttl         time.Duration
+	negativeTTL time.Duration
+	staleTTL    time.Duration
+	now         func() time.Time
+}
+
+type amsCacheEntry struct {
+	value    any
+	err      error
+	negative bool
+	storedAt time.Time
+}
+
+type termsRequirement struct {
+	termsRequired bool
+	redirectURL   string
+}
+
+// NewCachedAMSClient wraps the AMS client with a cache and the circuit breaker
+func NewCachedAMSClient(client ocm.Client, breaker *AMSCircuitBreaker, config *OCMConfig) ocm.Client {
+	// entries are kept as long as they may be served stale
+	expiration := config.AMSCacheStaleTTL
+	if expiration < config.AMSCacheTTL {
+		expiration = config.AMSCacheTTL
+	}
+	return &cachedAMSClient{
+		Client:      client,
+		breaker:     breaker,
+		cache:       cache.New(expiration, 10*time.Minute),
+		ttl:         config.AMSCacheTTL,
+		negativeTTL: config.AMSCacheNegativeTTL,
+		staleTTL:    config.AMSCacheStaleTTL,
+		now:         time.Now,
+	}
+}
+
+// GetOrganisationFromExternalID ...
+func (c *cachedAMSClient) GetOrganisationFromExternalID(externalID string) (*amsv1.Organization, error) {
+	value, err := c.call(amsOperationGetOrganisation, externalID,
+		func() (any, error) {
+			return c.Client.GetOrganisationFromExternalID(externalID)
+		},
+		func(value any, err error) bool {
+			return isNotFound(err)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*amsv1.Organization), nil
+}
+
+// GetCachedQuotaCostsForProduct ...
+func (c *cachedAMSClient) GetCachedQuotaCostsForProduct(organizationID, resourceName, product string) ([]*amsv1.QuotaCost, error) {
+	value, err := c.call(amsOperationGetQuotaCosts, fmt.Sprintf("%s/%s/%s", organizationID, resourceName, product),
+		func() (any, error) {
+			return c.Client.GetQuotaCostsForProduct(organizationID, resourceName, product)
+		},
+		func(value any, err error) bool {
+			return isNotFound(err) || (err == nil && len(value.([]*amsv1.QuotaCost)) == 0)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return value.([]*amsv1.QuotaCost), nil
+}
+
+// GetCustomerCloudAccounts ...
+func (c *cachedAMSClient) GetCustomerCloudAccounts(organizationID string, quotaIDs []string) ([]*amsv1.CloudAccount, error) {
+	value, err := c.call(amsOperationGetCloudAccounts, fmt.Sprintf("%s/%s", organizationID, strings.Join(quotaIDs, ",")),
+		func() (any, error) {
+			return c.Client.GetCustomerCloudAccounts(organizationID, quotaIDs)
+		},
+		func(value any, err error) bool {
+			return isNotFound(err) || (err == nil && len(value.([]*amsv1.CloudAccount)) == 0)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return value.([]*amsv1.CloudAccount), nil
+}
+
+// GetRequiresTermsAcceptance caches that terms are required only for the negative TTL, so that users can create
+// Centrals soon after they accepted the terms.
+func (c *cachedAMSClient) GetRequiresTermsAcceptance(username string) (bool, string, error) {
+	value, err := c.call(amsOperationGetTermsRequirement, username,
+		func() (any, error) {
+			termsRequired, redirectURL, err := c.Client.GetRequiresTermsAcceptance(username)
+			return termsRequirement{termsRequired: termsRequired, redirectURL: redirectURL}, err
+		},
+		func(value any, err error) bool {
+			return err == nil && value.(termsRequirement).termsRequired
+		})
+	if err != nil {
+		return false, "", err
+	}
+	requirement := value.(termsRequirement)
+	return requirement.termsRequired, requirement.redirectURL, nil
+}
+
+// call returns the cached result of the operation, or calls AMS if there is no fresh result. Results are negative if
+// the negative function returns true for them. Negative errors are cached, all other errors are not. Only errors
+// which indicate that AMS is unavailable count towards the circuit breaker and are replaced by stale results.
+func (c *cachedAMSClient) call(operation, key string, fetch func() (any, error), negative func(value any, err error) bool) (any, error) {
+	cacheKey := operation + "/" + key
+	var cached *amsCacheEntry
+	if item, found := c.cache.Get(cacheKey); found {
+		entry := item.(amsCacheEntry)
+		cached = &entry
+		if c.isFresh(entry) {
+			if entry.negative {
+				metrics.IncreaseAMSCacheRequestCountMetric(operation, amsCacheResultNegativeHit)
+			} else {
+				metrics.IncreaseAMSCacheRequestCountMetric(operation, amsCacheResultHit)
+			}
+			return entry.value, entry.err
+		}
+	}
+
+	if !c.breaker.Allow() {
+		return c.stale(operation, key, cached, ErrAMSUnavailable)
+	}
+	value, err := fetch()
+	isNegative := negative(value, err)
+	if err != nil && !isNegative {
+		if !isAMSFailure(err) {
+			// AMS is available, but rejected the request
+			c.breaker.RecordSuccess()
+			metrics.IncreaseAMSCacheRequestCountMetric(operation, amsCacheResultError)
+			return nil, err
+		}
+		c.breaker.RecordFailure()
+		return c.stale(operation, key, cached, err)
+	}
+	c.breaker.RecordSuccess()
+	metrics.IncreaseAMSCacheRequestCountMetric(operation, amsCacheResultMiss)
+	if c.ttl > 0 {
+		c.cache.SetDefault(cacheKey, amsCacheEntry{value: value, err: err, negative: isNegative, storedAt: c.now()})
+	}
+	return value, err
+}
+
+// stale returns the cached result if AMS fails. Negative results are not served stale, as they are likely to be
+// resolved by the time AMS fails.
+func (c *cachedAMSClient) stale(operation, key string, cached *amsCacheEntry, err error) (any, error) {
+	if cached != nil && !cached.negative && c.now().Sub(cached.storedAt) < c.staleTTL {
+		glog.Warningf("AMS %s for %q failed, using the result from %s: %v", operation, key, cached.storedAt.Format(time.RFC3339), err)
+		metrics.IncreaseAMSCacheRequestCountMetric(operation, amsCacheResultStale)
+		return cached.value, nil
+	}
+	metrics.IncreaseAMSCacheRequestCountMetric(operation, amsCacheResultError)
+	return nil, err
+}
+
+func (c *cachedAMSClient) isFresh(entry amsCacheEntry) bool {
+	ttl := c.ttl
+	if entry.negative {
+		ttl = c.negativeTTL
+	}
+	return c.now().Sub(entry.storedAt) < ttl
+}
+
+func isNotFound(err error) bool {
+	if svcErr, ok := err.(*serviceErrors.ServiceError); ok {
+		return svcErr.Is404()
+	}
+	return false
+}
+
+// isAMSFailure returns true for transport errors and 5xx responses, which indicate that AMS is unavailable. 4xx
+// responses are caused by the request.
+func isAMSFailure(err error) bool {
+	var ocmErr *ocmerrors.Error
+	if errors.As(err, &ocmErr) {
+		return ocmErr.Status() >= http.StatusInternalServerError
+	}
+	var svcErr *serviceErrors.ServiceError
+	if errors.As(err, &svcErr) {
+		return svcErr.HTTPCode >= http.StatusInternalServerError
+	}
+	return true
+}
@@ -0,0 +1,180 @@
+package impl
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	amsv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	ocmerrors "github.com/openshift-online/ocm-sdk-go/errors"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/client/ocm/mocks"
+	serviceErrors "github.com/stackrox/acs-fleet-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCachedAMSClient(t *testing.T, amsClient *mocks.ClientMock) (*cachedAMSClient, *time.Time) {
+	config := NewOCMConfig()
+	breaker := NewAMSCircuitBreaker(config)
+	client := NewCachedAMSClient(amsClient, breaker, config).(*cachedAMSClient)
+	now := time.Now()
+	client.now = func() time.Time { return now }
+	breaker.now = client.now
+	return client, &now
+}
+
+func TestCachedAMSClient_CachesResults(t *testing.T) {
+	org, err := amsv1.NewOrganization().ID("org-1").Build()
+	require.NoError(t, err)
+	amsClient := &mocks.ClientMock{
+		GetOrganisationFromExternalIDFunc: func(externalID string) (*amsv1.Organization, error) {
+			return org, nil
+		},
+	}
+	client, now := newTestCachedAMSClient(t, amsClient)
+
+	for i := 0; i < 2; i++ {
+		cached, err := client.GetOrganisationFromExternalID("external-1")
+		require.NoError(t, err)
+		assert.Equal(t, "org-1", cached.ID())
+	}
+	assert.Len(t, amsClient.GetOrganisationFromExternalIDCalls(), 1)
+
+	*now = now.Add(client.ttl)
+	_, err = client.GetOrganisationFromExternalID("external-1")
+	require.NoError(t, err)
+	assert.Len(t, amsClient.GetOrganisationFromExternalIDCalls(), 2)
+}
+
+func TestCachedAMSClient_CachesNegativeResultsShorter(t *testing.T) {
+	amsClient := &mocks.ClientMock{
+		GetOrganisationFromExternalIDFunc: func(externalID string) (*amsv1.Organization, error) {
+			return nil, serviceErrors.New(serviceErrors.ErrorNotFound, "organisation with external id '%s' not found", externalID)
+		},
+		GetRequiresTermsAcceptanceFunc: func(username string) (bool, string, error) {
+			return true, "https://example.com/terms", nil
+		},
+	}
+	client, now := newTestCachedAMSClient(t, amsClient)
+
+	for i := 0; i < 2; i++ {
+		_, err := client.GetOrganisationFromExternalID("external-1")
+		assert.Error(t, err)
+		termsRequired, redirectURL, err := client.GetRequiresTermsAcceptance("user-1")
+		require.NoError(t, err)
+		assert.True(t, termsRequired)
+		assert.Equal(t, "https://example.com/terms", redirectURL)
+	}
+	assert.Len(t, amsClient.GetOrganisationFromExternalIDCalls(), 1)
+	assert.Len(t, amsClient.GetRequiresTermsAcceptanceCalls(), 1)
+
+	*now = now.Add(client.negativeTTL)
+	_, err := client.GetOrganisationFromExternalID("external-1")
+	assert.Error(t, err)
+	_, _, err = client.GetRequiresTermsAcceptance("user-1")
+	require.NoError(t, err)
+	assert.Len(t, amsClient.GetOrganisationFromExternalIDCalls(), 2)
+	assert.Len(t, amsClient.GetRequiresTermsAcceptanceCalls(), 2)
+}
+
+func TestCachedAMSClient_ServesStaleResultsIfAMSFails(t *testing.T) {
+	quotaCost, err := amsv1.NewQuotaCost().Allowed(1).Build()
+	require.NoError(t, err)
+	amsAvailable := true
+	amsClient := &mocks.ClientMock{
+		GetQuotaCostsForProductFunc: func(organizationID, resourceName, product string) ([]*amsv1.QuotaCost, error) {
+			if !amsAvailable {
+				return nil, pkgerrors.New("service unavailable")
+			}
+			return []*amsv1.QuotaCost{quotaCost}, nil
+		},
+	}
+	client, now := newTestCachedAMSClient(t, amsClient)
+	_, err = client.GetCachedQuotaCostsForProduct("org-1", "rhacs", "RHACS")
+	require.NoError(t, err)
+
+	amsAvailable = false
+	*now = now.Add(client.ttl)
+	quotaCosts, err := client.GetCachedQuotaCostsForProduct("org-1", "rhacs", "RHACS")
+	require.NoError(t, err)
+	assert.Equal(t, []*amsv1.QuotaCost{quotaCost}, quotaCosts)
+
+	// results which are not cached are not served stale
+	_, err = client.GetCachedQuotaCostsForProduct("org-2", "rhacs", "RHACS")
+	assert.Error(t, err)
+
+	*now = now.Add(NewOCMConfig().AMSCacheStaleTTL)
+	_, err = client.GetCachedQuotaCostsForProduct("org-1", "rhacs", "RHACS")
+	assert.Error(t, err)
+}
+
+func TestCachedAMSClient_FailsFastWhileCircuitBreakerIsOpen(t *testing.T) {
+	amsClient := &mocks.ClientMock{
+		GetCustomerCloudAccountsFunc: func(organizationID string, quotaIDs []string) ([]*amsv1.CloudAccount, error) {
+			return nil, pkgerrors.New("service unavailable")
+		},
+	}
+	client, now := newTestCachedAMSClient(t, amsClient)
+	threshold := NewOCMConfig().AMSCircuitBreakerFailureThreshold
+
+	for i := 0; i < threshold; i++ {
+		_, err := client.GetCustomerCloudAccounts("org-1", []string{"quota-1"})
+		assert.Error(t, err)
+	}
+	assert.True(t, client.breaker.IsOpen())
+
+	_, err := client.GetCustomerCloudAccounts("org-1", []string{"quota-1"})
+	assert.ErrorIs(t, err, ErrAMSUnavailable)
+	assert.Len(t, amsClient.GetCustomerCloudAccountsCalls(), threshold)
+
+	// after the open duration AMS is probed again
+	*now = now.Add(NewOCMConfig().AMSCircuitBreakerOpenDuration)
+	_, err = client.GetCustomerCloudAccounts("org-1", []string{"quota-1"})
+	assert.NotErrorIs(t, err, ErrAMSUnavailable)
+	assert.Len(t, amsClient.GetCustomerCloudAccountsCalls(), threshold+1)
+	assert.True(t, client.breaker.IsOpen())
+}
+
+func TestCachedAMSClient_DoesNotCacheQuotaCostsForReservations(t *testing.T) {
+	amsClient := &mocks.ClientMock{
+		GetQuotaCostsForProductFunc: func(organizationID, resourceName, product string) ([]*amsv1.QuotaCost, error) {
+			return []*amsv1.QuotaCost{}, nil
+		},
+	}
+	client, _ := newTestCachedAMSClient(t, amsClient)
+
+	for i := 0; i < 2; i++ {
+		_, err := client.GetQuotaCostsForProduct("org-1", "rhacs", "RHACS")
+		require.NoError(t, err)
+	}
+	assert.Len(t, amsClient.GetQuotaCostsForProductCalls(), 2)
+}
+
+func TestCachedAMSClient_RejectedRequestsDoNotOpenCircuitBreaker(t *testing.T) {
+	cloudAccount, err := amsv1.NewCloudAccount().CloudAccountID("account-1").Build()
+	require.NoError(t, err)
+	badRequest, err := ocmerrors.NewError().Status(http.StatusBadRequest).Build()
+	require.NoError(t, err)
+	var amsErr error
+	amsClient := &mocks.ClientMock{
+		GetCustomerCloudAccountsFunc: func(organizationID string, quotaIDs []string) ([]*amsv1.CloudAccount, error) {
+			if amsErr != nil {
+				return nil, fmt.Errorf("error getting cloud accounts: %w", amsErr)
+			}
+			return []*amsv1.CloudAccount{cloudAccount}, nil
+		},
+	}
+	client, now := newTestCachedAMSClient(t, amsClient)
+	_, err = client.GetCustomerCloudAccounts("org-1", []string{"quota-1"})
+	require.NoError(t, err)
+
+	amsErr = badRequest
+	*now = now.Add(client.ttl)
+	for i := 0; i < NewOCMConfig().AMSCircuitBreakerFailureThreshold; i++ {
+		_, err = client.GetCustomerCloudAccounts("org-1", []string{"quota-1"})
+		assert.ErrorIs(t, err, badRequest, "rejected requests are not served stale")
+	}
+	assert.False(t, client.breaker.IsOpen())
+}
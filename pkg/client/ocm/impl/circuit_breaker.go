@@ -0,0 +1,90 @@
+package impl
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stackrox/acs-fleet-manager/pkg/metrics"
+)
+
+// ErrAMSUnavailable is returned instead of calling AMS while the circuit breaker is open
+var ErrAMSUnavailable = pkgerrors.New("AMS is unavailable after consecutive failures")
+
+// AMSCircuitBreaker stops calling AMS after consecutive failures. While it is open, callers fail fast, and workers
+// which act on AMS results, e.g. by expiring Centrals without quota, skip their run. After the open duration one call
+// is attempted again, which closes the breaker on success and reopens it on failure.
+type AMSCircuitBreaker struct {
+	failureThreshold int
+	openDuration     time.Duration
+	now              func() time.Time
+
+	mu                  sync.Mutex
+	consecutiveFailures int
+	openedAt            time.Time
+}
+
+// NewAMSCircuitBreaker ...
+func NewAMSCircuitBreaker(config *OCMConfig) *AMSCircuitBreaker {
+	return &AMSCircuitBreaker{
+		failureThreshold: config.AMSCircuitBreakerFailureThreshold,
+		openDuration:     config.AMSCircuitBreakerOpenDuration,
+		now:              time.Now,
+	}
+}
+
+// IsOpen returns true if AMS is considered unavailable and is not called
+func (b *AMSCircuitBreaker) IsOpen() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.isOpen()
+}
+
+// Allow returns true if AMS may be called. Once the open duration has passed, only the first caller is allowed to
+// probe AMS, and other callers keep failing fast until the result of the probe is recorded.
+func (b *AMSCircuitBreaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.isOpen() {
+		return false
+	}
+	if b.failureThreshold > 0 && b.consecutiveFailures >= b.failureThreshold {
+		b.openedAt = b.now()
+	}
+	return true
+}
+
+func (b *AMSCircuitBreaker) isOpen() bool {
+	if b.failureThreshold <= 0 || b.consecutiveFailures < b.failureThreshold {
+		return false
+	}
+	return b.now().Before(b.openedAt.Add(b.openDuration))
+}
+
+// RecordSuccess closes the breaker
+func (b *AMSCircuitBreaker) RecordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failureThreshold > 0 && b.consecutiveFailures >= b.failureThreshold {
+		glog.Infof("AMS is available again, closing the circuit breaker")
+		metrics.UpdateAMSCircuitBreakerOpenMetric(false)
+	}
+	b.consecutiveFailures = 0
+}
+
+// RecordFailure opens the breaker once the failure threshold is reached, and reopens it if the call after the open
+// duration fails
+func (b *AMSCircuitBreaker) RecordFailure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.consecutiveFailures++
+	if b.failureThreshold <= 0 || b.consecutiveFailures < b.failureThreshold {
+		return
+	}
+	if b.consecutiveFailures == b.failureThreshold {
+		glog.Warningf("AMS failed %d consecutive times, not calling it for %s", b.consecutiveFailures, b.openDuration)
+	}
+	b.openedAt = b.now()
+	metrics.UpdateAMSCircuitBreakerOpenMetric(true)
+}
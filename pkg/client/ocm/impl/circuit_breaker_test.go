@@ -0,0 +1,40 @@
+package impl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAMSCircuitBreaker(t *testing.T) {
+	breaker := NewAMSCircuitBreaker(&OCMConfig{AMSCircuitBreakerFailureThreshold: 2, AMSCircuitBreakerOpenDuration: time.Minute})
+	now := time.Now()
+	breaker.now = func() time.Time { return now }
+
+	breaker.RecordFailure()
+	assert.True(t, breaker.Allow())
+	breaker.RecordFailure()
+	assert.True(t, breaker.IsOpen())
+	assert.False(t, breaker.Allow())
+
+	now = now.Add(time.Minute)
+	assert.False(t, breaker.IsOpen())
+	assert.True(t, breaker.Allow(), "the first call after the open duration probes AMS")
+	assert.False(t, breaker.Allow(), "other calls fail fast until the probe finished")
+
+	breaker.RecordSuccess()
+	assert.False(t, breaker.IsOpen())
+	assert.True(t, breaker.Allow())
+	breaker.RecordFailure()
+	assert.True(t, breaker.Allow(), "the failures are counted from the last success")
+}
+
+func TestAMSCircuitBreaker_Disabled(t *testing.T) {
+	breaker := NewAMSCircuitBreaker(&OCMConfig{})
+	for i := 0; i < 10; i++ {
+		breaker.RecordFailure()
+	}
+	assert.False(t, breaker.IsOpen())
+	assert.True(t, breaker.Allow())
+}
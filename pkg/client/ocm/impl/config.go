@@ -2,6 +2,7 @@ package impl
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/pflag"
 	"github.com/stackrox/acs-fleet-manager/pkg/shared"
@@ -27,6 +28,14 @@ type OCMConfig struct {
 	Debug            bool   `json:"debug"`
 	EnableMock       bool   `json:"enable_mock"`
 	MockMode         string `json:"mock_type"`
+	// AMSCacheTTL is how long AMS results used for quota, terms and cloud account checks are cached
+	AMSCacheTTL time.Duration `json:"ams_cache_ttl"`
+	// AMSCacheNegativeTTL is how long negative AMS results are cached, e.g. unknown organisations or missing quota
+	AMSCacheNegativeTTL time.Duration `json:"ams_cache_negative_ttl"`
+	// AMSCacheStaleTTL is how long cached AMS results are used while AMS fails
+	AMSCacheStaleTTL                  time.Duration `json:"ams_cache_stale_ttl"`
+	AMSCircuitBreakerFailureThreshold int           `json:"ams_circuit_breaker_failure_threshold"`
+	AMSCircuitBreakerOpenDuration     time.Duration `json:"ams_circuit_breaker_open_duration"`
 }
 
 // NewOCMConfig ...
@@ -41,6 +50,12 @@ func NewOCMConfig() *OCMConfig {
 		Debug:            false,
 		EnableMock:       false,
 		MockMode:         MockModeStubServer,
+
+		AMSCacheTTL:                       5 * time.Minute,
+		AMSCacheNegativeTTL:               time.Minute,
+		AMSCacheStaleTTL:                  6 * time.Hour,
+		AMSCircuitBreakerFailureThreshold: 5,
+		AMSCircuitBreakerOpenDuration:     time.Minute,
 	}
 }
 
@@ -55,6 +70,11 @@ func (c *OCMConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&c.Debug, "ocm-debug", c.Debug, "Debug flag for OCM API")
 	fs.BoolVar(&c.EnableMock, "enable-ocm-mock", c.EnableMock, "Enable mock ocm clients")
 	fs.StringVar(&c.MockMode, "ocm-mock-mode", c.MockMode, "Set mock type")
+	fs.DurationVar(&c.AMSCacheTTL, "ams-cache-ttl", c.AMSCacheTTL, "Time AMS results for quota, terms and cloud account checks are cached, 0 disables the cache")
+	fs.DurationVar(&c.AMSCacheNegativeTTL, "ams-cache-negative-ttl", c.AMSCacheNegativeTTL, "Time negative AMS results, e.g. unknown organisations or missing quota, are cached")
+	fs.DurationVar(&c.AMSCacheStaleTTL, "ams-cache-stale-ttl", c.AMSCacheStaleTTL, "Time cached AMS results are used while AMS fails")
+	fs.IntVar(&c.AMSCircuitBreakerFailureThreshold, "ams-circuit-breaker-failure-threshold", c.AMSCircuitBreakerFailureThreshold, "Number of consecutive AMS failures after which AMS is considered unavailable, 0 disables the circuit breaker")
+	fs.DurationVar(&c.AMSCircuitBreakerOpenDuration, "ams-circuit-breaker-open-duration", c.AMSCircuitBreakerOpenDuration, "Time AMS is not called after it is considered unavailable")
 }
 
 // ReadFiles ...
@@ -120,3 +120,43 @@ func Test_rhSSOClient_DeleteDynamicClient(t *testing.T) {
 	_, err = apiClient.DeleteAcsClient(emptyCtx, dynamicClient.ClientId)
 	assert.Error(t, err)
 }
+
+func Test_rhSSOClient_GetDynamicClients(t *testing.T) {
+	server := mocks.NewMockServer()
+	server.Start()
+	defer server.Stop()
+	clientID, clientSecret := server.GetInitialClientCredentials()
+
+	realmConfig := &iam.IAMRealmConfig{
+		Realm:            "redhat-external",
+		ClientID:         clientID,
+		ClientSecret:     clientSecret, // pragma: allowlist secret
+		BaseURL:          server.BaseURL(),
+		APIEndpointURI:   "/auth/realms/redhat-external",
+		TokenEndpointURI: fmt.Sprintf("%s/auth/realms/redhat-external/protocol/openid-connect/token", server.BaseURL()),
+	}
+
+	apiClient := NewDynamicClientsAPI(realmConfig)
+	for _, name := range []string{"first", "second", "third"} {
+		_, _, err := apiClient.CreateAcsClient(emptyCtx, api.AcsClientRequestData{
+			Name:         name,
+			OrgId:        "orgId",
+			RedirectUris: []string{},
+		})
+		assert.NoError(t, err)
+	}
+
+	// 1. All clients are listed without their secrets
+	clients, _, err := apiClient.GetAcsClients(emptyCtx, 0, 10)
+	assert.NoError(t, err)
+	assert.Len(t, clients, 3)
+	for _, client := range clients {
+		assert.NotEmpty(t, client.ClientId)
+		assert.Empty(t, client.Secret)
+	}
+
+	// 2. Clients are listed page by page
+	clients, _, err = apiClient.GetAcsClients(emptyCtx, 2, 10)
+	assert.NoError(t, err)
+	assert.Len(t, clients, 1)
+}
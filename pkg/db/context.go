@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/stackrox/acs-fleet-manager/pkg/logger"
+	"gorm.io/gorm"
 )
 
 type contextKey int
@@ -34,6 +35,29 @@ func (c *ConnectionFactory) TxContext() (ctx context.Context, err error) {
 	return c.NewContext(context.Background())
 }
 
+// FromContext returns a database connection running its statements in the transaction of the context. If the context
+// has no transaction or it is already resolved, a new connection is returned.
+func (c *ConnectionFactory) FromContext(ctx context.Context) *gorm.DB {
+	tx, ok := ctx.Value(transactionKey).(*txFactory)
+	if !ok || tx.resolved {
+		return c.New()
+	}
+	dbConn := c.New().Session(&gorm.Session{Context: ctx, NewDB: true})
+	dbConn.Statement.ConnPool = tx.tx
+	return dbConn
+}
+
+// MarkForRollback flags the transaction of the context to be rolled back instead of committed when it is resolved
+func MarkForRollback(ctx context.Context, err error) {
+	tx, ok := ctx.Value(transactionKey).(*txFactory)
+	if !ok {
+		logger.NewUHCLogger(ctx).Warningf("Could not mark transaction for rollback: no transaction in context")
+		return
+	}
+	tx.rollbackFlag = true
+	logger.NewUHCLogger(ctx).Infof("Marked transaction for rollback: %v", err)
+}
+
 // Resolve resolves the current transaction according to the rollback flag.
 func Resolve(ctx context.Context) error {
 	tx, ok := ctx.Value(transactionKey).(*txFactory)
@@ -9,4 +9,10 @@ var (
 
 	// ClusterMigration enables the feature to migrate a tenant to another cluster
 	ClusterMigration = registerFeature("Cluster migraiton", "RHACS_CLUSTER_MIGRATION", true)
+
+	// CustomerAuthProviders enables the public API for customers to add their own OIDC providers to Central instances
+	CustomerAuthProviders = registerFeature("Customer OIDC auth providers for Central instances", "RHACS_CUSTOMER_AUTH_PROVIDERS", false)
+
+	// CustomerAPIKeys enables API keys for the machine access of customers to the public API
+	CustomerAPIKeys = registerFeature("Customer API keys for the public API", "RHACS_CUSTOMER_API_KEYS", false)
 )
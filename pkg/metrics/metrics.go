@@ -68,6 +68,45 @@ const (
 	// ClusterStatusCapacityUsed - metric name for the current number of instances
 	ClusterStatusCapacityUsed = "cluster_status_capacity_used"
 
+	// ClusterHealthScore - metric name for the health score of a data plane cluster derived from fleetshard-sync heartbeats
+	ClusterHealthScore = "cluster_health_score"
+	// ClusterHeartbeatAge - metric name for the time since the last heartbeat of fleetshard-sync on a data plane cluster
+	ClusterHeartbeatAge = "cluster_heartbeat_age_in_seconds"
+	// ClusterHeartbeatSilent - metric name for data plane clusters whose fleetshard-sync stopped sending heartbeats
+	ClusterHeartbeatSilent = "cluster_heartbeat_silent"
+
+	// ClusterCapacityProjectedCentrals - metric name for the forecast number of Centrals per region and instance type
+	ClusterCapacityProjectedCentrals = "cluster_capacity_projected_centrals"
+	// ClusterCapacityRemaining - metric name for the forecast remaining capacity per region and instance type
+	ClusterCapacityRemaining = "cluster_capacity_remaining"
+	// ClusterScaleOutDecision - metric name for the current scale-out decision per region and instance type
+	ClusterScaleOutDecision = "cluster_scale_out_decision"
+	// ClusterScaleOutRequestCount - metric name for the number of clusters requested by automatic scale-out
+	ClusterScaleOutRequestCount = "cluster_scale_out_request_count"
+
+	// CentralDNSRecordDrift - metric name for the number of DNS records of Centrals which drifted from their routes
+	CentralDNSRecordDrift = "central_dns_record_drift"
+	// CentralDNSRecordRepairCount - metric name for the number of drifted DNS records of Centrals which were repaired
+	CentralDNSRecordRepairCount = "central_dns_record_repair_count"
+
+	// CentralRHSSOOrphanedClients - metric name for the number of RHSSO dynamic clients which no longer belong to a Central
+	CentralRHSSOOrphanedClients = "central_rhsso_orphaned_clients"
+	// CentralRHSSOOrphanedClientDeletionCount - metric name for the number of orphaned RHSSO dynamic clients which were deleted
+	CentralRHSSOOrphanedClientDeletionCount = "central_rhsso_orphaned_client_deletion_count"
+
+	// CentralOIDCClientAge - metric name for the age of the OIDC clients of Centrals in seconds
+	CentralOIDCClientAge = "central_oidc_client_age_seconds"
+	// CentralOIDCClientRotationCount - metric name for the number of scheduled OIDC client rotations
+	CentralOIDCClientRotationCount = "central_oidc_client_rotation_count"
+
+	// AdminAuditRecordFailureCount - metric name for the number of admin mutations of Centrals which could not be recorded in the audit trail
+	AdminAuditRecordFailureCount = "admin_audit_record_failure_count"
+
+	// AMSCacheRequestCount - metric name for the number of cached AMS calls by operation and result
+	AMSCacheRequestCount = "ams_cache_request_count"
+	// AMSCircuitBreakerOpen - metric name for whether AMS is considered unavailable
+	AMSCircuitBreakerOpen = "ams_circuit_breaker_open"
+
 	// GitopsConfigProviderErrorCount - metric name for the number of errors encountered while fetching GitOps config
 	GitopsConfigProviderErrorCount = "gitops_config_provider_error_count"
 
@@ -75,6 +114,11 @@ const (
 	LabelDatabaseQueryType   = "query"
 	LabelRegion              = "region"
 	LabelInstanceType        = "instance_type"
+	LabelCloudProvider       = "cloud_provider"
+	LabelDecision            = "decision"
+	LabelDrift               = "drift"
+	LabelClientOrigin        = "client_origin"
+	LabelResult              = "result"
 )
 
 // JobType metric to capture
@@ -302,6 +346,262 @@ func UpdateCentralPerClusterCountMetric(clusterID string, clusterExternalID stri
 	centralPerClusterCountMetric.With(labels).Set(float64(count))
 }
 
+var clusterHealthMetricsLabels = []string{
+	LabelClusterID,
+}
+
+var clusterHealthScoreMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: FleetManager,
+		Name:      ClusterHealthScore,
+		Help:      "health score between 0 and 100 of a data plane cluster derived from fleetshard-sync heartbeats",
+	},
+	clusterHealthMetricsLabels,
+)
+
+var clusterHeartbeatAgeMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: FleetManager,
+		Name:      ClusterHeartbeatAge,
+		Help:      "time since the last heartbeat of fleetshard-sync on a data plane cluster",
+	},
+	clusterHealthMetricsLabels,
+)
+
+var clusterHeartbeatSilentMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: FleetManager,
+		Name:      ClusterHeartbeatSilent,
+		Help:      "1 if fleetshard-sync on a data plane cluster stopped sending heartbeats, 0 otherwise",
+	},
+	clusterHealthMetricsLabels,
+)
+
+// UpdateClusterHealthMetrics ...
+func UpdateClusterHealthMetrics(clusterID string, score int, heartbeatAge time.Duration, silent bool) {
+	labels := prometheus.Labels{
+		LabelClusterID: clusterID,
+	}
+	clusterHealthScoreMetric.With(labels).Set(float64(score))
+	clusterHeartbeatAgeMetric.With(labels).Set(heartbeatAge.Seconds())
+	if silent {
+		clusterHeartbeatSilentMetric.With(labels).Set(1)
+	} else {
+		clusterHeartbeatSilentMetric.With(labels).Set(0)
+	}
+}
+
+// ResetClusterHealthMetrics removes the health metrics of all clusters
+func ResetClusterHealthMetrics() {
+	clusterHealthScoreMetric.Reset()
+	clusterHeartbeatAgeMetric.Reset()
+	clusterHeartbeatSilentMetric.Reset()
+}
+
+var clusterCapacityForecastLabels = []string{
+	LabelCloudProvider,
+	LabelRegion,
+	LabelInstanceType,
+}
+
+var clusterCapacityProjectedCentralsMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: FleetManager,
+		Name:      ClusterCapacityProjectedCentrals,
+		Help:      "number of Centrals per region and instance type forecast at the end of the capacity forecast horizon",
+	},
+	clusterCapacityForecastLabels,
+)
+
+var clusterCapacityRemainingMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: FleetManager,
+		Name:      ClusterCapacityRemaining,
+		Help:      "remaining capacity per region and instance type forecast at the end of the capacity forecast horizon",
+	},
+	clusterCapacityForecastLabels,
+)
+
+var clusterScaleOutDecisionMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: FleetManager,
+		Name:      ClusterScaleOutDecision,
+		Help:      "1 for the current scale-out decision per region and instance type",
+	},
+	append(clusterCapacityForecastLabels, LabelDecision),
+)
+
+var clusterScaleOutRequestCountMetric = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: FleetManager,
+		Name:      ClusterScaleOutRequestCount,
+		Help:      "number of data plane clusters requested by automatic scale-out",
+	},
+	clusterCapacityForecastLabels,
+)
+
+// UpdateClusterCapacityForecastMetrics ...
+func UpdateClusterCapacityForecastMetrics(cloudProvider, region, instanceType string, projectedCentrals, remaining int, decision string) {
+	labels := prometheus.Labels{
+		LabelCloudProvider: cloudProvider,
+		LabelRegion:        region,
+		LabelInstanceType:  instanceType,
+	}
+	clusterCapacityProjectedCentralsMetric.With(labels).Set(float64(projectedCentrals))
+	clusterCapacityRemainingMetric.With(labels).Set(float64(remaining))
+	labels[LabelDecision] = decision
+	clusterScaleOutDecisionMetric.With(labels).Set(1)
+}
+
+// IncreaseClusterScaleOutRequestCountMetric ...
+func IncreaseClusterScaleOutRequestCountMetric(cloudProvider, region, instanceType string) {
+	labels := prometheus.Labels{
+		LabelCloudProvider: cloudProvider,
+		LabelRegion:        region,
+		LabelInstanceType:  instanceType,
+	}
+	clusterScaleOutRequestCountMetric.With(labels).Inc()
+}
+
+// ResetClusterCapacityForecastMetrics removes the capacity forecast of all regions and instance types
+func ResetClusterCapacityForecastMetrics() {
+	clusterCapacityProjectedCentralsMetric.Reset()
+	clusterCapacityRemainingMetric.Reset()
+	clusterScaleOutDecisionMetric.Reset()
+}
+
+var centralDNSRecordDriftMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: FleetManager,
+		Name:      CentralDNSRecordDrift,
+		Help:      "number of DNS records of Centrals which are missing, point to the wrong router or belong to deleted Centrals with or without owner record",
+	},
+	[]string{LabelDrift},
+)
+
+var centralDNSRecordRepairCountMetric = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: FleetManager,
+		Name:      CentralDNSRecordRepairCount,
+		Help:      "number of drifted DNS records of Centrals which were repaired",
+	},
+	[]string{LabelDrift},
+)
+
+// UpdateCentralDNSRecordDriftMetric ...
+func UpdateCentralDNSRecordDriftMetric(drift string, count int) {
+	centralDNSRecordDriftMetric.With(prometheus.Labels{LabelDrift: drift}).Set(float64(count))
+}
+
+// IncreaseCentralDNSRecordRepairCountMetric ...
+func IncreaseCentralDNSRecordRepairCountMetric(drift string) {
+	centralDNSRecordRepairCountMetric.With(prometheus.Labels{LabelDrift: drift}).Inc()
+}
+
+var centralRHSSOOrphanedClientsMetric = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Subsystem: FleetManager,
+		Name:      CentralRHSSOOrphanedClients,
+		Help:      "number of RHSSO dynamic clients older than the grace period which no longer belong to a Central",
+	},
+)
+
+var centralRHSSOOrphanedClientDeletionCountMetric = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Subsystem: FleetManager,
+		Name:      CentralRHSSOOrphanedClientDeletionCount,
+		Help:      "number of orphaned RHSSO dynamic clients which were deleted",
+	},
+)
+
+var centralOIDCClientAgeMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: FleetManager,
+		Name:      CentralOIDCClientAge,
+		Help:      "age of the OIDC client of a Central in seconds",
+	},
+	[]string{LabelID, LabelClientOrigin},
+)
+
+var centralOIDCClientRotationCountMetric = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: FleetManager,
+		Name:      CentralOIDCClientRotationCount,
+		Help:      "number of scheduled OIDC client rotations by result",
+	},
+	[]string{LabelResult},
+)
+
+var amsCacheRequestCountMetric = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: FleetManager,
+		Name:      AMSCacheRequestCount,
+		Help:      "number of cached AMS calls by operation and result (hit, negative_hit, miss, stale or error)",
+	},
+	[]string{labelOperation, LabelResult},
+)
+
+var amsCircuitBreakerOpenMetric = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Subsystem: FleetManager,
+		Name:      AMSCircuitBreakerOpen,
+		Help:      "1 if AMS is considered unavailable after consecutive failures and is not called, 0 otherwise",
+	},
+)
+
+// IncreaseAMSCacheRequestCountMetric ...
+func IncreaseAMSCacheRequestCountMetric(operation string, result string) {
+	amsCacheRequestCountMetric.With(prometheus.Labels{labelOperation: operation, LabelResult: result}).Inc()
+}
+
+// UpdateAMSCircuitBreakerOpenMetric ...
+func UpdateAMSCircuitBreakerOpenMetric(open bool) {
+	if open {
+		amsCircuitBreakerOpenMetric.Set(1)
+		return
+	}
+	amsCircuitBreakerOpenMetric.Set(0)
+}
+
+// ResetCentralOIDCClientAgeMetric removes the ages of all clients, so that clients of deleted Centrals are not
+// reported anymore
+func ResetCentralOIDCClientAgeMetric() {
+	centralOIDCClientAgeMetric.Reset()
+}
+
+// UpdateCentralOIDCClientAgeMetric ...
+func UpdateCentralOIDCClientAgeMetric(centralID string, clientOrigin string, age time.Duration) {
+	centralOIDCClientAgeMetric.With(prometheus.Labels{LabelID: centralID, LabelClientOrigin: clientOrigin}).Set(age.Seconds())
+}
+
+// IncreaseCentralOIDCClientRotationCountMetric ...
+func IncreaseCentralOIDCClientRotationCountMetric(result string) {
+	centralOIDCClientRotationCountMetric.With(prometheus.Labels{LabelResult: result}).Inc()
+}
+
+// UpdateCentralRHSSOOrphanedClientsMetric ...
+func UpdateCentralRHSSOOrphanedClientsMetric(count int) {
+	centralRHSSOOrphanedClientsMetric.Set(float64(count))
+}
+
+// IncreaseCentralRHSSOOrphanedClientDeletionCountMetric ...
+func IncreaseCentralRHSSOOrphanedClientDeletionCountMetric() {
+	centralRHSSOOrphanedClientDeletionCountMetric.Inc()
+}
+
+var adminAuditRecordFailureCountMetric = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Subsystem: FleetManager,
+		Name:      AdminAuditRecordFailureCount,
+		Help:      "number of admin mutations of Centrals which were applied but could not be recorded in the audit trail",
+	},
+)
+
+// IncreaseAdminAuditRecordFailureCountMetric ...
+func IncreaseAdminAuditRecordFailureCountMetric() {
+	adminAuditRecordFailureCountMetric.Inc()
+}
+
 // create a new gaugeVec with the total number of expired centrals
 var expiredCentralsMetric = prometheus.NewGaugeVec(
 	prometheus.GaugeOpts{
@@ -646,6 +946,13 @@ func init() {
 	prometheus.MustRegister(centralPerClusterCountMetric)
 	prometheus.MustRegister(clusterStatusCapacityMaxMetric)
 	prometheus.MustRegister(clusterStatusCapacityUsedMetric)
+	prometheus.MustRegister(clusterHealthScoreMetric)
+	prometheus.MustRegister(clusterHeartbeatAgeMetric)
+	prometheus.MustRegister(clusterHeartbeatSilentMetric)
+	prometheus.MustRegister(clusterCapacityProjectedCentralsMetric)
+	prometheus.MustRegister(clusterCapacityRemainingMetric)
+	prometheus.MustRegister(clusterScaleOutDecisionMetric)
+	prometheus.MustRegister(clusterScaleOutRequestCountMetric)
 	prometheus.MustRegister(GitopsConfigProviderErrorCounter)
 
 	// metrics for Centrals
@@ -655,6 +962,15 @@ func init() {
 	prometheus.MustRegister(centralStatusSinceCreatedMetric)
 	prometheus.MustRegister(CentralStatusCountMetric)
 	prometheus.MustRegister(expiredCentralsMetric)
+	prometheus.MustRegister(centralDNSRecordDriftMetric)
+	prometheus.MustRegister(centralDNSRecordRepairCountMetric)
+	prometheus.MustRegister(centralRHSSOOrphanedClientsMetric)
+	prometheus.MustRegister(centralRHSSOOrphanedClientDeletionCountMetric)
+	prometheus.MustRegister(centralOIDCClientAgeMetric)
+	prometheus.MustRegister(centralOIDCClientRotationCountMetric)
+	prometheus.MustRegister(adminAuditRecordFailureCountMetric)
+	prometheus.MustRegister(amsCacheRequestCountMetric)
+	prometheus.MustRegister(amsCircuitBreakerOpenMetric)
 
 	// metrics for reconcilers
 	prometheus.MustRegister(reconcilerDurationMetric)
@@ -684,6 +1000,7 @@ func ResetMetricsForClusterManagers() {
 	centralPerClusterCountMetric.Reset()
 	clusterStatusCapacityMaxMetric.Reset()
 	clusterStatusCapacityUsedMetric.Reset()
+	ResetClusterCapacityForecastMetrics()
 }
 
 // ResetMetricsForReconcilers will reset the metrics related to the reconcilers
@@ -705,6 +1022,9 @@ func Reset() {
 	centralPerClusterCountMetric.Reset()
 	clusterStatusCapacityMaxMetric.Reset()
 	clusterStatusCapacityUsedMetric.Reset()
+	ResetClusterHealthMetrics()
+	ResetClusterCapacityForecastMetrics()
+	clusterScaleOutRequestCountMetric.Reset()
 	GitopsConfigProviderErrorCounter.Reset()
 
 	requestCentralCreationDurationMetric.Reset()
@@ -713,6 +1033,13 @@ func Reset() {
 	centralStatusSinceCreatedMetric.Reset()
 	CentralStatusCountMetric.Reset()
 	expiredCentralsMetric.Reset()
+	centralDNSRecordDriftMetric.Reset()
+	centralDNSRecordRepairCountMetric.Reset()
+	centralRHSSOOrphanedClientsMetric.Set(0)
+	centralOIDCClientAgeMetric.Reset()
+	centralOIDCClientRotationCountMetric.Reset()
+	amsCacheRequestCountMetric.Reset()
+	amsCircuitBreakerOpenMetric.Set(0)
 
 	reconcilerDurationMetric.Reset()
 	reconcilerSuccessCountMetric.Reset()
@@ -70,7 +70,8 @@ func ServiceProviders() di.Option {
 			return ocm.NewClient(conn)
 		}),
 
-		di.Provide(func(config *ocm.OCMConfig) ocm.AMSClient {
+		di.Provide(ocm.NewAMSCircuitBreaker),
+		di.Provide(func(config *ocm.OCMConfig, breaker *ocm.AMSCircuitBreaker) ocm.AMSClient {
 			if config.EnableMock {
 				return ocm.NewMockClient()
 			}
@@ -79,11 +80,12 @@ func ServiceProviders() di.Option {
 			if err != nil {
 				logger.Logger.Error(err)
 			}
-			return ocm.NewClient(conn)
+			return ocm.NewCachedAMSClient(ocm.NewClient(conn), breaker, config)
 		}),
 
 		di.Provide(aws.NewDefaultClientFactory, di.As(new(aws.ClientFactory))),
 
+		di.Provide(acl.NewAccessRuleCache),
 		di.Provide(acl.NewAccessControlListMiddleware),
 		di.Provide(handlers.NewErrorsHandler),
 
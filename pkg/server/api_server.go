@@ -10,6 +10,7 @@ import (
 
 	"github.com/goava/di"
 	"github.com/stackrox/acs-fleet-manager/internal/central/pkg/handlers"
+	"github.com/stackrox/acs-fleet-manager/pkg/auth"
 	"github.com/stackrox/acs-fleet-manager/pkg/client/iam"
 	"github.com/stackrox/acs-fleet-manager/pkg/environments"
 	"github.com/stackrox/acs-fleet-manager/pkg/server/logging"
@@ -37,6 +38,11 @@ type ServerOptions struct {
 	IAMConfig    *iam.IAMConfig
 	RouteLoaders []environments.RouteLoader
 	Env          *environments.Env
+	// ClusterIdentities provides the fleetshard identities bound to data plane clusters, whose issuers are
+	// authenticated in addition to the configured data plane issuers
+	ClusterIdentities auth.ClusterIdentitySource `di:"optional"`
+	// APIKeys verifies the API keys customers use for the public API
+	APIKeys auth.APIKeyAuthenticator `di:"optional"`
 }
 
 // NewAPIServer ...
@@ -67,7 +73,7 @@ func NewAPIServer(options ServerOptions) *APIServer {
 	var mainHandler http.Handler = mainRouter
 
 	var err error
-	mainHandler, err = handlers.NewAuthenticationHandler(options.IAMConfig, mainHandler)
+	mainHandler, err = handlers.NewAuthenticationHandler(options.IAMConfig, options.ClusterIdentities, options.APIKeys, mainHandler)
 	check(err, "Unable to create authentication handler", 5*time.Second)
 
 	mainHandler = gorillahandlers.CORS(
@@ -57,8 +57,13 @@ func NewListArguments(params url.Values) *ListArguments {
 	return listArgs
 }
 
-// Validate ...
+// Validate validates the arguments, ordering by the fields of Centrals
 func (la *ListArguments) Validate() error {
+	return la.ValidateWithOrderByParams(GetAcceptedOrderByParams())
+}
+
+// ValidateWithOrderByParams validates the arguments, ordering by the given fields
+func (la *ListArguments) ValidateWithOrderByParams(acceptedOrderByParams []string) error {
 	if la.Page < 0 {
 		return errors.Errorf("page must be equal or greater than 0")
 	}
@@ -78,7 +83,7 @@ func (la *ListArguments) Validate() error {
 				return errors.Errorf("invalid order by clause '%s'", orderByClause)
 			}
 
-			if !shared.Contains(GetAcceptedOrderByParams(), keywords[0]) {
+			if !shared.Contains(acceptedOrderByParams, keywords[0]) {
 				return errors.Errorf("unknown order by field '%s'", keywords[0])
 			}
 
@@ -6,6 +6,9 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"sort"
+	"strconv"
+	"time"
 
 	"github.com/stackrox/acs-fleet-manager/pkg/client/redhatsso/api"
 
@@ -27,6 +30,8 @@ type RedhatSSOMock interface {
 	GetInitialClientCredentials() (string, string)
 	DeleteAllServiceAccounts()
 	ServiceAccountsLimit() int
+	AddDynamicClient(client api.AcsClientResponseData)
+	DynamicClients() []api.AcsClientResponseData
 }
 
 type redhatSSOMock struct {
@@ -77,6 +82,26 @@ func (mockServer *redhatSSOMock) DeleteAllServiceAccounts() {
 	mockServer.serviceAccounts = make(map[string]serviceaccountsclient.ServiceAccountData)
 }
 
+// AddDynamicClient ...
+func (mockServer *redhatSSOMock) AddDynamicClient(client api.AcsClientResponseData) {
+	mockServer.dynamicClients[client.ClientId] = client
+}
+
+// DynamicClients returns the dynamic clients ordered by their creation time
+func (mockServer *redhatSSOMock) DynamicClients() []api.AcsClientResponseData {
+	clients := make([]api.AcsClientResponseData, 0, len(mockServer.dynamicClients))
+	for _, client := range mockServer.dynamicClients {
+		clients = append(clients, client)
+	}
+	sort.Slice(clients, func(i, j int) bool {
+		if clients[i].CreatedAt != clients[j].CreatedAt {
+			return clients[i].CreatedAt < clients[j].CreatedAt
+		}
+		return clients[i].ClientId < clients[j].ClientId
+	})
+	return clients
+}
+
 // Start ...
 func (mockServer *redhatSSOMock) Start() {
 	mockServer.server.Start()
@@ -138,6 +163,7 @@ func (mockServer *redhatSSOMock) init() {
 	bearerTokenAuthRouter.HandleFunc("/auth/realms/redhat-external/apis/service_accounts/v1/{id}", mockServer.updateServiceAccountHandler).Methods("PATCH")
 	bearerTokenAuthRouter.HandleFunc("/auth/realms/redhat-external/apis/service_accounts/v1/{id}/resetSecret", mockServer.regenerateSecretHandler).Methods("POST")
 	bearerTokenAuthRouter.HandleFunc("/auth/realms/redhat-external/apis/beta/acs/v1", mockServer.createDynamicClientHandler).Methods("POST")
+	bearerTokenAuthRouter.HandleFunc("/auth/realms/redhat-external/apis/beta/acs/v1", mockServer.getDynamicClientsHandler).Methods("GET")
 	bearerTokenAuthRouter.HandleFunc("/auth/realms/redhat-external/apis/beta/acs/v1/{clientId}", mockServer.deleteDynamicClientHandler).Methods("DELETE")
 
 	mockServer.server = httptest.NewUnstartedServer(r)
@@ -324,6 +350,33 @@ func (mockServer *redhatSSOMock) deleteDynamicClientHandler(w http.ResponseWrite
 	}
 }
 
+func (mockServer *redhatSSOMock) getDynamicClientsHandler(w http.ResponseWriter, r *http.Request) {
+	first, err := strconv.Atoi(r.URL.Query().Get("first"))
+	if err != nil || first < 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	max, err := strconv.Atoi(r.URL.Query().Get("max"))
+	if err != nil || max < 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	res := make([]api.AcsClientResponseData, 0)
+	for i, client := range mockServer.DynamicClients() {
+		if i >= first && len(res) < max {
+			// the secret is only returned when the client is created
+			client.Secret = ""
+			res = append(res, client)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	data, _ := json.Marshal(res)
+	_, _ = w.Write(data)
+}
+
 func (mockServer *redhatSSOMock) createDynamicClientHandler(w http.ResponseWriter, r *http.Request) {
 	requestData, err := ioutil.ReadAll(r.Body)
 	defer r.Body.Close()
@@ -346,9 +399,10 @@ func (mockServer *redhatSSOMock) createDynamicClientHandler(w http.ResponseWrite
 	secret := uuid.New().String()
 
 	acsClientResponseData := api.AcsClientResponseData{
-		ClientId: clientID,
-		Secret:   secret, // pragma: allowlist secret
-		Name:     acsClientRequestData.Name,
+		ClientId:  clientID,
+		Secret:    secret, // pragma: allowlist secret
+		Name:      acsClientRequestData.Name,
+		CreatedAt: time.Now().UnixMilli(),
 	}
 
 	mockServer.dynamicClients[clientID] = acsClientResponseData